@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -41,6 +43,12 @@ type ServerConfig struct {
 	DisableActivity  bool `ini:"disable_activity"`
 	EnableLogExplain bool `ini:"enable_log_explain"`
 
+	// db_password, aws_access_key_id and aws_secret_access_key may also reference a
+	// Secrets Manager secret ARN, SSM parameter ARN, or SSM parameter path prefixed
+	// with "ssm:", to have the collector fetch the current value
+	//
+	// db_password, api_key and the db_ssl*_contents settings may also reference an
+	// Azure Key Vault secret URI (e.g. "https://myvault.vault.azure.net/secrets/name")
 	DbURL                 string `ini:"db_url"`
 	DbName                string `ini:"db_name"`
 	DbUsername            string `ini:"db_username"`
@@ -63,15 +71,84 @@ type ServerConfig struct {
 	DbExtraNames []string // Additional databases that should be fetched (determined by additional databases in db_name)
 	DbAllNames   bool     // All databases except template databases should be fetched (determined by * in the db_name list)
 
+	// Monitor the worker nodes of the Citus cluster (e.g. Azure Cosmos DB for PostgreSQL)
+	// whose coordinator this section connects to as additional servers, with the
+	// settings of this section
+	CitusWorkers bool `ini:"citus_workers"`
+
+	CitusCoordinatorSection string // Section of the coordinator, only set for workers discovered through citus_workers
+
 	AwsRegion               string `ini:"aws_region"`
 	AwsAccountID            string `ini:"aws_account_id"`
 	AwsDbInstanceID         string `ini:"aws_db_instance_id"`
+	AwsDbClusterID          string `ini:"aws_db_cluster_id"`
 	AwsAccessKeyID          string `ini:"aws_access_key_id"`
 	AwsSecretAccessKey      string `ini:"aws_secret_access_key"`
 	AwsAssumeRole           string `ini:"aws_assume_role"`
 	AwsWebIdentityTokenFile string `ini:"aws_web_identity_token_file"`
 	AwsRoleArn              string `ini:"aws_role_arn"`
 
+	// Monitor the readers of the Aurora cluster specified in aws_db_cluster_id as
+	// additional servers, with the settings of this section. Readers that are added
+	// to or removed from the cluster are picked up automatically.
+	AwsDbClusterReaders bool `ini:"aws_db_cluster_readers"`
+
+	// Additional settings for assuming aws_assume_role, e.g. for cross-account access
+	// that requires an external ID. Session tags are specified as comma separated
+	// key=value pairs (e.g. "team=dba,env=production").
+	//
+	// aws_assume_role may also be a comma separated list of roles that are assumed in
+	// sequence (e.g. an organization access role, then a role in the instance's
+	// account). The external ID and tags only apply to the last role in the list.
+	AwsAssumeRoleExternalID  string `ini:"aws_assume_role_external_id"`
+	AwsAssumeRoleSessionName string `ini:"aws_assume_role_session_name"`
+	AwsAssumeRoleTags        string `ini:"aws_assume_role_tags"`
+
+	// Use IAM database authentication for the monitoring connection, with a token
+	// generated from the AWS credentials instead of db_password (db_username needs
+	// to be granted the rds_iam role)
+	AwsDbIAMAuth bool `ini:"aws_db_iam_auth"`
+
+	// Import database load and wait events from Performance Insights into activity
+	// snapshots (Performance Insights needs to be enabled for the instance)
+	AwsPerformanceInsights bool `ini:"aws_performance_insights"`
+
+	// CloudWatch metrics are used for system statistics when Enhanced Monitoring
+	// is disabled. The period (in seconds, at least 60) determines what each value
+	// is averaged over, and the metric list (comma separated, e.g.
+	// "CPUUtilization,FreeableMemory") limits which metrics are fetched.
+	AwsCloudWatchPeriod  int    `ini:"aws_cloudwatch_period"`
+	AwsCloudWatchMetrics string `ini:"aws_cloudwatch_metrics"`
+
+	// Emit RDS events of the instance (and its Aurora cluster), such as failovers,
+	// restarts and parameter group changes, as log lines
+	AwsDbEvents bool `ini:"aws_db_events"`
+
+	// Name of the RDS Proxy that applications use to connect to this server, whose
+	// CloudWatch metrics (client connections, database connections and query
+	// requests) show pool pressure that isn't visible in pg_stat_activity
+	AwsDbProxyName string `ini:"aws_db_proxy_name"`
+
+	// Kinesis Data Stream that receives the instance's Postgres logs through a
+	// CloudWatch Logs subscription filter, used instead of downloading log files
+	AwsKinesisLogStream string `ini:"aws_kinesis_log_stream"`
+
+	// Address to listen on for Firehose HTTP endpoint deliveries of the instance's
+	// CloudWatch Logs (e.g. ":8443"), also used instead of downloading log files.
+	// Without a certificate the listener uses plain HTTP, for use behind a load
+	// balancer that terminates TLS.
+	AwsFirehoseListenAddress string `ini:"aws_firehose_listen_address"`
+	AwsFirehoseAccessKey     string `ini:"aws_firehose_access_key"`
+	AwsFirehoseTLSCert       string `ini:"aws_firehose_tls_cert"`
+	AwsFirehoseTLSKey        string `ini:"aws_firehose_tls_key"`
+
+	// S3 bucket (and optional prefix) that Firehose writes the instance's CloudWatch
+	// Logs to, polled for new objects instead of downloading log files. With a queue
+	// URL, new objects are found through S3 event notifications sent to the SQS queue.
+	AwsS3LogBucket      string `ini:"aws_s3_log_bucket"`
+	AwsS3LogPrefix      string `ini:"aws_s3_log_prefix"`
+	AwsS3LogSqsQueueURL string `ini:"aws_s3_log_sqs_queue_url"`
+
 	// Support for custom AWS endpoints
 	// See https://docs.aws.amazon.com/sdk-for-go/api/aws/endpoints/
 	AwsEndpointSigningRegion       string `ini:"aws_endpoint_signing_region"`
@@ -81,6 +158,10 @@ type ServerConfig struct {
 	AwsEndpointCloudwatchURL       string `ini:"aws_endpoint_cloudwatch_url"`
 	AwsEndpointCloudwatchLogsURL   string `ini:"aws_endpoint_cloudwatch_logs_url"`
 
+	// Endpoint overrides for any other service, as comma separated pairs of the
+	// service's endpoint ID and URL (e.g. "sts=https://sts.us-gov-west-1.amazonaws.com")
+	AwsEndpointURLs string `ini:"aws_endpoint_urls"`
+
 	AzureDbServerName          string `ini:"azure_db_server_name"`
 	AzureEventhubNamespace     string `ini:"azure_eventhub_namespace"`
 	AzureEventhubName          string `ini:"azure_eventhub_name"`
@@ -90,15 +171,118 @@ type ServerConfig struct {
 	AzureADCertificatePath     string `ini:"azure_ad_certificate_path"`
 	AzureADCertificatePassword string `ini:"azure_ad_certificate_password"`
 
+	// Azure resource ID of the server (e.g. "/subscriptions/.../flexibleServers/mydb"),
+	// which Event Hub log records are matched on instead of the server name when set
+	AzureDbResourceID string `ini:"azure_db_resource_id"`
+
+	// Use Azure AD authentication for the monitoring connection, with a token for the
+	// service principal (azure_ad_client_secret or azure_ad_certificate_path) or the
+	// managed identity instead of db_password
+	AzureDbADAuth bool `ini:"azure_db_ad_auth"`
+
+	// Consumer group used to receive from the Event Hub (defaults to "$Default")
+	AzureEventhubConsumerGroup string `ini:"azure_eventhub_consumer_group"`
+
+	// Blob Storage container that Event Hub partition offsets are checkpointed to, so that
+	// restarts resume where they left off, and multiple collectors can share the partitions
+	AzureEventhubCheckpointStorageAccount string `ini:"azure_eventhub_checkpoint_storage_account"`
+	AzureEventhubCheckpointContainer      string `ini:"azure_eventhub_checkpoint_container"`
+
+	// Heroku Postgres databases whose log drain messages belong to this server, as comma separated
+	// "<app>/<attachment>" pairs (e.g. "myapp/HEROKU_POSTGRESQL_RED"), where the app is the last part
+	// of the drain URL (".../logs/myapp"). Otherwise servers are matched based on the messages
+	// emitted by the collector (see logs.EmitTestLogMsg).
+	HerokuLogSources string `ini:"heroku_log_sources"`
+
 	GcpCloudSQLInstanceID string `ini:"gcp_cloudsql_instance_id"`
-	GcpPubsubSubscription string `ini:"gcp_pubsub_subscription"`
+	GcpPubsubSubscription string `ini:"gcp_pubsub_subscription"` // one or more subscriptions (comma separated)
 	GcpCredentialsFile    string `ini:"gcp_credentials_file"`
 
+	// Service account to impersonate when accessing GCP APIs, using the credentials
+	// file (or the default credentials) as the base identity
+	GcpImpersonateServiceAccount string `ini:"gcp_impersonate_service_account"`
+
+	// Only acknowledge Pub/Sub messages once they've been handed off for processing,
+	// instead of immediately when they are received
+	GcpPubsubAckAfterProcessing bool `ini:"gcp_pubsub_ack_after_processing"`
+
+	// Flow control for the Pub/Sub subscriber, to bound memory usage with high log
+	// volume (the client library defaults are used when not set)
+	GcpPubsubMaxOutstandingMessages int `ini:"gcp_pubsub_max_outstanding_messages"`
+	GcpPubsubMaxOutstandingBytes    int `ini:"gcp_pubsub_max_outstanding_bytes"`
+	GcpPubsubNumGoroutines          int `ini:"gcp_pubsub_num_goroutines"`
+
+	// Regional Pub/Sub endpoint, e.g. "europe-west3-pubsub.googleapis.com:443", for
+	// setups where log data must not leave a specific region
+	GcpPubsubEndpoint string `ini:"gcp_pubsub_endpoint"`
+
+	// Alternative to Pub/Sub, for log sinks that export to a Cloud Storage bucket
+	GcpStorageLogBucket string `ini:"gcp_storage_log_bucket"`
+	GcpStorageLogPrefix string `ini:"gcp_storage_log_prefix"`
+
+	// Alternative to Pub/Sub, that polls the Cloud Logging API for new log entries of
+	// the instance (only requires the Logs Viewer role)
+	GcpLoggingTail bool `ini:"gcp_logging_tail"`
+
+	// Also ingest the pgaudit log stream (cloudsql.googleapis.com/pgaudit.log), in
+	// addition to the regular Postgres logs
+	GcpIncludePgAuditLog bool `ini:"gcp_include_pgaudit_log"`
+
 	// Optional, we recommend passing the full "Connection name" as GCP CloudSQL instance ID
 	GcpProjectID string `ini:"gcp_project_id"`
 
+	// Connect to the Cloud SQL instance directly through its server-side proxy, like
+	// the Cloud SQL Auth Proxy does, instead of using db_host/db_port
+	//
+	// With IAM database authentication, db_username needs to be set to the IAM user
+	// (e.g. "collector@project.iam" for a service account), and no password is needed.
+	GcpCloudSQLUseConnector bool   `ini:"gcp_cloudsql_use_connector"`
+	GcpCloudSQLIAMAuth      bool   `ini:"gcp_cloudsql_iam_auth"`
+	GcpCloudSQLIPType       string `ini:"gcp_cloudsql_ip_type"` // public/private (defaults to "public")
+
+	// AlloyDB clusters can have multiple instances (a primary and read pools), each
+	// instance should be configured as its own server with the same cluster ID
+	GcpAlloyDBClusterID  string `ini:"gcp_alloydb_cluster_id"`
+	GcpAlloyDBInstanceID string `ini:"gcp_alloydb_instance_id"`
+
 	CrunchyBridgeClusterID string `ini:"crunchy_bridge_cluster_id"`
 
+	// API key for the Crunchy Bridge API, used to get cluster information and metrics
+	CrunchyBridgeAPIKey string `ini:"crunchy_bridge_api_key"`
+
+	// Reference ID of the Supabase project (e.g. "abcdefghijklmnopqrst"), detected
+	// automatically from the database or connection pooler host name
+	SupabaseProjectRef string `ini:"supabase_project_ref"`
+
+	// Address to listen on for requests of a Supabase HTTP log drain (e.g. ":8080"),
+	// whose Authorization header needs to match the token ("Bearer <token>"). As with
+	// Firehose, a certificate enables TLS, otherwise plain HTTP is used.
+	SupabaseLogDrainListenAddress string `ini:"supabase_log_drain_listen_address"`
+	SupabaseLogDrainToken         string `ini:"supabase_log_drain_token"`
+	SupabaseLogDrainTLSCert       string `ini:"supabase_log_drain_tls_cert"`
+	SupabaseLogDrainTLSKey        string `ini:"supabase_log_drain_tls_key"`
+
+	// Patroni REST API of the node this server connects to (e.g. "http://10.0.0.1:8008"), used
+	// to record the cluster topology and its failover history. Credentials are only needed if
+	// the REST API requires authentication for read-only endpoints.
+	PatroniAPIURL      string `ini:"patroni_api_url"`
+	PatroniAPIUsername string `ini:"patroni_api_username"`
+	PatroniAPIPassword string `ini:"patroni_api_password"`
+
+	// PgBouncer admin console URLs of the poolers in front of this server, comma separated if
+	// there are multiple (e.g. "postgres://pgbouncer_stats@10.0.0.5:6432/pgbouncer"). The user
+	// needs to be listed in stats_users (or admin_users) of the PgBouncer configuration.
+	PgBouncerURL string `ini:"pgbouncer_url"`
+
+	// Pgpool-II instance that load balances connections to this server and its standbys
+	// (e.g. "postgres://pganalyze@10.0.0.5:9999/postgres"), whose node status, child processes
+	// and in-memory query cache statistics are collected
+	PgpoolURL string `ini:"pgpool_url"`
+
+	// Connection string of the pg_auto_failover monitor (e.g. "postgres://autoctl_node@monitor:5432/pg_auto_failover"),
+	// used to record the state of the server's node and its recent state transitions
+	PgAutoFailoverMonitorURL string `ini:"pg_auto_failover_monitor_url"`
+
 	SectionName string
 	Identifier  ServerIdentifier
 
@@ -111,15 +295,89 @@ type ServerConfig struct {
 	// or a file - needs to readable by the regular pganalyze user
 	LogLocation string `ini:"db_log_location"`
 
-	// Configures the collector to tail a local docker container using
-	// "docker logs -t" - this is currently experimental and mostly intended for
-	// development and debugging. The value needs to be the name of the container.
+	// Configures the collector to tail the output of a local docker container,
+	// using the Docker API (at DOCKER_HOST, or /var/run/docker.sock otherwise).
+	// The value needs to be the name or ID of the container.
 	LogDockerTail string `ini:"db_log_docker_tail"`
 
+	// Alternatively to db_log_docker_tail, tails all running containers that have
+	// the given label ("key" or "key=value"), e.g. to follow a container that
+	// gets recreated with a new name on upgrades
+	LogDockerLabel string `ini:"db_log_docker_label"`
+
+	// Configures the collector to read Postgres log lines from a named pipe (FIFO)
+	// at the given path, or from the collector's standard input with "-", for
+	// log shippers that can only write to a pipe. Each pipe belongs to the server
+	// of the section, and stdin can only be used by one section.
+	LogPipe string `ini:"db_log_pipe"`
+
+	// Configures the collector to stream the logs of the Postgres pods selected
+	// by the label selector (e.g. "cnpg.io/cluster=mycluster"), using the
+	// Kubernetes API with the service account of the pod the collector runs in.
+	// The namespace defaults to that of the collector, and the container to
+	// "postgres", which is what CloudNativePG and the Zalando operator use.
+	LogKubernetesLabelSelector string `ini:"db_log_kubernetes_label_selector"`
+	LogKubernetesNamespace     string `ini:"db_log_kubernetes_namespace"`
+	LogKubernetesContainer     string `ini:"db_log_kubernetes_container"`
+
+	// Configures the collector to read Postgres log output from the systemd
+	// journal, using "journalctl". The value needs to be the name of the unit
+	// Postgres runs as (e.g. "postgresql@15-main.service").
+	LogJournaldUnit string `ini:"db_log_journald_unit"`
+
+	// Configures the collector to read Postgres log output from the Windows
+	// Event Log (log_destination = eventlog). The value needs to be the event
+	// source Postgres logs as, which is set by event_source ("PostgreSQL" by
+	// default).
+	LogEventLogSource string `ini:"db_log_eventlog_source"`
+
 	// Configures the collector to start a built-in syslog server that listens
 	// on the specifed "hostname:port" for Postgres log messages
 	LogSyslogServer string `ini:"db_log_syslog_server"`
 
+	// Certificate and key (PEM files) for accepting syslog messages over TLS, as
+	// required e.g. by Crunchy Bridge log destinations
+	LogSyslogServerTLSCert string `ini:"db_log_syslog_server_tls_cert"`
+	LogSyslogServerTLSKey  string `ini:"db_log_syslog_server_tls_key"`
+
+	// Transport(s) the built-in syslog server accepts messages on: "tcp" (the
+	// default), "udp", or both as "tcp,udp". TLS settings only apply to TCP.
+	LogSyslogServerProtocol string `ini:"db_log_syslog_server_protocol"`
+
+	// Hostname (or IP address) that identifies this server's syslog messages,
+	// needed when multiple servers send to the same db_log_syslog_server address
+	LogSyslogServerHostname string `ini:"db_log_syslog_server_hostname"`
+
+	// Configures the collector to accept OpenTelemetry (OTLP) log records on the
+	// specified "hostname:port", e.g. from an OpenTelemetry Collector exporter.
+	// The record bodies need to contain the Postgres log output (in stderr or
+	// jsonlog format). Servers can share the same address.
+	LogOtelServer string `ini:"db_log_otel_server"`
+
+	// OTLP transport the receiver accepts: "http" (the default, POST to /v1/logs
+	// with protobuf or JSON encoding) or "grpc"
+	LogOtelServerProtocol string `ini:"db_log_otel_server_protocol"`
+
+	// Resource attributes that identify this server's log records, as comma
+	// separated "key=value" pairs that all need to match (e.g.
+	// "service.name=postgres,host.name=db1"), needed when multiple servers
+	// share the same db_log_otel_server address
+	LogOtelResourceAttributes string `ini:"db_log_otel_resource_attributes"`
+
+	// Configures the collector to accept log records using the Fluent forward
+	// protocol on the specified "hostname:port", e.g. from a Fluentd or Fluent
+	// Bit "forward" output. Servers can share the same address.
+	LogFluentServer string `ini:"db_log_fluent_server"`
+
+	// Tag pattern that identifies this server's records (e.g. "postgres.db1.*",
+	// with "*" matching one tag part and "**" any number of tag parts), needed
+	// when multiple servers share the same db_log_fluent_server address
+	LogFluentTag string `ini:"db_log_fluent_tag"`
+
+	// Shared key that clients need to authenticate with (the forward output's
+	// "shared_key" setting), taken from the first server on an address
+	LogFluentSharedKey string `ini:"db_log_fluent_shared_key"`
+
 	// Specifies a table pattern to ignore - no statistics will be collected for
 	// tables that match the name. This uses Golang's filepath.Match function for
 	// comparison, so you can e.g. use "*" for wildcard matching.
@@ -156,11 +414,92 @@ type ServerConfig struct {
 	// once the server is promoted
 	SkipIfReplica bool `ini:"skip_if_replica"`
 
+	// Serverless Postgres (e.g. Neon) suspends endpoints that are idle - in this mode the
+	// collector only connects for full snapshots (no activity or high frequency query stats),
+	// skips system data, and tolerates connection failures while the endpoint is suspended for
+	// up to the specified number of seconds (defaults to 600). Enabled automatically for Neon,
+	// unless db_serverless is set explicitly.
+	DbServerless                 bool `ini:"db_serverless"`
+	DbServerlessSuspendTolerance int  `ini:"db_serverless_suspend_tolerance"`
+	dbServerlessSet              bool
+
+	// How far back (in minutes) log lines are ingested after the collector starts up,
+	// for log streams that retain a backlog (Google Cloud Pub/Sub, Azure Event Hub),
+	// and for resuming RDS log file downloads from the markers in the state file
+	//
+	// Raising this allows recovering the log data of a collector outage, as long as
+	// the backlog is still retained. Defaults to 1 minute.
+	LogReplayWindow int `ini:"log_replay_window"`
+
+	// Maximum number of log lines sent per classification (e.g. unique constraint violations)
+	// within each interval of log_rate_limit_interval seconds (defaults to 60) - further lines
+	// of that classification are dropped, and their count is reported on the next line that is
+	// sent. Disabled by default (0), unclassified log lines are never dropped.
+	LogRateLimit         int `ini:"log_rate_limit"`
+	LogRateLimitInterval int `ini:"log_rate_limit_interval"`
+
+	// The log_line_prefix the log lines of self-managed servers are written with, to use instead
+	// of the server's log_line_prefix setting (use a quoted value to keep the trailing space)
+	//
+	// Log shippers that wrap the log lines with their own prefix (e.g. a timestamp and tag) can
+	// be handled by setting log_line_prefix_strip to a regular expression that matches it, which
+	// gets removed from the start of each line before parsing.
+	LogLinePrefix            string         `ini:"log_line_prefix"`
+	LogLinePrefixStrip       string         `ini:"log_line_prefix_strip"`
+	LogLinePrefixStripRegexp *regexp.Regexp // Compiled regexp (determined by log_line_prefix_strip)
+
 	// Configuration for PII filtering
 	FilterLogSecret   string `ini:"filter_log_secret"`   // none/all/credential/parsing_error/statement_text/statement_parameter/table_data/ops/unidentified (comma separated)
 	FilterQuerySample string `ini:"filter_query_sample"` // none/all (defaults to "none")
 	FilterQueryText   string `ini:"filter_query_text"`   // none/unparsable (defaults to "unparsable")
 
+	// Collapses variadic IN-lists and multi-row VALUES lists to a single element in query texts,
+	// so the normalized query of each fingerprint is shown in a canonical form, instead of like
+	// the first variant that was seen (query samples and EXPLAIN plans keep the original text)
+	QueryCollapseLists bool `ini:"query_collapse_lists"`
+
+	// Maximum number of queries whose statistics are sent individually with each full snapshot,
+	// picked by their total time, mean time and calls - the statistics of all other queries are
+	// summed up into one "<remaining queries>" entry per database. Disabled by default (0).
+	QueryStatsLimit int `ini:"query_stats_limit"`
+
+	// Controls the role (user) dimension of the query statistics sent with each full snapshot:
+	// "full" reports each query per role (the default), "rollup" only keeps the 10 roles with the
+	// most total time separately and sums up the others as "<all roles>", and "collapse" sums up
+	// all roles, which reduces the number of statistics on servers with many roles
+	QueryStatsUserDimension string `ini:"query_stats_user_dimension"`
+
+	// Interval (in seconds) at which pg_stat_activity is sampled in between activity snapshots, to
+	// catch short lock storms - the samples are aggregated in memory and summarized with the next
+	// activity snapshot, using one additional connection. Disabled by default (0).
+	ActivitySampleInterval int `ini:"activity_sample_interval"`
+
+	// Age (in seconds) after which transactions are reported as long-running with each activity
+	// snapshot, and time after which sessions that are idle in transaction are reported - defaults
+	// to 300 and 60 seconds, 0 disables either
+	LongTransactionThreshold   int `ini:"long_transaction_threshold"`
+	IdleInTransactionThreshold int `ini:"idle_in_transaction_threshold"`
+
+	// Number of backends with the most private memory (of at least 100 MB) whose memory contexts
+	// are written to the Postgres log with each activity snapshot, using the superuser-only
+	// pg_log_backend_memory_contexts (Postgres 14+). Requires the collector to run on the database
+	// server (on Linux), and each backend is logged at most every 10 minutes, since this is costly.
+	// Disabled by default (0).
+	LogBackendMemoryContexts int `ini:"log_backend_memory_contexts"`
+
+	// Replaces query texts in activity snapshots that were truncated at track_activity_query_size
+	// with the full normalized text from pg_stat_statements, found by query ID (Postgres 14+, with
+	// compute_query_id enabled)
+	ActivityFullQueryText bool `ini:"activity_full_query_text"`
+
+	// Redaction rules applied to log line contents before they are sent, one rule per line,
+	// in the format "<regexp> => <replacement>" (use a """ quoted value for multiple rules)
+	//
+	// The replacement may reference capture groups (e.g. "$1"). Rules set in the [pganalyze]
+	// section apply to all servers, unless the server's section sets its own rules.
+	FilterLogRedact      string          `ini:"filter_log_redact"`
+	FilterLogRedactRules []LogRedactRule // Parsed rules (determined by filter_log_redact)
+
 	// HTTP proxy overrides
 	HTTPProxy  string `ini:"http_proxy"`
 	HTTPSProxy string `ini:"https_proxy"`
@@ -171,15 +510,205 @@ type ServerConfig struct {
 	HTTPClientWithRetry *http.Client
 }
 
+// LogRedactRule - Replaces matches of the regular expression in log line contents
+type LogRedactRule struct {
+	Regexp      *regexp.Regexp
+	Replacement string
+}
+
+// ParseLogRedactRules - Parses the redaction rules of the filter_log_redact setting
+func ParseLogRedactRules(input string) ([]LogRedactRule, error) {
+	var rules []LogRedactRule
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, " => ", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("missing \" => \" separator in rule \"%s\"", line)
+		}
+		re, err := regexp.Compile(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression in rule \"%s\": %s", line, err)
+		}
+		rules = append(rules, LogRedactRule{Regexp: re, Replacement: strings.TrimSpace(parts[1])})
+	}
+	return rules, nil
+}
+
 // SupportsLogDownload - Determines whether the specified config can download logs
+//
+// Crunchy Bridge clusters that stream their logs to the built-in syslog server (through a
+// log destination) don't need to download them as well.
 func (config ServerConfig) SupportsLogDownload() bool {
-	return config.AwsDbInstanceID != "" || config.CrunchyBridgeClusterID != ""
+	return (config.AwsDbInstanceID != "" && !config.HasAwsLogStream()) || (config.CrunchyBridgeClusterID != "" && config.LogSyslogServer == "")
+}
+
+// HasAwsLogStream - Determines whether RDS logs are received through CloudWatch Logs subscriptions
+func (config ServerConfig) HasAwsLogStream() bool {
+	return config.AwsKinesisLogStream != "" || config.AwsFirehoseListenAddress != "" || config.AwsS3LogBucket != ""
+}
+
+// GetLogReplayWindow - Gets the duration before startup for which log lines are still ingested
+func (config ServerConfig) GetLogReplayWindow() time.Duration {
+	if config.LogReplayWindow <= 0 {
+		return 1 * time.Minute
+	}
+	return time.Duration(config.LogReplayWindow) * time.Minute
+}
+
+// GetLogRateLimitInterval - Gets the interval over which log lines are counted for log_rate_limit
+func (config ServerConfig) GetLogRateLimitInterval() time.Duration {
+	if config.LogRateLimitInterval <= 0 {
+		return 1 * time.Minute
+	}
+	return time.Duration(config.LogRateLimitInterval) * time.Second
+}
+
+// GetServerlessSuspendTolerance - Gets how long connection failures are not reported as errors for serverless endpoints
+func (config ServerConfig) GetServerlessSuspendTolerance() time.Duration {
+	if config.DbServerlessSuspendTolerance <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(config.DbServerlessSuspendTolerance) * time.Second
+}
+
+// GetAwsCloudWatchPeriod - Gets the period that CloudWatch metrics are averaged over
+func (config ServerConfig) GetAwsCloudWatchPeriod() time.Duration {
+	// RDS only publishes standard resolution metrics
+	if config.AwsCloudWatchPeriod < 60 {
+		return 1 * time.Minute
+	}
+	return time.Duration(config.AwsCloudWatchPeriod/60) * time.Minute
+}
+
+// GetAwsCloudWatchMetrics - Gets the CloudWatch metrics that should be fetched (nil if not restricted)
+func (config ServerConfig) GetAwsCloudWatchMetrics() []string {
+	var metrics []string
+	for _, metric := range strings.Split(config.AwsCloudWatchMetrics, ",") {
+		metric = strings.TrimSpace(metric)
+		if metric != "" {
+			metrics = append(metrics, metric)
+		}
+	}
+	return metrics
+}
+
+// GetAwsAssumeRoles - Gets the roles to assume, in the order they need to be assumed in
+func (config ServerConfig) GetAwsAssumeRoles() []string {
+	var roles []string
+	for _, role := range strings.Split(config.AwsAssumeRole, ",") {
+		role = strings.TrimSpace(role)
+		if role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// GetAwsEndpointURLs - Gets the endpoint URL overrides by AWS service endpoint ID
+func (config ServerConfig) GetAwsEndpointURLs() (map[string]string, error) {
+	urls := make(map[string]string)
+	for _, pair := range strings.Split(config.AwsEndpointURLs, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		keyValue := strings.SplitN(pair, "=", 2)
+		if len(keyValue) != 2 || strings.TrimSpace(keyValue[0]) == "" || strings.TrimSpace(keyValue[1]) == "" {
+			return nil, fmt.Errorf("Invalid aws_endpoint_urls entry \"%s\", expected service=url", pair)
+		}
+		urls[strings.TrimSpace(keyValue[0])] = strings.TrimSpace(keyValue[1])
+	}
+	return urls, nil
+}
+
+// GetGcpPubsubSubscriptions - Gets the list of Google Pub/Sub subscriptions that log data is received from
+func (config ServerConfig) GetGcpPubsubSubscriptions() []string {
+	var subscriptions []string
+	for _, subscription := range strings.Split(config.GcpPubsubSubscription, ",") {
+		subscription = strings.TrimSpace(subscription)
+		if subscription != "" {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions
+}
+
+// GetPgBouncerURLs - Gets the admin console URLs of the PgBouncer instances in front of this server
+func (config ServerConfig) GetPgBouncerURLs() []string {
+	var urls []string
+	for _, u := range strings.Split(config.PgBouncerURL, ",") {
+		u = strings.TrimSpace(u)
+		if u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// GetHerokuLogSources - Gets the log drain sources of this server, in the form "<app> / HEROKU_POSTGRESQL_<color>"
+func (config ServerConfig) GetHerokuLogSources() []string {
+	var sources []string
+	for _, source := range strings.Split(config.HerokuLogSources, ",") {
+		parts := strings.SplitN(strings.TrimSpace(source), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		attachment := strings.ToUpper(strings.TrimSpace(parts[1]))
+		if !strings.HasPrefix(attachment, "HEROKU_POSTGRESQL_") {
+			attachment = "HEROKU_POSTGRESQL_" + attachment
+		}
+		sources = append(sources, strings.TrimSpace(parts[0])+" / "+attachment)
+	}
+	return sources
+}
+
+// GetDbEndpoint - Gets the database host, port and username that GetPqOpenString connects with
+//
+// Settings override the corresponding parts of db_url, and host and port fall back to the
+// libpq defaults if neither is set.
+func (config ServerConfig) GetDbEndpoint() (dbHost string, dbPort int, dbUsername string) {
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err == nil {
+			if u.User != nil {
+				dbUsername = u.User.Username()
+			}
+
+			hostSplits := strings.SplitN(u.Host, ":", 2)
+			dbHost = hostSplits[0]
+			if len(hostSplits) > 1 {
+				dbPort, _ = strconv.Atoi(hostSplits[1])
+			}
+		}
+	}
+
+	if config.DbUsername != "" {
+		dbUsername = config.DbUsername
+	}
+	if config.DbHost != "" {
+		dbHost = config.DbHost
+	}
+	if config.DbPort != 0 {
+		dbPort = config.DbPort
+	}
+
+	// Defaults if nothing is set
+	if dbHost == "" {
+		dbHost = "localhost"
+	}
+	if dbPort == 0 {
+		dbPort = 5432
+	}
+
+	return
 }
 
 // GetPqOpenString - Gets the database configuration as a string that can be passed to lib/pq for connecting
 func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
-	var dbUsername, dbPassword, dbName, dbHost, dbSslMode, dbSslRootCert, dbSslCert, dbSslKey string
-	var dbPort int
+	var dbPassword, dbName, dbSslMode, dbSslRootCert, dbSslCert, dbSslKey string
 
 	if config.DbURL != "" {
 		u, err := url.Parse(config.DbURL)
@@ -188,7 +717,6 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 		}
 
 		if u.User != nil {
-			dbUsername = u.User.Username()
 			dbPassword, _ = u.User.Password()
 		}
 
@@ -196,12 +724,6 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 			dbName = u.Path[1:len(u.Path)]
 		}
 
-		hostSplits := strings.SplitN(u.Host, ":", 2)
-		dbHost = hostSplits[0]
-		if len(hostSplits) > 1 {
-			dbPort, _ = strconv.Atoi(hostSplits[1])
-		}
-
 		querySplits := strings.Split(u.RawQuery, "&")
 		for _, querySplit := range querySplits {
 			keyValue := strings.SplitN(querySplit, "=", 2)
@@ -219,10 +741,8 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 	}
 
 	dbinfo := []string{}
+	dbHost, dbPort, dbUsername := config.GetDbEndpoint()
 
-	if config.DbUsername != "" {
-		dbUsername = config.DbUsername
-	}
 	if config.DbPassword != "" {
 		dbPassword = config.DbPassword
 	}
@@ -231,12 +751,6 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 	} else if config.DbName != "" {
 		dbName = config.DbName
 	}
-	if config.DbHost != "" {
-		dbHost = config.DbHost
-	}
-	if config.DbPort != 0 {
-		dbPort = config.DbPort
-	}
 	if config.DbSslMode != "" {
 		dbSslMode = config.DbSslMode
 	}
@@ -251,12 +765,6 @@ func (config ServerConfig) GetPqOpenString(dbNameOverride string) string {
 	}
 
 	// Defaults if nothing is set
-	if dbHost == "" {
-		dbHost = "localhost"
-	}
-	if dbPort == 0 {
-		dbPort = 5432
-	}
 	if dbSslMode == "" {
 		dbSslMode = "prefer"
 	}
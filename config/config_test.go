@@ -1,9 +1,17 @@
 package config_test
 
 import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
 )
 
 type testItem struct {
@@ -30,3 +38,201 @@ func TestGetDbURLRedacted(t *testing.T) {
 		}
 	}
 }
+
+var gcpPubsubSubscriptionTests = []testItem{
+	{"projects/p/subscriptions/s", "projects/p/subscriptions/s"},
+	{"projects/p/subscriptions/s1, projects/p/subscriptions/s2", "projects/p/subscriptions/s1|projects/p/subscriptions/s2"},
+	{"projects/p/subscriptions/s1,,", "projects/p/subscriptions/s1"},
+	{"", ""},
+}
+
+func TestGetGcpPubsubSubscriptions(t *testing.T) {
+	var config config.ServerConfig
+
+	for _, item := range gcpPubsubSubscriptionTests {
+		config.GcpPubsubSubscription = item.input
+		if subscriptions := strings.Join(config.GetGcpPubsubSubscriptions(), "|"); subscriptions != item.expected {
+			t.Errorf("want %s; got %s", item.expected, subscriptions)
+		}
+	}
+}
+
+var awsEndpointURLsTests = []testItem{
+	{"", ""},
+	{"sts=https://sts.us-gov-west-1.amazonaws.com", "sts=https://sts.us-gov-west-1.amazonaws.com"},
+	{" kinesis=https://kinesis.cn-north-1.amazonaws.com.cn, s3=https://s3.cn-north-1.amazonaws.com.cn ,", "kinesis=https://kinesis.cn-north-1.amazonaws.com.cn|s3=https://s3.cn-north-1.amazonaws.com.cn"},
+	{"sts", "error"},
+	{"=https://sts.amazonaws.com", "error"},
+}
+
+func TestGetAwsEndpointURLs(t *testing.T) {
+	var config config.ServerConfig
+
+	for _, item := range awsEndpointURLsTests {
+		config.AwsEndpointURLs = item.input
+		result := "error"
+		urls, err := config.GetAwsEndpointURLs()
+		if err == nil {
+			var pairs []string
+			for service, url := range urls {
+				pairs = append(pairs, service+"="+url)
+			}
+			sort.Strings(pairs)
+			result = strings.Join(pairs, "|")
+		}
+		if result != item.expected {
+			t.Errorf("want %s; got %s", item.expected, result)
+		}
+	}
+}
+
+var dbEndpointTests = []struct {
+	config   config.ServerConfig
+	expected string
+}{
+	{config.ServerConfig{}, "localhost:5432/"},
+	{config.ServerConfig{DbURL: "postgres://app@db.example.com:6432/mydb"}, "db.example.com:6432/app"},
+	{config.ServerConfig{DbURL: "postgres://app@db.example.com/mydb", DbUsername: "pganalyze", DbPort: 5433}, "db.example.com:5433/pganalyze"},
+	{config.ServerConfig{DbURL: "postgres://app@db.example.com:6432/mydb", DbHost: "replica.example.com"}, "replica.example.com:6432/app"},
+	{config.ServerConfig{DbHost: "db.example.com", DbUsername: "pganalyze"}, "db.example.com:5432/pganalyze"},
+}
+
+func TestGetDbEndpoint(t *testing.T) {
+	for _, item := range dbEndpointTests {
+		dbHost, dbPort, dbUsername := item.config.GetDbEndpoint()
+		if result := fmt.Sprintf("%s:%d/%s", dbHost, dbPort, dbUsername); result != item.expected {
+			t.Errorf("want %s; got %s", item.expected, result)
+		}
+		// The connection string has to connect to the same endpoint
+		pqOpenString := item.config.GetPqOpenString("")
+		if !strings.Contains(pqOpenString, fmt.Sprintf("host='%s' port=%d", dbHost, dbPort)) {
+			t.Errorf("want %s:%d in %s", dbHost, dbPort, pqOpenString)
+		}
+	}
+}
+
+var herokuLogSourcesTests = []testItem{
+	{"", ""},
+	{"myapp/HEROKU_POSTGRESQL_RED", "myapp / HEROKU_POSTGRESQL_RED"},
+	{"myapp/red, otherapp/HEROKU_POSTGRESQL_BLUE", "myapp / HEROKU_POSTGRESQL_RED|otherapp / HEROKU_POSTGRESQL_BLUE"},
+	{"myapp, /red", ""},
+}
+
+func TestGetHerokuLogSources(t *testing.T) {
+	var config config.ServerConfig
+
+	for _, item := range herokuLogSourcesTests {
+		config.HerokuLogSources = item.input
+		result := strings.Join(config.GetHerokuLogSources(), "|")
+		if result != item.expected {
+			t.Errorf("want %s; got %s", item.expected, result)
+		}
+	}
+}
+
+var logRedactRulesTests = []testItem{
+	{"", ""},
+	{`customer_\d+ => customer_X`, `customer_\d+=customer_X`},
+	{"\n  customer_\\d+ => customer_X\n(token)=\\w+ => $1=REDACTED \n", `customer_\d+=customer_X|(token)=\w+=$1=REDACTED`},
+	{`customer_\d+`, "error"},
+	{`customer_(\d+ => X`, "error"},
+}
+
+func TestParseLogRedactRules(t *testing.T) {
+	for _, item := range logRedactRulesTests {
+		result := "error"
+		rules, err := config.ParseLogRedactRules(item.input)
+		if err == nil {
+			var pairs []string
+			for _, rule := range rules {
+				pairs = append(pairs, rule.Regexp.String()+"="+rule.Replacement)
+			}
+			result = strings.Join(pairs, "|")
+		}
+		if result != item.expected {
+			t.Errorf("want %s; got %s", item.expected, result)
+		}
+	}
+}
+
+func TestDerivedServerConfigs(t *testing.T) {
+	base := config.ServerConfig{
+		SectionName:                "coordinator",
+		DbHost:                     "coordinator.example.com",
+		DbPort:                     5432,
+		CitusWorkers:               true,
+		LogLocation:                "/var/log/postgresql",
+		LogDockerTail:              "postgres",
+		LogDockerLabel:             "app=postgres",
+		LogPipe:                    "-",
+		LogKubernetesLabelSelector: "cnpg.io/cluster=mycluster",
+		LogKubernetesNamespace:     "db",
+		LogKubernetesContainer:     "postgres",
+		LogJournaldUnit:            "postgresql.service",
+		LogEventLogSource:          "PostgreSQL",
+		PatroniAPIURL:              "http://coordinator.example.com:8008",
+		PgBouncerURL:               "postgres://pgbouncer@coordinator.example.com:6432/pgbouncer",
+		PgpoolURL:                  "postgres://pgpool@coordinator.example.com:9999/postgres",
+	}
+
+	derived := map[string]config.ServerConfig{
+		"citus worker":  config.CitusWorkerConfig(base, "worker-1.example.com", 5432),
+		"aurora reader": config.AuroraReaderConfig(base, "reader-1", "reader-1.example.com", 5432),
+	}
+	for name, cfg := range derived {
+		logInputs := []string{cfg.LogLocation, cfg.LogDockerTail, cfg.LogDockerLabel, cfg.LogPipe, cfg.LogKubernetesLabelSelector,
+			cfg.LogKubernetesNamespace, cfg.LogKubernetesContainer, cfg.LogJournaldUnit, cfg.LogEventLogSource}
+		if strings.Join(logInputs, "") != "" {
+			t.Errorf("%s: expected local log inputs to be cleared, got %q", name, logInputs)
+		}
+		if cfg.DbHost == base.DbHost {
+			t.Errorf("%s: expected host of the derived server, got %s", name, cfg.DbHost)
+		}
+		if cfg.PatroniAPIURL != "" || cfg.PgBouncerURL != "" || cfg.PgpoolURL != "" {
+			t.Errorf("%s: expected Patroni, PgBouncer and Pgpool-II URLs of the base server to be cleared, got %q, %q and %q", name, cfg.PatroniAPIURL, cfg.PgBouncerURL, cfg.PgpoolURL)
+		}
+	}
+}
+
+var dbServerlessTests = []struct {
+	name     string
+	section  string
+	env      string
+	expected bool
+}{
+	{"Neon host", "db_host = ep-cool-darkness-123456.us-east-2.aws.neon.tech", "", true},
+	{"other host", "db_host = db.example.com", "", false},
+	{"explicitly enabled", "db_host = db.example.com\ndb_serverless = true", "", true},
+	{"Neon host explicitly disabled", "db_host = ep-cool-darkness-123456.us-east-2.aws.neon.tech\ndb_serverless = false", "", false},
+	{"Neon host disabled through the environment", "db_host = ep-cool-darkness-123456.us-east-2.aws.neon.tech", "false", false},
+}
+
+func TestReadDbServerless(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, test := range dbServerlessTests {
+		t.Setenv("DB_SERVERLESS", test.env)
+		filename := filepath.Join(dir, "pganalyze-collector.conf")
+		content := "[pganalyze]\napi_key = abc\n\n[server1]\ndb_name = app\n" + test.section + "\n"
+		if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		conf, err := config.Read(logger, filename)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if len(conf.Servers) != 1 {
+			t.Errorf("%s: expected one server, got %d", test.name, len(conf.Servers))
+			continue
+		}
+		if conf.Servers[0].DbServerless != test.expected {
+			t.Errorf("%s: expected db_serverless %t, got %t", test.name, test.expected, conf.Servers[0].DbServerless)
+		}
+	}
+}
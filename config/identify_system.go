@@ -33,6 +33,19 @@ func identifySystem(config ServerConfig) (systemType string, systemScope string,
 		if systemID == "" {
 			systemID = config.AzureDbServerName
 		}
+	} else if config.GcpProjectID != "" && config.GcpAlloyDBClusterID != "" {
+		systemType = "google_cloudsql"
+		if systemScope == "" {
+			systemScope = config.GcpProjectID
+		}
+		if systemID == "" {
+			// Instances of the same cluster share the cluster ID as a prefix, so the primary
+			// and its read pools are grouped together
+			systemID = config.GcpAlloyDBClusterID
+			if config.GcpAlloyDBInstanceID != "" {
+				systemID += "/" + config.GcpAlloyDBInstanceID
+			}
+		}
 	} else if (config.GcpProjectID != "" && config.GcpCloudSQLInstanceID != "") || systemType == "google_cloudsql" {
 		systemType = "google_cloudsql"
 		if systemScope == "" {
@@ -48,6 +61,10 @@ func identifySystem(config ServerConfig) (systemType string, systemScope string,
 		}
 	} else {
 		systemType = "self_hosted"
+		if systemID == "" && config.SupabaseProjectRef != "" {
+			// Supabase connection pooler hosts are shared between projects
+			systemID = config.SupabaseProjectRef
+		}
 		if systemID == "" {
 			hostname := config.GetDbHost()
 			if hostname == "" || hostname == "localhost" || hostname == "127.0.0.1" {
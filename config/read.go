@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -34,10 +35,13 @@ func parseConfigBool(value string) bool {
 
 func getDefaultConfig() *ServerConfig {
 	config := &ServerConfig{
-		APIBaseURL:              DefaultAPIBaseURL,
-		SectionName:             "default",
-		QueryStatsInterval:      60,
-		MaxCollectorConnections: 10,
+		APIBaseURL:                 DefaultAPIBaseURL,
+		SectionName:                "default",
+		QueryStatsInterval:         60,
+		MaxCollectorConnections:    10,
+		LogReplayWindow:            1,
+		LongTransactionThreshold:   300,
+		IdleInTransactionThreshold: 60,
 	}
 
 	// The environment variables are the default way to configure when running inside a Docker container.
@@ -131,12 +135,69 @@ func getDefaultConfig() *ServerConfig {
 	if awsAssumeRole := os.Getenv("AWS_ASSUME_ROLE"); awsAssumeRole != "" {
 		config.AwsAssumeRole = awsAssumeRole
 	}
+	if awsAssumeRoleExternalID := os.Getenv("AWS_ASSUME_ROLE_EXTERNAL_ID"); awsAssumeRoleExternalID != "" {
+		config.AwsAssumeRoleExternalID = awsAssumeRoleExternalID
+	}
+	if awsAssumeRoleSessionName := os.Getenv("AWS_ASSUME_ROLE_SESSION_NAME"); awsAssumeRoleSessionName != "" {
+		config.AwsAssumeRoleSessionName = awsAssumeRoleSessionName
+	}
+	if awsAssumeRoleTags := os.Getenv("AWS_ASSUME_ROLE_TAGS"); awsAssumeRoleTags != "" {
+		config.AwsAssumeRoleTags = awsAssumeRoleTags
+	}
 	if awsWebIdentityTokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"); awsWebIdentityTokenFile != "" {
 		config.AwsWebIdentityTokenFile = awsWebIdentityTokenFile
 	}
 	if awsRoleArn := os.Getenv("AWS_ROLE_ARN"); awsRoleArn != "" {
 		config.AwsRoleArn = awsRoleArn
 	}
+	if awsDbClusterID := os.Getenv("AWS_DB_CLUSTER_ID"); awsDbClusterID != "" {
+		config.AwsDbClusterID = awsDbClusterID
+	}
+	if awsDbClusterReaders := os.Getenv("AWS_DB_CLUSTER_READERS"); awsDbClusterReaders != "" {
+		config.AwsDbClusterReaders = parseConfigBool(awsDbClusterReaders)
+	}
+	if awsDbIAMAuth := os.Getenv("AWS_DB_IAM_AUTH"); awsDbIAMAuth != "" {
+		config.AwsDbIAMAuth = parseConfigBool(awsDbIAMAuth)
+	}
+	if awsPerformanceInsights := os.Getenv("AWS_PERFORMANCE_INSIGHTS"); awsPerformanceInsights != "" {
+		config.AwsPerformanceInsights = parseConfigBool(awsPerformanceInsights)
+	}
+	if awsCloudWatchPeriod := os.Getenv("AWS_CLOUDWATCH_PERIOD"); awsCloudWatchPeriod != "" {
+		config.AwsCloudWatchPeriod, _ = strconv.Atoi(awsCloudWatchPeriod)
+	}
+	if awsCloudWatchMetrics := os.Getenv("AWS_CLOUDWATCH_METRICS"); awsCloudWatchMetrics != "" {
+		config.AwsCloudWatchMetrics = awsCloudWatchMetrics
+	}
+	if awsDbEvents := os.Getenv("AWS_DB_EVENTS"); awsDbEvents != "" {
+		config.AwsDbEvents = parseConfigBool(awsDbEvents)
+	}
+	if awsDbProxyName := os.Getenv("AWS_DB_PROXY_NAME"); awsDbProxyName != "" {
+		config.AwsDbProxyName = awsDbProxyName
+	}
+	if awsKinesisLogStream := os.Getenv("AWS_KINESIS_LOG_STREAM"); awsKinesisLogStream != "" {
+		config.AwsKinesisLogStream = awsKinesisLogStream
+	}
+	if awsFirehoseListenAddress := os.Getenv("AWS_FIREHOSE_LISTEN_ADDRESS"); awsFirehoseListenAddress != "" {
+		config.AwsFirehoseListenAddress = awsFirehoseListenAddress
+	}
+	if awsFirehoseAccessKey := os.Getenv("AWS_FIREHOSE_ACCESS_KEY"); awsFirehoseAccessKey != "" {
+		config.AwsFirehoseAccessKey = awsFirehoseAccessKey
+	}
+	if awsFirehoseTLSCert := os.Getenv("AWS_FIREHOSE_TLS_CERT"); awsFirehoseTLSCert != "" {
+		config.AwsFirehoseTLSCert = awsFirehoseTLSCert
+	}
+	if awsFirehoseTLSKey := os.Getenv("AWS_FIREHOSE_TLS_KEY"); awsFirehoseTLSKey != "" {
+		config.AwsFirehoseTLSKey = awsFirehoseTLSKey
+	}
+	if awsS3LogBucket := os.Getenv("AWS_S3_LOG_BUCKET"); awsS3LogBucket != "" {
+		config.AwsS3LogBucket = awsS3LogBucket
+	}
+	if awsS3LogPrefix := os.Getenv("AWS_S3_LOG_PREFIX"); awsS3LogPrefix != "" {
+		config.AwsS3LogPrefix = awsS3LogPrefix
+	}
+	if awsS3LogSqsQueueURL := os.Getenv("AWS_S3_LOG_SQS_QUEUE_URL"); awsS3LogSqsQueueURL != "" {
+		config.AwsS3LogSqsQueueURL = awsS3LogSqsQueueURL
+	}
 	if awsEndpointSigningRegion := os.Getenv("AWS_ENDPOINT_SIGNING_REGION"); awsEndpointSigningRegion != "" {
 		config.AwsEndpointSigningRegion = awsEndpointSigningRegion
 	}
@@ -152,15 +213,36 @@ func getDefaultConfig() *ServerConfig {
 	if awsEndpointCloudwatchLogsURL := os.Getenv("AWS_ENDPOINT_CLOUDWATCH_LOGS_URL"); awsEndpointCloudwatchLogsURL != "" {
 		config.AwsEndpointCloudwatchLogsURL = awsEndpointCloudwatchLogsURL
 	}
+	if awsEndpointURLs := os.Getenv("AWS_ENDPOINT_URLS"); awsEndpointURLs != "" {
+		config.AwsEndpointURLs = awsEndpointURLs
+	}
 	if azureDbServerName := os.Getenv("AZURE_DB_SERVER_NAME"); azureDbServerName != "" {
 		config.AzureDbServerName = azureDbServerName
 	}
+	if azureDbResourceID := os.Getenv("AZURE_DB_RESOURCE_ID"); azureDbResourceID != "" {
+		config.AzureDbResourceID = azureDbResourceID
+	}
+	if citusWorkers := os.Getenv("CITUS_WORKERS"); citusWorkers != "" {
+		config.CitusWorkers = parseConfigBool(citusWorkers)
+	}
+	if azureDbADAuth := os.Getenv("AZURE_DB_AD_AUTH"); azureDbADAuth != "" {
+		config.AzureDbADAuth = parseConfigBool(azureDbADAuth)
+	}
 	if azureEventhubNamespace := os.Getenv("AZURE_EVENTHUB_NAMESPACE"); azureEventhubNamespace != "" {
 		config.AzureEventhubNamespace = azureEventhubNamespace
 	}
 	if azureEventhubName := os.Getenv("AZURE_EVENTHUB_NAME"); azureEventhubName != "" {
 		config.AzureEventhubName = azureEventhubName
 	}
+	if azureEventhubConsumerGroup := os.Getenv("AZURE_EVENTHUB_CONSUMER_GROUP"); azureEventhubConsumerGroup != "" {
+		config.AzureEventhubConsumerGroup = azureEventhubConsumerGroup
+	}
+	if azureEventhubCheckpointStorageAccount := os.Getenv("AZURE_EVENTHUB_CHECKPOINT_STORAGE_ACCOUNT"); azureEventhubCheckpointStorageAccount != "" {
+		config.AzureEventhubCheckpointStorageAccount = azureEventhubCheckpointStorageAccount
+	}
+	if azureEventhubCheckpointContainer := os.Getenv("AZURE_EVENTHUB_CHECKPOINT_CONTAINER"); azureEventhubCheckpointContainer != "" {
+		config.AzureEventhubCheckpointContainer = azureEventhubCheckpointContainer
+	}
 	if azureADTenantID := os.Getenv("AZURE_AD_TENANT_ID"); azureADTenantID != "" {
 		config.AzureADTenantID = azureADTenantID
 	}
@@ -185,20 +267,148 @@ func getDefaultConfig() *ServerConfig {
 	if gcpCredentialsFile := os.Getenv("GCP_CREDENTIALS_FILE"); gcpCredentialsFile != "" {
 		config.GcpCredentialsFile = gcpCredentialsFile
 	}
+	if gcpImpersonateServiceAccount := os.Getenv("GCP_IMPERSONATE_SERVICE_ACCOUNT"); gcpImpersonateServiceAccount != "" {
+		config.GcpImpersonateServiceAccount = gcpImpersonateServiceAccount
+	}
+	if gcpPubsubAckAfterProcessing := os.Getenv("GCP_PUBSUB_ACK_AFTER_PROCESSING"); gcpPubsubAckAfterProcessing != "" {
+		config.GcpPubsubAckAfterProcessing = parseConfigBool(gcpPubsubAckAfterProcessing)
+	}
+	if gcpPubsubMaxOutstandingMessages := os.Getenv("GCP_PUBSUB_MAX_OUTSTANDING_MESSAGES"); gcpPubsubMaxOutstandingMessages != "" {
+		config.GcpPubsubMaxOutstandingMessages, _ = strconv.Atoi(gcpPubsubMaxOutstandingMessages)
+	}
+	if gcpPubsubMaxOutstandingBytes := os.Getenv("GCP_PUBSUB_MAX_OUTSTANDING_BYTES"); gcpPubsubMaxOutstandingBytes != "" {
+		config.GcpPubsubMaxOutstandingBytes, _ = strconv.Atoi(gcpPubsubMaxOutstandingBytes)
+	}
+	if gcpPubsubNumGoroutines := os.Getenv("GCP_PUBSUB_NUM_GOROUTINES"); gcpPubsubNumGoroutines != "" {
+		config.GcpPubsubNumGoroutines, _ = strconv.Atoi(gcpPubsubNumGoroutines)
+	}
+	if gcpPubsubEndpoint := os.Getenv("GCP_PUBSUB_ENDPOINT"); gcpPubsubEndpoint != "" {
+		config.GcpPubsubEndpoint = gcpPubsubEndpoint
+	}
+	if gcpStorageLogBucket := os.Getenv("GCP_STORAGE_LOG_BUCKET"); gcpStorageLogBucket != "" {
+		config.GcpStorageLogBucket = gcpStorageLogBucket
+	}
+	if gcpStorageLogPrefix := os.Getenv("GCP_STORAGE_LOG_PREFIX"); gcpStorageLogPrefix != "" {
+		config.GcpStorageLogPrefix = gcpStorageLogPrefix
+	}
+	if gcpLoggingTail := os.Getenv("GCP_LOGGING_TAIL"); gcpLoggingTail != "" {
+		config.GcpLoggingTail = parseConfigBool(gcpLoggingTail)
+	}
+	if gcpIncludePgAuditLog := os.Getenv("GCP_INCLUDE_PGAUDIT_LOG"); gcpIncludePgAuditLog != "" {
+		config.GcpIncludePgAuditLog = parseConfigBool(gcpIncludePgAuditLog)
+	}
 	if gcpProjectID := os.Getenv("GCP_PROJECT_ID"); gcpProjectID != "" {
 		config.GcpProjectID = gcpProjectID
 	}
+	if gcpCloudSQLUseConnector := os.Getenv("GCP_CLOUDSQL_USE_CONNECTOR"); gcpCloudSQLUseConnector != "" {
+		config.GcpCloudSQLUseConnector = parseConfigBool(gcpCloudSQLUseConnector)
+	}
+	if gcpCloudSQLIAMAuth := os.Getenv("GCP_CLOUDSQL_IAM_AUTH"); gcpCloudSQLIAMAuth != "" {
+		config.GcpCloudSQLIAMAuth = parseConfigBool(gcpCloudSQLIAMAuth)
+	}
+	if gcpCloudSQLIPType := os.Getenv("GCP_CLOUDSQL_IP_TYPE"); gcpCloudSQLIPType != "" {
+		config.GcpCloudSQLIPType = gcpCloudSQLIPType
+	}
+	if gcpAlloyDBClusterID := os.Getenv("GCP_ALLOYDB_CLUSTER_ID"); gcpAlloyDBClusterID != "" {
+		config.GcpAlloyDBClusterID = gcpAlloyDBClusterID
+	}
+	if gcpAlloyDBInstanceID := os.Getenv("GCP_ALLOYDB_INSTANCE_ID"); gcpAlloyDBInstanceID != "" {
+		config.GcpAlloyDBInstanceID = gcpAlloyDBInstanceID
+	}
+	if crunchyBridgeClusterID := os.Getenv("CRUNCHY_BRIDGE_CLUSTER_ID"); crunchyBridgeClusterID != "" {
+		config.CrunchyBridgeClusterID = crunchyBridgeClusterID
+	}
+	if crunchyBridgeAPIKey := os.Getenv("CRUNCHY_BRIDGE_API_KEY"); crunchyBridgeAPIKey != "" {
+		config.CrunchyBridgeAPIKey = crunchyBridgeAPIKey
+	}
 	if logLocation := os.Getenv("LOG_LOCATION"); logLocation != "" {
 		config.LogLocation = logLocation
 	}
+	if supabaseProjectRef := os.Getenv("SUPABASE_PROJECT_REF"); supabaseProjectRef != "" {
+		config.SupabaseProjectRef = supabaseProjectRef
+	}
+	if supabaseLogDrainListenAddress := os.Getenv("SUPABASE_LOG_DRAIN_LISTEN_ADDRESS"); supabaseLogDrainListenAddress != "" {
+		config.SupabaseLogDrainListenAddress = supabaseLogDrainListenAddress
+	}
+	if supabaseLogDrainToken := os.Getenv("SUPABASE_LOG_DRAIN_TOKEN"); supabaseLogDrainToken != "" {
+		config.SupabaseLogDrainToken = supabaseLogDrainToken
+	}
+	if supabaseLogDrainTLSCert := os.Getenv("SUPABASE_LOG_DRAIN_TLS_CERT"); supabaseLogDrainTLSCert != "" {
+		config.SupabaseLogDrainTLSCert = supabaseLogDrainTLSCert
+	}
+	if supabaseLogDrainTLSKey := os.Getenv("SUPABASE_LOG_DRAIN_TLS_KEY"); supabaseLogDrainTLSKey != "" {
+		config.SupabaseLogDrainTLSKey = supabaseLogDrainTLSKey
+	}
+	if patroniAPIURL := os.Getenv("PATRONI_API_URL"); patroniAPIURL != "" {
+		config.PatroniAPIURL = patroniAPIURL
+	}
+	if patroniAPIUsername := os.Getenv("PATRONI_API_USERNAME"); patroniAPIUsername != "" {
+		config.PatroniAPIUsername = patroniAPIUsername
+	}
+	if patroniAPIPassword := os.Getenv("PATRONI_API_PASSWORD"); patroniAPIPassword != "" {
+		config.PatroniAPIPassword = patroniAPIPassword
+	}
+	if pgBouncerURL := os.Getenv("PGBOUNCER_URL"); pgBouncerURL != "" {
+		config.PgBouncerURL = pgBouncerURL
+	}
+	if pgpoolURL := os.Getenv("PGPOOL_URL"); pgpoolURL != "" {
+		config.PgpoolURL = pgpoolURL
+	}
+	if pgAutoFailoverMonitorURL := os.Getenv("PG_AUTO_FAILOVER_MONITOR_URL"); pgAutoFailoverMonitorURL != "" {
+		config.PgAutoFailoverMonitorURL = pgAutoFailoverMonitorURL
+	}
 	if logSyslogServer := os.Getenv("LOG_SYSLOG_SERVER"); logSyslogServer != "" {
 		config.LogSyslogServer = logSyslogServer
 	}
-	// Note: We don't support LogDockerTail here since it would require the "docker"
-	// binary inside the pganalyze container (as well as full Docker access), instead
+	if logSyslogServerTLSCert := os.Getenv("LOG_SYSLOG_SERVER_TLS_CERT"); logSyslogServerTLSCert != "" {
+		config.LogSyslogServerTLSCert = logSyslogServerTLSCert
+	}
+	if logSyslogServerTLSKey := os.Getenv("LOG_SYSLOG_SERVER_TLS_KEY"); logSyslogServerTLSKey != "" {
+		config.LogSyslogServerTLSKey = logSyslogServerTLSKey
+	}
+	if logSyslogServerProtocol := os.Getenv("LOG_SYSLOG_SERVER_PROTOCOL"); logSyslogServerProtocol != "" {
+		config.LogSyslogServerProtocol = logSyslogServerProtocol
+	}
+	if logSyslogServerHostname := os.Getenv("LOG_SYSLOG_SERVER_HOSTNAME"); logSyslogServerHostname != "" {
+		config.LogSyslogServerHostname = logSyslogServerHostname
+	}
+	if logPipe := os.Getenv("LOG_PIPE"); logPipe != "" {
+		config.LogPipe = logPipe
+	}
+	if logKubernetesLabelSelector := os.Getenv("LOG_KUBERNETES_LABEL_SELECTOR"); logKubernetesLabelSelector != "" {
+		config.LogKubernetesLabelSelector = logKubernetesLabelSelector
+	}
+	if logKubernetesNamespace := os.Getenv("LOG_KUBERNETES_NAMESPACE"); logKubernetesNamespace != "" {
+		config.LogKubernetesNamespace = logKubernetesNamespace
+	}
+	if logKubernetesContainer := os.Getenv("LOG_KUBERNETES_CONTAINER"); logKubernetesContainer != "" {
+		config.LogKubernetesContainer = logKubernetesContainer
+	}
+	if logOtelServer := os.Getenv("LOG_OTEL_SERVER"); logOtelServer != "" {
+		config.LogOtelServer = logOtelServer
+	}
+	if logOtelServerProtocol := os.Getenv("LOG_OTEL_SERVER_PROTOCOL"); logOtelServerProtocol != "" {
+		config.LogOtelServerProtocol = logOtelServerProtocol
+	}
+	if logOtelResourceAttributes := os.Getenv("LOG_OTEL_RESOURCE_ATTRIBUTES"); logOtelResourceAttributes != "" {
+		config.LogOtelResourceAttributes = logOtelResourceAttributes
+	}
+	if logFluentServer := os.Getenv("LOG_FLUENT_SERVER"); logFluentServer != "" {
+		config.LogFluentServer = logFluentServer
+	}
+	if logFluentTag := os.Getenv("LOG_FLUENT_TAG"); logFluentTag != "" {
+		config.LogFluentTag = logFluentTag
+	}
+	if logFluentSharedKey := os.Getenv("LOG_FLUENT_SHARED_KEY"); logFluentSharedKey != "" {
+		config.LogFluentSharedKey = logFluentSharedKey
+	}
+	// Note: We don't support LogDockerTail/LogDockerLabel here since it would require
+	// full Docker access from inside the pganalyze container, instead
 	// the approach for using pganalyze as a sidecar container alongside Postgres
 	// currently requires writing to a file and then mounting that as a volume
-	// inside the pganalyze container.
+	// inside the pganalyze container. The same applies to LogJournaldUnit, which
+	// requires "journalctl" and access to the host's journal, and LogEventLogSource,
+	// which is only supported on Windows.
 	if ignoreTablePattern := os.Getenv("IGNORE_TABLE_PATTERN"); ignoreTablePattern != "" {
 		config.IgnoreTablePattern = ignoreTablePattern
 	}
@@ -211,9 +421,31 @@ func getDefaultConfig() *ServerConfig {
 	if maxCollectorConnections := os.Getenv("MAX_COLLECTOR_CONNECTION"); maxCollectorConnections != "" {
 		config.MaxCollectorConnections, _ = strconv.Atoi(maxCollectorConnections)
 	}
+	if logReplayWindow := os.Getenv("LOG_REPLAY_WINDOW"); logReplayWindow != "" {
+		config.LogReplayWindow, _ = strconv.Atoi(logReplayWindow)
+	}
+	if logRateLimit := os.Getenv("LOG_RATE_LIMIT"); logRateLimit != "" {
+		config.LogRateLimit, _ = strconv.Atoi(logRateLimit)
+	}
+	if logRateLimitInterval := os.Getenv("LOG_RATE_LIMIT_INTERVAL"); logRateLimitInterval != "" {
+		config.LogRateLimitInterval, _ = strconv.Atoi(logRateLimitInterval)
+	}
+	if logLinePrefix := os.Getenv("LOG_LINE_PREFIX"); logLinePrefix != "" {
+		config.LogLinePrefix = logLinePrefix
+	}
+	if logLinePrefixStrip := os.Getenv("LOG_LINE_PREFIX_STRIP"); logLinePrefixStrip != "" {
+		config.LogLinePrefixStrip = logLinePrefixStrip
+	}
 	if skipIfReplica := os.Getenv("SKIP_IF_REPLICA"); skipIfReplica != "" {
 		config.SkipIfReplica = parseConfigBool(skipIfReplica)
 	}
+	if dbServerless := os.Getenv("DB_SERVERLESS"); dbServerless != "" {
+		config.DbServerless = parseConfigBool(dbServerless)
+		config.dbServerlessSet = true
+	}
+	if dbServerlessSuspendTolerance := os.Getenv("DB_SERVERLESS_SUSPEND_TOLERANCE"); dbServerlessSuspendTolerance != "" {
+		config.DbServerlessSuspendTolerance, _ = strconv.Atoi(dbServerlessSuspendTolerance)
+	}
 	if filterLogSecret := os.Getenv("FILTER_LOG_SECRET"); filterLogSecret != "" {
 		config.FilterLogSecret = filterLogSecret
 	}
@@ -223,6 +455,33 @@ func getDefaultConfig() *ServerConfig {
 	if filterQueryText := os.Getenv("FILTER_QUERY_TEXT"); filterQueryText != "" {
 		config.FilterQueryText = filterQueryText
 	}
+	if queryCollapseLists := os.Getenv("QUERY_COLLAPSE_LISTS"); queryCollapseLists != "" {
+		config.QueryCollapseLists = parseConfigBool(queryCollapseLists)
+	}
+	if queryStatsLimit := os.Getenv("QUERY_STATS_LIMIT"); queryStatsLimit != "" {
+		config.QueryStatsLimit, _ = strconv.Atoi(queryStatsLimit)
+	}
+	if queryStatsUserDimension := os.Getenv("QUERY_STATS_USER_DIMENSION"); queryStatsUserDimension != "" {
+		config.QueryStatsUserDimension = queryStatsUserDimension
+	}
+	if activitySampleInterval := os.Getenv("ACTIVITY_SAMPLE_INTERVAL"); activitySampleInterval != "" {
+		config.ActivitySampleInterval, _ = strconv.Atoi(activitySampleInterval)
+	}
+	if longTransactionThreshold := os.Getenv("LONG_TRANSACTION_THRESHOLD"); longTransactionThreshold != "" {
+		config.LongTransactionThreshold, _ = strconv.Atoi(longTransactionThreshold)
+	}
+	if idleInTransactionThreshold := os.Getenv("IDLE_IN_TRANSACTION_THRESHOLD"); idleInTransactionThreshold != "" {
+		config.IdleInTransactionThreshold, _ = strconv.Atoi(idleInTransactionThreshold)
+	}
+	if logBackendMemoryContexts := os.Getenv("LOG_BACKEND_MEMORY_CONTEXTS"); logBackendMemoryContexts != "" {
+		config.LogBackendMemoryContexts, _ = strconv.Atoi(logBackendMemoryContexts)
+	}
+	if activityFullQueryText := os.Getenv("ACTIVITY_FULL_QUERY_TEXT"); activityFullQueryText != "" {
+		config.ActivityFullQueryText = parseConfigBool(activityFullQueryText)
+	}
+	if filterLogRedact := os.Getenv("FILTER_LOG_REDACT"); filterLogRedact != "" {
+		config.FilterLogRedact = filterLogRedact
+	}
 	if httpProxy := os.Getenv("HTTP_PROXY"); httpProxy != "" {
 		config.HTTPProxy = httpProxy
 	}
@@ -317,7 +576,8 @@ func CreateEC2IMDSHTTPClient(conf ServerConfig) *http.Client {
 	}
 }
 
-func writeValueToTempfile(value string) (string, error) {
+// WriteValueToTempfile - Writes the value to a new temporary file, and returns its path
+func WriteValueToTempfile(value string) (string, error) {
 	file, err := ioutil.TempFile("", "")
 	if err != nil {
 		return "", err
@@ -337,9 +597,10 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 	var err error
 
 	host := config.GetDbHost()
-	if strings.HasSuffix(host, ".rds.amazonaws.com") {
+	// Instances in the China regions use the amazonaws.com.cn domain instead
+	if strings.HasSuffix(host, ".rds.amazonaws.com") || strings.HasSuffix(host, ".rds.amazonaws.com.cn") {
 		parts := strings.SplitN(host, ".", 4)
-		if len(parts) == 4 && parts[3] == "rds.amazonaws.com" { // Safety check for any escaping issues
+		if len(parts) == 4 && (parts[3] == "rds.amazonaws.com" || parts[3] == "rds.amazonaws.com.cn") { // Safety check for any escaping issues
 			if config.AwsDbInstanceID == "" {
 				config.AwsDbInstanceID = parts[0]
 			}
@@ -357,6 +618,38 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 				config.AzureDbServerName = parts[0]
 			}
 		}
+	} else if strings.HasSuffix(host, ".postgres.cosmos.azure.com") {
+		// Azure Cosmos DB for PostgreSQL nodes are named "c-<cluster>" (coordinator) or "w<N>-<cluster>" (workers)
+		parts := strings.SplitN(host, ".", 2)
+		if len(parts) == 2 && strings.Count(parts[1], ".") == 4 { // Safety check for any escaping issues
+			if config.AzureDbServerName == "" {
+				config.AzureDbServerName = parts[0]
+			}
+		}
+	} else if config.AzureDbResourceID != "" {
+		// Servers connected to through a private endpoint or IP address are identified by their resource ID
+		resourceParts := strings.Split(strings.TrimRight(config.AzureDbResourceID, "/"), "/")
+		if config.AzureDbServerName == "" {
+			config.AzureDbServerName = strings.ToLower(resourceParts[len(resourceParts)-1])
+		}
+	} else if strings.HasSuffix(host, ".supabase.co") {
+		parts := strings.SplitN(host, ".", 3)
+		if len(parts) == 3 && parts[0] == "db" && parts[2] == "supabase.co" { // Safety check for any escaping issues
+			if config.SupabaseProjectRef == "" {
+				config.SupabaseProjectRef = parts[1]
+			}
+		}
+	} else if strings.HasSuffix(host, ".pooler.supabase.com") {
+		// The pooler is shared between projects, which are told apart by the "<user>.<project ref>" username
+		parts := strings.SplitN(config.GetDbUsername(), ".", 2)
+		if len(parts) == 2 && config.SupabaseProjectRef == "" {
+			config.SupabaseProjectRef = parts[1]
+		}
+	} else if strings.HasSuffix(host, ".neon.tech") {
+		// Neon suspends endpoints after a few minutes without connections
+		if !config.dbServerlessSet {
+			config.DbServerless = true
+		}
 	} else if strings.HasSuffix(host, ".postgresbridge.com") {
 		parts := strings.SplitN(host, ".", 3)
 		if len(parts) == 3 && parts[0] == "p" && (parts[2] == "db.postgresbridge.com") { // Safety check for any escaping issues
@@ -378,6 +671,12 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 		config.GcpCloudSQLInstanceID = instanceParts[2]
 	}
 
+	// AlloyDB logs and metrics are identified by the cluster, so we route them the same
+	// way as for Cloud SQL instances
+	if config.GcpAlloyDBClusterID != "" && config.GcpCloudSQLInstanceID == "" {
+		config.GcpCloudSQLInstanceID = config.GcpAlloyDBClusterID
+	}
+
 	dbNameParts := []string{}
 	for _, s := range strings.Split(config.DbName, ",") {
 		dbNameParts = append(dbNameParts, strings.TrimSpace(s))
@@ -389,19 +688,38 @@ func preprocessConfig(config *ServerConfig) (*ServerConfig, error) {
 		config.DbExtraNames = dbNameParts[1:]
 	}
 
-	if config.DbSslRootCertContents != "" {
-		config.DbSslRootCert, err = writeValueToTempfile(config.DbSslRootCertContents)
+	// Contents that reference an Azure Key Vault secret (https://...) are written out once resolved
+	if config.DbSslRootCertContents != "" && !strings.HasPrefix(config.DbSslRootCertContents, "https://") {
+		config.DbSslRootCert, err = WriteValueToTempfile(config.DbSslRootCertContents)
 		if err != nil {
 			return config, err
 		}
 	}
 
-	if config.DbSslCertContents != "" {
-		config.DbSslCert, err = writeValueToTempfile(config.DbSslCertContents)
+	if config.DbSslCertContents != "" && !strings.HasPrefix(config.DbSslCertContents, "https://") {
+		config.DbSslCert, err = WriteValueToTempfile(config.DbSslCertContents)
+	}
+
+	if config.DbSslKeyContents != "" && !strings.HasPrefix(config.DbSslKeyContents, "https://") {
+		config.DbSslKey, err = WriteValueToTempfile(config.DbSslKeyContents)
+	}
+
+	config.FilterLogRedactRules, err = ParseLogRedactRules(config.FilterLogRedact)
+	if err != nil {
+		return config, fmt.Errorf("Failed to parse filter_log_redact: %s", err)
+	}
+
+	if config.LogLinePrefixStrip != "" {
+		config.LogLinePrefixStripRegexp, err = regexp.Compile(`^(?:` + config.LogLinePrefixStrip + `)`)
+		if err != nil {
+			return config, fmt.Errorf("Failed to parse log_line_prefix_strip: %s", err)
+		}
 	}
 
-	if config.DbSslKeyContents != "" {
-		config.DbSslKey, err = writeValueToTempfile(config.DbSslKeyContents)
+	switch config.QueryStatsUserDimension {
+	case "", "full", "rollup", "collapse":
+	default:
+		return config, fmt.Errorf("Failed to parse query_stats_user_dimension: unknown value \"%s\" (expected full, rollup or collapse)", config.QueryStatsUserDimension)
 	}
 
 	if config.AwsEndpointSigningRegionLegacy != "" && config.AwsEndpointSigningRegion == "" {
@@ -432,6 +750,7 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 		if err != nil {
 			return conf, fmt.Errorf("Failed to map [pganalyze] section in config: %s", err)
 		}
+		defaultConfig.dbServerlessSet = defaultConfig.dbServerlessSet || pgaSection.HasKey("db_serverless")
 
 		sections := configFile.Sections()
 		for _, section := range sections {
@@ -442,6 +761,7 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 			if err != nil {
 				return conf, err
 			}
+			config.dbServerlessSet = config.dbServerlessSet || section.HasKey("db_serverless")
 
 			config, err = preprocessConfig(config)
 			if err != nil {
@@ -499,6 +819,8 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 					config.SystemID = strings.Replace(parts[0], "_URL", "", 1)
 					config.SystemType = "heroku"
 					config.DbURL = parts[1]
+					// e.g. HEROKU_POSTGRESQL_RED_LOG_SOURCES for HEROKU_POSTGRESQL_RED_URL
+					config.HerokuLogSources = os.Getenv(strings.TrimSuffix(parts[0], "_URL") + "_LOG_SOURCES")
 					conf.Servers = append(conf.Servers, *config)
 				}
 			}
@@ -533,3 +855,107 @@ func Read(logger *util.Logger, filename string) (Config, error) {
 
 	return conf, nil
 }
+
+// CitusWorkerConfig - Derives the configuration for monitoring a worker node of the Citus
+// cluster whose coordinator is configured in the given section (see citus_workers)
+func CitusWorkerConfig(base ServerConfig, host string, port int) ServerConfig {
+	config := base
+	config.SectionName = fmt.Sprintf("%s/%s:%d", base.SectionName, host, port)
+	config.CitusWorkers = false
+	config.CitusCoordinatorSection = base.SectionName
+	// The Patroni REST API of the coordinator only reports on the coordinator's own cluster, and
+	// its PgBouncer and Pgpool-II instances only pool connections to the coordinator
+	config.PatroniAPIURL = ""
+	config.PgBouncerURL = ""
+	config.PgpoolURL = ""
+	// Syslog messages, OTLP log records and Fluent records of the worker are routed by its own hostname
+	config.LogSyslogServerHostname = ""
+	config.LogOtelResourceAttributes = ""
+	config.LogFluentTag = ""
+	clearLocalLogInputs(&config)
+
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err == nil {
+			u.Host = fmt.Sprintf("%s:%d", host, port)
+			config.DbURL = u.String()
+		}
+	} else {
+		config.DbHost = host
+		config.DbPort = port
+	}
+
+	// On Azure Cosmos DB for PostgreSQL, the server name of each node (the first part of its
+	// hostname) determines which node log lines are routed to
+	if config.AzureDbServerName != "" {
+		config.AzureDbServerName = strings.SplitN(host, ".", 2)[0]
+	}
+
+	config.SystemID = ""
+	config.SystemType, config.SystemScope, config.SystemScopeFallback, config.SystemID = identifySystem(config)
+	config.Identifier = ServerIdentifier{
+		APIKey:      config.APIKey,
+		APIBaseURL:  config.APIBaseURL,
+		SystemID:    config.SystemID,
+		SystemType:  config.SystemType,
+		SystemScope: config.SystemScope,
+	}
+
+	return config
+}
+
+// AuroraReaderConfig - Derives the configuration for monitoring a reader of the
+// Aurora cluster configured in the given section (see aws_db_cluster_readers)
+func AuroraReaderConfig(base ServerConfig, instanceID string, host string, port int) ServerConfig {
+	config := base
+	config.SectionName = base.SectionName + "/" + instanceID
+	config.AwsDbInstanceID = instanceID
+	config.AwsDbClusterReaders = false
+	// Proxy metrics aren't specific to an instance, so they are only collected for the section itself
+	config.AwsDbProxyName = ""
+	config.PatroniAPIURL = ""
+	config.PgBouncerURL = ""
+	config.PgpoolURL = ""
+	config.LogSyslogServerHostname = ""
+	config.LogOtelResourceAttributes = ""
+	config.LogFluentTag = ""
+	clearLocalLogInputs(&config)
+
+	if config.DbURL != "" {
+		u, err := url.Parse(config.DbURL)
+		if err == nil {
+			u.Host = fmt.Sprintf("%s:%d", host, port)
+			config.DbURL = u.String()
+		}
+	} else {
+		config.DbHost = host
+		config.DbPort = port
+	}
+
+	// The system ID of the section identifies its own instance, so it needs to be re-determined
+	config.SystemID = ""
+	config.SystemType, config.SystemScope, config.SystemScopeFallback, config.SystemID = identifySystem(config)
+	config.Identifier = ServerIdentifier{
+		APIKey:      config.APIKey,
+		APIBaseURL:  config.APIBaseURL,
+		SystemID:    config.SystemID,
+		SystemType:  config.SystemType,
+		SystemScope: config.SystemScope,
+	}
+
+	return config
+}
+
+// clearLocalLogInputs - Removes the log inputs that read the logs of the section's own
+// Postgres server on the collector's system, which never contain the logs of a derived server
+func clearLocalLogInputs(config *ServerConfig) {
+	config.LogLocation = ""
+	config.LogDockerTail = ""
+	config.LogDockerLabel = ""
+	config.LogPipe = ""
+	config.LogKubernetesLabelSelector = ""
+	config.LogKubernetesNamespace = ""
+	config.LogKubernetesContainer = ""
+	config.LogJournaldUnit = ""
+	config.LogEventLogSource = ""
+}
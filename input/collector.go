@@ -5,6 +5,7 @@ import (
 	"runtime"
 
 	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/system/google_cloudsql"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 	"github.com/shirou/gopsutil/host"
@@ -39,6 +40,7 @@ func getCollectorStats() state.CollectorStats {
 		MemoryHeapObjects:        memStats.HeapObjects,
 		MemorySystemBytes:        memStats.Sys,
 		MemoryRssBytes:           getMemoryRssBytes(),
+		GcpPubSub:                google_cloudsql.GetPubSubStats(),
 	}
 }
 
@@ -7,8 +7,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pganalyze/collector/input/patroni"
+	"github.com/pganalyze/collector/input/pg_auto_failover"
+	"github.com/pganalyze/collector/input/pgbouncer"
+	"github.com/pganalyze/collector/input/pgpool"
 	"github.com/pganalyze/collector/input/postgres"
 	"github.com/pganalyze/collector/input/system"
+	"github.com/pganalyze/collector/input/system/azure"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 )
@@ -18,6 +23,14 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 	systemType := server.Config.SystemType
 	ps.CollectedAt = time.Now()
 
+	if server.Config.DbServerless {
+		ps.PostmasterStartTime, err = postgres.GetPostmasterStartTime(connection)
+		if err != nil {
+			err = fmt.Errorf("Error checking server start time: %s", err)
+			return
+		}
+	}
+
 	ts.Version, err = postgres.GetPostgresVersion(logger, connection)
 	if err != nil {
 		logger.PrintError("Error collecting Postgres Version")
@@ -50,8 +63,24 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 		return
 	}
 
+	ps.StatementStatsInfo, err = postgres.GetStatementStatsInfo(connection, ts.Version)
+	if err != nil {
+		err = fmt.Errorf("Error collecting pg_stat_statements_info: %s", err)
+		return
+	}
+
+	ts.StatementLimit = server.Config.QueryStatsLimit
+	ts.StatementUserDimension = server.Config.QueryStatsUserDimension
+
+	ts.PlanTexts, ps.PlanStats, err = postgres.GetPlans(logger, connection, true)
+	if err != nil {
+		logger.PrintWarning("Skipping plan statistics, due to error: %s", err)
+		err = nil
+	}
+
 	ps.StatementResetCounter = server.PrevState.StatementResetCounter + 1
-	if server.Grant.Config.Features.StatementResetFrequency != 0 && ps.StatementResetCounter >= server.Grant.Config.Features.StatementResetFrequency {
+	// pg_stat_monitor statistics expire with their buckets, and are summed up by the collector instead
+	if server.Grant.Config.Features.StatementResetFrequency != 0 && ps.StatementResetCounter >= server.Grant.Config.Features.StatementResetFrequency && !postgres.UsesPgStatMonitor(connection) {
 		ps.StatementResetCounter = 0
 		err = postgres.ResetStatements(logger, connection, systemType)
 		if err != nil {
@@ -63,6 +92,11 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 			err = fmt.Errorf("Error collecting pg_stat_statements: %s", err)
 			return
 		}
+		ts.ResetStatementStatsInfo, err = postgres.GetStatementStatsInfo(connection, ts.Version)
+		if err != nil {
+			err = fmt.Errorf("Error collecting pg_stat_statements_info: %s", err)
+			return
+		}
 	}
 
 	if globalCollectionOpts.CollectPostgresSettings {
@@ -71,6 +105,9 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 			logger.PrintError("Error collecting config settings")
 			return
 		}
+		if systemType == "azure_database" && server.Config.AzureDbResourceID != "" {
+			ts.Settings = azure.MergeServerParameters(server.Config, logger, ts.Settings)
+		}
 	}
 
 	ts.Replication, err = postgres.GetReplication(logger, connection, ts.Version, systemType)
@@ -81,12 +118,102 @@ func CollectFull(server *state.Server, connection *sql.DB, globalCollectionOpts
 		err = nil
 	}
 
+	if systemType == "google_cloudsql" && postgres.IsAlloyDB(connection) {
+		ts.AlloyDB, err = postgres.GetAlloyDB(connection, ts.Replication)
+		if err != nil {
+			logger.PrintWarning("Skipping AlloyDB statistics, due to error: %s", err)
+			err = nil
+		} else {
+			logger.PrintVerbose("AlloyDB instance role: %s, columnar engine enabled: %t (%d relations, %d recommended columns)",
+				ts.AlloyDB.InstanceRole, ts.AlloyDB.ColumnarEngineEnabled, len(ts.AlloyDB.ColumnarRelations), len(ts.AlloyDB.ColumnarRecommendedColumns))
+		}
+	}
+
+	if ts.Version.IsCitus && server.Config.CitusCoordinatorSection == "" {
+		ts.Citus, err = postgres.GetCitus(connection)
+		if err != nil {
+			logger.PrintWarning("Skipping Citus statistics, due to error: %s", err)
+			err = nil
+		} else {
+			logger.PrintVerbose("Citus cluster: %d nodes, %d distributed tables, %d distributed queries",
+				len(ts.Citus.Nodes), len(ts.Citus.DistributedTables), len(ts.Citus.DistributedActivity))
+			if len(ts.Citus.RebalanceProgress) > 0 {
+				logger.PrintVerbose("Citus cluster: shard rebalance in progress, %d shard moves", len(ts.Citus.RebalanceProgress))
+			}
+		}
+	}
+
+	if server.Config.PatroniAPIURL != "" {
+		ts.Patroni, err = patroni.GetCluster(server.Config)
+		if err != nil {
+			logger.PrintWarning("Skipping Patroni cluster information, due to error: %s", err)
+			err = nil
+		} else if leader, ok := ts.Patroni.Leader(); ok {
+			logger.PrintVerbose("Patroni cluster %s: %d members, leader %s, current member %s", ts.Patroni.Scope, len(ts.Patroni.Members), leader.Name, ts.Patroni.CurrentMember)
+		} else {
+			logger.PrintVerbose("Patroni cluster %s: %d members, no leader", ts.Patroni.Scope, len(ts.Patroni.Members))
+		}
+	}
+
+	if server.Config.PgAutoFailoverMonitorURL != "" {
+		ts.PgAutoFailover, err = pg_auto_failover.GetFormation(server.Config)
+		if err != nil {
+			logger.PrintWarning("Skipping pg_auto_failover node state, due to error: %s", err)
+			err = nil
+		} else {
+			logger.PrintVerbose("pg_auto_failover formation %s: %d nodes, %d recent state transitions of this node",
+				ts.PgAutoFailover.FormationID, len(ts.PgAutoFailover.Nodes), len(ts.PgAutoFailover.Events))
+		}
+	}
+
+	if server.Config.PgBouncerURL != "" {
+		ts.PgBouncer, ps.PgBouncerStats = pgbouncer.GetInstances(server.Config, logger)
+	}
+
+	if server.Config.PgpoolURL != "" {
+		ts.Pgpool, err = pgpool.GetInstance(server.Config, logger)
+		if err != nil {
+			logger.PrintWarning("Skipping Pgpool-II statistics, due to error: %s", err)
+			err = nil
+		}
+	}
+
 	ts.BackendCounts, err = postgres.GetBackendCounts(logger, connection, ts.Version, server.Config.SystemType)
 	if err != nil {
 		logger.PrintError("Error collecting backend counts: %s", err)
 		return
 	}
 
+	ps.DatabaseStats, err = postgres.GetDatabaseStats(connection)
+	if err != nil {
+		logger.PrintWarning("Skipping database statistics, due to error: %s", err)
+		err = nil
+	}
+
+	ps.StatSLRU, err = postgres.GetStatSLRU(connection, ts.Version)
+	if err != nil {
+		logger.PrintWarning("Skipping SLRU statistics, due to error: %s", err)
+		err = nil
+	}
+
+	ps.StatWAL, err = postgres.GetStatWAL(connection, ts.Version)
+	if err != nil {
+		logger.PrintWarning("Skipping WAL statistics, due to error: %s", err)
+		err = nil
+	}
+
+	ps.StatIO, err = postgres.GetStatIO(connection, ts.Version)
+	if err != nil {
+		logger.PrintWarning("Skipping I/O statistics, due to error: %s", err)
+		err = nil
+	}
+
+	ps.WaitSamplingProfile, ps.WaitSamplingSamplePeriodMs, err = postgres.GetWaitSamplingProfile(connection)
+	if err != nil {
+		logger.PrintWarning("Skipping pg_wait_sampling statistics, due to error: %s", err)
+		err = nil
+	}
+
 	ps, ts = postgres.CollectAllSchemas(server, globalCollectionOpts, logger, ps, ts, systemType)
 
 	if server.Config.IgnoreTablePattern != "" {
@@ -0,0 +1,156 @@
+package patroni
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+)
+
+const apiTimeout = 10 * time.Second
+
+// Number of timeline changes to keep, Patroni returns the full history of the cluster
+const historyLimit = 10
+
+type clusterResponse struct {
+	Scope   string           `json:"scope"`
+	Members []memberResponse `json:"members"`
+}
+
+type memberResponse struct {
+	Name     string      `json:"name"`
+	Role     string      `json:"role"`
+	State    string      `json:"state"`
+	Host     string      `json:"host"`
+	Port     int32       `json:"port"`
+	Timeline null.Int    `json:"timeline"`
+	Lag      interface{} `json:"lag"` // Number of bytes, or "unknown"
+}
+
+func getAPI(cfg config.ServerConfig, path string, out interface{}) error {
+	req, err := http.NewRequest("GET", strings.TrimRight(cfg.PatroniAPIURL, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if cfg.PatroniAPIUsername != "" {
+		req.SetBasicAuth(cfg.PatroniAPIUsername, cfg.PatroniAPIPassword)
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status code %d for Patroni API %s: %s", resp.StatusCode, path, body)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// GetCluster - Gets the members of the Patroni cluster and its recent failovers
+func GetCluster(cfg config.ServerConfig) (*state.PatroniCluster, error) {
+	var clusterResp clusterResponse
+	err := getAPI(cfg, "/cluster", &clusterResp)
+	if err != nil {
+		return nil, fmt.Errorf("Patroni/Cluster: %s", err)
+	}
+
+	cluster := state.PatroniCluster{Scope: clusterResp.Scope}
+	for _, m := range clusterResp.Members {
+		member := state.PatroniMember{Name: m.Name, Role: m.Role, State: m.State, Host: m.Host, Port: m.Port, Timeline: m.Timeline}
+		if lag, ok := m.Lag.(float64); ok {
+			member.LagBytes = null.IntFrom(int64(lag))
+		}
+		cluster.Members = append(cluster.Members, member)
+	}
+	cluster.CurrentMember = findCurrentMember(cfg, cluster.Members)
+
+	var historyResp [][]interface{}
+	err = getAPI(cfg, "/history", &historyResp)
+	if err != nil {
+		return nil, fmt.Errorf("Patroni/History: %s", err)
+	}
+	if len(historyResp) > historyLimit {
+		historyResp = historyResp[len(historyResp)-historyLimit:]
+	}
+	for _, entry := range historyResp {
+		cluster.History = append(cluster.History, parseHistoryEntry(entry))
+	}
+
+	return &cluster, nil
+}
+
+// Members are matched on the Postgres host and port first, since the REST API may listen on a
+// different address, and otherwise on the host of the REST API itself
+func findCurrentMember(cfg config.ServerConfig, members []state.PatroniMember) string {
+	for _, member := range members {
+		if member.Host == cfg.GetDbHost() && int(member.Port) == cfg.GetDbPort() {
+			return member.Name
+		}
+	}
+	apiHost := strings.TrimPrefix(strings.TrimPrefix(cfg.PatroniAPIURL, "http://"), "https://")
+	apiHost = strings.SplitN(apiHost, "/", 2)[0]
+	if host, _, err := net.SplitHostPort(apiHost); err == nil {
+		apiHost = host
+	}
+	for _, member := range members {
+		if member.Host == apiHost {
+			return member.Name
+		}
+	}
+	return ""
+}
+
+// History entries are arrays of the timeline, LSN, reason, and (in newer Patroni versions) the
+// timestamp and the new leader
+func parseHistoryEntry(entry []interface{}) (change state.PatroniTimelineChange) {
+	if len(entry) > 0 {
+		if timeline, ok := entry[0].(float64); ok {
+			change.Timeline = int64(timeline)
+		}
+	}
+	if len(entry) > 1 {
+		if lsn, ok := entry[1].(float64); ok {
+			change.LSN = int64(lsn)
+		} else if lsn, ok := entry[1].(string); ok {
+			change.LSN, _ = strconv.ParseInt(lsn, 10, 64)
+		}
+	}
+	if len(entry) > 2 {
+		change.Reason, _ = entry[2].(string)
+	}
+	if len(entry) > 3 {
+		if timestamp, ok := entry[3].(string); ok {
+			changedAt, err := time.Parse(time.RFC3339Nano, timestamp)
+			if err == nil {
+				change.ChangedAt = null.TimeFrom(changedAt)
+			}
+		}
+	}
+	if len(entry) > 4 {
+		change.NewLeader, _ = entry[4].(string)
+	}
+	return
+}
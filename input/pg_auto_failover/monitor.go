@@ -0,0 +1,128 @@
+package pg_auto_failover
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+)
+
+const nodesSQL string = `
+SELECT formationid,
+			 nodeid,
+			 groupid,
+			 nodename,
+			 nodehost,
+			 nodeport,
+			 reportedstate::text,
+			 goalstate::text,
+			 reportedrepstate::text,
+			 reporttime,
+			 statechangetime,
+			 health,
+			 candidatepriority,
+			 replicationquorum
+	FROM pgautofailover.node
+ ORDER BY formationid, groupid, nodeid`
+
+const eventsSQL string = `
+SELECT eventid,
+			 eventtime,
+			 reportedstate::text,
+			 goalstate::text,
+			 COALESCE(description, '')
+	FROM pgautofailover.event
+ WHERE nodeid = $1 AND eventtime > pg_catalog.now() - interval '1 hour'
+ ORDER BY eventid
+ LIMIT 100`
+
+// GetFormation - Gets the nodes of the server's formation from the monitor, and the recent
+// state transitions of the server's own node
+func GetFormation(cfg config.ServerConfig) (*state.PgAutoFailoverFormation, error) {
+	db, err := sql.Open("postgres", cfg.PgAutoFailoverMonitorURL)
+	if err != nil {
+		return nil, fmt.Errorf("PgAutoFailover: %s", err)
+	}
+	defer db.Close()
+
+	nodesByFormation, err := getNodes(db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the formation through the server's node, there may be multiple formations tracked by the monitor
+	var formation state.PgAutoFailoverFormation
+	for formationID, nodes := range nodesByFormation {
+		for _, node := range nodes {
+			if node.NodeHost == cfg.GetDbHost() && int(node.NodePort) == cfg.GetDbPort() {
+				formation = state.PgAutoFailoverFormation{FormationID: formationID, Nodes: nodes, CurrentNodeID: null.IntFrom(node.NodeID)}
+			}
+		}
+	}
+	if !formation.CurrentNodeID.Valid {
+		if len(nodesByFormation) != 1 {
+			return nil, fmt.Errorf("PgAutoFailover: could not find node %s:%d on monitor", cfg.GetDbHost(), cfg.GetDbPort())
+		}
+		for formationID, nodes := range nodesByFormation {
+			formation = state.PgAutoFailoverFormation{FormationID: formationID, Nodes: nodes}
+		}
+		return &formation, nil
+	}
+
+	formation.Events, err = getEvents(db, formation.CurrentNodeID.Int64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &formation, nil
+}
+
+func getNodes(db *sql.DB) (map[string][]state.PgAutoFailoverNode, error) {
+	rows, err := db.Query(postgres.QueryMarkerSQL + nodesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("PgAutoFailover/Nodes/Query: %s", err)
+	}
+	defer rows.Close()
+
+	nodesByFormation := make(map[string][]state.PgAutoFailoverNode)
+	for rows.Next() {
+		var formationID string
+		var row state.PgAutoFailoverNode
+
+		err := rows.Scan(&formationID, &row.NodeID, &row.GroupID, &row.NodeName, &row.NodeHost, &row.NodePort,
+			&row.ReportedState, &row.GoalState, &row.ReportedRepState, &row.ReportTime, &row.StateChangeTime,
+			&row.Health, &row.CandidatePriority, &row.ReplicationQuorum)
+		if err != nil {
+			return nil, fmt.Errorf("PgAutoFailover/Nodes/Scan: %s", err)
+		}
+
+		nodesByFormation[formationID] = append(nodesByFormation[formationID], row)
+	}
+
+	return nodesByFormation, nil
+}
+
+func getEvents(db *sql.DB, nodeID int64) ([]state.PgAutoFailoverEvent, error) {
+	rows, err := db.Query(postgres.QueryMarkerSQL+eventsSQL, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("PgAutoFailover/Events/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var events []state.PgAutoFailoverEvent
+	for rows.Next() {
+		var row state.PgAutoFailoverEvent
+
+		err := rows.Scan(&row.EventID, &row.EventTime, &row.ReportedState, &row.GoalState, &row.Description)
+		if err != nil {
+			return nil, fmt.Errorf("PgAutoFailover/Events/Scan: %s", err)
+		}
+
+		events = append(events, row)
+	}
+
+	return events, nil
+}
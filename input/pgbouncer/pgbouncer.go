@@ -0,0 +1,131 @@
+package pgbouncer
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// GetInstances - Collects statistics from the admin console of each PgBouncer instance in front
+// of the server, skipping (and warning about) the ones that can't be reached
+//
+// The cumulative SHOW STATS counters are returned separately, to be diffed against the last run.
+func GetInstances(cfg config.ServerConfig, logger *util.Logger) ([]state.PgBouncerInstance, state.PgBouncerStatsMap) {
+	var instances []state.PgBouncerInstance
+	stats := make(state.PgBouncerStatsMap)
+	for _, adminURL := range cfg.GetPgBouncerURLs() {
+		instanceStats := make(state.PgBouncerStatsMap)
+		instance, err := getInstance(adminURL, instanceStats)
+		if err != nil {
+			logger.PrintWarning("Skipping PgBouncer statistics for %s, due to error: %s", instance.Address, err)
+			continue
+		}
+		instances = append(instances, instance)
+		for key, value := range instanceStats {
+			stats[key] = value
+		}
+	}
+	return instances, stats
+}
+
+func getInstance(adminURL string, stats state.PgBouncerStatsMap) (state.PgBouncerInstance, error) {
+	var instance state.PgBouncerInstance
+
+	u, err := url.Parse(adminURL)
+	if err != nil {
+		instance.Address = "(invalid URL)"
+		// The error includes the URL, which may contain a password
+		return instance, fmt.Errorf("Could not parse pgbouncer_url")
+	}
+	instance.Address = u.Host
+
+	db, err := sql.Open("postgres", adminURL)
+	if err != nil {
+		return instance, err
+	}
+	defer db.Close()
+	// The admin console doesn't support prepared statements or multiple connections per client
+	db.SetMaxOpenConns(1)
+
+	rows, err := showCommand(db, "STATS")
+	if err != nil {
+		return instance, err
+	}
+	for _, row := range rows {
+		stats[state.PgBouncerStatsKey{Address: instance.Address, Database: row["database"]}] = state.PgBouncerDatabaseStats{
+			TotalXactCount:   parseInt(row["total_xact_count"]),
+			TotalQueryCount:  parseInt(row["total_query_count"]),
+			TotalReceived:    parseInt(row["total_received"]),
+			TotalSent:        parseInt(row["total_sent"]),
+			TotalXactTimeUs:  parseInt(row["total_xact_time"]),
+			TotalQueryTimeUs: parseInt(row["total_query_time"]),
+			TotalWaitTimeUs:  parseInt(row["total_wait_time"]),
+		}
+	}
+
+	rows, err = showCommand(db, "POOLS")
+	if err != nil {
+		return instance, err
+	}
+	for _, row := range rows {
+		instance.Pools = append(instance.Pools, state.PgBouncerPool{
+			Database:  row["database"],
+			User:      row["user"],
+			PoolMode:  row["pool_mode"],
+			ClActive:  parseInt(row["cl_active"]),
+			ClWaiting: parseInt(row["cl_waiting"]),
+			SvActive:  parseInt(row["sv_active"]),
+			SvIdle:    parseInt(row["sv_idle"]),
+			SvUsed:    parseInt(row["sv_used"]),
+			SvTested:  parseInt(row["sv_tested"]),
+			SvLogin:   parseInt(row["sv_login"]),
+			MaxWaitUs: parseInt(row["maxwait"])*1000000 + parseInt(row["maxwait_us"]),
+		})
+	}
+
+	rows, err = showCommand(db, "CLIENTS")
+	if err != nil {
+		return instance, err
+	}
+	clientCounts := make(map[state.PgBouncerClientCount]int64)
+	for _, row := range rows {
+		clientCounts[state.PgBouncerClientCount{Database: row["database"], User: row["user"], State: row["state"]}]++
+	}
+	for key, count := range clientCounts {
+		key.Count = count
+		instance.Clients = append(instance.Clients, key)
+	}
+
+	rows, err = showCommand(db, "LISTS")
+	if err != nil {
+		return instance, err
+	}
+	instance.Lists = make(map[string]int64)
+	for _, row := range rows {
+		instance.Lists[row["list"]] = parseInt(row["items"])
+	}
+
+	return instance, nil
+}
+
+// showCommand - Runs a SHOW command on the admin console, returning each row by column name
+//
+// Columns differ between PgBouncer versions, so they are not scanned positionally.
+func showCommand(db *sql.DB, command string) ([]map[string]string, error) {
+	rows, err := postgres.QueryRowsByColumn(db, "SHOW "+command)
+	if err != nil {
+		return nil, fmt.Errorf("PgBouncer/%s: %s", command, err)
+	}
+	return rows, nil
+}
+
+func parseInt(value string) int64 {
+	i, _ := strconv.ParseInt(value, 10, 64)
+	return i
+}
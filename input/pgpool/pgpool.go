@@ -0,0 +1,108 @@
+package pgpool
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// GetInstance - Collects node health, child process and query cache statistics from Pgpool-II
+func GetInstance(cfg config.ServerConfig, logger *util.Logger) (*state.PgpoolInstance, error) {
+	u, err := url.Parse(cfg.PgpoolURL)
+	if err != nil {
+		// The error includes the URL, which may contain a password
+		return nil, fmt.Errorf("Could not parse pgpool_url")
+	}
+	instance := state.PgpoolInstance{Address: u.Host}
+
+	db, err := sql.Open("postgres", cfg.PgpoolURL)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	// SHOW POOL_PROCESSES and the query cache refer to the child process the session is connected to
+	db.SetMaxOpenConns(1)
+
+	rows, err := showCommand(db, "POOL_NODES")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		node := state.PgpoolNode{
+			NodeID:           int32(parseInt(row["node_id"])),
+			Hostname:         row["hostname"],
+			Port:             int32(parseInt(row["port"])),
+			Status:           row["status"],
+			Role:             row["role"],
+			SelectCount:      parseInt(row["select_cnt"]),
+			LoadBalanceNode:  row["load_balance_node"] == "true",
+			ReplicationDelay: parseInt(row["replication_delay"]),
+		}
+		node.LbWeight, _ = strconv.ParseFloat(row["lb_weight"], 64)
+		if value, ok := row["replication_state"]; ok && value != "" {
+			node.ReplicationState = null.StringFrom(value)
+		}
+		if value, ok := row["last_status_change"]; ok && value != "" {
+			node.LastStatusChange = null.StringFrom(value)
+		}
+		instance.Nodes = append(instance.Nodes, node)
+	}
+
+	rows, err = showCommand(db, "POOL_PROCESSES")
+	if err != nil {
+		return nil, err
+	}
+	processCounts := make(map[state.PgpoolProcessCount]int64)
+	for _, row := range rows {
+		instance.ChildProcesses++
+		if row["database"] != "" {
+			processCounts[state.PgpoolProcessCount{Database: row["database"], Username: row["username"]}]++
+		}
+	}
+	for key, count := range processCounts {
+		key.Count = count
+		instance.ConnectedProcesses = append(instance.ConnectedProcesses, key)
+	}
+
+	// Fails (or returns nothing) unless memory_cache_enabled is on
+	rows, err = showCommand(db, "POOL_CACHE")
+	if err != nil {
+		logger.PrintVerbose("Skipping Pgpool-II query cache statistics: %s", err)
+	} else if len(rows) == 1 {
+		row := rows[0]
+		cache := state.PgpoolQueryCache{
+			NumCacheHits:         parseInt(row["num_cache_hits"]),
+			NumSelects:           parseInt(row["num_selects"]),
+			NumHashEntries:       parseInt(row["num_hash_entries"]),
+			UsedHashEntries:      parseInt(row["used_hash_entries"]),
+			NumCacheEntries:      parseInt(row["num_cache_entries"]),
+			UsedCacheEntriesSize: parseInt(row["used_cache_entries_size"]),
+			FreeCacheEntriesSize: parseInt(row["free_cache_entries_size"]),
+		}
+		cache.CacheHitRatio, _ = strconv.ParseFloat(row["cache_hit_ratio"], 64)
+		instance.QueryCache = &cache
+	}
+
+	return &instance, nil
+}
+
+// Columns of the SHOW commands were added over time, so they are read by name
+func showCommand(db *sql.DB, command string) ([]map[string]string, error) {
+	rows, err := postgres.QueryRowsByColumn(db, "SHOW "+command)
+	if err != nil {
+		return nil, fmt.Errorf("Pgpool/%s: %s", command, err)
+	}
+	return rows, nil
+}
+
+func parseInt(value string) int64 {
+	i, _ := strconv.ParseInt(value, 10, 64)
+	return i
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// Grouped on the server, to keep each sample cheap to transfer and aggregate
+const activitySampleSQL string = `
+SELECT COALESCE(state, ''), COALESCE(wait_event_type, ''), COALESCE(wait_event, ''), count(*)
+	FROM pg_catalog.pg_stat_activity
+ WHERE pid <> pg_catalog.pg_backend_pid()
+ GROUP BY 1, 2, 3`
+
+// Query texts are only needed for fingerprinting when there is no query ID (before Postgres 14,
+// or with compute_query_id disabled)
+const activityWaitSampleSQL string = `
+SELECT %s, COALESCE(datname, ''), COALESCE(usename, ''), wait_event_type, wait_event, %s, count(*)
+	FROM pg_catalog.pg_stat_activity
+ WHERE wait_event IS NOT NULL AND (state = 'active' OR state IS NULL) AND pid <> pg_catalog.pg_backend_pid()
+ GROUP BY 1, 2, 3, 4, 5, 6, 7`
+
+const activityWaitSampleDefaultFields = "0::bigint, COALESCE(query, '')"
+const activityWaitSamplepg14Fields = "COALESCE(query_id, 0), CASE WHEN query_id IS NULL THEN COALESCE(query, '') ELSE '' END"
+
+// GetActivitySample - Counts the backends by their state and wait event (Postgres 9.6+), for
+// sampling activity in between activity snapshots
+func GetActivitySample(db *sql.DB) (map[state.ActivitySampleKey]int32, error) {
+	rows, err := db.Query(QueryMarkerSQL + activitySampleSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[state.ActivitySampleKey]int32)
+	for rows.Next() {
+		var key state.ActivitySampleKey
+		var count int32
+
+		err = rows.Scan(&key.State, &key.WaitEventType, &key.WaitEvent, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		counts[key] = count
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetActivityWaitSample - Counts the active backends that are waiting, by kind of process, wait
+// event and query (and its database and role) (Postgres 9.6+)
+func GetActivityWaitSample(db *sql.DB, postgresVersion state.PostgresVersion) ([]state.ActivityWaitSample, error) {
+	backendTypeField := "''"
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		backendTypeField = "COALESCE(backend_type, '')"
+	}
+	queryFields := activityWaitSampleDefaultFields
+	if postgresVersion.Numeric >= state.PostgresVersion14 {
+		queryFields = activityWaitSamplepg14Fields
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(activityWaitSampleSQL, backendTypeField, queryFields))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var samples []state.ActivityWaitSample
+	for rows.Next() {
+		var row state.ActivityWaitSample
+
+		err = rows.Scan(&row.BackendType, &row.DatabaseName, &row.RoleName, &row.WaitEventType, &row.WaitEvent, &row.QueryID, &row.Query, &row.Count)
+		if err != nil {
+			return nil, err
+		}
+
+		samples = append(samples, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return samples, nil
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/state"
+)
+
+const alloyDBDetectSQL string = `
+SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_settings WHERE name LIKE 'alloydb.%' OR name LIKE 'google_columnar_engine.%')
+`
+
+const alloyDBColumnarEngineEnabledSQL string = `
+SELECT COALESCE(current_setting('google_columnar_engine.enabled', true), 'off') = 'on'
+			 AND pg_catalog.to_regclass('g_columnar_relations') IS NOT NULL
+`
+
+const alloyDBColumnarRelationsSQL string = `
+SELECT database_name,
+			 schema_name,
+			 relation_name,
+			 status,
+			 COALESCE(size, 0),
+			 invalid_block_count,
+			 total_block_count
+	FROM g_columnar_relations`
+
+const alloyDBColumnarRecommendedColumnsSQL string = `
+SELECT database_name,
+			 schema_name,
+			 relation_name,
+			 column_name
+	FROM g_columnar_recommended_columns`
+
+// IsAlloyDB - Determines whether the connected server is an AlloyDB instance
+func IsAlloyDB(db *sql.DB) bool {
+	var isAlloyDB bool
+
+	err := db.QueryRow(QueryMarkerSQL + alloyDBDetectSQL).Scan(&isAlloyDB)
+	if err != nil {
+		return false
+	}
+
+	return isAlloyDB
+}
+
+// GetAlloyDB - Collects AlloyDB-specific information, including columnar engine usage and recommendations
+func GetAlloyDB(db *sql.DB, replication state.PostgresReplication) (*state.PostgresAlloyDB, error) {
+	alloyDB := state.PostgresAlloyDB{InstanceRole: "primary"}
+	if replication.InRecovery {
+		alloyDB.InstanceRole = "read_pool"
+	}
+
+	err := db.QueryRow(QueryMarkerSQL + alloyDBColumnarEngineEnabledSQL).Scan(&alloyDB.ColumnarEngineEnabled)
+	if err != nil {
+		return nil, fmt.Errorf("AlloyDB/ColumnarEngine: %s", err)
+	}
+	if !alloyDB.ColumnarEngineEnabled {
+		return &alloyDB, nil
+	}
+
+	alloyDB.ColumnarRelations, err = getAlloyDBColumnarRelations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	alloyDB.ColumnarRecommendedColumns, err = getAlloyDBColumnarRecommendedColumns(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &alloyDB, nil
+}
+
+func getAlloyDBColumnarRelations(db *sql.DB) ([]state.PostgresAlloyDBColumnarRelation, error) {
+	stmt, err := db.Prepare(QueryMarkerSQL + alloyDBColumnarRelationsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("AlloyDB/ColumnarRelations/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("AlloyDB/ColumnarRelations/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var relations []state.PostgresAlloyDBColumnarRelation
+	for rows.Next() {
+		var row state.PostgresAlloyDBColumnarRelation
+
+		err := rows.Scan(&row.DatabaseName, &row.SchemaName, &row.RelationName, &row.Status,
+			&row.SizeBytes, &row.InvalidBlockCount, &row.TotalBlockCount)
+		if err != nil {
+			return nil, fmt.Errorf("AlloyDB/ColumnarRelations/Scan: %s", err)
+		}
+
+		relations = append(relations, row)
+	}
+
+	return relations, nil
+}
+
+func getAlloyDBColumnarRecommendedColumns(db *sql.DB) ([]state.PostgresAlloyDBColumnarColumn, error) {
+	// The recommendation view only exists on newer AlloyDB versions
+	var exists bool
+	err := db.QueryRow(QueryMarkerSQL + "SELECT pg_catalog.to_regclass('g_columnar_recommended_columns') IS NOT NULL").Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("AlloyDB/ColumnarRecommendedColumns: %s", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + alloyDBColumnarRecommendedColumnsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("AlloyDB/ColumnarRecommendedColumns/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("AlloyDB/ColumnarRecommendedColumns/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var columns []state.PostgresAlloyDBColumnarColumn
+	for rows.Next() {
+		var row state.PostgresAlloyDBColumnarColumn
+
+		err := rows.Scan(&row.DatabaseName, &row.SchemaName, &row.RelationName, &row.ColumnName)
+		if err != nil {
+			return nil, fmt.Errorf("AlloyDB/ColumnarRecommendedColumns/Scan: %s", err)
+		}
+
+		columns = append(columns, row)
+	}
+
+	return columns, nil
+}
@@ -9,10 +9,11 @@ import (
 	"github.com/pganalyze/collector/util"
 )
 
-const activitySQLDefaultOptionalFields = "waiting, NULL, NULL, NULL, NULL, NULL"
-const activitySQLpg94OptionalFields = "waiting, backend_xid, backend_xmin, NULL, NULL, NULL"
-const activitySQLpg96OptionalFields = "COALESCE(wait_event_type, '') = 'Lock', backend_xid, backend_xmin, wait_event_type, wait_event, NULL"
-const activitySQLpg10OptionalFields = "COALESCE(wait_event_type, '') = 'Lock', backend_xid, backend_xmin, wait_event_type, wait_event, backend_type"
+const activitySQLDefaultOptionalFields = "waiting, NULL, NULL, NULL, NULL, NULL, NULL"
+const activitySQLpg94OptionalFields = "waiting, backend_xid, backend_xmin, NULL, NULL, NULL, NULL"
+const activitySQLpg96OptionalFields = "COALESCE(wait_event_type, '') = 'Lock', backend_xid, backend_xmin, wait_event_type, wait_event, NULL, NULL"
+const activitySQLpg10OptionalFields = "COALESCE(wait_event_type, '') = 'Lock', backend_xid, backend_xmin, wait_event_type, wait_event, backend_type, NULL"
+const activitySQLpg14OptionalFields = "COALESCE(wait_event_type, '') = 'Lock', backend_xid, backend_xmin, wait_event_type, wait_event, backend_type, query_id"
 
 const activitySQL string = `SELECT (extract(epoch from COALESCE(backend_start, pg_catalog.pg_postmaster_start_time()))::int::text || pg_catalog.to_char(pid, 'FM0000000'))::bigint,
 				datid, datname, usesysid, usename, pid, application_name, client_addr::text, client_port,
@@ -24,7 +25,9 @@ func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.Postgres
 	var optionalFields string
 	var sourceTable string
 
-	if postgresVersion.Numeric >= state.PostgresVersion10 {
+	if postgresVersion.Numeric >= state.PostgresVersion14 {
+		optionalFields = activitySQLpg14OptionalFields
+	} else if postgresVersion.Numeric >= state.PostgresVersion10 {
 		optionalFields = activitySQLpg10OptionalFields
 	} else if postgresVersion.Numeric >= state.PostgresVersion96 {
 		optionalFields = activitySQLpg96OptionalFields
@@ -63,7 +66,7 @@ func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.Postgres
 			&row.RoleOid, &row.RoleName, &row.Pid, &row.ApplicationName, &row.ClientAddr,
 			&row.ClientPort, &row.BackendStart, &row.XactStart, &row.QueryStart,
 			&row.StateChange, &row.Waiting, &row.BackendXid, &row.BackendXmin,
-			&row.WaitEventType, &row.WaitEvent, &row.BackendType, &row.State, &row.Query)
+			&row.WaitEventType, &row.WaitEvent, &row.BackendType, &row.QueryID, &row.State, &row.Query)
 		if err != nil {
 			return nil, err
 		}
@@ -78,3 +81,26 @@ func GetBackends(logger *util.Logger, db *sql.DB, postgresVersion state.Postgres
 
 	return activities, nil
 }
+
+const nextTransactionIDSQL string = `SELECT pg_catalog.txid_snapshot_xmax(pg_catalog.txid_current_snapshot())`
+
+// GetNextTransactionID - Returns the next transaction ID to be assigned (including epoch), used
+// to determine the age of the xmin horizons held back by backends
+func GetNextTransactionID(db *sql.DB) (int64, error) {
+	var xid int64
+	err := db.QueryRow(QueryMarkerSQL + nextTransactionIDSQL).Scan(&xid)
+	return xid, err
+}
+
+const logBackendMemoryContextsSQL string = `SELECT pg_catalog.pg_log_backend_memory_contexts($1)`
+
+// LogBackendMemoryContexts - Writes the memory contexts of the backend to the Postgres log
+// (Postgres 14+), and returns whether the backend still existed
+//
+// Memory contexts of other backends can't be queried directly, since pg_backend_memory_contexts
+// only shows the current backend.
+func LogBackendMemoryContexts(db *sql.DB, pid int32) (bool, error) {
+	var logged bool
+	err := db.QueryRow(QueryMarkerSQL+logBackendMemoryContextsSQL, pid).Scan(&logged)
+	return logged, err
+}
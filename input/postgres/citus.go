@@ -0,0 +1,477 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const citusHasRelationSQL string = `SELECT pg_catalog.to_regclass($1) IS NOT NULL`
+
+const citusNodesSQL string = `
+SELECT n.nodeid,
+			 n.groupid,
+			 n.nodename,
+			 n.nodeport,
+			 n.noderole::text,
+			 n.isactive,
+			 n.shouldhaveshards,
+			 COALESCE(s.shard_count, 0),
+			 COALESCE(s.shard_size, 0)
+	FROM pg_catalog.pg_dist_node n
+			 %s
+ ORDER BY n.nodeid`
+
+const citusNodesShardSizesSQL string = `
+LEFT JOIN (SELECT nodename, nodeport, pg_catalog.count(*) AS shard_count, pg_catalog.sum(shard_size)::bigint AS shard_size
+						 FROM citus_shards
+						GROUP BY 1, 2) s USING (nodename, nodeport)`
+
+const citusNodesShardCountsSQL string = `
+LEFT JOIN (SELECT nodename, nodeport, pg_catalog.count(*) AS shard_count, 0::bigint AS shard_size
+						 FROM pg_catalog.pg_dist_shard_placement
+						GROUP BY 1, 2) s USING (nodename, nodeport)`
+
+const citusTablesSQL string = `
+SELECT n.nspname,
+			 c.relname,
+			 t.citus_table_type,
+			 NULLIF(t.distribution_column, '<none>'),
+			 t.colocation_id,
+			 t.shard_count,
+			 t.table_size
+	FROM citus_tables t
+			 JOIN pg_catalog.pg_class c ON (c.oid = t.table_name)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)`
+
+const citusHasGlobalPidSQL string = `
+SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_attribute WHERE attrelid = 'citus_dist_stat_activity'::regclass AND attname = 'global_pid')`
+
+// Citus 11.1 replaced the query host columns with the node ID and a global PID
+const citusDistActivitySQL string = `
+SELECT COALESCE(n.nodename, ''),
+			 COALESCE(n.nodeport, 0),
+			 a.pid,
+			 a.global_pid,
+			 a.datname,
+			 a.usename,
+			 a.application_name,
+			 a.state,
+			 a.query_start,
+			 a.wait_event_type,
+			 a.wait_event
+	FROM citus_dist_stat_activity a
+			 LEFT JOIN pg_catalog.pg_dist_node n USING (nodeid)`
+
+const citusDistActivityLegacySQL string = `
+SELECT COALESCE(a.query_hostname, ''),
+			 COALESCE(a.query_hostport, 0),
+			 a.pid,
+			 NULL::bigint,
+			 a.datname,
+			 a.usename,
+			 a.application_name,
+			 a.state,
+			 a.query_start,
+			 a.wait_event_type,
+			 a.wait_event
+	FROM citus_dist_stat_activity a`
+
+const citusStatStatementsSQL string = `
+SELECT queryid,
+			 userid,
+			 dbid,
+			 executor,
+			 partition_key,
+			 calls
+	FROM citus_stat_statements`
+
+// Citus 10 and newer
+const citusShardPlacementsSQL string = `
+SELECT s.shardid,
+			 n.nspname,
+			 c.relname,
+			 s.nodename,
+			 s.nodeport,
+			 s.shard_size
+	FROM citus_shards s
+			 JOIN pg_catalog.pg_class c ON (c.oid = s.table_name)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ ORDER BY s.shard_size DESC
+ LIMIT %d`
+
+const citusShardPlacementsLegacySQL string = `
+SELECT p.shardid,
+			 n.nspname,
+			 c.relname,
+			 p.nodename,
+			 p.nodeport,
+			 0::bigint
+	FROM pg_catalog.pg_dist_shard_placement p
+			 JOIN pg_catalog.pg_dist_shard s USING (shardid)
+			 JOIN pg_catalog.pg_class c ON (c.oid = s.logicalrelid)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ ORDER BY p.shardid
+ LIMIT %d`
+
+const citusHasRebalanceProgressSQL string = `SELECT pg_catalog.to_regproc('pg_catalog.get_rebalance_progress') IS NOT NULL`
+
+const citusRebalanceProgressSQL string = `
+SELECT r.sessionid,
+			 n.nspname,
+			 c.relname,
+			 r.shardid,
+			 r.shard_size,
+			 r.sourcename,
+			 r.sourceport,
+			 r.targetname,
+			 r.targetport,
+			 r.progress
+	FROM pg_catalog.get_rebalance_progress() r
+			 JOIN pg_catalog.pg_class c ON (c.oid = r.table_name)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)`
+
+// Large clusters can have hundreds of thousands of shard placements, only the largest are collected
+const citusShardPlacementLimit = 5000
+
+// GetCitus - Collects the topology of a Citus cluster, its distributed tables, shards and distributed queries
+func GetCitus(db *sql.DB) (*state.PostgresCitus, error) {
+	var citus state.PostgresCitus
+	var err error
+
+	citus.DatabaseName, err = CurrentDatabaseName(db)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/DatabaseName: %s", err)
+	}
+
+	citus.Nodes, err = getCitusNodes(db)
+	if err != nil {
+		return nil, err
+	}
+
+	citus.DistributedTables, err = getCitusTables(db)
+	if err != nil {
+		return nil, err
+	}
+
+	citus.DistributedActivity, err = getCitusDistributedActivity(db)
+	if err != nil {
+		return nil, err
+	}
+
+	citus.DistributedStatements, err = getCitusStatStatements(db)
+	if err != nil {
+		return nil, err
+	}
+
+	citus.ShardPlacements, err = getCitusShardPlacements(db)
+	if err != nil {
+		return nil, err
+	}
+
+	citus.RebalanceProgress, err = getCitusRebalanceProgress(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &citus, nil
+}
+
+func citusHasRelation(db *sql.DB, name string) (bool, error) {
+	var exists bool
+	err := db.QueryRow(QueryMarkerSQL+citusHasRelationSQL, name).Scan(&exists)
+	return exists, err
+}
+
+func getCitusNodes(db *sql.DB) ([]state.PostgresCitusNode, error) {
+	// Shard sizes are only available through the citus_shards view (Citus 10+)
+	hasShardsView, err := citusHasRelation(db, "citus_shards")
+	if err != nil {
+		return nil, fmt.Errorf("Citus/Nodes: %s", err)
+	}
+	shardsSQL := citusNodesShardCountsSQL
+	if hasShardsView {
+		shardsSQL = citusNodesShardSizesSQL
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + fmt.Sprintf(citusNodesSQL, shardsSQL))
+	if err != nil {
+		return nil, fmt.Errorf("Citus/Nodes/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("Citus/Nodes/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var nodes []state.PostgresCitusNode
+	for rows.Next() {
+		var row state.PostgresCitusNode
+
+		err := rows.Scan(&row.NodeID, &row.GroupID, &row.NodeName, &row.NodePort, &row.NodeRole,
+			&row.IsActive, &row.ShouldHaveShards, &row.ShardCount, &row.ShardSizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("Citus/Nodes/Scan: %s", err)
+		}
+
+		nodes = append(nodes, row)
+	}
+
+	return nodes, nil
+}
+
+func getCitusTables(db *sql.DB) ([]state.PostgresCitusTable, error) {
+	hasTablesView, err := citusHasRelation(db, "citus_tables")
+	if err != nil {
+		return nil, fmt.Errorf("Citus/Tables: %s", err)
+	}
+	if !hasTablesView {
+		return nil, nil
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + citusTablesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/Tables/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("Citus/Tables/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var tables []state.PostgresCitusTable
+	for rows.Next() {
+		var row state.PostgresCitusTable
+
+		err := rows.Scan(&row.SchemaName, &row.RelationName, &row.TableType, &row.DistributionColumn,
+			&row.ColocationID, &row.ShardCount, &row.TableSizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("Citus/Tables/Scan: %s", err)
+		}
+
+		tables = append(tables, row)
+	}
+
+	return tables, nil
+}
+
+func getCitusDistributedActivity(db *sql.DB) ([]state.PostgresCitusBackend, error) {
+	var hasGlobalPid bool
+	err := db.QueryRow(QueryMarkerSQL + citusHasGlobalPidSQL).Scan(&hasGlobalPid)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/DistributedActivity: %s", err)
+	}
+	activitySQL := citusDistActivityLegacySQL
+	if hasGlobalPid {
+		activitySQL = citusDistActivitySQL
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + activitySQL)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/DistributedActivity/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("Citus/DistributedActivity/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var backends []state.PostgresCitusBackend
+	for rows.Next() {
+		var row state.PostgresCitusBackend
+
+		err := rows.Scan(&row.NodeName, &row.NodePort, &row.Pid, &row.GlobalPid, &row.DatabaseName, &row.RoleName,
+			&row.ApplicationName, &row.State, &row.QueryStart, &row.WaitEventType, &row.WaitEvent)
+		if err != nil {
+			return nil, fmt.Errorf("Citus/DistributedActivity/Scan: %s", err)
+		}
+
+		backends = append(backends, row)
+	}
+
+	return backends, nil
+}
+
+func getCitusStatStatements(db *sql.DB) ([]state.PostgresCitusStatement, error) {
+	// Only available on Citus Enterprise, and Citus 11 and newer
+	hasStatStatements, err := citusHasRelation(db, "citus_stat_statements")
+	if err != nil {
+		return nil, fmt.Errorf("Citus/StatStatements: %s", err)
+	}
+	if !hasStatStatements {
+		return nil, nil
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + citusStatStatementsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/StatStatements/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("Citus/StatStatements/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var statements []state.PostgresCitusStatement
+	for rows.Next() {
+		var row state.PostgresCitusStatement
+
+		err := rows.Scan(&row.QueryID, &row.UserOid, &row.DatabaseOid, &row.Executor, &row.PartitionKey, &row.Calls)
+		if err != nil {
+			return nil, fmt.Errorf("Citus/StatStatements/Scan: %s", err)
+		}
+
+		statements = append(statements, row)
+	}
+
+	return statements, nil
+}
+
+func getCitusShardPlacements(db *sql.DB) ([]state.PostgresCitusShardPlacement, error) {
+	hasShardsView, err := citusHasRelation(db, "citus_shards")
+	if err != nil {
+		return nil, fmt.Errorf("Citus/ShardPlacements: %s", err)
+	}
+	placementsSQL := citusShardPlacementsLegacySQL
+	if hasShardsView {
+		placementsSQL = citusShardPlacementsSQL
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + fmt.Sprintf(placementsSQL, citusShardPlacementLimit))
+	if err != nil {
+		return nil, fmt.Errorf("Citus/ShardPlacements/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("Citus/ShardPlacements/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var placements []state.PostgresCitusShardPlacement
+	for rows.Next() {
+		var row state.PostgresCitusShardPlacement
+
+		err := rows.Scan(&row.ShardID, &row.SchemaName, &row.RelationName, &row.NodeName, &row.NodePort, &row.SizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("Citus/ShardPlacements/Scan: %s", err)
+		}
+
+		placements = append(placements, row)
+	}
+
+	return placements, nil
+}
+
+func getCitusRebalanceProgress(db *sql.DB) ([]state.PostgresCitusRebalanceMove, error) {
+	var hasRebalanceProgress bool
+	err := db.QueryRow(QueryMarkerSQL + citusHasRebalanceProgressSQL).Scan(&hasRebalanceProgress)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/RebalanceProgress: %s", err)
+	}
+	if !hasRebalanceProgress {
+		return nil, nil
+	}
+
+	stmt, err := db.Prepare(QueryMarkerSQL + citusRebalanceProgressSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Citus/RebalanceProgress/Prepare: %s", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("Citus/RebalanceProgress/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var moves []state.PostgresCitusRebalanceMove
+	for rows.Next() {
+		var row state.PostgresCitusRebalanceMove
+
+		err := rows.Scan(&row.SessionID, &row.SchemaName, &row.RelationName, &row.ShardID, &row.SizeBytes,
+			&row.SourceName, &row.SourcePort, &row.TargetName, &row.TargetPort, &row.Progress)
+		if err != nil {
+			return nil, fmt.Errorf("Citus/RebalanceProgress/Scan: %s", err)
+		}
+
+		moves = append(moves, row)
+	}
+
+	return moves, nil
+}
+
+// AddCitusWorkers - Adds a server for each worker node of the Citus clusters that have citus_workers enabled
+//
+// Workers are looked up on the coordinator when the configuration is loaded, so changes in the
+// cluster topology are picked up on the next reload.
+func AddCitusWorkers(servers []config.ServerConfig, globalCollectionOpts state.CollectionOpts, logger *util.Logger) []config.ServerConfig {
+	result := append([]config.ServerConfig{}, servers...)
+
+	for _, server := range servers {
+		if !server.CitusWorkers {
+			continue
+		}
+		prefixedLogger := logger.WithPrefix(server.SectionName)
+
+		nodes, err := findCitusWorkerNodes(server, globalCollectionOpts, prefixedLogger)
+		if err != nil {
+			prefixedLogger.PrintWarning("Could not discover workers of Citus cluster: %s", err)
+			continue
+		}
+
+		for _, node := range nodes {
+			workerConfig := config.CitusWorkerConfig(server, node.NodeName, int(node.NodePort))
+
+			// Ensure we have no duplicate identifiers, in case the worker is also configured explicitly
+			skip := false
+			for _, existing := range result {
+				if workerConfig.Identifier == existing.Identifier {
+					skip = true
+				}
+			}
+			if skip {
+				prefixedLogger.PrintVerbose("Skipping Citus worker %s:%d, already configured", node.NodeName, node.NodePort)
+				continue
+			}
+			prefixedLogger.PrintVerbose("Discovered Citus worker %s:%d", node.NodeName, node.NodePort)
+			result = append(result, workerConfig)
+		}
+	}
+
+	return result
+}
+
+func findCitusWorkerNodes(cfg config.ServerConfig, globalCollectionOpts state.CollectionOpts, logger *util.Logger) ([]state.PostgresCitusNode, error) {
+	db, err := EstablishConnection(&state.Server{Config: cfg}, logger, globalCollectionOpts, "")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	nodes, err := getCitusNodes(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var workers []state.PostgresCitusNode
+	for _, node := range nodes {
+		if node.GroupID != 0 && node.IsActive && node.NodeRole == "primary" {
+			workers = append(workers, node)
+		}
+	}
+
+	return workers, nil
+}
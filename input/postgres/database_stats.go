@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const databaseStatsSQL string = `
+SELECT datid, temp_files, temp_bytes, stats_reset
+	FROM pg_catalog.pg_stat_database
+ WHERE datid <> 0`
+
+// GetDatabaseStats - Collects the cumulative statistics of all databases
+func GetDatabaseStats(db *sql.DB) (state.PostgresDatabaseStatsMap, error) {
+	rows, err := db.Query(QueryMarkerSQL + databaseStatsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	databaseStats := make(state.PostgresDatabaseStatsMap)
+	for rows.Next() {
+		var oid state.Oid
+		var stats state.PostgresDatabaseStats
+		var statsReset null.Time
+
+		err = rows.Scan(&oid, &stats.TempFiles, &stats.TempBytes, &statsReset)
+		if err != nil {
+			return nil, err
+		}
+		stats.StatsReset = statsReset.Time
+
+		databaseStats[oid] = stats
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return databaseStats, nil
+}
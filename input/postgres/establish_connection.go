@@ -1,13 +1,21 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+	"github.com/pganalyze/collector/util/azureutil"
+	"github.com/pganalyze/collector/util/gcputil"
 )
 
 func EstablishConnection(server *state.Server, logger *util.Logger, globalCollectionOpts state.CollectionOpts, databaseName string) (connection *sql.DB, err error) {
@@ -19,6 +27,14 @@ func EstablishConnection(server *state.Server, logger *util.Logger, globalCollec
 		}
 	}
 
+	// The password may have been rotated since we last fetched it
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "28P01" && (awsutil.IsSecretReference(server.Config.DbPassword) || azureutil.IsKeyVaultReference(server.Config.DbPassword)) {
+		logger.PrintVerbose("Password authentication failed, fetching db_password from %s again", server.Config.DbPassword)
+		awsutil.InvalidateSecretReference(server.Config.DbPassword)
+		azureutil.InvalidateKeyVaultReference(server.Config.DbPassword)
+		connection, err = connectToDb(server.Config, logger, globalCollectionOpts, databaseName)
+	}
+
 	if err != nil {
 		return
 	}
@@ -40,15 +56,62 @@ func connectToDb(config config.ServerConfig, logger *util.Logger, globalCollecti
 
 	// logger.PrintVerbose("sql.Open(\"postgres\", \"%s\")", connectString)
 
-	db, err := sql.Open("postgres", connectString)
-	if err != nil {
-		return nil, err
+	if awsutil.IsSecretReference(config.DbPassword) {
+		password, err := awsutil.ResolveSecretReference(config, config.DbPassword, "password")
+		if err != nil {
+			return nil, fmt.Errorf("Could not get db_password: %s", err)
+		}
+		connectString += " password='" + strings.Replace(password, "'", "\\'", -1) + "'"
+	}
+
+	if azureutil.IsKeyVaultReference(config.DbPassword) {
+		password, err := azureutil.ResolveKeyVaultReference(config, config.DbPassword)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get db_password: %s", err)
+		}
+		connectString += " password='" + strings.Replace(password, "'", "\\'", -1) + "'"
+	}
+
+	if config.AzureDbADAuth {
+		// Tokens are valid for about an hour, and get refreshed before they expire
+		token, err := azureutil.GetDbAuthToken(config)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get Azure AD authentication token: %s", err)
+		}
+		connectString += " password='" + strings.Replace(token, "'", "\\'", -1) + "'"
+	}
+
+	connector := pqConnector{dsn: connectString}
+
+	if config.AwsDbIAMAuth {
+		// Tokens expire after 15 minutes, so we get a current one for every new connection
+		// (including the ones database/sql opens later on), which overrides any password
+		// that was configured
+		connector.password = func() (string, error) {
+			token, err := awsutil.GetRdsAuthToken(config)
+			if err != nil {
+				return "", fmt.Errorf("Could not get RDS IAM authentication token: %s", err)
+			}
+			return token, nil
+		}
+	}
+
+	if config.GcpCloudSQLUseConnector {
+		dialer, err := gcputil.GetCloudSQLDialer(config, logger)
+		if err != nil {
+			return nil, err
+		}
+		// The connection is already encrypted by the dialer
+		connector.dsn += " sslmode=disable"
+		connector.dialer = dialer
 	}
 
+	db := sql.OpenDB(connector)
+
 	db.SetMaxOpenConns(1)
 	db.SetConnMaxLifetime(30 * time.Second)
 
-	err = db.Ping()
+	err := db.Ping()
 	if err != nil {
 		db.Close()
 		return nil, err
@@ -57,6 +120,38 @@ func connectToDb(config config.ServerConfig, logger *util.Logger, globalCollecti
 	return db, nil
 }
 
+// pqConnector - Opens Postgres connections, optionally through a custom dialer and
+// with a password that is determined for each new connection
+type pqConnector struct {
+	dsn      string
+	dialer   pq.Dialer
+	password func() (string, error)
+}
+
+func (c pqConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	dsn := c.dsn
+	if c.password != nil {
+		password, err := c.password()
+		if err != nil {
+			return nil, err
+		}
+		dsn += " password='" + strings.Replace(password, "'", "\\'", -1) + "'"
+	}
+
+	if c.dialer != nil {
+		return pq.DialOpen(c.dialer, dsn)
+	}
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+func (c pqConnector) Driver() driver.Driver {
+	return &pq.Driver{}
+}
+
 func validateConnectionCount(connection *sql.DB, logger *util.Logger, maxCollectorConnections int, globalCollectionOpts state.CollectionOpts) error {
 	var connectionCount int
 
@@ -87,3 +87,42 @@ func GetPostgresSetting(settingName string, server *state.Server, globalCollecti
 
 	return value, nil
 }
+
+// QueryRowsByColumn - Runs a query and returns each row as a map of column names to values, for
+// sources whose columns differ between versions (e.g. the PgBouncer admin console)
+//
+// The query is sent without parameters or the query marker, so that the simple query protocol
+// is used, which is the only one that admin consoles of connection poolers support.
+func QueryRowsByColumn(db *sql.DB, query string) ([]map[string]string, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []map[string]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		scanArgs := make([]interface{}, len(columns))
+		for i := range values {
+			scanArgs[i] = &values[i]
+		}
+		err = rows.Scan(scanArgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string)
+		for i, column := range columns {
+			row[column] = values[i].String
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
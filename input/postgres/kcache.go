@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const kcacheSchemaSQL string = `
+SELECT pgn.nspname, pge.extversion
+	FROM pg_catalog.pg_extension pge
+	JOIN pg_catalog.pg_namespace pgn ON (pge.extnamespace = pgn.oid)
+ WHERE pge.extname = 'pg_stat_kcache'`
+
+// Times are reported in seconds (and converted to milliseconds to match pg_stat_statements), and
+// top-level and nested statements are summed up like in pg_stat_statements with track = all
+const kcacheSQL string = `
+SELECT dbid, userid, queryid, sum(exec_user_time) * 1000, sum(exec_system_time) * 1000,
+			 sum(exec_reads), sum(exec_writes)
+	FROM %s.pg_stat_kcache()
+ GROUP BY dbid, userid, queryid`
+
+// addKcacheStats - Adds the per-query CPU time and filesystem I/O from pg_stat_kcache (2.1 or newer,
+// if installed) to the statement statistics, which tells apart CPU-bound and I/O-bound queries
+func addKcacheStats(logger *util.Logger, db *sql.DB, statementStats state.PostgresStatementStatsMap) {
+	var schema, version string
+	err := db.QueryRow(QueryMarkerSQL+kcacheSchemaSQL).Scan(&schema, &version)
+	if err != nil {
+		return
+	}
+	versionParts := strings.SplitN(version, ".", 3)
+	major, _ := strconv.Atoi(versionParts[0])
+	var minor int
+	if len(versionParts) > 1 {
+		minor, _ = strconv.Atoi(versionParts[1])
+	}
+	if major < 2 || (major == 2 && minor < 1) {
+		logger.PrintVerbose("Skipping pg_stat_kcache statistics, since version %s is too old (2.1+ required)", version)
+		return
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(kcacheSQL, pq.QuoteIdentifier(schema)))
+	if err != nil {
+		logger.PrintVerbose("Skipping pg_stat_kcache statistics, due to error: %s", err)
+		return
+	}
+	defer rows.Close()
+
+	// Only applied once all rows are read, to avoid partial statistics (and bogus diffs) on errors
+	kcacheStats := make(state.PostgresStatementStatsMap)
+	for rows.Next() {
+		var key state.PostgresStatementKey
+		var userTime, systemTime float64
+		var reads, writes int64
+
+		err = rows.Scan(&key.DatabaseOid, &key.UserOid, &key.QueryID, &userTime, &systemTime, &reads, &writes)
+		if err != nil {
+			logger.PrintVerbose("Skipping pg_stat_kcache statistics, due to error: %s", err)
+			return
+		}
+
+		kcacheStats[key] = state.PostgresStatementStats{ExecUserTime: userTime, ExecSystemTime: systemTime, ExecReads: reads, ExecWrites: writes}
+	}
+	if err = rows.Err(); err != nil {
+		logger.PrintVerbose("Skipping pg_stat_kcache statistics, due to error: %s", err)
+		return
+	}
+
+	for key, stats := range statementStats {
+		kcache, ok := kcacheStats[key]
+		if !ok {
+			continue
+		}
+		stats.ExecUserTime = kcache.ExecUserTime
+		stats.ExecSystemTime = kcache.ExecSystemTime
+		stats.ExecReads = kcache.ExecReads
+		stats.ExecWrites = kcache.ExecWrites
+		statementStats[key] = stats
+	}
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pganalyze/collector/state"
+)
+
+const blockingLocksWaitStartDefaultField = "a.query_start"
+const blockingLocksWaitStartpg14Field = "COALESCE(l.waitstart, a.query_start)"
+
+const blockingLocksSQL string = `
+SELECT l.pid, b.pid, l.locktype, l.mode, COALESCE(l.database, 0), COALESCE(d.datname, ''),
+			 COALESCE(l.relation, 0), COALESCE(n.nspname, ''),
+			 CASE
+				 WHEN ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1) THEN COALESCE(c.relname, '')
+				 ELSE ''
+			 END AS relname,
+			 %s
+	FROM pg_catalog.pg_locks l
+			 JOIN pg_catalog.pg_stat_activity a ON (a.pid = l.pid)
+			 CROSS JOIN LATERAL unnest(pg_catalog.pg_blocking_pids(l.pid)) b (pid)
+			 LEFT JOIN pg_catalog.pg_database d ON (d.oid = l.database)
+			 LEFT JOIN pg_catalog.pg_class c ON (c.oid = l.relation AND l.database = (SELECT oid FROM pg_catalog.pg_database WHERE datname = pg_catalog.current_database()))
+			 LEFT JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+ WHERE NOT l.granted AND l.pid <> pg_catalog.pg_backend_pid()`
+
+// GetBlockingLocks - Collects which backends are blocked by which other backends, for each lock
+// that is currently waited for (Postgres 9.6+), to reconstruct lock pileups
+func GetBlockingLocks(db *sql.DB, postgresVersion state.PostgresVersion, ignoreRegexp string) ([]state.PostgresBlockingLock, error) {
+	if postgresVersion.Numeric < state.PostgresVersion96 {
+		return nil, nil
+	}
+
+	waitStartField := blockingLocksWaitStartDefaultField
+	if postgresVersion.Numeric >= state.PostgresVersion14 {
+		waitStartField = blockingLocksWaitStartpg14Field
+	}
+
+	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(blockingLocksSQL, waitStartField), ignoreRegexp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locks []state.PostgresBlockingLock
+	for rows.Next() {
+		var row state.PostgresBlockingLock
+
+		err = rows.Scan(&row.BlockedPid, &row.BlockingPid, &row.LockType, &row.Mode, &row.DatabaseOid,
+			&row.DatabaseName, &row.RelationOid, &row.SchemaName, &row.RelationName, &row.WaitingSince)
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return locks, nil
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const pgStatMonitorSchemaSQL string = `
+SELECT pgn.nspname, pge.extversion
+	FROM pg_catalog.pg_extension pge
+	JOIN pg_catalog.pg_namespace pgn ON (pge.extnamespace = pgn.oid)
+ WHERE pge.extname = 'pg_stat_monitor'
+			 AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_extension WHERE extname = 'pg_stat_statements')`
+
+const pgStatMonitorBlkTimeFieldsSQL string = `
+SELECT EXISTS (
+	SELECT 1
+		FROM pg_catalog.pg_attribute
+	 WHERE attrelid = %s::regclass AND attname = 'shared_blk_read_time'
+)`
+
+// Each row covers the queries of one bucket (a time window of pg_stat_monitor.pgsm_bucket_time
+// seconds), and is only included once the bucket has ended
+const pgStatMonitorSQL string = `
+SELECT dbid, userid, queryid, %s, calls, total_exec_time, rows, shared_blks_hit, shared_blks_read,
+			 shared_blks_dirtied, shared_blks_written, local_blks_hit, local_blks_read,
+			 local_blks_dirtied, local_blks_written, temp_blks_read, temp_blks_written,
+			 %s, min_exec_time, max_exec_time, mean_exec_time, stddev_exec_time,
+			 bucket_start_time + current_setting('pg_stat_monitor.pgsm_bucket_time')::int * interval '1 second' > $1 AS new_bucket
+	FROM %s.pg_stat_monitor
+ WHERE bucket_start_time + current_setting('pg_stat_monitor.pgsm_bucket_time')::int * interval '1 second' <= $2`
+
+// UsesPgStatMonitor - Whether query statistics are collected from pg_stat_monitor, which is
+// the case when pg_stat_monitor 2.0 or newer is installed, and pg_stat_statements is not
+func UsesPgStatMonitor(db *sql.DB) bool {
+	_, ok := pgStatMonitorSchema(db)
+	return ok
+}
+
+func pgStatMonitorSchema(db *sql.DB) (string, bool) {
+	var schema, version string
+	err := db.QueryRow(QueryMarkerSQL+pgStatMonitorSchemaSQL).Scan(&schema, &version)
+	if err != nil {
+		return "", false
+	}
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil || major < 2 {
+		return "", false
+	}
+	return schema, true
+}
+
+// getPgStatMonitorStatements - Collects query statistics from pg_stat_monitor, as an alternative
+// to pg_stat_statements
+//
+// Unlike pg_stat_statements, pg_stat_monitor doesn't keep counters for the lifetime of the server,
+// but for each bucket (a time window, of 60 seconds by default) separately, and only retains the
+// most recent buckets. To fit these into the statistics diffs between snapshots, we keep our own
+// running totals, and add the buckets that ended since the last time query statistics were
+// collected (as of the collector's clock). Query texts are taken from all retained buckets.
+func getPgStatMonitorStatements(server *state.Server, logger *util.Logger, db *sql.DB, schema string, showtext bool) (state.PostgresStatementMap, state.PostgresStatementTextMap, state.PostgresStatementStatsMap, error) {
+	var hasSharedBlkTimeFields bool
+	var blkTimeFields string
+	var queryField string
+
+	quotedSchema := pq.QuoteIdentifier(schema)
+
+	err := db.QueryRow(QueryMarkerSQL + fmt.Sprintf(pgStatMonitorBlkTimeFieldsSQL, pq.QuoteLiteral(quotedSchema+".pg_stat_monitor"))).Scan(&hasSharedBlkTimeFields)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if hasSharedBlkTimeFields { // pg_stat_monitor 2.1+ on Postgres 17+
+		blkTimeFields = "shared_blk_read_time, shared_blk_write_time"
+	} else {
+		blkTimeFields = "blk_read_time, blk_write_time"
+	}
+	if showtext {
+		queryField = "query"
+	} else {
+		queryField = "NULL"
+	}
+
+	logger.PrintVerbose("Found pg_stat_monitor (without pg_stat_statements), collecting query statistics from completed buckets")
+
+	prevCollectedAt := server.PrevState.LastStatementStatsAt
+	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(pgStatMonitorSQL, queryField, blkTimeFields, quotedSchema), prevCollectedAt, time.Now())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	statementTexts := make(map[state.PostgresStatementKey]string)
+	statementStats := make(state.PostgresStatementStatsMap)
+
+	for rows.Next() {
+		var key state.PostgresStatementKey
+		var queryID null.Int
+		var receivedQuery null.String
+		var stats state.PostgresStatementStats
+		var newBucket bool
+
+		err = rows.Scan(&key.DatabaseOid, &key.UserOid, &queryID, &receivedQuery, &stats.Calls, &stats.TotalTime, &stats.Rows,
+			&stats.SharedBlksHit, &stats.SharedBlksRead, &stats.SharedBlksDirtied, &stats.SharedBlksWritten,
+			&stats.LocalBlksHit, &stats.LocalBlksRead, &stats.LocalBlksDirtied, &stats.LocalBlksWritten,
+			&stats.TempBlksRead, &stats.TempBlksWritten, &stats.BlkReadTime, &stats.BlkWriteTime,
+			&stats.MinTime, &stats.MaxTime, &stats.MeanTime, &stats.StddevTime, &newBucket)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !queryID.Valid {
+			continue
+		}
+		key.QueryID = queryID.Int64
+
+		if showtext && receivedQuery.Valid {
+			statementTexts[key] = receivedQuery.String
+		}
+		// Totals of queries that are no longer in any retained bucket are dropped, which makes
+		// them start over from zero (i.e. count as a new query) if they run again later
+		total, ok := statementStats[key]
+		if !ok {
+			total = server.PrevState.StatementStats[key]
+		}
+		if newBucket {
+			total = combineStatementStats(total, stats)
+		}
+		statementStats[key] = total
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	statements, statementTextsByFp := fingerprintStatementTexts(server, statementTexts, showtext)
+
+	return statements, statementTextsByFp, statementStats, nil
+}
+
+// combineStatementStats - Adds the statistics of a bucket (or of a separately tracked entry of the
+// same query) to the running totals, combining the execution time distributions (min/max/mean/stddev)
+// of both
+func combineStatementStats(total state.PostgresStatementStats, bucket state.PostgresStatementStats) state.PostgresStatementStats {
+	calls := total.Calls + bucket.Calls
+	if calls > 0 && bucket.MeanTime.Valid && bucket.StddevTime.Valid {
+		mean := (total.TotalTime + bucket.TotalTime) / float64(calls)
+		// Sum of squared differences from the mean, based on each population's variance and mean
+		m2 := bucket.StddevTime.Float64*bucket.StddevTime.Float64*float64(bucket.Calls) +
+			float64(bucket.Calls)*math.Pow(bucket.MeanTime.Float64-mean, 2)
+		if total.Calls > 0 && total.MeanTime.Valid && total.StddevTime.Valid {
+			m2 += total.StddevTime.Float64*total.StddevTime.Float64*float64(total.Calls) +
+				float64(total.Calls)*math.Pow(total.MeanTime.Float64-mean, 2)
+		}
+		total.MeanTime = null.FloatFrom(mean)
+		total.StddevTime = null.FloatFrom(math.Sqrt(m2 / float64(calls)))
+	}
+	if bucket.MinTime.Valid && (!total.MinTime.Valid || bucket.MinTime.Float64 < total.MinTime.Float64) {
+		total.MinTime = bucket.MinTime
+	}
+	if bucket.MaxTime.Valid && (!total.MaxTime.Valid || bucket.MaxTime.Float64 > total.MaxTime.Float64) {
+		total.MaxTime = bucket.MaxTime
+	}
+
+	total.Calls = calls
+	total.NestedCalls += bucket.NestedCalls
+	total.Plans += bucket.Plans
+	total.TotalTime += bucket.TotalTime
+	total.Rows += bucket.Rows
+	total.SharedBlksHit += bucket.SharedBlksHit
+	total.SharedBlksRead += bucket.SharedBlksRead
+	total.SharedBlksDirtied += bucket.SharedBlksDirtied
+	total.SharedBlksWritten += bucket.SharedBlksWritten
+	total.LocalBlksHit += bucket.LocalBlksHit
+	total.LocalBlksRead += bucket.LocalBlksRead
+	total.LocalBlksDirtied += bucket.LocalBlksDirtied
+	total.LocalBlksWritten += bucket.LocalBlksWritten
+	total.TempBlksRead += bucket.TempBlksRead
+	total.TempBlksWritten += bucket.TempBlksWritten
+	total.BlkReadTime += bucket.BlkReadTime
+	total.BlkWriteTime += bucket.BlkWriteTime
+	return total
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const plansExtensionSQL string = `
+SELECT pge.extname, pgn.nspname, pge.extversion
+	FROM pg_catalog.pg_extension pge
+	JOIN pg_catalog.pg_namespace pgn ON (pge.extnamespace = pgn.oid)
+ WHERE pge.extname IN ('pg_stat_plans', 'pg_store_plans')
+ ORDER BY pge.extname = 'pg_stat_plans' DESC
+ LIMIT 1`
+
+const pgStatPlansSQL string = `
+SELECT dbid, userid, queryid, planid, calls, total_exec_time, %s
+	FROM %s.pg_stat_plans`
+
+// pg_store_plans 1.4+ uses the same query ID as pg_stat_statements (older versions have their own)
+const pgStorePlansSQL string = `
+SELECT dbid, userid, queryid, planid, calls, total_time, %s
+	FROM %s.pg_store_plans`
+
+// GetPlans - Collects per-plan statistics (and plan texts, if showtext is set) from pg_stat_plans
+// or pg_store_plans, if either is installed, which makes plan changes of a query visible
+//
+// Returns nil maps (and no error) if neither extension is available.
+func GetPlans(logger *util.Logger, db *sql.DB, showtext bool) (state.PostgresPlanTextMap, state.PostgresPlanStatsMap, error) {
+	var extName, schema, version string
+	err := db.QueryRow(QueryMarkerSQL+plansExtensionSQL).Scan(&extName, &schema, &version)
+	if err == sql.ErrNoRows {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	var querySQL string
+	if extName == "pg_stat_plans" {
+		querySQL = pgStatPlansSQL
+	} else {
+		versionParts := strings.SplitN(version, ".", 3)
+		major, _ := strconv.Atoi(versionParts[0])
+		var minor int
+		if len(versionParts) > 1 {
+			minor, _ = strconv.Atoi(versionParts[1])
+		}
+		if major < 1 || (major == 1 && minor < 4) {
+			logger.PrintVerbose("Skipping plan statistics, since pg_store_plans version %s is too old (1.4+ required)", version)
+			return nil, nil, nil
+		}
+		querySQL = pgStorePlansSQL
+	}
+
+	var planField string
+	if showtext {
+		planField = "plan"
+	} else {
+		planField = "NULL"
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(querySQL, planField, pq.QuoteIdentifier(schema)))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	planTexts := make(state.PostgresPlanTextMap)
+	planStats := make(state.PostgresPlanStatsMap)
+	for rows.Next() {
+		var key state.PostgresPlanKey
+		var stats state.PostgresPlanStats
+		var planText sql.NullString
+
+		err = rows.Scan(&key.DatabaseOid, &key.UserOid, &key.QueryID, &key.PlanID, &stats.Calls, &stats.TotalTime, &planText)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Top-level and nested executions of the same plan are tracked separately by pg_stat_plans
+		if prevStats, ok := planStats[key]; ok {
+			stats.Calls += prevStats.Calls
+			stats.TotalTime += prevStats.TotalTime
+		}
+		planStats[key] = stats
+		if showtext && planText.Valid {
+			planTexts[key] = planText.String
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return planTexts, planStats, nil
+}
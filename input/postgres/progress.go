@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+)
+
+const progressAnalyzeSQL string = `
+SELECT pid, 'ANALYZE'::text AS command, datname::text, relid, phase::text,
+			 sample_blks_total, sample_blks_scanned, 0::bigint, 0::bigint, 0::bigint, 0::bigint
+	FROM pg_catalog.pg_stat_progress_analyze`
+
+const progressClusterSQL string = `
+SELECT pid, command::text, datname::text, relid, phase::text,
+			 heap_blks_total, heap_blks_scanned, 0::bigint, heap_tuples_written, 0::bigint, 0::bigint
+	FROM pg_catalog.pg_stat_progress_cluster`
+
+const progressCreateIndexSQL string = `
+SELECT pid, command::text, datname::text, relid, phase::text,
+			 blocks_total, blocks_done, tuples_total, tuples_done, 0::bigint, 0::bigint
+	FROM pg_catalog.pg_stat_progress_create_index`
+
+const progressBasebackupSQL string = `
+SELECT pid, 'BASE_BACKUP'::text AS command, ''::text, 0::oid, phase::text,
+			 0::bigint, 0::bigint, 0::bigint, 0::bigint, COALESCE(backup_total, 0), backup_streamed
+	FROM pg_catalog.pg_stat_progress_basebackup`
+
+const progressCopySQL string = `
+SELECT pid, command::text, datname::text, relid, ''::text,
+			 0::bigint, 0::bigint, 0::bigint, tuples_processed, bytes_total, bytes_processed
+	FROM pg_catalog.pg_stat_progress_copy`
+
+const progressSQL string = `
+SELECT p.pid, p.command, COALESCE(p.datname, ''), COALESCE(n.nspname, ''),
+			 CASE
+				 WHEN ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1) THEN COALESCE(c.relname, '')
+				 ELSE ''
+			 END AS relname,
+			 p.phase, p.blocks_total, p.blocks_done, p.tuples_total, p.tuples_done, p.bytes_total, p.bytes_done
+	FROM (%s) p (pid, command, datname, relid, phase, blocks_total, blocks_done, tuples_total, tuples_done, bytes_total, bytes_done)
+			 LEFT JOIN pg_catalog.pg_class c ON (c.oid = p.relid)
+			 LEFT JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)`
+
+// GetProgress - Collects the progress of running maintenance operations other than VACUUM
+// (which is collected by GetVacuumProgress), from the pg_stat_progress_* views that are
+// available for the Postgres version (12+)
+//
+// Relations are only resolved for operations in the database we're connected to.
+func GetProgress(db *sql.DB, postgresVersion state.PostgresVersion, ignoreRegexp string) ([]state.PostgresProgress, error) {
+	var sources []string
+	if postgresVersion.Numeric >= state.PostgresVersion12 {
+		sources = append(sources, progressClusterSQL, progressCreateIndexSQL)
+	}
+	if postgresVersion.Numeric >= state.PostgresVersion13 {
+		sources = append(sources, progressAnalyzeSQL, progressBasebackupSQL)
+	}
+	if postgresVersion.Numeric >= state.PostgresVersion14 {
+		sources = append(sources, progressCopySQL)
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(progressSQL, strings.Join(sources, "\nUNION ALL")), ignoreRegexp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var progress []state.PostgresProgress
+	for rows.Next() {
+		var row state.PostgresProgress
+
+		err = rows.Scan(&row.Pid, &row.Command, &row.DatabaseName, &row.SchemaName, &row.RelationName,
+			&row.Phase, &row.BlocksTotal, &row.BlocksDone, &row.TuplesTotal, &row.TuplesDone,
+			&row.BytesTotal, &row.BytesDone)
+		if err != nil {
+			return nil, err
+		}
+
+		progress = append(progress, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
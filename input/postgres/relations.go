@@ -123,6 +123,39 @@ WHERE n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
 			AND c.oid NOT IN (SELECT relid FROM locked_relids)
 			AND ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
 
+const extendedStatisticsSQL string = `
+	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock' AND relation IS NOT NULL)
+SELECT s.stxrelid,
+			 sn.nspname,
+			 s.stxname,
+			 s.stxkeys::text,
+			 s.stxkind::text,
+			 pg_catalog.pg_get_statisticsobjdef(s.oid),
+			 %s
+	FROM pg_catalog.pg_statistic_ext s
+			 JOIN pg_catalog.pg_namespace sn ON (sn.oid = s.stxnamespace)
+			 JOIN pg_catalog.pg_class c ON (c.oid = s.stxrelid)
+			 JOIN pg_catalog.pg_namespace n ON (n.oid = c.relnamespace)
+			 %s
+ WHERE c.relkind IN ('r','m','p')
+			 AND c.relpersistence <> 't'
+			 AND n.nspname NOT IN ('pg_catalog','pg_toast','information_schema')
+			 AND c.oid NOT IN (SELECT relid FROM locked_relids)
+			 AND ($1 = '' OR (n.nspname || '.' || c.relname) !~* $1)`
+
+// Before Postgres 12, the computed statistics are stored in pg_statistic_ext itself
+const extendedStatisticsSQLDefaultDataFields = "s.stxndistinct IS NOT NULL, s.stxdependencies IS NOT NULL, false"
+const extendedStatisticsSQLDefaultDataJoin = ""
+
+// On Postgres 15+, pg_stats_ext has separate rows for statistics including inheritance children
+const extendedStatisticsSQLpg12DataFields = "COALESCE(e.has_ndistinct, false), COALESCE(e.has_dependencies, false), COALESCE(e.has_mcv, false)"
+const extendedStatisticsSQLpg12DataJoin = `LEFT JOIN LATERAL (
+				 SELECT bool_or(n_distinct IS NOT NULL) AS has_ndistinct, bool_or(dependencies IS NOT NULL) AS has_dependencies,
+								bool_or(most_common_vals IS NOT NULL) AS has_mcv
+					 FROM pg_catalog.pg_stats_ext
+					WHERE statistics_schemaname = sn.nspname AND statistics_name = s.stxname
+			 ) e ON (true)`
+
 const viewDefinitionSQL string = `
 	WITH locked_relids AS (SELECT DISTINCT relation relid FROM pg_catalog.pg_locks WHERE mode = 'AccessExclusiveLock' AND relation IS NOT NULL)
 SELECT c.oid,
@@ -326,6 +359,51 @@ func GetRelations(db *sql.DB, postgresVersion state.PostgresVersion, currentData
 		relations[row.RelationOid] = relation
 	}
 
+	// Extended statistics
+	if postgresVersion.Numeric >= state.PostgresVersion10 {
+		dataFields := extendedStatisticsSQLDefaultDataFields
+		dataJoin := extendedStatisticsSQLDefaultDataJoin
+		if postgresVersion.Numeric >= state.PostgresVersion12 {
+			dataFields = extendedStatisticsSQLpg12DataFields
+			dataJoin = extendedStatisticsSQLpg12DataJoin
+		}
+
+		rows, err = db.Query(QueryMarkerSQL+fmt.Sprintf(extendedStatisticsSQL, dataFields, dataJoin), ignoreRegexp)
+		if err != nil {
+			err = fmt.Errorf("ExtendedStatistics/Query: %s", err)
+			return nil, err
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var row state.PostgresExtendedStatistic
+			var columns, kinds string
+
+			err = rows.Scan(&row.RelationOid, &row.SchemaName, &row.Name, &columns, &kinds,
+				&row.Definition, &row.HasNDistinct, &row.HasDependencies, &row.HasMCV)
+			if err != nil {
+				err = fmt.Errorf("ExtendedStatistics/Scan: %s", err)
+				return nil, err
+			}
+
+			// stxkeys is an int2vector (space-separated), and empty for statistics on expressions only
+			for _, cstr := range strings.Fields(columns) {
+				cint, _ := strconv.ParseInt(cstr, 10, 32)
+				row.Columns = append(row.Columns, int32(cint))
+			}
+			for _, kind := range strings.Split(strings.Trim(kinds, "{}"), ",") {
+				if kind != "" {
+					row.Kinds = append(row.Kinds, kind)
+				}
+			}
+
+			relation := relations[row.RelationOid]
+			relation.ExtendedStatistics = append(relation.ExtendedStatistics, row)
+			relations[row.RelationOid] = relation
+		}
+	}
+
 	// View definitions
 	rows, err = db.Query(QueryMarkerSQL+viewDefinitionSQL, ignoreRegexp)
 	if err != nil {
@@ -117,6 +117,11 @@ func collectSchemaData(collectionOpts state.CollectionOpts, logger *util.Logger,
 		for k, v := range newColumnStats {
 			ps.SchemaStats[databaseOid].ColumnStats[k] = v
 		}
+
+		ps, ts, err = collectTimescale(db, ps, ts, databaseOid, ignoreRegexp)
+		if err != nil {
+			logger.PrintWarning("Skipping TimescaleDB statistics for database %s, due to error: %s", dbName, err)
+		}
 	}
 
 	if collectionOpts.CollectPostgresFunctions {
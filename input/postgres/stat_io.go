@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const statIOSQL string = `
+SELECT backend_type, object, context,
+			 COALESCE(reads, 0), COALESCE(read_time, 0), COALESCE(writes, 0), COALESCE(write_time, 0),
+			 COALESCE(writebacks, 0), COALESCE(writeback_time, 0), COALESCE(extends, 0), COALESCE(extend_time, 0),
+			 COALESCE(hits, 0), COALESCE(evictions, 0), COALESCE(reuses, 0), COALESCE(fsyncs, 0), COALESCE(fsync_time, 0),
+			 stats_reset
+	FROM pg_catalog.pg_stat_io`
+
+// GetStatIO - Collects the I/O statistics by backend type, object and context (Postgres 16+)
+func GetStatIO(db *sql.DB, postgresVersion state.PostgresVersion) (state.PostgresStatIOMap, error) {
+	if postgresVersion.Numeric < state.PostgresVersion16 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + statIOSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statIO := make(state.PostgresStatIOMap)
+	for rows.Next() {
+		var key state.PostgresStatIOKey
+		var stats state.PostgresStatIO
+		var statsReset null.Time
+
+		err = rows.Scan(&key.BackendType, &key.Object, &key.Context,
+			&stats.Reads, &stats.ReadTime, &stats.Writes, &stats.WriteTime,
+			&stats.Writebacks, &stats.WritebackTime, &stats.Extends, &stats.ExtendTime,
+			&stats.Hits, &stats.Evictions, &stats.Reuses, &stats.Fsyncs, &stats.FsyncTime,
+			&statsReset)
+		if err != nil {
+			return nil, err
+		}
+		stats.StatsReset = statsReset.Time
+
+		statIO[key] = stats
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return statIO, nil
+}
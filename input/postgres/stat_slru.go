@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const statSLRUSQL string = `
+SELECT name, blks_zeroed, blks_hit, blks_read, blks_written, blks_exists, flushes, truncates, stats_reset
+	FROM pg_catalog.pg_stat_slru`
+
+// GetStatSLRU - Collects the statistics of the SLRU caches (Postgres 13+)
+func GetStatSLRU(db *sql.DB, postgresVersion state.PostgresVersion) (state.PostgresStatSLRUMap, error) {
+	if postgresVersion.Numeric < state.PostgresVersion13 {
+		return nil, nil
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + statSLRUSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statSLRU := make(state.PostgresStatSLRUMap)
+	for rows.Next() {
+		var name string
+		var stats state.PostgresStatSLRU
+		var statsReset null.Time
+
+		err = rows.Scan(&name, &stats.BlksZeroed, &stats.BlksHit, &stats.BlksRead, &stats.BlksWritten,
+			&stats.BlksExists, &stats.Flushes, &stats.Truncates, &statsReset)
+		if err != nil {
+			return nil, err
+		}
+		stats.StatsReset = statsReset.Time
+
+		statSLRU[name] = stats
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return statSLRU, nil
+}
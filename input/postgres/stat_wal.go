@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+const statWALSQLpg14 string = `
+SELECT wal_records, wal_fpi, wal_bytes::bigint, wal_buffers_full, wal_write, wal_sync,
+			 wal_write_time, wal_sync_time, stats_reset
+	FROM pg_catalog.pg_stat_wal`
+
+// Postgres 18 moved the WAL write/sync counters and timings to pg_stat_io
+const statWALSQLpg18 string = `
+SELECT wal_records, wal_fpi, wal_bytes::bigint, wal_buffers_full, 0, 0, 0, 0, stats_reset
+	FROM pg_catalog.pg_stat_wal`
+
+// GetStatWAL - Collects the WAL activity statistics (Postgres 14+), returning nil for older versions
+func GetStatWAL(db *sql.DB, postgresVersion state.PostgresVersion) (*state.PostgresStatWAL, error) {
+	var sql string
+
+	if postgresVersion.Numeric >= state.PostgresVersion18 {
+		sql = statWALSQLpg18
+	} else if postgresVersion.Numeric >= state.PostgresVersion14 {
+		sql = statWALSQLpg14
+	} else {
+		return nil, nil
+	}
+
+	var stats state.PostgresStatWAL
+	var statsReset null.Time
+	err := db.QueryRow(QueryMarkerSQL+sql).Scan(&stats.WalRecords, &stats.WalFpi, &stats.WalBytes,
+		&stats.WalBuffersFull, &stats.WalWrite, &stats.WalSync, &stats.WalWriteTime, &stats.WalSyncTime,
+		&statsReset)
+	if err != nil {
+		return nil, err
+	}
+	stats.StatsReset = statsReset.Time
+
+	return &stats, nil
+}
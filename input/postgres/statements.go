@@ -19,12 +19,23 @@ const statementSQLpg95OptionalFields = "queryid, min_time, max_time, mean_time,
 const statementSQLpg13OptionalFields = "queryid, min_exec_time, max_exec_time, mean_exec_time, stddev_exec_time"
 const statementSQLDefaultTotalTimeField = "total_time"
 const statementSQLpg13TotalTimeField = "total_exec_time"
+const statementSQLDefaultPlansField = "0"
+const statementSQLpg13PlansField = "plans"
+const statementSQLDefaultToplevelField = "true"
+const statementSQLpg14ToplevelField = "toplevel"
+
+// Checks for the pg_stat_statements 1.8+ "plans" and 1.9+ "toplevel" columns, since the extension
+// may not have been updated after a Postgres upgrade
+const statementColumnsSQL string = `
+SELECT COALESCE(bool_or(attname = 'plans'), false), COALESCE(bool_or(attname = 'toplevel'), false)
+	FROM pg_catalog.pg_attribute
+ WHERE attrelid = 'public.pg_stat_statements'::regclass AND attname IN ('plans', 'toplevel')`
 
 const statementSQL string = `
 SELECT dbid, userid, query, calls, %s, rows, shared_blks_hit, shared_blks_read,
 			 shared_blks_dirtied, shared_blks_written, local_blks_hit, local_blks_read,
 			 local_blks_dirtied, local_blks_written, temp_blks_read, temp_blks_written,
-			 blk_read_time, blk_write_time, %s
+			 blk_read_time, blk_write_time, %s, %s, %s
 	FROM %s`
 
 const statementStatsHelperSQL string = `
@@ -78,10 +89,37 @@ func ResetStatements(logger *util.Logger, db *sql.DB, systemType string) error {
 	return nil
 }
 
+const statementStatsInfoSQL string = `
+SELECT dealloc, stats_reset
+	FROM public.pg_stat_statements_info`
+
+// GetStatementStatsInfo - Collects the overall statistics of pg_stat_statements (Postgres 14+, with
+// pg_stat_statements 1.9 or newer), returning a zero value otherwise
+func GetStatementStatsInfo(db *sql.DB, postgresVersion state.PostgresVersion) (state.PostgresStatementStatsInfo, error) {
+	var info state.PostgresStatementStatsInfo
+
+	if postgresVersion.Numeric < state.PostgresVersion14 || UsesPgStatMonitor(db) {
+		return info, nil
+	}
+
+	err := db.QueryRow(QueryMarkerSQL+statementStatsInfoSQL).Scan(&info.Dealloc, &info.StatsReset)
+	if err != nil {
+		var e *pq.Error
+		if errors.As(err, &e) && (e.Code == "42P01" || e.Code == "55000") { // undefined_table (extension not yet updated) / object_not_in_prerequisite_state
+			return info, nil
+		}
+		return info, err
+	}
+
+	return info, nil
+}
+
 func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, globalCollectionOpts state.CollectionOpts, postgresVersion state.PostgresVersion, showtext bool, systemType string) (state.PostgresStatementMap, state.PostgresStatementTextMap, state.PostgresStatementStatsMap, error) {
 	var err error
 	var totalTimeField string
 	var optionalFields string
+	var plansField string
+	var toplevelField string
 	var sourceTable string
 
 	if postgresVersion.Numeric >= state.PostgresVersion13 {
@@ -100,6 +138,23 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 		optionalFields = statementSQLDefaultOptionalFields
 	}
 
+	if schema, ok := pgStatMonitorSchema(db); ok {
+		return getPgStatMonitorStatements(server, logger, db, schema, showtext)
+	}
+
+	plansField = statementSQLDefaultPlansField
+	toplevelField = statementSQLDefaultToplevelField
+	if postgresVersion.Numeric >= state.PostgresVersion13 {
+		var hasPlans, hasToplevel bool
+		err = db.QueryRow(QueryMarkerSQL+statementColumnsSQL).Scan(&hasPlans, &hasToplevel)
+		if err == nil && hasPlans {
+			plansField = statementSQLpg13PlansField
+		}
+		if err == nil && hasToplevel {
+			toplevelField = statementSQLpg14ToplevelField
+		}
+	}
+
 	usingStatsHelper := false
 
 	if statementStatsHelperExists(db, showtext) {
@@ -124,7 +179,7 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 		}
 	}
 
-	querySql := QueryMarkerSQL + fmt.Sprintf(statementSQL, totalTimeField, optionalFields, sourceTable)
+	querySql := QueryMarkerSQL + fmt.Sprintf(statementSQL, totalTimeField, optionalFields, plansField, toplevelField, sourceTable)
 
 	stmt, err := db.Prepare(querySql)
 	if err != nil {
@@ -180,12 +235,13 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 		var queryID null.Int
 		var receivedQuery null.String
 		var stats state.PostgresStatementStats
+		var toplevel bool
 
 		err = rows.Scan(&key.DatabaseOid, &key.UserOid, &receivedQuery, &stats.Calls, &stats.TotalTime, &stats.Rows,
 			&stats.SharedBlksHit, &stats.SharedBlksRead, &stats.SharedBlksDirtied, &stats.SharedBlksWritten,
 			&stats.LocalBlksHit, &stats.LocalBlksRead, &stats.LocalBlksDirtied, &stats.LocalBlksWritten,
 			&stats.TempBlksRead, &stats.TempBlksWritten, &stats.BlkReadTime, &stats.BlkWriteTime,
-			&queryID, &stats.MinTime, &stats.MaxTime, &stats.MeanTime, &stats.StddevTime)
+			&queryID, &stats.MinTime, &stats.MaxTime, &stats.MeanTime, &stats.StddevTime, &stats.Plans, &toplevel)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -209,6 +265,13 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 			stats.BlkReadTime = 0
 			stats.BlkWriteTime = 0
 		}
+		if !toplevel {
+			stats.NestedCalls = stats.Calls
+		}
+		// With pg_stat_statements.track = all, top-level and nested executions are separate entries
+		if other, ok := statementStats[key]; ok {
+			stats = combineStatementStats(other, stats)
+		}
 		statementStats[key] = stats
 	}
 	err = rows.Err()
@@ -216,6 +279,15 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 		return nil, nil, nil, err
 	}
 
+	addKcacheStats(logger, db, statementStats)
+
+	statements, statementTextsByFp := fingerprintStatementTexts(server, statementTexts, showtext)
+
+	return statements, statementTextsByFp, statementStats, nil
+}
+
+// fingerprintStatementTexts - Fingerprints and normalizes the query texts of the statements
+func fingerprintStatementTexts(server *state.Server, statementTexts map[state.PostgresStatementKey]string, showtext bool) (state.PostgresStatementMap, state.PostgresStatementTextMap) {
 	statements := make(state.PostgresStatementMap)
 	statementTextsByFp := make(state.PostgresStatementTextMap)
 	if showtext {
@@ -234,8 +306,13 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 					Fingerprint: collectorQueryFingerprint,
 				}
 			} else {
+				// Tags have to be extracted first, since collapsing lists drops all comments
+				tags := util.ParseQueryTags(text)
+				if server.Config.QueryCollapseLists {
+					text = util.CollapseQueryLists(text)
+				}
 				fp := util.FingerprintQuery(text, server.Config.FilterQueryText, -1)
-				statements[key] = state.PostgresStatement{Fingerprint: fp}
+				statements[key] = state.PostgresStatement{Fingerprint: fp, Tags: tags}
 				_, ok := statementTextsByFp[fp]
 				if !ok {
 					statementTextsByFp[fp] = util.NormalizeQuery(text, server.Config.FilterQueryText, -1)
@@ -244,7 +321,7 @@ func GetStatements(server *state.Server, logger *util.Logger, db *sql.DB, global
 		}
 	}
 
-	return statements, statementTextsByFp, statementStats, nil
+	return statements, statementTextsByFp
 }
 
 func ignoreIOTiming(postgresVersion state.PostgresVersion, receivedQuery null.String) bool {
@@ -267,3 +344,42 @@ func ignoreIOTiming(postgresVersion state.PostgresVersion, receivedQuery null.St
 
 	return false
 }
+
+const statementTextsByQueryIDSQL string = `
+SELECT DISTINCT ON (queryid) queryid, query
+	FROM %s
+ WHERE queryid = ANY($1)`
+
+// GetStatementTextsByQueryID - Looks up the full normalized query texts of the given query IDs
+// in pg_stat_statements, e.g. to complete query texts that were truncated in pg_stat_activity
+func GetStatementTextsByQueryID(db *sql.DB, queryIDs []int64) (map[int64]string, error) {
+	sourceTable := "public.pg_stat_statements"
+	if statementStatsHelperExists(db, true) {
+		sourceTable = "pganalyze.get_stat_statements()"
+	}
+
+	rows, err := db.Query(QueryMarkerSQL+fmt.Sprintf(statementTextsByQueryIDSQL, sourceTable), pq.Array(queryIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	texts := make(map[int64]string)
+	for rows.Next() {
+		var queryID int64
+		var query null.String
+
+		err = rows.Scan(&queryID, &query)
+		if err != nil {
+			return nil, err
+		}
+		if query.Valid && !insufficientPrivilege(query.String) {
+			texts[queryID] = query.String
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return texts, nil
+}
@@ -19,6 +19,11 @@ const connectedAsCloudSQLSuperuser string = `
 SELECT pg_has_role(oid, 'MEMBER') FROM pg_roles WHERE rolname = 'cloudsqlsuperuser'
 `
 
+// Supabase doesn't give out superuser access, the "postgres" role is the most privileged role available
+const connectedAsSupabasePostgresSQL string = `
+SELECT pg_has_role('postgres', 'MEMBER') AND EXISTS(SELECT 1 FROM pg_roles WHERE rolname = 'supabase_admin')
+`
+
 func connectedAsSuperUser(db *sql.DB, systemType string) bool {
 	var enabled bool
 
@@ -50,6 +55,12 @@ func connectedAsSuperUser(db *sql.DB, systemType string) bool {
 	if err != nil {
 		return false
 	}
+	if !enabled && systemType == "self_hosted" {
+		err = db.QueryRow(QueryMarkerSQL + connectedAsSupabasePostgresSQL).Scan(&enabled)
+		if err != nil {
+			return false
+		}
+	}
 
 	return enabled
 }
@@ -64,6 +75,10 @@ func isCloudInternalDatabase(systemType string, databaseName string) bool {
 	if systemType == "google_cloudsql" {
 		return databaseName == "cloudsqladmin"
 	}
+	if systemType == "self_hosted" {
+		// Used by Supabase for its own services (log storage, connection pooler)
+		return databaseName == "_supabase"
+	}
 	return false
 }
 
@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/state"
+)
+
+// TimescaleDB 1.x has a different catalog and information views, and isn't supported
+const timescaleInstalledSQL string = `
+SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_extension WHERE extname = 'timescaledb' AND extversion !~ '^[01]\.')`
+
+// Chunks of the internal hypertables that hold compressed data, and those internal hypertables
+// themselves, are attributed to the user-facing hypertable as well
+const timescaleChunksSQL string = `
+WITH hypertables AS (
+	SELECT h.id,
+				 pg_catalog.to_regclass(pg_catalog.quote_ident(COALESCE(p.schema_name, h.schema_name)) || '.' || pg_catalog.quote_ident(COALESCE(p.table_name, h.table_name)))::oid AS parent_oid
+		FROM _timescaledb_catalog.hypertable h
+				 LEFT JOIN _timescaledb_catalog.hypertable p ON (p.compressed_hypertable_id = h.id)
+)
+SELECT c.schema_name,
+			 c.table_name,
+			 pg_catalog.to_regclass(pg_catalog.quote_ident(c.schema_name) || '.' || pg_catalog.quote_ident(c.table_name))::oid,
+			 h.parent_oid
+	FROM _timescaledb_catalog.chunk c
+			 JOIN hypertables h ON (h.id = c.hypertable_id)
+ WHERE NOT c.dropped
+ UNION ALL
+SELECT h.schema_name,
+			 h.table_name,
+			 pg_catalog.to_regclass(pg_catalog.quote_ident(h.schema_name) || '.' || pg_catalog.quote_ident(h.table_name))::oid,
+			 p.parent_oid
+	FROM _timescaledb_catalog.hypertable h
+			 JOIN hypertables p ON (p.id = h.id)
+ WHERE h.id IN (SELECT compressed_hypertable_id FROM _timescaledb_catalog.hypertable)`
+
+const timescaleChunkIndicesSQL string = `
+SELECT pg_catalog.to_regclass(pg_catalog.quote_ident(c.schema_name) || '.' || pg_catalog.quote_ident(ci.index_name))::oid,
+			 pg_catalog.to_regclass(pg_catalog.quote_ident(h.schema_name) || '.' || pg_catalog.quote_ident(ci.hypertable_index_name))::oid
+	FROM _timescaledb_catalog.chunk_index ci
+			 JOIN _timescaledb_catalog.chunk c ON (c.id = ci.chunk_id)
+			 JOIN _timescaledb_catalog.hypertable h ON (h.id = ci.hypertable_id)
+ WHERE NOT c.dropped`
+
+const timescaleHypertablesSQL string = `
+SELECT h.hypertable_schema,
+			 h.hypertable_name,
+			 pg_catalog.to_regclass(pg_catalog.quote_ident(h.hypertable_schema) || '.' || pg_catalog.quote_ident(h.hypertable_name))::oid,
+			 h.num_dimensions,
+			 h.num_chunks,
+			 h.compression_enabled,
+			 COALESCE(s.number_compressed_chunks, 0),
+			 COALESCE(s.before_compression_total_bytes, 0),
+			 COALESCE(s.after_compression_total_bytes, 0)
+	FROM timescaledb_information.hypertables h
+			 LEFT JOIN LATERAL (
+				 SELECT *
+					 FROM hypertable_compression_stats(pg_catalog.to_regclass(pg_catalog.quote_ident(h.hypertable_schema) || '.' || pg_catalog.quote_ident(h.hypertable_name)))
+					WHERE h.compression_enabled
+			 ) s ON true
+ WHERE ($1 = '' OR (h.hypertable_schema || '.' || h.hypertable_name) !~* $1)`
+
+const timescaleContinuousAggregatesSQL string = `
+SELECT ca.view_schema,
+			 ca.view_name,
+			 ca.hypertable_schema,
+			 ca.hypertable_name,
+			 ca.materialized_only,
+			 s.job_id,
+			 s.last_run_started_at,
+			 s.last_successful_finish,
+			 s.last_run_status,
+			 EXTRACT(epoch FROM s.last_run_duration),
+			 s.next_start,
+			 COALESCE(s.total_runs, 0),
+			 COALESCE(s.total_failures, 0)
+	FROM timescaledb_information.continuous_aggregates ca
+			 LEFT JOIN timescaledb_information.jobs j ON (j.hypertable_schema = ca.materialization_hypertable_schema
+																									AND j.hypertable_name = ca.materialization_hypertable_name
+																									AND j.proc_name = 'policy_refresh_continuous_aggregate')
+			 LEFT JOIN timescaledb_information.job_stats s ON (s.job_id = j.job_id)
+ WHERE ($1 = '' OR (ca.view_schema || '.' || ca.view_name) !~* $1)`
+
+type timescaleChunk struct {
+	schemaName    string
+	relationName  string
+	hypertableOid state.Oid
+}
+
+func timescaleInstalled(db *sql.DB) (bool, error) {
+	var installed bool
+	err := db.QueryRow(QueryMarkerSQL + timescaleInstalledSQL).Scan(&installed)
+	return installed, err
+}
+
+// collectTimescale - Rolls up the chunks of TimescaleDB hypertables into their hypertable, and
+// collects hypertable and continuous aggregate statistics, if TimescaleDB is installed
+//
+// This needs to run after relations and their statistics have been collected for the database.
+func collectTimescale(db *sql.DB, ps state.PersistedState, ts state.TransientState, databaseOid state.Oid, ignoreRegexp string) (state.PersistedState, state.TransientState, error) {
+	installed, err := timescaleInstalled(db)
+	if err != nil {
+		return ps, ts, fmt.Errorf("Timescale: %s", err)
+	}
+	if !installed {
+		return ps, ts, nil
+	}
+
+	chunks, err := getTimescaleChunks(db)
+	if err != nil {
+		return ps, ts, err
+	}
+	chunkIndices, err := getTimescaleChunkIndices(db)
+	if err != nil {
+		return ps, ts, err
+	}
+	ps = rollUpTimescaleChunks(ps, databaseOid, chunks, chunkIndices)
+
+	hypertables, err := getTimescaleHypertables(db, databaseOid, ignoreRegexp)
+	if err != nil {
+		return ps, ts, err
+	}
+	ts.TimescaleHypertables = append(ts.TimescaleHypertables, hypertables...)
+
+	continuousAggregates, err := getTimescaleContinuousAggregates(db, databaseOid, ignoreRegexp)
+	if err != nil {
+		return ps, ts, err
+	}
+	ts.TimescaleContinuousAggregates = append(ts.TimescaleContinuousAggregates, continuousAggregates...)
+
+	return ps, ts, nil
+}
+
+func getTimescaleChunks(db *sql.DB) (map[state.Oid]timescaleChunk, error) {
+	rows, err := db.Query(QueryMarkerSQL + timescaleChunksSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Timescale/Chunks/Query: %s", err)
+	}
+	defer rows.Close()
+
+	chunks := make(map[state.Oid]timescaleChunk)
+	for rows.Next() {
+		var chunk timescaleChunk
+		var oid, hypertableOid null.Int
+
+		err := rows.Scan(&chunk.schemaName, &chunk.relationName, &oid, &hypertableOid)
+		if err != nil {
+			return nil, fmt.Errorf("Timescale/Chunks/Scan: %s", err)
+		}
+		// Chunks may be dropped concurrently
+		if !oid.Valid || !hypertableOid.Valid {
+			continue
+		}
+		chunk.hypertableOid = state.Oid(hypertableOid.Int64)
+		chunks[state.Oid(oid.Int64)] = chunk
+	}
+
+	return chunks, nil
+}
+
+func getTimescaleChunkIndices(db *sql.DB) (map[state.Oid]state.Oid, error) {
+	rows, err := db.Query(QueryMarkerSQL + timescaleChunkIndicesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("Timescale/ChunkIndices/Query: %s", err)
+	}
+	defer rows.Close()
+
+	indices := make(map[state.Oid]state.Oid)
+	for rows.Next() {
+		var oid, hypertableIndexOid null.Int
+
+		err := rows.Scan(&oid, &hypertableIndexOid)
+		if err != nil {
+			return nil, fmt.Errorf("Timescale/ChunkIndices/Scan: %s", err)
+		}
+		if !oid.Valid || !hypertableIndexOid.Valid {
+			continue
+		}
+		indices[state.Oid(oid.Int64)] = state.Oid(hypertableIndexOid.Int64)
+	}
+
+	return indices, nil
+}
+
+// rollUpTimescaleChunks - Removes chunks from the relations of the database, and records which
+// hypertable (and hypertable index) each chunk belongs to
+//
+// Chunk statistics are kept as-is, so they can be diffed per chunk (chunks get dropped by retention
+// policies), and are only added to those of their hypertable after diffing. Chunks of hypertables
+// that are not collected (e.g. due to the ignore regexp) are discarded.
+func rollUpTimescaleChunks(ps state.PersistedState, databaseOid state.Oid, chunks map[state.Oid]timescaleChunk, chunkIndices map[state.Oid]state.Oid) state.PersistedState {
+	hypertables := make(map[state.Oid]bool)
+	hypertableIndices := make(map[state.Oid]bool)
+	relations := []state.PostgresRelation{}
+	for _, relation := range ps.Relations {
+		if relation.DatabaseOid == databaseOid {
+			if _, ok := chunks[relation.Oid]; ok {
+				continue
+			}
+			hypertables[relation.Oid] = true
+			for _, index := range relation.Indices {
+				hypertableIndices[index.IndexOid] = true
+			}
+		}
+		relations = append(relations, relation)
+	}
+	ps.Relations = relations
+
+	schemaStats := ps.SchemaStats[databaseOid]
+	schemaStats.TimescaleChunks = make(map[state.Oid]state.Oid)
+	schemaStats.TimescaleChunkIndices = make(map[state.Oid]state.Oid)
+	chunkNames := make(map[[2]string]bool)
+	for oid, chunk := range chunks {
+		if hypertables[chunk.hypertableOid] {
+			schemaStats.TimescaleChunks[oid] = chunk.hypertableOid
+		} else {
+			delete(schemaStats.RelationStats, oid)
+		}
+		chunkNames[[2]string{chunk.schemaName, chunk.relationName}] = true
+	}
+	for key := range schemaStats.ColumnStats {
+		if chunkNames[[2]string{key.SchemaName, key.TableName}] {
+			delete(schemaStats.ColumnStats, key)
+		}
+	}
+	for oid, hypertableIndexOid := range chunkIndices {
+		if hypertableIndices[hypertableIndexOid] {
+			schemaStats.TimescaleChunkIndices[oid] = hypertableIndexOid
+		} else {
+			delete(schemaStats.IndexStats, oid)
+		}
+	}
+
+	return ps
+}
+
+func getTimescaleHypertables(db *sql.DB, databaseOid state.Oid, ignoreRegexp string) ([]state.PostgresTimescaleHypertable, error) {
+	rows, err := db.Query(QueryMarkerSQL+timescaleHypertablesSQL, ignoreRegexp)
+	if err != nil {
+		return nil, fmt.Errorf("Timescale/Hypertables/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var hypertables []state.PostgresTimescaleHypertable
+	for rows.Next() {
+		row := state.PostgresTimescaleHypertable{DatabaseOid: databaseOid}
+		var oid null.Int
+
+		err := rows.Scan(&row.SchemaName, &row.RelationName, &oid, &row.NumDimensions, &row.NumChunks,
+			&row.CompressionEnabled, &row.CompressedChunks, &row.BeforeCompressionSizeBytes, &row.AfterCompressionSizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("Timescale/Hypertables/Scan: %s", err)
+		}
+		row.RelationOid = state.Oid(oid.Int64)
+
+		hypertables = append(hypertables, row)
+	}
+
+	return hypertables, nil
+}
+
+func getTimescaleContinuousAggregates(db *sql.DB, databaseOid state.Oid, ignoreRegexp string) ([]state.PostgresTimescaleContinuousAggregate, error) {
+	rows, err := db.Query(QueryMarkerSQL+timescaleContinuousAggregatesSQL, ignoreRegexp)
+	if err != nil {
+		return nil, fmt.Errorf("Timescale/ContinuousAggregates/Query: %s", err)
+	}
+	defer rows.Close()
+
+	var continuousAggregates []state.PostgresTimescaleContinuousAggregate
+	for rows.Next() {
+		row := state.PostgresTimescaleContinuousAggregate{DatabaseOid: databaseOid}
+
+		err := rows.Scan(&row.SchemaName, &row.ViewName, &row.HypertableSchemaName, &row.HypertableName,
+			&row.MaterializedOnly, &row.JobID, &row.LastRunStartedAt, &row.LastSuccessfulFinish, &row.LastRunStatus,
+			&row.LastRunDurationSeconds, &row.NextStart, &row.TotalRuns, &row.TotalFailures)
+		if err != nil {
+			return nil, fmt.Errorf("Timescale/ContinuousAggregates/Scan: %s", err)
+		}
+
+		continuousAggregates = append(continuousAggregates, row)
+	}
+
+	return continuousAggregates, nil
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/state"
+)
+
+func TestRollUpTimescaleChunks(t *testing.T) {
+	ps := state.PersistedState{
+		Relations: []state.PostgresRelation{
+			{DatabaseOid: 1, Oid: 100, SchemaName: "public", RelationName: "metrics", Indices: []state.PostgresIndex{{RelationOid: 100, IndexOid: 200}}},
+			{DatabaseOid: 1, Oid: 101, SchemaName: "_timescaledb_internal", RelationName: "_hyper_1_1_chunk"},
+			{DatabaseOid: 1, Oid: 111, SchemaName: "_timescaledb_internal", RelationName: "_hyper_2_1_chunk"},
+			{DatabaseOid: 2, Oid: 101, SchemaName: "public", RelationName: "other"},
+		},
+		SchemaStats: map[state.Oid]*state.SchemaStats{
+			1: {
+				RelationStats: state.PostgresRelationStatsMap{100: {}, 101: {SeqScan: 5}, 111: {SeqScan: 7}},
+				IndexStats:    state.PostgresIndexStatsMap{200: {}, 201: {IdxScan: 3}, 211: {IdxScan: 4}},
+				ColumnStats: state.PostgresColumnStatsMap{
+					{SchemaName: "public", TableName: "metrics", ColumnName: "time"}:                         nil,
+					{SchemaName: "_timescaledb_internal", TableName: "_hyper_1_1_chunk", ColumnName: "time"}: nil,
+				},
+			},
+		},
+	}
+	// Hypertable 110 is not collected, e.g. because it matches the ignore regexp
+	chunks := map[state.Oid]timescaleChunk{
+		101: {schemaName: "_timescaledb_internal", relationName: "_hyper_1_1_chunk", hypertableOid: 100},
+		111: {schemaName: "_timescaledb_internal", relationName: "_hyper_2_1_chunk", hypertableOid: 110},
+	}
+	chunkIndices := map[state.Oid]state.Oid{201: 200, 211: 210}
+
+	ps = rollUpTimescaleChunks(ps, 1, chunks, chunkIndices)
+
+	var relations []string
+	for _, relation := range ps.Relations {
+		relations = append(relations, relation.SchemaName+"."+relation.RelationName)
+	}
+	if d := pretty.Compare([]string{"public.metrics", "public.other"}, relations); d != "" {
+		t.Errorf("relations diff: (-want +got)\n%s", d)
+	}
+
+	schemaStats := ps.SchemaStats[1]
+	if d := pretty.Compare(map[state.Oid]state.Oid{101: 100}, schemaStats.TimescaleChunks); d != "" {
+		t.Errorf("chunks diff: (-want +got)\n%s", d)
+	}
+	if d := pretty.Compare(map[state.Oid]state.Oid{201: 200}, schemaStats.TimescaleChunkIndices); d != "" {
+		t.Errorf("chunk indices diff: (-want +got)\n%s", d)
+	}
+	// Chunk statistics are kept for diffing, except for chunks of hypertables that are not collected
+	if _, ok := schemaStats.RelationStats[101]; !ok {
+		t.Errorf("expected chunk relation stats to be kept")
+	}
+	if _, ok := schemaStats.RelationStats[111]; ok {
+		t.Errorf("expected relation stats of chunk of uncollected hypertable to be removed")
+	}
+	if _, ok := schemaStats.IndexStats[211]; ok {
+		t.Errorf("expected index stats of chunk of uncollected hypertable to be removed")
+	}
+	if len(schemaStats.ColumnStats) != 1 {
+		t.Errorf("expected chunk column stats to be removed, got %+v", schemaStats.ColumnStats)
+	}
+}
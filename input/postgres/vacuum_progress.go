@@ -18,7 +18,9 @@ WITH activity AS (
 				 (SELECT pg_catalog.regexp_matches(query, 'autovacuum: VACUUM (ANALYZE )?([^\.]+).([^\(]+)( \(to prevent wraparound\))?'))[3] AS relname,
 				 COALESCE(a.usename, '') AS usename,
 				 a.query_start,
-				 a.query LIKE 'autovacuum: VACUUM%%' AS autovacuum
+				 a.query LIKE 'autovacuum: VACUUM%%' AS autovacuum,
+				 a.query LIKE 'autovacuum: VACUUM%%(to prevent wraparound)' AS anti_wraparound,
+				 a.pid
     FROM %s a
 	 WHERE a.query LIKE 'autovacuum: VACUUM%%'
 )
@@ -33,13 +35,21 @@ SELECT (a.query_start_epoch || a.padded_pid)::bigint AS vacuum_identity,
        a.usename,
 			 a.query_start AS started_at,
 			 a.autovacuum,
+			 a.anti_wraparound,
+			 a.pid,
+			 '' AS wait_event_type,
+			 '' AS wait_event,
 			 '' AS phase,
 			 0 AS heap_blks_total,
 			 0 AS heap_blks_scanned,
 			 0 AS heap_blks_vacuumed,
 			 0 AS index_vacuum_count,
 			 0 AS max_dead_tuples,
-			 0 AS num_dead_tuples
+			 0 AS num_dead_tuples,
+			 0 AS max_dead_tuple_bytes,
+			 0 AS dead_tuple_bytes,
+			 0 AS indexes_total,
+			 0 AS indexes_processed
 	FROM activity a
 `
 
@@ -54,6 +64,9 @@ WITH activity AS (
 				 COALESCE(a.usename, '') AS usename,
 				 a.query_start,
 				 a.query LIKE 'autovacuum: VACUUM%%' AS autovacuum,
+				 a.query LIKE 'autovacuum: VACUUM%%(to prevent wraparound)' AS anti_wraparound,
+				 COALESCE(a.wait_event_type, '') AS wait_event_type,
+				 COALESCE(a.wait_event, '') AS wait_event,
 				 a.query,
 				 a.pid
   FROM %s a
@@ -69,13 +82,16 @@ SELECT (query_start_epoch || padded_pid)::bigint AS vacuum_identity,
 			 a.usename,
 			 a.query_start AS started_at,
 			 a.autovacuum,
+			 a.anti_wraparound,
+			 a.pid,
+			 a.wait_event_type,
+			 a.wait_event,
 			 COALESCE(v.phase, '') AS phase,
 			 COALESCE(v.heap_blks_total, 0) AS heap_blks_total,
 			 COALESCE(v.heap_blks_scanned, 0) AS heap_blks_scanned,
 			 COALESCE(v.heap_blks_vacuumed, 0) AS heap_blks_vacuumed,
 			 COALESCE(v.index_vacuum_count, 0) AS index_vacuum_count,
-			 COALESCE(v.max_dead_tuples, 0) AS max_dead_tuples,
-			 COALESCE(v.num_dead_tuples, 0) AS num_dead_tuples
+			 %s
 	FROM %s v
 			 JOIN activity a USING (pid)
 			 LEFT JOIN pg_catalog.pg_class c ON (c.oid = v.relid)
@@ -83,6 +99,23 @@ SELECT (query_start_epoch || padded_pid)::bigint AS vacuum_identity,
  WHERE c.oid IS NOT NULL OR (a.query <> '<insufficient privilege>' AND a.nspname IS NOT NULL AND a.relname IS NOT NULL)
 `
 
+const vacuumProgressDeadTuplesDefaultFields = `COALESCE(v.max_dead_tuples, 0) AS max_dead_tuples,
+			 COALESCE(v.num_dead_tuples, 0) AS num_dead_tuples,
+			 0 AS max_dead_tuple_bytes,
+			 0 AS dead_tuple_bytes,
+			 0 AS indexes_total,
+			 0 AS indexes_processed`
+
+// Postgres 17 tracks the dead tuples by memory use (and item IDs) instead of a maximum count
+const vacuumProgressDeadTuplespg17Fields = `0 AS max_dead_tuples,
+			 COALESCE(v.num_dead_item_ids, 0) AS num_dead_tuples,
+			 COALESCE(v.max_dead_tuple_bytes, 0) AS max_dead_tuple_bytes,
+			 COALESCE(v.dead_tuple_bytes, 0) AS dead_tuple_bytes,
+			 COALESCE(v.indexes_total, 0) AS indexes_total,
+			 COALESCE(v.indexes_processed, 0) AS indexes_processed`
+
+// GetVacuumProgress - Collects the running vacuums, combining pg_stat_progress_vacuum (Postgres
+// 9.6+) with the pg_stat_activity entry of each (auto)vacuum worker
 func GetVacuumProgress(logger *util.Logger, db *sql.DB, postgresVersion state.PostgresVersion, ignoreRegexp string) ([]state.PostgresVacuumProgress, error) {
 	var activitySourceTable string
 	var sql string
@@ -102,7 +135,11 @@ func GetVacuumProgress(logger *util.Logger, db *sql.DB, postgresVersion state.Po
 		} else {
 			vacuumSourceTable = "pg_catalog.pg_stat_progress_vacuum"
 		}
-		sql = fmt.Sprintf(vacuumProgressSQLDefault, activitySourceTable, vacuumSourceTable)
+		deadTuplesFields := vacuumProgressDeadTuplesDefaultFields
+		if postgresVersion.Numeric >= state.PostgresVersion17 {
+			deadTuplesFields = vacuumProgressDeadTuplespg17Fields
+		}
+		sql = fmt.Sprintf(vacuumProgressSQLDefault, activitySourceTable, deadTuplesFields, vacuumSourceTable)
 	}
 
 	stmt, err := db.Prepare(QueryMarkerSQL + sql)
@@ -125,8 +162,10 @@ func GetVacuumProgress(logger *util.Logger, db *sql.DB, postgresVersion state.Po
 
 		err := rows.Scan(&row.VacuumIdentity, &row.BackendIdentity, &row.DatabaseName,
 			&row.SchemaName, &row.RelationName, &row.RoleName, &row.StartedAt, &row.Autovacuum,
+			&row.AntiWraparound, &row.Pid, &row.WaitEventType, &row.WaitEvent,
 			&row.Phase, &row.HeapBlksTotal, &row.HeapBlksScanned, &row.HeapBlksVacuumed,
-			&row.IndexVacuumCount, &row.MaxDeadTuples, &row.NumDeadTuples)
+			&row.IndexVacuumCount, &row.MaxDeadTuples, &row.NumDeadTuples,
+			&row.MaxDeadTupleBytes, &row.DeadTupleBytes, &row.IndexesTotal, &row.IndexesProcessed)
 		if err != nil {
 			return nil, err
 		}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
@@ -45,3 +46,10 @@ func GetIsAwsAurora(db *sql.DB) (bool, error) {
 	err := db.QueryRow(QueryMarkerSQL + "SELECT pg_catalog.count(1) = 1 FROM pg_settings WHERE name = 'rds.extensions' AND setting LIKE '%aurora_stat_utils%'").Scan(&isAurora)
 	return isAurora, err
 }
+
+// GetPostmasterStartTime - Reads when the server was (re)started, which resets its statistics
+func GetPostmasterStartTime(db *sql.DB) (time.Time, error) {
+	var startTime time.Time
+	err := db.QueryRow(QueryMarkerSQL + "SELECT pg_catalog.pg_postmaster_start_time()").Scan(&startTime)
+	return startTime, err
+}
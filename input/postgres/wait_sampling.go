@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pganalyze/collector/state"
+)
+
+const waitSamplingSchemaSQL string = `
+SELECT pgn.nspname
+	FROM pg_catalog.pg_extension pge
+	JOIN pg_catalog.pg_namespace pgn ON (pge.extnamespace = pgn.oid)
+ WHERE pge.extname = 'pg_wait_sampling'`
+
+const waitSamplingSamplePeriodSQL string = `
+SELECT COALESCE(pg_catalog.current_setting('pg_wait_sampling.sample_period', true), '10')::int`
+
+const waitSamplingProfileSQL string = `
+SELECT pid, COALESCE(event_type, ''), COALESCE(event, ''), COALESCE(queryid, 0), count
+	FROM %s.pg_wait_sampling_profile`
+
+// GetWaitSamplingProfile - Collects the wait event profile of pg_wait_sampling (if installed),
+// together with its sample period in milliseconds
+//
+// pg_wait_sampling samples the wait events of all processes at a much higher rate (every 10ms by
+// default) than the collector's pg_stat_activity snapshots, and counts them per process and query ID.
+func GetWaitSamplingProfile(db *sql.DB) (state.PostgresWaitSamplingProfile, int64, error) {
+	var schema string
+	err := db.QueryRow(QueryMarkerSQL + waitSamplingSchemaSQL).Scan(&schema)
+	if err == sql.ErrNoRows {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	var samplePeriodMs int64
+	err = db.QueryRow(QueryMarkerSQL + waitSamplingSamplePeriodSQL).Scan(&samplePeriodMs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := db.Query(QueryMarkerSQL + fmt.Sprintf(waitSamplingProfileSQL, pq.QuoteIdentifier(schema)))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	profile := make(state.PostgresWaitSamplingProfile)
+	for rows.Next() {
+		var key state.PostgresWaitSamplingKey
+		var count int64
+
+		err = rows.Scan(&key.Pid, &key.EventType, &key.Event, &key.QueryID, &count)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		profile[key] += count
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return profile, samplePeriodMs, nil
+}
@@ -0,0 +1,112 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	eventhubs "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/azureutil"
+)
+
+// How often checkpoints are uploaded, leases renewed, and partitions of other collectors that went away taken over
+const partitionBalanceInterval = 20 * time.Second
+
+// receiveWithCheckpoints - Receives from the partitions that this collector is able to claim, and
+// keeps checking for partitions that become available until the context is cancelled
+func receiveWithCheckpoints(ctx context.Context, wg *sync.WaitGroup, logger *util.Logger, config config.ServerConfig, hub *eventhubs.Hub, store *azureutil.BlobCheckpointStore, partitionIDs []string, handler eventhubs.Handler) error {
+	namespace := config.AzureEventhubNamespace
+	name := config.AzureEventhubName
+	consumerGroup := eventhubConsumerGroup(config)
+	listeners := make(map[string]*eventhubs.ListenerHandle)
+
+	claimAvailable := func() error {
+		for _, partitionID := range partitionIDs {
+			if _, ok := listeners[partitionID]; ok {
+				continue
+			}
+			claimed, err := store.ClaimPartition(namespace, name, consumerGroup, partitionID)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				continue
+			}
+			// Without a starting offset the receiver resumes from the stored checkpoint
+			listener, err := hub.Receive(ctx, partitionID, handler, eventhubs.ReceiveWithConsumerGroup(consumerGroup))
+			if err != nil {
+				store.ReleasePartition(namespace, name, consumerGroup, partitionID)
+				return fmt.Errorf("failed to setup Azure Event Hub receiver for partition ID %s: %s", partitionID, err)
+			}
+			logger.PrintVerbose("Receiving from Azure Event Hub partition ID %s", partitionID)
+			listeners[partitionID] = listener
+		}
+		return nil
+	}
+
+	err := claimAvailable()
+	if err != nil {
+		for partitionID, listener := range listeners {
+			listener.Close(context.Background())
+			store.ReleasePartition(namespace, name, consumerGroup, partitionID)
+		}
+		return err
+	}
+	if len(listeners) == 0 {
+		logger.PrintVerbose("All Azure Event Hub partitions are currently owned by other collectors")
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(partitionBalanceInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				err := store.Flush()
+				if err != nil {
+					logger.PrintWarning("Could not store Azure Event Hub checkpoints: %s", err)
+				}
+				for partitionID := range listeners {
+					store.ReleasePartition(namespace, name, consumerGroup, partitionID)
+				}
+				return
+			case <-ticker.C:
+				err := store.Flush()
+				if err != nil {
+					logger.PrintWarning("Could not store Azure Event Hub checkpoints: %s", err)
+				}
+				for partitionID, listener := range listeners {
+					owned, err := store.RenewPartition(namespace, name, consumerGroup, partitionID)
+					if err != nil {
+						logger.PrintVerbose("Could not renew ownership of Azure Event Hub partition ID %s: %s", partitionID, err)
+					}
+					if !owned {
+						logger.PrintVerbose("Azure Event Hub partition ID %s was taken over by another collector", partitionID)
+						listener.Close(ctx)
+						delete(listeners, partitionID)
+					}
+				}
+				err = claimAvailable()
+				if err != nil {
+					logger.PrintWarning("Could not claim Azure Event Hub partitions: %s", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func eventhubConsumerGroup(config config.ServerConfig) string {
+	if config.AzureEventhubConsumerGroup != "" {
+		return config.AzureEventhubConsumerGroup
+	}
+	return eventhubs.DefaultConsumerGroup
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/azureutil"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/Azure/azure-amqp-common-go/v3/aad"
@@ -66,7 +67,16 @@ func setupEventHubReceiver(ctx context.Context, wg *sync.WaitGroup, logger *util
 		return fmt.Errorf("failed to configure Azure AD JWT provider: %s", err)
 	}
 
-	hub, err := eventhubs.NewHub(config.AzureEventhubNamespace, config.AzureEventhubName, provider)
+	var store *azureutil.BlobCheckpointStore
+	var hubOpts []eventhubs.HubOption
+	if config.AzureEventhubCheckpointStorageAccount != "" && config.AzureEventhubCheckpointContainer != "" {
+		store = azureutil.NewBlobCheckpointStore(config)
+		hubOpts = append(hubOpts, eventhubs.HubWithOffsetPersistence(store))
+	} else if config.AzureEventhubCheckpointStorageAccount != "" || config.AzureEventhubCheckpointContainer != "" {
+		return fmt.Errorf("both azure_eventhub_checkpoint_storage_account and azure_eventhub_checkpoint_container need to be set for checkpointing")
+	}
+
+	hub, err := eventhubs.NewHub(config.AzureEventhubNamespace, config.AzureEventhubName, provider, hubOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to configure Event Hub: %s", err)
 	}
@@ -135,11 +145,16 @@ func setupEventHubReceiver(ctx context.Context, wg *sync.WaitGroup, logger *util
 
 	logger.PrintVerbose("Initializing Azure Event Hub handler")
 
+	if store != nil {
+		return receiveWithCheckpoints(ctx, wg, logger, config, hub, store, info.PartitionIDs, handler)
+	}
+
 	for _, partitionID := range info.PartitionIDs {
 		_, err := hub.Receive(
 			ctx,
 			partitionID,
 			handler,
+			eventhubs.ReceiveWithConsumerGroup(eventhubConsumerGroup(config)),
 			eventhubs.ReceiveWithStartingOffset(persist.StartOfStream),
 		)
 		if err != nil {
@@ -154,13 +169,14 @@ func SetupLogSubscriber(ctx context.Context, wg *sync.WaitGroup, globalCollectio
 	azureLogStream := make(chan AzurePostgresLogRecord, state.LogStreamBufferLen)
 	setupLogTransformer(ctx, wg, servers, azureLogStream, parsedLogStream, globalCollectionOpts, logger)
 
-	// This map is used to avoid duplicate receivers to the same Azure Event Hub
+	// This map is used to avoid duplicate receivers to the same Azure Event Hub (and consumer group)
 	eventHubReceivers := make(map[string]bool)
 
 	for _, server := range servers {
 		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
 		if server.Config.AzureEventhubNamespace != "" && server.Config.AzureEventhubName != "" {
-			if _, ok := eventHubReceivers[server.Config.AzureEventhubNamespace+"/"+server.Config.AzureEventhubName]; ok {
+			receiverKey := server.Config.AzureEventhubNamespace + "/" + server.Config.AzureEventhubName + "/" + eventhubConsumerGroup(server.Config)
+			if _, ok := eventHubReceivers[receiverKey]; ok {
 				continue
 			}
 			err := setupEventHubReceiver(ctx, wg, prefixedLogger, server.Config, azureLogStream)
@@ -173,21 +189,40 @@ func SetupLogSubscriber(ctx context.Context, wg *sync.WaitGroup, globalCollectio
 				continue
 			}
 
-			eventHubReceivers[server.Config.AzureEventhubNamespace+"/"+server.Config.AzureEventhubName] = true
+			eventHubReceivers[receiverKey] = true
 		}
 	}
 
 	return nil
 }
 
+// matchesServer - Determines whether a log record belongs to the given server, based on the
+// resource ID if configured (servers in different subscriptions may share the same name)
+//
+// All nodes of an Azure Cosmos DB for PostgreSQL cluster share the cluster's resource ID, so
+// for those the record's server name needs to match as well.
+func matchesServer(record AzurePostgresLogRecord, config config.ServerConfig) bool {
+	if config.AzureDbResourceID == "" {
+		return record.LogicalServerName == config.AzureDbServerName
+	}
+	// Resource IDs are case-insensitive, and Azure Monitor reports them in upper case
+	if !strings.EqualFold(strings.TrimRight(record.ResourceID, "/"), strings.TrimRight(config.AzureDbResourceID, "/")) {
+		return false
+	}
+	if config.CitusWorkers || config.CitusCoordinatorSection != "" {
+		return strings.EqualFold(record.LogicalServerName, config.AzureDbServerName)
+	}
+	return true
+}
+
 func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, servers []*state.Server, in <-chan AzurePostgresLogRecord, out chan state.ParsedLogStreamItem, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 
-		// Only ingest log lines that were written in the last minute before startup
-		// (Azure Event Hub will return older log lines as well)
-		linesNewerThan := time.Now().Add(-1 * time.Minute)
+		// Only ingest log lines that were written within the replay window (one minute
+		// by default) before startup, since Azure Event Hub will return older log lines as well
+		startedAt := time.Now()
 
 		for {
 			select {
@@ -212,21 +247,21 @@ func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, servers []*sta
 				logLine.CollectedAt = time.Now()
 				logLine.UUID = uuid.NewV4()
 
-				// Ignore loglines which are outside our time window (except in test runs)
-				if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) && !globalCollectionOpts.TestRun {
-					continue
-				}
-
 				foundServer := false
 				for _, server := range servers {
-					if in.LogicalServerName == server.Config.AzureDbServerName {
+					if matchesServer(in, server.Config) {
+						// Ignore loglines which are outside our time window (except in test runs)
+						if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(startedAt.Add(-server.Config.GetLogReplayWindow())) && !globalCollectionOpts.TestRun {
+							continue
+						}
+
 						out <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
 						foundServer = true
 					}
 				}
 
 				if !foundServer && globalCollectionOpts.TestRun {
-					logger.PrintError("Discarding log line because of unknown server (did you set the correct azure_db_server_name?): %s", in.LogicalServerName)
+					logger.PrintError("Discarding log line because of unknown server (did you set the correct azure_db_server_name or azure_db_resource_id?): %s", in.ResourceID)
 				}
 			}
 		}
@@ -0,0 +1,99 @@
+package azure
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/azureutil"
+)
+
+type monitorMetricValue struct {
+	TimeStamp string   `json:"timeStamp"`
+	Average   *float64 `json:"average"`
+	Total     *float64 `json:"total"`
+	Maximum   *float64 `json:"maximum"`
+}
+
+type monitorMetric struct {
+	Name struct {
+		Value string `json:"value"`
+	} `json:"name"`
+	Timeseries []struct {
+		Data []monitorMetricValue `json:"data"`
+	} `json:"timeseries"`
+}
+
+type monitorMetricsResponse struct {
+	Value []monitorMetric `json:"value"`
+}
+
+// monitorMetrics - Latest values of the metrics of one resource, fetched in a single Azure Monitor request
+type monitorMetrics struct {
+	latest map[string]monitorMetricValue
+}
+
+// getMonitorMetrics - Gets the most recent one-minute values of the given metrics for the resource
+func getMonitorMetrics(cfg config.ServerConfig, logger *util.Logger, resourceID string, metricNames []string) monitorMetrics {
+	metrics := monitorMetrics{latest: make(map[string]monitorMetricValue)}
+
+	now := time.Now().UTC()
+	params := url.Values{}
+	params.Set("api-version", "2018-01-01")
+	params.Set("metricnames", strings.Join(metricNames, ","))
+	params.Set("timespan", now.Add(-10*time.Minute).Format(time.RFC3339)+"/"+now.Format(time.RFC3339))
+	params.Set("interval", "PT1M")
+	params.Set("aggregation", "Average,Total,Maximum")
+
+	var resp monitorMetricsResponse
+	err := azureutil.GetResourceManagerJSON(cfg, resourceID+"/providers/Microsoft.Insights/metrics", params, &resp)
+	if err != nil {
+		logger.PrintVerbose("Could not get Azure Monitor metrics: %s", err)
+		return metrics
+	}
+
+	for _, metric := range resp.Value {
+		for _, timeseries := range metric.Timeseries {
+			// Data is returned in time order, with empty entries for minutes that have no data (yet)
+			for _, value := range timeseries.Data {
+				if value.Average != nil || value.Total != nil || value.Maximum != nil {
+					metrics.latest[metric.Name.Value] = value
+				}
+			}
+		}
+	}
+
+	return metrics
+}
+
+// GetAverage - Gets the average of the most recent minute, or 0 if the metric is not available
+func (m monitorMetrics) GetAverage(metricName string) float64 {
+	if value, ok := m.latest[metricName]; ok && value.Average != nil {
+		return *value.Average
+	}
+	return 0.0
+}
+
+// GetRate - Gets the per-second rate of a metric that is reported as a total for each minute
+func (m monitorMetrics) GetRate(metricName string) float64 {
+	if value, ok := m.latest[metricName]; ok && value.Total != nil {
+		return *value.Total / 60
+	}
+	return 0.0
+}
+
+// GetMaximum - Gets the maximum of the most recent minute, or 0 if the metric is not available
+func (m monitorMetrics) GetMaximum(metricName string) float64 {
+	if value, ok := m.latest[metricName]; ok && value.Maximum != nil {
+		return *value.Maximum
+	}
+	return 0.0
+}
+
+// Has - Determines whether there is any recent data for the metric
+func (m monitorMetrics) Has(metricName string) bool {
+	_, ok := m.latest[metricName]
+	return ok
+}
@@ -0,0 +1,106 @@
+package azure
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/azureutil"
+)
+
+type serverParameter struct {
+	Name       string `json:"name"`
+	Properties struct {
+		Value                  string `json:"value"`
+		DefaultValue           string `json:"defaultValue"`
+		Unit                   string `json:"unit"`
+		Source                 string `json:"source"`
+		IsConfigPendingRestart bool   `json:"isConfigPendingRestart"`
+	} `json:"properties"`
+}
+
+type serverParametersResponse struct {
+	Value    []serverParameter `json:"value"`
+	NextLink string            `json:"nextLink"`
+}
+
+// getServerParameters - Lists the server parameters of an Azure Database for PostgreSQL Flexible Server
+func getServerParameters(cfg config.ServerConfig) ([]serverParameter, error) {
+	var parameters []serverParameter
+
+	path := cfg.AzureDbResourceID + "/configurations"
+	params := url.Values{}
+	params.Set("api-version", "2022-12-01")
+	for path != "" {
+		var resp serverParametersResponse
+		err := azureutil.GetResourceManagerJSON(cfg, path, params, &resp)
+		if err != nil {
+			return nil, err
+		}
+		parameters = append(parameters, resp.Value...)
+
+		// The next link already includes the API version and paging token
+		path = resp.NextLink
+		params = nil
+	}
+
+	return parameters, nil
+}
+
+// MergeServerParameters - Adds the server parameters configured through the Azure API to the settings
+// read from pg_settings, including their default value and whether a change is pending a restart
+//
+// Some settings are hidden from the monitoring user on Azure (they require superuser), these get added
+// based on the parameter value instead.
+func MergeServerParameters(cfg config.ServerConfig, logger *util.Logger, settings []state.PostgresSetting) []state.PostgresSetting {
+	if !strings.Contains(strings.ToLower(cfg.AzureDbResourceID), "/microsoft.dbforpostgresql/flexibleservers/") {
+		logger.PrintVerbose("Skipping Azure server parameters, only supported for Flexible Server (azure_db_resource_id needs to be set)")
+		return settings
+	}
+
+	parameters, err := getServerParameters(cfg)
+	if err != nil {
+		logger.PrintWarning("Could not get Azure server parameters: %s", err)
+		return settings
+	}
+
+	settingIdx := make(map[string]int)
+	for idx, setting := range settings {
+		settingIdx[strings.ToLower(setting.Name)] = idx
+	}
+
+	pendingRestart := 0
+	for _, parameter := range parameters {
+		props := parameter.Properties
+		idx, ok := settingIdx[strings.ToLower(parameter.Name)]
+		if !ok {
+			setting := state.PostgresSetting{
+				Name:         parameter.Name,
+				CurrentValue: null.StringFrom(props.Value),
+				BootValue:    null.StringFrom(props.DefaultValue),
+				Source:       null.StringFrom(props.Source),
+			}
+			if props.Unit != "" {
+				setting.Unit = null.StringFrom(props.Unit)
+			}
+			settings = append(settings, setting)
+			idx = len(settings) - 1
+		}
+		settings[idx].ProviderDefaultValue = null.StringFrom(props.DefaultValue)
+
+		if props.IsConfigPendingRestart {
+			settings[idx].PendingRestart = true
+			settings[idx].PendingValue = null.StringFrom(props.Value)
+			pendingRestart++
+		}
+	}
+
+	if pendingRestart > 0 {
+		logger.PrintVerbose("Azure server parameters: %d changes pending a restart", pendingRestart)
+	}
+
+	return settings
+}
@@ -0,0 +1,93 @@
+package azure
+
+import (
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Metrics of Azure Database for PostgreSQL Flexible Server, see
+// https://learn.microsoft.com/en-us/azure/postgresql/flexible-server/concepts-monitoring
+var flexibleServerMetrics = []string{
+	"cpu_percent",
+	"memory_percent",
+	"iops",
+	"read_iops",
+	"write_iops",
+	"read_throughput",
+	"write_throughput",
+	"disk_queue_depth",
+	"storage_used",
+	"storage_free",
+	"network_bytes_ingress",
+	"network_bytes_egress",
+	"physical_replication_delay_in_seconds",
+}
+
+// GetSystemState - Gets system information about an Azure Database for PostgreSQL server from Azure Monitor
+func GetSystemState(config config.ServerConfig, logger *util.Logger) (system state.SystemState) {
+	system.Info.Type = state.AzureDatabaseSystem
+
+	if config.AzureDbResourceID == "" {
+		logger.PrintVerbose("Skipping Azure Database system data, azure_db_resource_id needs to be set")
+		return
+	}
+
+	metrics := getMonitorMetrics(config, logger, config.AzureDbResourceID, flexibleServerMetrics)
+	if !metrics.Has("cpu_percent") {
+		logger.PrintWarning("Could not find Azure Database metrics in Azure Monitor, skipping system data")
+		return
+	}
+
+	system.CPUStats = make(state.CPUStatisticMap)
+	system.CPUStats["all"] = state.CPUStatistic{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedSystemCPUStats{
+			UserPercent: metrics.GetAverage("cpu_percent"),
+		},
+	}
+
+	system.MemoryUsedPercent = metrics.GetAverage("memory_percent")
+
+	// Older servers only report the combined IOPS
+	readIOPS := metrics.GetAverage("read_iops")
+	writeIOPS := metrics.GetAverage("write_iops")
+	if !metrics.Has("read_iops") && !metrics.Has("write_iops") {
+		readIOPS = metrics.GetAverage("iops")
+	}
+
+	system.DiskStats = make(state.DiskStatsMap)
+	system.DiskStats["default"] = state.DiskStats{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedDiskStats{
+			ReadOperationsPerSecond:  readIOPS,
+			WriteOperationsPerSecond: writeIOPS,
+			BytesReadPerSecond:       metrics.GetAverage("read_throughput"),
+			BytesWrittenPerSecond:    metrics.GetAverage("write_throughput"),
+			AvgQueueSize:             int32(metrics.GetAverage("disk_queue_depth")),
+		},
+	}
+
+	system.NetworkStats = make(state.NetworkStatsMap)
+	system.NetworkStats["default"] = state.NetworkStats{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedNetworkStats{
+			ReceiveThroughputBytesPerSecond:  uint64(metrics.GetRate("network_bytes_ingress")),
+			TransmitThroughputBytesPerSecond: uint64(metrics.GetRate("network_bytes_egress")),
+		},
+	}
+
+	usedBytes := uint64(metrics.GetMaximum("storage_used"))
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	system.DiskPartitions["/"] = state.DiskPartition{
+		DiskName:   "default",
+		UsedBytes:  usedBytes,
+		TotalBytes: usedBytes + uint64(metrics.GetMaximum("storage_free")),
+	}
+
+	// Only reported for read replicas
+	system.ReplicaLagSeconds = metrics.GetMaximum("physical_replication_delay_in_seconds")
+
+	return
+}
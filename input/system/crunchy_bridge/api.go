@@ -0,0 +1,127 @@
+package crunchy_bridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+)
+
+const apiBaseURL = "https://api.crunchybridge.com"
+
+const apiTimeout = 30 * time.Second
+
+// ClusterInfo - Metadata of a Crunchy Bridge cluster, as returned by the Bridge API
+type ClusterInfo struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	PlanID       string    `json:"plan_id"`
+	ProviderID   string    `json:"provider_id"`
+	RegionID     string    `json:"region_id"`
+	MajorVersion int       `json:"major_version"`
+	CPU          int32     `json:"cpu"`     // Number of vCPUs
+	Memory       float64   `json:"memory"`  // GiB
+	Storage      int64     `json:"storage"` // GiB
+	IsHA         bool      `json:"is_ha"`
+	State        string    `json:"state"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type metricPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+type metricSeries struct {
+	Name   string        `json:"name"`
+	Points []metricPoint `json:"points"`
+}
+
+type metricView struct {
+	Name   string         `json:"name"`
+	Series []metricSeries `json:"series"`
+}
+
+// metricValues - Latest value of each series of a metric view, by series name
+type metricValues map[string]float64
+
+func getAPI(cfg config.ServerConfig, path string, params url.Values, out interface{}) error {
+	endpoint := apiBaseURL + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.CrunchyBridgeAPIKey)
+	req.Header.Set("Accept", "application/json")
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status code %d from Crunchy Bridge API: %s", resp.StatusCode, body)
+	}
+
+	err = json.Unmarshal(body, out)
+	if err != nil {
+		return fmt.Errorf("Error parsing Crunchy Bridge API response: %s", err)
+	}
+	return nil
+}
+
+// getClusterInfo - Gets the metadata of the configured cluster
+func getClusterInfo(cfg config.ServerConfig) (*ClusterInfo, error) {
+	var info ClusterInfo
+	err := getAPI(cfg, "/clusters/"+url.PathEscape(cfg.CrunchyBridgeClusterID), nil, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// getMetricValues - Gets the most recent values of a metric view (e.g. "cpu" or "memory") for the configured cluster
+func getMetricValues(cfg config.ServerConfig, view string) (metricValues, error) {
+	params := url.Values{}
+	params.Set("cluster_id", cfg.CrunchyBridgeClusterID)
+	params.Set("period", "15m")
+
+	var resp metricView
+	err := getAPI(cfg, "/metric-views/"+url.PathEscape(view), params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(metricValues)
+	for _, series := range resp.Series {
+		var latest *metricPoint
+		for idx, point := range series.Points {
+			if latest == nil || point.Time.After(latest.Time) {
+				latest = &series.Points[idx]
+			}
+		}
+		if latest != nil {
+			values[series.Name] = latest.Value
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,100 @@
+package crunchy_bridge
+
+import (
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const bytesPerGiB = 1024 * 1024 * 1024
+
+// GetSystemState - Gets system information about a Crunchy Bridge cluster from the Bridge API
+func GetSystemState(config config.ServerConfig, logger *util.Logger) (system state.SystemState) {
+	system.Info.Type = state.CrunchyBridgeSystem
+
+	if config.CrunchyBridgeAPIKey == "" {
+		logger.PrintVerbose("Skipping Crunchy Bridge system data, crunchy_bridge_api_key needs to be set")
+		return
+	}
+
+	info, err := getClusterInfo(config)
+	if err != nil {
+		logger.PrintWarning("Could not get Crunchy Bridge cluster information: %s", err)
+		return
+	}
+	system.Info.CrunchyBridge = &state.SystemInfoCrunchyBridge{
+		ClusterName:  info.Name,
+		PlanID:       info.PlanID,
+		ProviderID:   info.ProviderID,
+		RegionID:     info.RegionID,
+		MajorVersion: info.MajorVersion,
+		IsHA:         info.IsHA,
+		State:        info.State,
+		CreatedAt:    info.CreatedAt,
+	}
+	system.CPUInfo.LogicalCoreCount = info.CPU
+
+	cpu, err := getMetricValues(config, "cpu")
+	if err != nil {
+		logger.PrintVerbose("Could not get Crunchy Bridge CPU metrics: %s", err)
+	} else {
+		system.CPUStats = make(state.CPUStatisticMap)
+		system.CPUStats["all"] = state.CPUStatistic{
+			DiffedOnInput: true,
+			DiffedValues: &state.DiffedSystemCPUStats{
+				UserPercent:   cpu["cpu_load_user"],
+				SystemPercent: cpu["cpu_load_system"],
+				IowaitPercent: cpu["cpu_load_iowait"],
+				StealPercent:  cpu["cpu_load_steal"],
+			},
+		}
+	}
+
+	load, err := getMetricValues(config, "load-average")
+	if err != nil {
+		logger.PrintVerbose("Could not get Crunchy Bridge load average metrics: %s", err)
+	} else {
+		system.Scheduler = state.Scheduler{
+			Loadavg1min:  load["load_average_1"],
+			Loadavg5min:  load["load_average_5"],
+			Loadavg15min: load["load_average_15"],
+		}
+	}
+
+	system.Memory.TotalBytes = uint64(info.Memory * bytesPerGiB)
+	memory, err := getMetricValues(config, "memory")
+	if err != nil {
+		logger.PrintVerbose("Could not get Crunchy Bridge memory metrics: %s", err)
+	} else {
+		system.Memory.FreeBytes = uint64(memory["memory_free"])
+		system.Memory.CachedBytes = uint64(memory["memory_cached"])
+		system.Memory.SwapUsedBytes = uint64(memory["swap_used"])
+	}
+
+	iops, err := getMetricValues(config, "iops")
+	if err != nil {
+		logger.PrintVerbose("Could not get Crunchy Bridge IOPS metrics: %s", err)
+	} else {
+		system.DiskStats = make(state.DiskStatsMap)
+		system.DiskStats["default"] = state.DiskStats{
+			DiffedOnInput: true,
+			DiffedValues: &state.DiffedDiskStats{
+				ReadOperationsPerSecond:  iops["io_rtps"],
+				WriteOperationsPerSecond: iops["io_wtps"],
+			},
+		}
+	}
+
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	partition := state.DiskPartition{DiskName: "default", TotalBytes: uint64(info.Storage * bytesPerGiB)}
+	diskUsage, err := getMetricValues(config, "disk-usage")
+	if err != nil {
+		logger.PrintVerbose("Could not get Crunchy Bridge disk usage metrics: %s", err)
+	} else {
+		partition.UsedBytes = uint64(diskUsage["disk_usage"])
+	}
+	system.DiskPartitions["/"] = partition
+
+	return
+}
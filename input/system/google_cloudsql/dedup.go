@@ -0,0 +1,46 @@
+package google_cloudsql
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Number of recent insertIds to remember per Pub/Sub subscription - redeliveries
+// typically happen within seconds to minutes of the original delivery
+const insertIDCacheSize = 10000
+
+// insertIDCache - Bounded LRU of recently seen log entry insertIds, used to drop
+// duplicate deliveries from Pub/Sub
+type insertIDCache struct {
+	mutex   sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newInsertIDCache(size int) *insertIDCache {
+	return &insertIDCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// add records the insertId as seen, and returns false if it was already seen before
+func (c *insertIDCache) add(insertID string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if elem, ok := c.entries[insertID]; ok {
+		c.order.MoveToFront(elem)
+		return false
+	}
+
+	c.entries[insertID] = c.order.PushFront(insertID)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(string))
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+package google_cloudsql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/gcputil"
+)
+
+const loggingAPIURL = "https://logging.googleapis.com/v2/entries:list"
+
+// The Cloud Logging API has a default quota of 60 entries.list requests per minute
+// and project, so we need to be conservative here
+const loggingPollInterval = 10 * time.Second
+
+// Log entries can show up in the API with a slight delay after their timestamp, so
+// we always re-request this window and skip entries we've already seen
+const loggingLookback = 30 * time.Second
+
+type loggingListRequest struct {
+	ResourceNames []string `json:"resourceNames"`
+	Filter        string   `json:"filter"`
+	OrderBy       string   `json:"orderBy"`
+	PageSize      int      `json:"pageSize"`
+	PageToken     string   `json:"pageToken,omitempty"`
+}
+
+type loggingListResponse struct {
+	Entries       []json.RawMessage `json:"entries"`
+	NextPageToken string            `json:"nextPageToken"`
+}
+
+type loggingEntryID struct {
+	InsertID  string    `json:"insertId"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type loggingTail struct {
+	client *http.Client
+	config config.ServerConfig
+
+	// Most recent timestamp we've received, and the insert IDs of entries received in the lookback window before it
+	latest time.Time
+	seen   map[string]time.Time
+}
+
+func loggingTailKey(config config.ServerConfig) string {
+	key := config.GcpProjectID + ":" + config.GcpCloudSQLInstanceID
+	if config.GcpAlloyDBInstanceID != "" {
+		key += "/" + config.GcpAlloyDBInstanceID
+	}
+	return key
+}
+
+func (t *loggingTail) filter() string {
+	logIDs := []string{"cloudsql.googleapis.com/postgres.log", "alloydb.googleapis.com/postgres.log"}
+	if t.config.GcpIncludePgAuditLog {
+		logIDs = append(logIDs, "cloudsql.googleapis.com/pgaudit.log", "alloydb.googleapis.com/pgaudit.log")
+	}
+	var logFilters []string
+	for _, logID := range logIDs {
+		logFilters = append(logFilters, fmt.Sprintf("log_id(\"%s\")", logID))
+	}
+
+	// Like for Cloud Monitoring, the instance ID is either a Cloud SQL instance or an AlloyDB
+	// cluster (optionally narrowed down to a single AlloyDB instance)
+	cloudSQLFilter := fmt.Sprintf("resource.type=\"cloudsql_database\" AND resource.labels.database_id=\"%s:%s\"", t.config.GcpProjectID, t.config.GcpCloudSQLInstanceID)
+	alloyDBFilter := fmt.Sprintf("resource.type=\"alloydb.googleapis.com/Instance\" AND resource.labels.cluster_id=\"%s\"", t.config.GcpCloudSQLInstanceID)
+	if t.config.GcpAlloyDBInstanceID != "" {
+		alloyDBFilter += fmt.Sprintf(" AND resource.labels.instance_id=\"%s\"", t.config.GcpAlloyDBInstanceID)
+	}
+
+	return fmt.Sprintf(
+		"((%s) OR (%s)) AND (%s) AND timestamp>=\"%s\"",
+		cloudSQLFilter, alloyDBFilter, strings.Join(logFilters, " OR "), t.latest.Add(-loggingLookback).Format(time.RFC3339Nano),
+	)
+}
+
+func (t *loggingTail) listEntries(ctx context.Context, pageToken string) (*loggingListResponse, error) {
+	reqBody, err := json.Marshal(loggingListRequest{
+		ResourceNames: []string{"projects/" + t.config.GcpProjectID},
+		Filter:        t.filter(),
+		OrderBy:       "timestamp asc",
+		PageSize:      1000,
+		PageToken:     pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", loggingAPIURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status code %d from Cloud Logging API: %s", resp.StatusCode, body)
+	}
+
+	var list loggingListResponse
+	err = json.Unmarshal(body, &list)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Cloud Logging API response: %s", err)
+	}
+
+	return &list, nil
+}
+
+func (t *loggingTail) poll(ctx context.Context, logger *util.Logger, gcpLogStream chan LogStreamItem) error {
+	var pageToken string
+	for {
+		list, err := t.listEntries(ctx, pageToken)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range list.Entries {
+			var id loggingEntryID
+			err = json.Unmarshal(entry, &id)
+			if err != nil {
+				logger.PrintError("Error parsing JSON: %s", err)
+				continue
+			}
+			if _, ok := t.seen[id.InsertID]; ok {
+				continue
+			}
+
+			err = processLogEntry(ctx, entry, logEntrySource{includePgAudit: t.config.GcpIncludePgAuditLog}, gcpLogStream)
+			if err == ctx.Err() {
+				return err
+			} else if err != nil {
+				logger.PrintError("%s", err)
+			}
+
+			t.seen[id.InsertID] = id.Timestamp
+			if id.Timestamp.After(t.latest) {
+				t.latest = id.Timestamp
+			}
+		}
+
+		if list.NextPageToken == "" {
+			break
+		}
+		pageToken = list.NextPageToken
+	}
+
+	for insertID, ts := range t.seen {
+		if ts.Before(t.latest.Add(-loggingLookback)) {
+			delete(t.seen, insertID)
+		}
+	}
+
+	return nil
+}
+
+func setupLoggingTail(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, config config.ServerConfig, gcpLogStream chan LogStreamItem) error {
+	if config.GcpProjectID == "" || config.GcpCloudSQLInstanceID == "" {
+		return fmt.Errorf("Cloud Logging tail requires gcp_project_id and gcp_cloudsql_instance_id to be set")
+	}
+
+	client, err := gcputil.GetHTTPClient(ctx, config, logger)
+	if err != nil {
+		return err
+	}
+
+	t := &loggingTail{
+		client: client,
+		config: config,
+		latest: time.Now().Add(-config.GetLogReplayWindow()),
+		seen:   make(map[string]time.Time),
+	}
+
+	// Verify we can access the logs before we start polling in the background
+	_, err = t.listEntries(ctx, "")
+	if err != nil {
+		return fmt.Errorf("Failed to list Cloud Logging entries: %s", err)
+	}
+
+	interval := loggingPollInterval
+	if globalCollectionOpts.TestRun {
+		interval = 1 * time.Second
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		logger.PrintVerbose("Initializing Google Cloud Logging tail for %s", loggingTailKey(config))
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := t.poll(ctx, logger, gcpLogStream)
+				if err != nil && ctx.Err() == nil {
+					logger.PrintError("Failed to read logs from Google Cloud Logging: %s", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
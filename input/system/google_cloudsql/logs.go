@@ -4,17 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"github.com/jpillora/backoff"
 	"google.golang.org/api/option"
 
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/gcputil"
 )
 
 type googleLogResource struct {
@@ -22,37 +26,188 @@ type googleLogResource struct {
 	Labels       map[string]string `json:"labels"`
 }
 
+type googleLogJSONPayload struct {
+	Message   string `json:"message"`
+	Detail    string `json:"detail"`
+	Hint      string `json:"hint"`
+	Context   string `json:"context"`
+	Statement string `json:"statement"`
+}
+
 type googleLogMessage struct {
-	InsertID         string            `json:"insertId"`
-	LogName          string            `json:"logName"`
-	ReceiveTimestamp string            `json:"receiveTimestamp"`
-	Resource         googleLogResource `json:"resource"`
-	Severity         string            `json:"severity"`
-	TextPayload      string            `json:"textPayload"`
-	Timestamp        string            `json:"timestamp"`
+	InsertID         string               `json:"insertId"`
+	LogName          string               `json:"logName"`
+	ReceiveTimestamp string               `json:"receiveTimestamp"`
+	Resource         googleLogResource    `json:"resource"`
+	Severity         string               `json:"severity"`
+	TextPayload      string               `json:"textPayload"`
+	JSONPayload      googleLogJSONPayload `json:"jsonPayload"`
+	Timestamp        string               `json:"timestamp"`
 }
 
 type LogStreamItem struct {
 	GcpProjectID          string
 	GcpCloudSQLInstanceID string
+	GcpAlloyDBInstanceID  string // Only set for AlloyDB, where GcpCloudSQLInstanceID is the cluster ID
 	OccurredAt            time.Time
 	Content               string
+
+	stats *subscriptionStats // Only set for items received through Pub/Sub
+}
+
+var logLevelRegexp = regexp.MustCompile(`^(.*?)(DEBUG\d?|INFO|NOTICE|WARNING|ERROR|LOG|FATAL|PANIC):  `)
+
+// logContents returns the Postgres log lines contained in a log message. Text
+// payloads are passed through as-is, whereas structured payloads carry the
+// secondary lines (DETAIL, HINT, etc) as separate fields, which we turn back
+// into log lines with the same log_line_prefix as the primary message.
+func logContents(msg googleLogMessage) []string {
+	if msg.TextPayload != "" {
+		return []string{msg.TextPayload}
+	}
+	payload := msg.JSONPayload
+	if payload.Message == "" {
+		return nil
+	}
+	contents := []string{payload.Message}
+
+	parts := logLevelRegexp.FindStringSubmatch(payload.Message)
+	if parts == nil {
+		return contents
+	}
+	prefix := parts[1]
+	for _, line := range []struct {
+		level   string
+		content string
+	}{
+		{"DETAIL", payload.Detail},
+		{"HINT", payload.Hint},
+		{"CONTEXT", payload.Context},
+		{"STATEMENT", payload.Statement},
+	} {
+		if line.content != "" {
+			contents = append(contents, fmt.Sprintf("%s%s:  %s", prefix, line.level, line.content))
+		}
+	}
+	return contents
+}
+
+// logEntrySource - Settings and state of the input that log entries are received from
+type logEntrySource struct {
+	includePgAudit bool
+
+	// Only set for Pub/Sub subscriptions
+	stats *subscriptionStats
+	seen  *insertIDCache
+
+	// Whether to keep handing off log lines when shutting down, instead of giving up
+	// once the context is done - the log transformer keeps reading until all inputs
+	// have exited, so this doesn't block shutdown
+	drain bool
+}
+
+// processLogEntry parses a single Cloud Logging entry (as received through Pub/Sub
+// or read from a Cloud Storage sink) and hands off the Postgres log lines it
+// contains to the log stream. Entries that are not Postgres logs are ignored,
+// as are pgaudit logs unless explicitly included.
+func processLogEntry(ctx context.Context, data []byte, source logEntrySource, gcpLogStream chan LogStreamItem) error {
+	stats := source.stats
+
+	var msg googleLogMessage
+	err := json.Unmarshal(data, &msg)
+	if err != nil {
+		stats.recordUnparseable()
+		return fmt.Errorf("Error parsing JSON: %s", err)
+	}
+
+	if msg.Resource.ResourceType != "cloudsql_database" && msg.Resource.ResourceType != "alloydb.googleapis.com/Instance" {
+		stats.recordFiltered()
+		return nil
+	}
+	if !strings.HasSuffix(msg.LogName, "postgres.log") && !(source.includePgAudit && strings.HasSuffix(msg.LogName, "pgaudit.log")) {
+		stats.recordFiltered()
+		return nil
+	}
+
+	var projectID, instanceID, alloyDBInstanceID string
+	if databaseID, ok := msg.Resource.Labels["database_id"]; ok {
+		parts := strings.SplitN(databaseID, ":", 2) // project_id:instance_id
+		if len(parts) != 2 {
+			stats.recordFiltered()
+			return nil
+		}
+		projectID = parts[0]
+		instanceID = parts[1]
+	} else {
+		resourceContainer, ok := msg.Resource.Labels["resource_container"]
+		if !ok || strings.Count(resourceContainer, "/") != 1 {
+			stats.recordFiltered()
+			return nil
+		}
+		parts := strings.SplitN(resourceContainer, "/", 2) // projects/project_id
+		projectID = parts[1]
+
+		instanceID, ok = msg.Resource.Labels["cluster_id"]
+		if !ok {
+			stats.recordFiltered()
+			return nil
+		}
+		alloyDBInstanceID = msg.Resource.Labels["instance_id"]
+	}
+
+	if source.seen != nil && msg.InsertID != "" {
+		if !source.seen.add(msg.InsertID) {
+			stats.recordDuplicate()
+			return nil
+		}
+	}
+
+	t, _ := time.Parse(time.RFC3339Nano, msg.Timestamp)
+
+	for _, content := range logContents(msg) {
+		item := LogStreamItem{
+			GcpProjectID:          projectID,
+			GcpCloudSQLInstanceID: instanceID,
+			GcpAlloyDBInstanceID:  alloyDBInstanceID,
+			Content:               content,
+			OccurredAt:            t,
+			stats:                 stats,
+		}
+		if source.drain {
+			gcpLogStream <- item
+			continue
+		}
+		select {
+		case gcpLogStream <- item:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
 }
 
-func setupPubSubSubscriber(ctx context.Context, wg *sync.WaitGroup, logger *util.Logger, config config.ServerConfig, gcpLogStream chan LogStreamItem) error {
-	if strings.Count(config.GcpPubsubSubscription, "/") != 3 {
-		return fmt.Errorf("Unsupported subscription format - must be \"projects/PROJECT_NAME/subscriptions/SUBSCRIPTION_NAME\", got: %s", config.GcpPubsubSubscription)
+// Bounds for the wait between Pub/Sub receive retries, which grows exponentially
+// (with jitter) while receiving keeps failing
+const receiveRetryMinWait = 5 * time.Second
+const receiveRetryMaxWait = 10 * time.Minute
+const receiveRetryResetAfter = 5 * time.Minute
+
+func setupPubSubSubscriber(ctx context.Context, wg *sync.WaitGroup, logger *util.Logger, config config.ServerConfig, subscription string, gcpLogStream chan LogStreamItem) error {
+	if strings.Count(subscription, "/") != 3 {
+		return fmt.Errorf("Unsupported subscription format - must be \"projects/PROJECT_NAME/subscriptions/SUBSCRIPTION_NAME\", got: %s", subscription)
 	}
-	idParts := strings.SplitN(config.GcpPubsubSubscription, "/", 4)
+	idParts := strings.SplitN(subscription, "/", 4)
 	projectID := idParts[1]
 	subID := idParts[3]
 
-	var opts []option.ClientOption
-	if config.GcpCredentialsFile != "" {
-		logger.PrintVerbose("Using GCP credentials file located at: %s", config.GcpCredentialsFile)
-		opts = append(opts, option.WithCredentialsFile(config.GcpCredentialsFile))
-	} else {
-		logger.PrintVerbose("No GCP credentials file provided; assuming GKE workload identity or VM-associated service account")
+	opts, err := gcputil.GetClientOptions(config, logger)
+	if err != nil {
+		return err
+	}
+	if config.GcpPubsubEndpoint != "" {
+		logger.PrintVerbose("Using Google Pub/Sub endpoint: %s", config.GcpPubsubEndpoint)
+		opts = append(opts, option.WithEndpoint(config.GcpPubsubEndpoint))
 	}
 	client, err := pubsub.NewClient(ctx, projectID, opts...)
 	if err != nil {
@@ -60,129 +215,281 @@ func setupPubSubSubscriber(ctx context.Context, wg *sync.WaitGroup, logger *util
 	}
 
 	sub := client.Subscription(subID)
+	if config.GcpPubsubMaxOutstandingMessages > 0 {
+		sub.ReceiveSettings.MaxOutstandingMessages = config.GcpPubsubMaxOutstandingMessages
+	}
+	if config.GcpPubsubMaxOutstandingBytes > 0 {
+		sub.ReceiveSettings.MaxOutstandingBytes = config.GcpPubsubMaxOutstandingBytes
+	}
+	if config.GcpPubsubNumGoroutines > 0 {
+		sub.ReceiveSettings.NumGoroutines = config.GcpPubsubNumGoroutines
+	}
+	if config.GcpPubsubAckAfterProcessing {
+		subConfig, err := sub.Config(ctx)
+		if err != nil {
+			logger.PrintVerbose("Could not determine dead letter policy for Pub/Sub subscription %s: %s", subscription, err)
+		} else if subConfig.DeadLetterPolicy != nil {
+			logger.PrintVerbose("Pub/Sub messages that fail processing will be forwarded to dead letter topic %s after %d delivery attempts", subConfig.DeadLetterPolicy.DeadLetterTopic, subConfig.DeadLetterPolicy.MaxDeliveryAttempts)
+		}
+	}
+
+	source := logEntrySource{
+		includePgAudit: config.GcpIncludePgAuditLog,
+		stats:          getSubscriptionStats(subscription),
+		seen:           newInsertIDCache(insertIDCacheSize),
+
+		// Messages that were received were potentially acknowledged already, so make
+		// sure their log lines are still sent when shutting down
+		drain: true,
+	}
+
+	wg.Add(1)
 	go func(ctx context.Context, wg *sync.WaitGroup, logger *util.Logger, sub *pubsub.Subscription) {
-		wg.Add(1)
+		defer wg.Done()
+
+		retryBackoff := &backoff.Backoff{
+			Min:    receiveRetryMinWait,
+			Max:    receiveRetryMaxWait,
+			Factor: 2,
+			Jitter: true,
+		}
+
 		for {
 			logger.PrintVerbose("Initializing Google Pub/Sub handler")
+			receiveStartedAt := time.Now()
 			err := sub.Receive(ctx, func(ctx context.Context, pubsubMsg *pubsub.Message) {
-				pubsubMsg.Ack()
-
-				var msg googleLogMessage
-				err = json.Unmarshal(pubsubMsg.Data, &msg)
-				if err != nil {
-					logger.PrintError("Error parsing JSON: %s", err)
-					return
-				}
-
-				if msg.Resource.ResourceType != "cloudsql_database" && msg.Resource.ResourceType != "alloydb.googleapis.com/Instance" {
-					return
-				}
-				if !strings.HasSuffix(msg.LogName, "postgres.log") {
-					return
-				}
-
-				resourceContainer, ok := msg.Resource.Labels["resource_container"]
-				if !ok || strings.Count(resourceContainer, "/") != 1 {
-					return
+				if !config.GcpPubsubAckAfterProcessing {
+					pubsubMsg.Ack()
 				}
-				parts := strings.SplitN(resourceContainer, "/", 2) // projects/project_id
+				source.stats.recordReceived(pubsubMsg.PublishTime)
 
-				clusterID, ok := msg.Resource.Labels["cluster_id"]
-				if !ok {
+				err := processLogEntry(ctx, pubsubMsg.Data, source, gcpLogStream)
+				if err == nil {
+					if config.GcpPubsubAckAfterProcessing {
+						pubsubMsg.Ack()
+					}
 					return
 				}
 
-				t, _ := time.Parse(time.RFC3339Nano, msg.Timestamp)
-
-				gcpLogStream <- LogStreamItem{
-					GcpProjectID:          parts[1],
-					GcpCloudSQLInstanceID: clusterID,
-					Content:               msg.TextPayload,
-					OccurredAt:            t,
+				logger.PrintError("%s", err)
+				if config.GcpPubsubAckAfterProcessing {
+					// Redelivering a message we can't parse is only useful if the subscription
+					// has a dead letter topic that it ends up in eventually, otherwise we'd keep
+					// receiving it forever
+					if pubsubMsg.DeliveryAttempt != nil {
+						logger.PrintVerbose("Rejecting Pub/Sub message %s (delivery attempt %d), it will be forwarded to the dead letter topic once the maximum delivery attempts are reached", pubsubMsg.ID, *pubsubMsg.DeliveryAttempt)
+						pubsubMsg.Nack()
+					} else {
+						pubsubMsg.Ack()
+					}
 				}
 			})
 			if err == nil || err == context.Canceled {
 				break
 			}
 
-			logger.PrintError("Failed to receive from Google PubSub, retrying in 1 minute: %v", err)
-			time.Sleep(1 * time.Minute)
+			// Start over with short waits if the subscriber ran fine for a while, so we
+			// recover quickly from brief outages, but back off during longer incidents
+			if time.Since(receiveStartedAt) > receiveRetryResetAfter {
+				retryBackoff.Reset()
+			}
+			wait := retryBackoff.Duration()
+			logger.PrintError("Failed to receive from Google PubSub, retrying in %s: %v", wait.Round(time.Second), err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
 		}
-		wg.Done()
 	}(ctx, wg, logger, sub)
 
 	return nil
 }
 
+// SetupLogSubscriber - Starts receiving log data for all Google Cloud SQL and AlloyDB servers
+//
+// On shutdown, the inputs stop receiving new log data first, and the log lines they
+// already received are handed off to the parsed log stream before the wait group is done.
 func SetupLogSubscriber(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) error {
 	gcpLogStream := make(chan LogStreamItem, state.LogStreamBufferLen)
-	setupLogTransformer(ctx, wg, servers, gcpLogStream, parsedLogStream, logger)
+	setupLogTransformer(wg, servers, gcpLogStream, parsedLogStream, globalCollectionOpts, logger)
+
+	// The log stream is closed once all inputs have exited, which in turn stops the
+	// transformer after it processed everything that's left
+	var inputWg sync.WaitGroup
+	defer func() {
+		go func() {
+			inputWg.Wait()
+			close(gcpLogStream)
+		}()
+	}()
 
-	// This map is used to avoid duplicate receivers to the same subscriber
+	// These maps are used to avoid duplicate receivers to the same subscriber, bucket or instance
 	gcpPubSubHandlers := make(map[string]bool)
+	gcpStoragePollers := make(map[string]bool)
+	gcpLoggingTails := make(map[string]bool)
 
 	for _, server := range servers {
 		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
-		if server.Config.GcpPubsubSubscription != "" {
-			_, ok := gcpPubSubHandlers[server.Config.GcpPubsubSubscription]
+		for _, subscription := range server.Config.GetGcpPubsubSubscriptions() {
+			_, ok := gcpPubSubHandlers[subscription]
 			if ok {
 				continue
 			}
-			err := setupPubSubSubscriber(ctx, wg, prefixedLogger, server.Config, gcpLogStream)
+			err := setupPubSubSubscriber(ctx, &inputWg, prefixedLogger, server.Config, subscription, gcpLogStream)
 			if err != nil {
 				if globalCollectionOpts.TestRun {
 					return err
 				}
 
-				prefixedLogger.PrintWarning("Skipping logs, could not setup log subscriber: %s", err)
+				prefixedLogger.PrintWarning("Skipping logs from %s, could not setup log subscriber: %s", subscription, err)
 				continue
 			}
 
-			gcpPubSubHandlers[server.Config.GcpPubsubSubscription] = true
+			gcpPubSubHandlers[subscription] = true
+		}
+
+		if server.Config.GcpStorageLogBucket != "" {
+			key := storageMarkerKey(server.Config)
+			if _, ok := gcpStoragePollers[key]; !ok {
+				err := setupStorageLogPoller(ctx, &inputWg, prefixedLogger, server, gcpLogStream)
+				if err != nil {
+					if globalCollectionOpts.TestRun {
+						return err
+					}
+
+					prefixedLogger.PrintWarning("Skipping logs from gs://%s, could not setup log poller: %s", key, err)
+				} else {
+					gcpStoragePollers[key] = true
+				}
+			}
+		}
+
+		if server.Config.GcpLoggingTail {
+			key := loggingTailKey(server.Config)
+			if _, ok := gcpLoggingTails[key]; !ok {
+				err := setupLoggingTail(ctx, &inputWg, globalCollectionOpts, prefixedLogger, server.Config, gcpLogStream)
+				if err != nil {
+					if globalCollectionOpts.TestRun {
+						return err
+					}
+
+					prefixedLogger.PrintWarning("Skipping logs, could not setup Cloud Logging tail: %s", err)
+				} else {
+					gcpLoggingTails[key] = true
+				}
+			}
 		}
 	}
 
 	return nil
 }
 
-func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, servers []*state.Server, in <-chan LogStreamItem, out chan state.ParsedLogStreamItem, logger *util.Logger) {
+// matches - Whether the log stream item belongs to the server, which for AlloyDB servers
+// that specify an instance ID also requires the instance to match (otherwise all log
+// lines of the cluster are attributed to the server)
+func (item LogStreamItem) matches(cfg config.ServerConfig) bool {
+	if item.GcpProjectID != cfg.GcpProjectID || item.GcpCloudSQLInstanceID != cfg.GcpCloudSQLInstanceID {
+		return false
+	}
+	if cfg.GcpAlloyDBInstanceID != "" && item.GcpAlloyDBInstanceID != cfg.GcpAlloyDBInstanceID {
+		return false
+	}
+	return true
+}
+
+// How often to warn about log lines that couldn't be matched to any server
+const unmatchedLogLinesReportInterval = 10 * time.Minute
+
+func setupLogTransformer(wg *sync.WaitGroup, servers []*state.Server, in <-chan LogStreamItem, out chan state.ParsedLogStreamItem, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 
-		// Only ingest log lines that were written in the last minute before startup
-		linesNewerThan := time.Now().Add(-1 * time.Minute)
+		// Only ingest log lines that were written within the replay window (one minute
+		// by default) before startup - Pub/Sub may deliver an older retained backlog
+		startedAt := time.Now()
 
+		// Log lines for instances that don't have a server configured, by "project:instance"
+		unmatched := make(map[string]int)
+		ticker := time.NewTicker(unmatchedLogLinesReportInterval)
+		defer ticker.Stop()
+
+		// This runs until the log stream is closed, to avoid losing log lines on shutdown
 		for {
+			var item LogStreamItem
+			var ok bool
 			select {
-			case <-ctx.Done():
-				return
-			case in, ok := <-in:
+			case <-ticker.C:
+				reportUnmatchedLogLines(unmatched, logger)
+				unmatched = make(map[string]int)
+				continue
+			case item, ok = <-in:
 				if !ok {
 					return
 				}
+			}
 
-				// Note that we need to restore the original trailing newlines since
-				// ProcessLogStream below expects them and they are not present in the GCP
-				// log stream.
-				logLine, ok := logs.ParseLogLineWithPrefix("", in.Content+"\n")
-				if !ok {
-					logger.PrintError("Can't parse log line: \"%s\"", in.Content)
-					continue
-				}
-				logLine.OccurredAt = in.OccurredAt
-
-				// Ignore loglines which are outside our time window
-				if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
-					continue
-				}
+			// Note that we need to restore the original trailing newlines since
+			// ProcessLogStream below expects them and they are not present in the GCP
+			// log stream.
+			//
+			// Lines without a log_line_prefix are passed on as well, since very large
+			// messages (e.g. auto_explain plans) get split into multiple entries, and
+			// the log stream analysis puts them back together.
+			logLine, ok := logs.ParseLogLineWithPrefix("", item.Content+"\n")
+			if !ok && strings.TrimSpace(logLine.Content) == "" {
+				logger.PrintError("Can't parse log line: \"%s\"", item.Content)
+				continue
+			}
+			logLine.OccurredAt = item.OccurredAt
 
-				for _, server := range servers {
-					if in.GcpProjectID == server.Config.GcpProjectID && in.GcpCloudSQLInstanceID == server.Config.GcpCloudSQLInstanceID {
-						out <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
+			matched := false
+			delivered := false
+			for _, server := range servers {
+				if item.matches(server.Config) {
+					matched = true
+					// Ignore loglines which are outside our time window
+					if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(startedAt.Add(-server.Config.GetLogReplayWindow())) {
+						continue
 					}
+
+					out <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
+					delivered = true
+				}
+			}
+			if delivered {
+				item.stats.recordDelivered()
+			}
+			if !matched {
+				key := item.GcpProjectID + ":" + item.GcpCloudSQLInstanceID
+				if item.GcpAlloyDBInstanceID != "" {
+					key += "/" + item.GcpAlloyDBInstanceID
+				}
+				if unmatched[key] == 0 && globalCollectionOpts.TestRun {
+					logger.PrintError("Discarding log lines because of unknown instance (did you set the correct gcp_project_id and gcp_cloudsql_instance_id?): %s", key)
 				}
+				unmatched[key]++
 			}
 		}
 	}()
 }
+
+func reportUnmatchedLogLines(unmatched map[string]int, logger *util.Logger) {
+	if len(unmatched) == 0 {
+		return
+	}
+
+	var keys []string
+	for key := range unmatched {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var summary []string
+	for _, key := range keys {
+		summary = append(summary, fmt.Sprintf("%s (%d lines)", key, unmatched[key]))
+	}
+	logger.PrintWarning("Discarded log lines from Google Cloud SQL instances that don't match any configured server in the last %s (check gcp_project_id and gcp_cloudsql_instance_id): %s",
+		unmatchedLogLinesReportInterval, strings.Join(summary, ", "))
+}
@@ -0,0 +1,132 @@
+package google_cloudsql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+const monitoringAPIURL = "https://monitoring.googleapis.com/v3"
+
+type monitoringTypedValue struct {
+	DoubleValue *float64 `json:"doubleValue"`
+	Int64Value  *string  `json:"int64Value"` // int64 values are encoded as strings in JSON
+}
+
+type monitoringPoint struct {
+	Value monitoringTypedValue `json:"value"`
+}
+
+type monitoringTimeSeries struct {
+	Points []monitoringPoint `json:"points"`
+}
+
+type monitoringTimeSeriesList struct {
+	TimeSeries []monitoringTimeSeries `json:"timeSeries"`
+}
+
+type monitoringReader struct {
+	client         *http.Client
+	projectID      string
+	resourceFilter string
+	logger         *util.Logger
+}
+
+func newMonitoringReader(client *http.Client, logger *util.Logger, projectID string, resourceFilter string) monitoringReader {
+	return monitoringReader{client: client, projectID: projectID, resourceFilter: resourceFilter, logger: logger}
+}
+
+// getLatestValue - Gets the most recent data point for the metric, aggregated across all matching time series
+func (reader monitoringReader) getLatestValue(metricType string, aligner string, reducer string) (float64, bool, error) {
+	params := url.Values{}
+	params.Set("filter", fmt.Sprintf("metric.type=\"%s\" AND %s", metricType, reader.resourceFilter))
+	params.Set("interval.startTime", time.Now().Add(-10*time.Minute).Format(time.RFC3339))
+	params.Set("interval.endTime", time.Now().Format(time.RFC3339))
+	params.Set("aggregation.alignmentPeriod", "60s")
+	params.Set("aggregation.perSeriesAligner", aligner)
+	params.Set("aggregation.crossSeriesReducer", reducer)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/timeSeries?%s", monitoringAPIURL, url.PathEscape(reader.projectID), params.Encode()), nil)
+	if err != nil {
+		return 0.0, false, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := reader.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0.0, false, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0.0, false, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0.0, false, fmt.Errorf("Unexpected status code %d from Cloud Monitoring API: %s", resp.StatusCode, body)
+	}
+
+	var list monitoringTimeSeriesList
+	err = json.Unmarshal(body, &list)
+	if err != nil {
+		return 0.0, false, fmt.Errorf("Error parsing Cloud Monitoring API response: %s", err)
+	}
+
+	// Points are returned in reverse time order, so the first one is the most recent
+	if len(list.TimeSeries) == 0 || len(list.TimeSeries[0].Points) == 0 {
+		return 0.0, false, nil
+	}
+	value := list.TimeSeries[0].Points[0].Value
+	if value.DoubleValue != nil {
+		return *value.DoubleValue, true, nil
+	} else if value.Int64Value != nil {
+		v, _ := strconv.ParseInt(*value.Int64Value, 10, 64)
+		return float64(v), true, nil
+	}
+
+	return 0.0, false, nil
+}
+
+func (reader monitoringReader) getMetric(metricType string, aligner string, reducer string) float64 {
+	value, _, err := reader.getLatestValue(metricType, aligner, reducer)
+	if err != nil {
+		reader.logger.PrintVerbose("Could not get %s: %s", metricType, err)
+	}
+	return value
+}
+
+// GetMeanMetric - Gets the most recent value of a gauge metric, averaged if there are multiple time series
+func (reader monitoringReader) GetMeanMetric(metricType string) float64 {
+	return reader.getMetric(metricType, "ALIGN_MEAN", "REDUCE_MEAN")
+}
+
+// GetSumMetric - Gets the most recent value of a gauge metric, summed up if there are multiple time series
+func (reader monitoringReader) GetSumMetric(metricType string) float64 {
+	return reader.getMetric(metricType, "ALIGN_MEAN", "REDUCE_SUM")
+}
+
+// GetMaxMetric - Gets the most recent maximum of a gauge metric, across all time series
+func (reader monitoringReader) GetMaxMetric(metricType string) float64 {
+	return reader.getMetric(metricType, "ALIGN_MAX", "REDUCE_MAX")
+}
+
+// GetRateMetric - Gets the most recent per-second rate of a delta or cumulative metric
+func (reader monitoringReader) GetRateMetric(metricType string) float64 {
+	return reader.getMetric(metricType, "ALIGN_RATE", "REDUCE_SUM")
+}
+
+// HasMetric - Determines whether there is any recent data for the metric
+func (reader monitoringReader) HasMetric(metricType string) bool {
+	_, found, err := reader.getLatestValue(metricType, "ALIGN_MEAN", "REDUCE_MEAN")
+	if err != nil {
+		reader.logger.PrintVerbose("Could not get %s: %s", metricType, err)
+	}
+	return found
+}
@@ -0,0 +1,92 @@
+package google_cloudsql
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// subscriptionStats - Counters for a single Pub/Sub subscription, updated concurrently by
+// the receive callbacks and the log transformer
+type subscriptionStats struct {
+	messagesReceived    int64
+	messagesFiltered    int64
+	messagesUnparseable int64
+	messagesDuplicate   int64
+	linesDelivered      int64
+	backlogAgeNanos     int64 // Age of the most recently received message, as an estimate of the backlog
+}
+
+var pubSubStatsMutex sync.Mutex
+var pubSubStats = make(map[string]*subscriptionStats)
+
+func getSubscriptionStats(subscription string) *subscriptionStats {
+	pubSubStatsMutex.Lock()
+	defer pubSubStatsMutex.Unlock()
+
+	stats, ok := pubSubStats[subscription]
+	if !ok {
+		stats = &subscriptionStats{}
+		pubSubStats[subscription] = stats
+	}
+	return stats
+}
+
+func (s *subscriptionStats) recordReceived(publishTime time.Time) {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.messagesReceived, 1)
+	if !publishTime.IsZero() {
+		atomic.StoreInt64(&s.backlogAgeNanos, int64(time.Since(publishTime)))
+	}
+}
+
+func (s *subscriptionStats) recordFiltered() {
+	if s != nil {
+		atomic.AddInt64(&s.messagesFiltered, 1)
+	}
+}
+
+func (s *subscriptionStats) recordUnparseable() {
+	if s != nil {
+		atomic.AddInt64(&s.messagesUnparseable, 1)
+	}
+}
+
+func (s *subscriptionStats) recordDuplicate() {
+	if s != nil {
+		atomic.AddInt64(&s.messagesDuplicate, 1)
+	}
+}
+
+func (s *subscriptionStats) recordDelivered() {
+	if s != nil {
+		atomic.AddInt64(&s.linesDelivered, 1)
+	}
+}
+
+// GetPubSubStats - Returns the counters of all Pub/Sub subscriptions this collector receives log data from
+func GetPubSubStats() []state.GcpPubSubStats {
+	pubSubStatsMutex.Lock()
+	defer pubSubStatsMutex.Unlock()
+
+	var result []state.GcpPubSubStats
+	for subscription, stats := range pubSubStats {
+		result = append(result, state.GcpPubSubStats{
+			Subscription:        subscription,
+			MessagesReceived:    atomic.LoadInt64(&stats.messagesReceived),
+			MessagesFiltered:    atomic.LoadInt64(&stats.messagesFiltered),
+			MessagesUnparseable: atomic.LoadInt64(&stats.messagesUnparseable),
+			MessagesDuplicate:   atomic.LoadInt64(&stats.messagesDuplicate),
+			LinesDelivered:      atomic.LoadInt64(&stats.linesDelivered),
+			BacklogAge:          time.Duration(atomic.LoadInt64(&stats.backlogAgeNanos)),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Subscription < result[j].Subscription })
+
+	return result
+}
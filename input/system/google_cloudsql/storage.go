@@ -0,0 +1,222 @@
+package google_cloudsql
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/gcputil"
+)
+
+const storageAPIURL = "https://storage.googleapis.com/storage/v1"
+
+// Log sinks write to Cloud Storage in hourly batches, checking every few minutes
+// picks up each batch soon after it is written
+const storagePollInterval = 5 * time.Minute
+
+// Each line in a log sink object is a single log entry, in rare cases these can be large
+const maxStorageLogEntrySize = 10 * 1024 * 1024
+
+type storageObject struct {
+	Name    string    `json:"name"`
+	Updated time.Time `json:"updated"`
+}
+
+type storageObjectList struct {
+	Items         []storageObject `json:"items"`
+	NextPageToken string          `json:"nextPageToken"`
+}
+
+func storageMarkerKey(config config.ServerConfig) string {
+	return config.GcpStorageLogBucket + "/" + config.GcpStorageLogPrefix
+}
+
+func storageRequest(ctx context.Context, client *http.Client, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("Unexpected status code %d from Cloud Storage API: %s", resp.StatusCode, body)
+	}
+	return resp.Body, nil
+}
+
+// listStorageObjects returns the objects below the prefix that match the glob
+// pattern (if any), see https://cloud.google.com/storage/docs/json_api/v1/objects/list
+func listStorageObjects(ctx context.Context, client *http.Client, bucket string, prefix string, matchGlob string) ([]storageObject, error) {
+	var objects []storageObject
+	var pageToken string
+
+	for {
+		params := url.Values{}
+		params.Set("prefix", prefix)
+		if matchGlob != "" {
+			params.Set("matchGlob", matchGlob)
+		}
+		params.Set("fields", "items(name,updated),nextPageToken")
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+		body, err := storageRequest(ctx, client, fmt.Sprintf("%s/b/%s/o?%s", storageAPIURL, url.PathEscape(bucket), params.Encode()))
+		if err != nil {
+			return nil, err
+		}
+
+		var list storageObjectList
+		err = json.NewDecoder(body).Decode(&list)
+		body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing Cloud Storage object list: %s", err)
+		}
+
+		objects = append(objects, list.Items...)
+		if list.NextPageToken == "" {
+			break
+		}
+		pageToken = list.NextPageToken
+	}
+
+	return objects, nil
+}
+
+func readStorageObject(ctx context.Context, client *http.Client, bucket string, name string, includePgAudit bool, logger *util.Logger, gcpLogStream chan LogStreamItem) error {
+	body, err := storageRequest(ctx, client, fmt.Sprintf("%s/b/%s/o/%s?alt=media", storageAPIURL, url.PathEscape(bucket), url.PathEscape(name)))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStorageLogEntrySize)
+	for scanner.Scan() {
+		err = processLogEntry(ctx, scanner.Bytes(), logEntrySource{includePgAudit: includePgAudit}, gcpLogStream)
+		if err == ctx.Err() {
+			return err
+		} else if err != nil {
+			logger.PrintError("%s", err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// pollStorageLogs reads all objects that were written since the last poll, based on
+// the marker in the server's log state, and advances the marker as objects are read
+func pollStorageLogs(ctx context.Context, client *http.Client, server *state.Server, logger *util.Logger, gcpLogStream chan LogStreamItem) error {
+	bucket := server.Config.GcpStorageLogBucket
+	key := storageMarkerKey(server.Config)
+
+	server.LogStateMutex.Lock()
+	marker, ok := server.LogPrevState.GcsMarkers[key]
+	server.LogStateMutex.Unlock()
+	if !ok {
+		// Don't backfill the whole bucket on first start
+		marker = time.Now().Add(-server.Config.GetLogReplayWindow())
+	}
+
+	// Log sinks name objects "LOG_ID/YYYY/MM/DD/HH:MM:SS_HH:MM:SS_S0.json" (in UTC),
+	// so only list the days since the marker, instead of the whole prefix every time
+	var newObjects []storageObject
+	for _, day := range storageLogDays(marker, time.Now()) {
+		objects, err := listStorageObjects(ctx, client, bucket, server.Config.GcpStorageLogPrefix, "**/"+day+"/*")
+		if err != nil {
+			return err
+		}
+		for _, object := range objects {
+			if object.Updated.After(marker) {
+				newObjects = append(newObjects, object)
+			}
+		}
+	}
+	sort.Slice(newObjects, func(i, j int) bool {
+		return newObjects[i].Updated.Before(newObjects[j].Updated)
+	})
+
+	for _, object := range newObjects {
+		logger.PrintVerbose("Reading log entries from gs://%s/%s", bucket, object.Name)
+		err := readStorageObject(ctx, client, bucket, object.Name, server.Config.GcpIncludePgAuditLog, logger, gcpLogStream)
+		if err != nil {
+			return fmt.Errorf("Failed to read gs://%s/%s: %s", bucket, object.Name, err)
+		}
+
+		// The state file writer may hold a reference to the current map, so replace it instead of modifying it
+		server.LogStateMutex.Lock()
+		markers := make(map[string]time.Time)
+		for k, v := range server.LogPrevState.GcsMarkers {
+			markers[k] = v
+		}
+		markers[key] = object.Updated
+		server.LogPrevState.GcsMarkers = markers
+		server.LogStateMutex.Unlock()
+	}
+
+	return nil
+}
+
+// storageLogDays returns the "YYYY/MM/DD" object name segments of each day (in UTC)
+// from the marker up to now
+func storageLogDays(marker time.Time, now time.Time) []string {
+	var days []string
+	day := marker.UTC().Truncate(24 * time.Hour)
+	for !day.After(now.UTC()) {
+		days = append(days, day.Format("2006/01/02"))
+		day = day.Add(24 * time.Hour)
+	}
+	return days
+}
+
+func setupStorageLogPoller(ctx context.Context, wg *sync.WaitGroup, logger *util.Logger, server *state.Server, gcpLogStream chan LogStreamItem) error {
+	client, err := gcputil.GetHTTPClient(ctx, server.Config, logger)
+	if err != nil {
+		return err
+	}
+
+	// Verify access to the bucket before we start polling in the background
+	body, err := storageRequest(ctx, client, fmt.Sprintf("%s/b/%s/o?maxResults=1&prefix=%s", storageAPIURL, url.PathEscape(server.Config.GcpStorageLogBucket), url.QueryEscape(server.Config.GcpStorageLogPrefix)))
+	if err != nil {
+		return fmt.Errorf("Failed to list objects in Cloud Storage bucket: %s", err)
+	}
+	body.Close()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		logger.PrintVerbose("Initializing Google Cloud Storage log poller for gs://%s", storageMarkerKey(server.Config))
+		ticker := time.NewTicker(storagePollInterval)
+		defer ticker.Stop()
+
+		for {
+			err := pollStorageLogs(ctx, client, server, logger, gcpLogStream)
+			if err != nil && ctx.Err() == nil {
+				logger.PrintError("Failed to read logs from Google Cloud Storage: %s", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
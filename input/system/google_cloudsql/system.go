@@ -0,0 +1,139 @@
+package google_cloudsql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/gcputil"
+)
+
+// AlloyDB storage is automatically extended up until 128TB, and shared across the
+// cluster, so we report that limit as the total disk space
+const AlloyDBMaxStorage = 128 * 1024 * 1024 * 1024 * 1024
+
+// GetSystemState - Gets system information about a Google Cloud SQL or AlloyDB instance from Cloud Monitoring
+func GetSystemState(config config.ServerConfig, logger *util.Logger) (system state.SystemState) {
+	system.Info.Type = state.GoogleCloudSQLSystem
+
+	if config.GcpProjectID == "" || config.GcpCloudSQLInstanceID == "" {
+		logger.PrintVerbose("Skipping Google Cloud SQL system data, gcp_project_id and gcp_cloudsql_instance_id need to be set")
+		return
+	}
+
+	client, err := gcputil.GetHTTPClient(context.Background(), config, logger)
+	if err != nil {
+		logger.PrintError("GoogleCloudSQL/System: Encountered error getting HTTP client: %v\n", err)
+		return
+	}
+
+	cloudSQLReader := newMonitoringReader(client, logger, config.GcpProjectID,
+		fmt.Sprintf("resource.type=\"cloudsql_database\" AND resource.labels.database_id=\"%s:%s\"", config.GcpProjectID, config.GcpCloudSQLInstanceID))
+	if cloudSQLReader.HasMetric("cloudsql.googleapis.com/database/cpu/utilization") {
+		getCloudSQLSystemState(cloudSQLReader, &system)
+		return
+	}
+
+	// AlloyDB clusters are configured by passing the cluster ID as the instance ID (or by
+	// setting gcp_alloydb_cluster_id), optionally narrowed down to a single instance
+	alloyDBInstanceFilter := fmt.Sprintf("resource.type=\"alloydb.googleapis.com/Instance\" AND resource.labels.cluster_id=\"%s\"", config.GcpCloudSQLInstanceID)
+	if config.GcpAlloyDBInstanceID != "" {
+		alloyDBInstanceFilter += fmt.Sprintf(" AND resource.labels.instance_id=\"%s\"", config.GcpAlloyDBInstanceID)
+	}
+	alloyDBReader := newMonitoringReader(client, logger, config.GcpProjectID, alloyDBInstanceFilter)
+	if alloyDBReader.HasMetric("alloydb.googleapis.com/instance/cpu/average_utilization") {
+		alloyDBClusterReader := newMonitoringReader(client, logger, config.GcpProjectID,
+			fmt.Sprintf("resource.type=\"alloydb.googleapis.com/Cluster\" AND resource.labels.cluster_id=\"%s\"", config.GcpCloudSQLInstanceID))
+		getAlloyDBSystemState(alloyDBReader, alloyDBClusterReader, &system)
+		return
+	}
+
+	logger.PrintWarning("Could not find Google Cloud SQL or AlloyDB metrics in Cloud Monitoring, skipping system data")
+	return
+}
+
+func getCloudSQLSystemState(reader monitoringReader, system *state.SystemState) {
+	system.CPUStats = make(state.CPUStatisticMap)
+	system.CPUStats["all"] = state.CPUStatistic{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedSystemCPUStats{
+			UserPercent: reader.GetMeanMetric("cloudsql.googleapis.com/database/cpu/utilization") * 100,
+		},
+	}
+	cores := int32(reader.GetMeanMetric("cloudsql.googleapis.com/database/cpu/reserved_cores"))
+	system.CPUInfo.SocketCount = 1
+	system.CPUInfo.LogicalCoreCount = cores
+	system.CPUInfo.PhysicalCoreCount = cores
+
+	// Memory usage excludes the OS page cache, whereas total usage includes it
+	memoryQuota := uint64(reader.GetMeanMetric("cloudsql.googleapis.com/database/memory/quota"))
+	memoryUsage := uint64(reader.GetMeanMetric("cloudsql.googleapis.com/database/memory/usage"))
+	memoryTotalUsage := uint64(reader.GetMeanMetric("cloudsql.googleapis.com/database/memory/total_usage"))
+	system.Memory.TotalBytes = memoryQuota
+	if memoryQuota > memoryTotalUsage {
+		system.Memory.FreeBytes = memoryQuota - memoryTotalUsage
+	}
+	if memoryTotalUsage > memoryUsage {
+		system.Memory.CachedBytes = memoryTotalUsage - memoryUsage
+	}
+
+	// Only reported for read replicas
+	system.ReplicaLagSeconds = reader.GetMaxMetric("cloudsql.googleapis.com/database/replication/replica_lag")
+
+	system.NetworkStats = make(state.NetworkStatsMap)
+	system.NetworkStats["default"] = state.NetworkStats{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedNetworkStats{
+			ReceiveThroughputBytesPerSecond:  uint64(reader.GetRateMetric("cloudsql.googleapis.com/database/network/received_bytes_count")),
+			TransmitThroughputBytesPerSecond: uint64(reader.GetRateMetric("cloudsql.googleapis.com/database/network/sent_bytes_count")),
+		},
+	}
+
+	system.DiskStats = make(state.DiskStatsMap)
+	system.DiskStats["default"] = state.DiskStats{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedDiskStats{
+			ReadOperationsPerSecond:  reader.GetRateMetric("cloudsql.googleapis.com/database/disk/read_ops_count"),
+			WriteOperationsPerSecond: reader.GetRateMetric("cloudsql.googleapis.com/database/disk/write_ops_count"),
+			BytesReadPerSecond:       reader.GetRateMetric("cloudsql.googleapis.com/database/disk/read_bytes_count"),
+			BytesWrittenPerSecond:    reader.GetRateMetric("cloudsql.googleapis.com/database/disk/write_bytes_count"),
+		},
+	}
+
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	system.DiskPartitions["/"] = state.DiskPartition{
+		DiskName:   "default",
+		UsedBytes:  uint64(reader.GetMeanMetric("cloudsql.googleapis.com/database/disk/bytes_used")),
+		TotalBytes: uint64(reader.GetMeanMetric("cloudsql.googleapis.com/database/disk/quota")),
+	}
+}
+
+func getAlloyDBSystemState(reader monitoringReader, clusterReader monitoringReader, system *state.SystemState) {
+	system.CPUStats = make(state.CPUStatisticMap)
+	system.CPUStats["all"] = state.CPUStatistic{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedSystemCPUStats{
+			UserPercent: reader.GetMeanMetric("alloydb.googleapis.com/instance/cpu/average_utilization"),
+		},
+	}
+	cores := int32(reader.GetSumMetric("alloydb.googleapis.com/instance/cpu/vcpus"))
+	system.CPUInfo.SocketCount = 1
+	system.CPUInfo.LogicalCoreCount = cores
+	system.CPUInfo.PhysicalCoreCount = cores
+
+	system.Memory.FreeBytes = uint64(reader.GetSumMetric("alloydb.googleapis.com/instance/memory/min_available_memory"))
+
+	// Only reported for read pool instances (in milliseconds, the maximum across the pool's nodes)
+	system.ReplicaLagSeconds = reader.GetMaxMetric("alloydb.googleapis.com/instance/postgres/replication/maximum_lag") / 1000
+
+	system.DataDirectoryPartition = "/"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	system.DiskPartitions["/"] = state.DiskPartition{
+		DiskName:   "default",
+		UsedBytes:  uint64(clusterReader.GetMeanMetric("alloydb.googleapis.com/cluster/storage/usage")),
+		TotalBytes: AlloyDBMaxStorage,
+	}
+}
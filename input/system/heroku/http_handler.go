@@ -2,12 +2,16 @@ package heroku
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bmizerany/lpx"
 	"github.com/pganalyze/collector/logs"
@@ -15,24 +19,43 @@ import (
 	"github.com/pganalyze/collector/util"
 )
 
+// Logplex batches are much smaller than this, a request beyond 10 MB is not a log drain request
+const maxDrainRequestBytes = 10 * 1024 * 1024
+
+// Once this much is waiting to be processed, requests are rejected so that Logplex retries them later
+const maxDrainQueueBytes = 256 * 1024 * 1024
+
+const drainRetryAfterSeconds = 10
+
 func SetupHttpHandlerLogs(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) {
 	herokuLogStream := make(chan HerokuLogStreamItem, state.LogStreamBufferLen)
 	setupLogTransformer(ctx, wg, servers, herokuLogStream, parsedLogStream, globalCollectionOpts, logger)
 
+	queue, err := newDiskQueue(filepath.Join(os.TempDir(), "pganalyze-heroku-log-drain"), maxDrainQueueBytes)
+	if err != nil {
+		logger.PrintError("Could not set up log drain queue, skipping log drain: %s", err)
+		return
+	}
+	setupQueueProcessor(ctx, wg, queue, herokuLogStream, logger)
+
 	go func() {
 		http.HandleFunc("/", util.HttpRedirectToApp)
 		http.HandleFunc("/logs/", func(w http.ResponseWriter, r *http.Request) {
-			lp := lpx.NewReader(bufio.NewReader(r.Body))
-			for lp.Next() {
-				procID := string(lp.Header().Procid)
-				if procID == "heroku-postgres" || strings.HasPrefix(procID, "postgres.") {
-					select {
-					case herokuLogStream <- HerokuLogStreamItem{Header: *lp.Header(), Content: lp.Bytes(), Path: r.URL.Path}:
-						// Handed over successfully
-					default:
-						fmt.Printf("WARNING: Channel buffer exceeded, skipping message\n")
-					}
-				}
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxDrainRequestBytes))
+			if err != nil {
+				http.Error(w, "could not read request body", http.StatusBadRequest)
+				return
+			}
+			err = queue.Push(r.URL.Path, body)
+			if err == errQueueFull {
+				w.Header().Set("Retry-After", fmt.Sprintf("%d", drainRetryAfterSeconds))
+				http.Error(w, "log drain queue is full, retry later", http.StatusServiceUnavailable)
+				return
+			} else if err != nil {
+				logger.PrintWarning("Could not queue log drain request: %s", err)
+				w.Header().Set("Retry-After", fmt.Sprintf("%d", drainRetryAfterSeconds))
+				http.Error(w, "could not queue request", http.StatusServiceUnavailable)
+				return
 			}
 		})
 		http.ListenAndServe(":"+os.Getenv("PORT"), nil)
@@ -42,3 +65,44 @@ func SetupHttpHandlerLogs(ctx context.Context, wg *sync.WaitGroup, globalCollect
 		logs.EmitTestLogMsg(server, globalCollectionOpts, logger)
 	}
 }
+
+// setupQueueProcessor - Parses queued log drain requests in order, handing the Postgres-related
+// messages to the log transformer (this may block, unlike the HTTP handler)
+func setupQueueProcessor(ctx context.Context, wg *sync.WaitGroup, queue *diskQueue, herokuLogStream chan<- HerokuLogStreamItem, logger *util.Logger) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			item, ok, err := queue.Peek()
+			if err != nil {
+				logger.PrintWarning("Skipping unreadable log drain request: %s", err)
+				queue.Remove(item)
+				continue
+			}
+			if !ok {
+				select {
+				case <-ctx.Done():
+					return
+				case <-queue.Notify():
+				case <-time.After(time.Minute):
+				}
+				continue
+			}
+
+			lp := lpx.NewReader(bufio.NewReader(bytes.NewReader(item.body)))
+			for lp.Next() {
+				procID := string(lp.Header().Procid)
+				if procID == "heroku-postgres" || strings.HasPrefix(procID, "postgres.") {
+					select {
+					case herokuLogStream <- HerokuLogStreamItem{Header: *lp.Header(), Content: lp.Bytes(), Path: item.path}:
+					case <-ctx.Done():
+						// Leave the item in the queue, to be processed again after the restart
+						return
+					}
+				}
+			}
+			queue.Remove(item)
+		}
+	}()
+}
@@ -203,6 +203,11 @@ func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, servers []*sta
 
 		logLinesBySource := make(map[string][]state.LogLine)
 		sourceToServer := make(map[string]*state.Server)
+		for _, server := range servers {
+			for _, source := range server.Config.GetHerokuLogSources() {
+				sourceToServer[source] = server
+			}
+		}
 
 		for {
 			select {
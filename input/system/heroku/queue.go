@@ -0,0 +1,152 @@
+package heroku
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Log drain requests that haven't been processed yet are kept on disk, so that log spikes don't
+// block the HTTP handler (which would cause Logplex to drop messages), and survive a restart of
+// the collector process (but not of the dyno, whose filesystem is discarded)
+//
+// Each request body is stored as a separate file, named by its sequence number, with the request
+// path (which identifies the app) on the first line.
+type diskQueue struct {
+	dir      string
+	maxBytes int64
+
+	mutex   sync.Mutex
+	nextSeq uint64
+	pending []uint64
+	sizes   map[uint64]int64
+	size    int64
+	notify  chan struct{}
+}
+
+type diskQueueItem struct {
+	seq  uint64
+	path string
+	body []byte
+}
+
+var errQueueFull = fmt.Errorf("log drain queue is full")
+
+// newDiskQueue - Opens the queue directory, picking up any items left over from a previous run
+func newDiskQueue(dir string, maxBytes int64) (*diskQueue, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &diskQueue{dir: dir, maxBytes: maxBytes, sizes: make(map[uint64]int64), notify: make(chan struct{}, 1)}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		seq, err := strconv.ParseUint(strings.TrimSuffix(file.Name(), ".drain"), 10, 64)
+		if err != nil || !strings.HasSuffix(file.Name(), ".drain") {
+			continue
+		}
+		q.pending = append(q.pending, seq)
+		q.sizes[seq] = file.Size()
+		q.size += file.Size()
+		if seq >= q.nextSeq {
+			q.nextSeq = seq + 1
+		}
+	}
+	sort.Slice(q.pending, func(i, j int) bool { return q.pending[i] < q.pending[j] })
+	if len(q.pending) > 0 {
+		q.notify <- struct{}{}
+	}
+
+	return q, nil
+}
+
+func (q *diskQueue) filename(seq uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d.drain", seq))
+}
+
+// Push - Stores a request body, or returns errQueueFull if that would exceed the maximum size
+func (q *diskQueue) Push(path string, body []byte) error {
+	data := append([]byte(path+"\n"), body...)
+	size := int64(len(data))
+
+	q.mutex.Lock()
+	if q.size+size > q.maxBytes {
+		q.mutex.Unlock()
+		return errQueueFull
+	}
+	seq := q.nextSeq
+	q.nextSeq++
+	q.size += size
+	q.mutex.Unlock()
+
+	err := ioutil.WriteFile(q.filename(seq), data, 0600)
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if err != nil {
+		q.size -= size
+		return err
+	}
+	q.pending = append(q.pending, seq)
+	q.sizes[seq] = size
+	sort.Slice(q.pending, func(i, j int) bool { return q.pending[i] < q.pending[j] })
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Peek - Returns the oldest item, if there is one (it stays in the queue until Remove is called)
+func (q *diskQueue) Peek() (diskQueueItem, bool, error) {
+	q.mutex.Lock()
+	if len(q.pending) == 0 {
+		q.mutex.Unlock()
+		return diskQueueItem{}, false, nil
+	}
+	seq := q.pending[0]
+	q.mutex.Unlock()
+
+	data, err := ioutil.ReadFile(q.filename(seq))
+	if err != nil {
+		return diskQueueItem{seq: seq}, true, err
+	}
+	parts := bytes.SplitN(data, []byte("\n"), 2)
+	if len(parts) != 2 {
+		return diskQueueItem{seq: seq}, true, fmt.Errorf("invalid log drain queue file %s", q.filename(seq))
+	}
+	return diskQueueItem{seq: seq, path: string(parts[0]), body: parts[1]}, true, nil
+}
+
+// Remove - Deletes an item once it has been processed
+func (q *diskQueue) Remove(item diskQueueItem) {
+	os.Remove(q.filename(item.seq))
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for i, seq := range q.pending {
+		if seq == item.seq {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			break
+		}
+	}
+	q.size -= q.sizes[item.seq]
+	delete(q.sizes, item.seq)
+}
+
+// Notify - Receives a value whenever new items were added
+func (q *diskQueue) Notify() <-chan struct{} {
+	return q.notify
+}
@@ -0,0 +1,149 @@
+package rds
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// How often we check whether readers were added to or removed from Aurora clusters
+const auroraClusterRefreshInterval = 5 * time.Minute
+
+// AuroraClusterMembership - Reader instances discovered for each section with aws_db_cluster_readers enabled
+type AuroraClusterMembership map[string][]string
+
+func findAuroraReaderConfigs(cfg config.ServerConfig) ([]config.ServerConfig, error) {
+	sess, err := awsutil.GetAwsSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	readers, err := awsutil.FindAuroraClusterReaders(cfg, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []config.ServerConfig
+	for _, reader := range readers {
+		// The section itself may already monitor this instance (e.g. after a failover)
+		if *reader.DBInstanceIdentifier == cfg.AwsDbInstanceID {
+			continue
+		}
+		configs = append(configs, config.AuroraReaderConfig(cfg, *reader.DBInstanceIdentifier, *reader.Endpoint.Address, int(*reader.Endpoint.Port)))
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].AwsDbInstanceID < configs[j].AwsDbInstanceID
+	})
+
+	return configs, nil
+}
+
+func readerInstanceIDs(configs []config.ServerConfig) []string {
+	var ids []string
+	for _, cfg := range configs {
+		ids = append(ids, cfg.AwsDbInstanceID)
+	}
+	return ids
+}
+
+// AddAuroraClusterReaders - Adds a server for each reader of the Aurora clusters that have aws_db_cluster_readers enabled
+//
+// Readers that can't be discovered right now are picked up by WatchAuroraClusterMembership later on.
+func AddAuroraClusterReaders(servers []config.ServerConfig, logger *util.Logger) ([]config.ServerConfig, AuroraClusterMembership) {
+	membership := make(AuroraClusterMembership)
+
+	result := append([]config.ServerConfig{}, servers...)
+
+	for _, server := range servers {
+		if !server.AwsDbClusterReaders {
+			continue
+		}
+		prefixedLogger := logger.WithPrefix(server.SectionName)
+		if server.AwsDbClusterID == "" {
+			prefixedLogger.PrintError("Ignoring aws_db_cluster_readers, since aws_db_cluster_id is not set")
+			continue
+		}
+
+		readerConfigs, err := findAuroraReaderConfigs(server)
+		if err != nil {
+			prefixedLogger.PrintWarning("Could not discover readers of Aurora cluster %s: %s", server.AwsDbClusterID, err)
+			continue
+		}
+		membership[server.SectionName] = readerInstanceIDs(readerConfigs)
+
+		for _, readerConfig := range readerConfigs {
+			// Ensure we have no duplicate identifiers, in case the reader is also configured explicitly
+			skip := false
+			for _, existing := range result {
+				if readerConfig.Identifier == existing.Identifier {
+					skip = true
+				}
+			}
+			if skip {
+				prefixedLogger.PrintVerbose("Skipping Aurora reader %s, already configured", readerConfig.AwsDbInstanceID)
+				continue
+			}
+			prefixedLogger.PrintVerbose("Discovered Aurora reader %s (%s)", readerConfig.AwsDbInstanceID, readerConfig.GetDbHost())
+			result = append(result, readerConfig)
+		}
+	}
+
+	return result, membership
+}
+
+// WatchAuroraClusterMembership - Periodically checks the readers of Aurora clusters, and notifies the
+// passed channel when they differ from the discovered membership (which requires a configuration reload)
+func WatchAuroraClusterMembership(ctx context.Context, wg *sync.WaitGroup, servers []config.ServerConfig, membership AuroraClusterMembership, logger *util.Logger, changed chan<- struct{}) {
+	var clusterServers []config.ServerConfig
+	for _, server := range servers {
+		if server.AwsDbClusterReaders && server.AwsDbClusterID != "" {
+			clusterServers = append(clusterServers, server)
+		}
+	}
+	if len(clusterServers) == 0 {
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(auroraClusterRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, server := range clusterServers {
+					prefixedLogger := logger.WithPrefix(server.SectionName)
+					readerConfigs, err := findAuroraReaderConfigs(server)
+					if err != nil {
+						prefixedLogger.PrintVerbose("Could not check readers of Aurora cluster %s: %s", server.AwsDbClusterID, err)
+						continue
+					}
+
+					previous, ok := membership[server.SectionName]
+					current := readerInstanceIDs(readerConfigs)
+					if ok && strings.Join(previous, ",") == strings.Join(current, ",") {
+						continue
+					}
+
+					prefixedLogger.PrintInfo("Readers of Aurora cluster %s changed (now: %s)", server.AwsDbClusterID, strings.Join(current, ", "))
+					select {
+					case changed <- struct{}{}:
+					default:
+					}
+					return
+				}
+			}
+		}
+	}()
+}
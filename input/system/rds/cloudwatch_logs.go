@@ -0,0 +1,258 @@
+package rds
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// cloudWatchLogsSubscriptionData - Payload that a CloudWatch Logs subscription filter writes
+// to its destination (gzip-compressed), both for Kinesis Data Streams and Firehose
+type cloudWatchLogsSubscriptionData struct {
+	MessageType string `json:"messageType"`
+	LogGroup    string `json:"logGroup"`
+	LogStream   string `json:"logStream"`
+	LogEvents   []struct {
+		ID        string `json:"id"`
+		Timestamp int64  `json:"timestamp"`
+		Message   string `json:"message"`
+	} `json:"logEvents"`
+}
+
+type cloudWatchLogStreamItem struct {
+	// Where the log line was received from, see kinesisLogSource and firehoseLogSource
+	Source       string
+	DbInstanceID string
+	OccurredAt   time.Time
+	Content      string
+}
+
+func kinesisLogSource(streamName string) string {
+	return "kinesis:" + streamName
+}
+
+func firehoseLogSource(listenAddress string) string {
+	return "firehose:" + listenAddress
+}
+
+func s3LogSource(bucket string, prefix string) string {
+	return "s3:" + bucket + "/" + prefix
+}
+
+func matchesLogSource(cfg config.ServerConfig, source string) bool {
+	return (cfg.AwsKinesisLogStream != "" && kinesisLogSource(cfg.AwsKinesisLogStream) == source) ||
+		(cfg.AwsFirehoseListenAddress != "" && firehoseLogSource(cfg.AwsFirehoseListenAddress) == source) ||
+		(cfg.AwsS3LogBucket != "" && s3LogSource(cfg.AwsS3LogBucket, cfg.AwsS3LogPrefix) == source)
+}
+
+// RDS log groups are named /aws/rds/instance/<instance>/postgresql, and Aurora log groups
+// /aws/rds/cluster/<cluster>/postgresql with one log stream per instance
+var rdsLogGroupRegexp = regexp.MustCompile(`^/aws/rds/(instance|cluster)/([^/]+)/postgresql$`)
+var rdsLogStreamSuffixRegexp = regexp.MustCompile(`\.\d+$`)
+
+func dbInstanceIDFromLogGroup(logGroup string, logStream string) (string, bool) {
+	parts := rdsLogGroupRegexp.FindStringSubmatch(logGroup)
+	if parts == nil {
+		return "", false
+	}
+	if parts[1] == "instance" {
+		return parts[2], true
+	}
+	return rdsLogStreamSuffixRegexp.ReplaceAllString(logStream, ""), true
+}
+
+func decodeCloudWatchLogsData(data []byte) (*cloudWatchLogsSubscriptionData, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Error decompressing CloudWatch Logs data: %s", err)
+	}
+	defer reader.Close()
+
+	var payload cloudWatchLogsSubscriptionData
+	err = json.NewDecoder(reader).Decode(&payload)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing CloudWatch Logs data: %s", err)
+	}
+
+	return &payload, nil
+}
+
+// processCloudWatchLogsData - Decodes a CloudWatch Logs subscription record and passes its log lines on
+//
+// This intentionally blocks (instead of aborting on shutdown), since the log transformer
+// keeps running until all log lines are processed.
+func processCloudWatchLogsData(data []byte, source string, logger *util.Logger, out chan<- cloudWatchLogStreamItem) error {
+	payload, err := decodeCloudWatchLogsData(data)
+	if err != nil {
+		return err
+	}
+
+	emitCloudWatchLogsPayload(payload, source, logger, out)
+	return nil
+}
+
+func emitCloudWatchLogsPayload(payload *cloudWatchLogsSubscriptionData, source string, logger *util.Logger, out chan<- cloudWatchLogStreamItem) {
+	// CloudWatch Logs sends a control message when the subscription filter is created, to check the destination is writable
+	if payload.MessageType != "DATA_MESSAGE" {
+		return
+	}
+
+	dbInstanceID, ok := dbInstanceIDFromLogGroup(payload.LogGroup, payload.LogStream)
+	if !ok {
+		logger.PrintVerbose("Ignoring CloudWatch Logs data for unsupported log group %s", payload.LogGroup)
+		return
+	}
+
+	for _, event := range payload.LogEvents {
+		occurredAt := time.Unix(0, event.Timestamp*int64(time.Millisecond))
+
+		// Each event holds one log line, followed by its continuation lines (if any)
+		for _, line := range strings.Split(strings.TrimRight(event.Message, "\n"), "\n") {
+			out <- cloudWatchLogStreamItem{
+				Source:       source,
+				DbInstanceID: dbInstanceID,
+				OccurredAt:   occurredAt,
+				Content:      line,
+			}
+		}
+	}
+}
+
+// SetupLogSubscriber - Starts receiving logs of Amazon RDS and Aurora instances that CloudWatch Logs
+// subscription filters forward, either through Kinesis Data Streams, Firehose HTTP deliveries, or
+// objects that Firehose writes to S3
+//
+// The log transformer registers with the passed wait group, and exits once all log lines
+// that were received before shutdown have been passed on.
+func SetupLogSubscriber(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) error {
+	cloudWatchLogStream := make(chan cloudWatchLogStreamItem, state.LogStreamBufferLen)
+	setupLogTransformer(wg, servers, cloudWatchLogStream, parsedLogStream, globalCollectionOpts, logger)
+
+	var inputWg sync.WaitGroup
+	defer func() {
+		go func() {
+			inputWg.Wait()
+			close(cloudWatchLogStream)
+		}()
+	}()
+
+	// Servers commonly share the same stream or listener, which only needs to be set up once
+	serversByStream := make(map[string][]*state.Server)
+	serversByListenAddress := make(map[string][]*state.Server)
+	serversByS3Location := make(map[string][]*state.Server)
+	var streamNames []string
+	var listenAddresses []string
+	var s3Locations []string
+	for _, server := range servers {
+		if server.Config.DisableLogs {
+			continue
+		}
+		if streamName := server.Config.AwsKinesisLogStream; streamName != "" {
+			if _, ok := serversByStream[streamName]; !ok {
+				streamNames = append(streamNames, streamName)
+			}
+			serversByStream[streamName] = append(serversByStream[streamName], server)
+		}
+		if listenAddress := server.Config.AwsFirehoseListenAddress; listenAddress != "" {
+			if _, ok := serversByListenAddress[listenAddress]; !ok {
+				listenAddresses = append(listenAddresses, listenAddress)
+			}
+			serversByListenAddress[listenAddress] = append(serversByListenAddress[listenAddress], server)
+		}
+		if server.Config.AwsS3LogBucket != "" {
+			location := s3LogSource(server.Config.AwsS3LogBucket, server.Config.AwsS3LogPrefix)
+			if _, ok := serversByS3Location[location]; !ok {
+				s3Locations = append(s3Locations, location)
+			}
+			serversByS3Location[location] = append(serversByS3Location[location], server)
+		}
+	}
+
+	for _, streamName := range streamNames {
+		streamServers := serversByStream[streamName]
+		prefixedLogger := logger.WithPrefix(streamServers[0].Config.SectionName)
+		err := setupKinesisStreamReader(ctx, &inputWg, globalCollectionOpts, prefixedLogger, streamServers, cloudWatchLogStream)
+		if err != nil {
+			if globalCollectionOpts.TestRun {
+				return err
+			}
+
+			prefixedLogger.PrintWarning("Skipping logs from Kinesis stream %s, could not setup log subscriber: %s", streamName, err)
+			continue
+		}
+	}
+
+	for _, listenAddress := range listenAddresses {
+		listenServers := serversByListenAddress[listenAddress]
+		prefixedLogger := logger.WithPrefix(listenServers[0].Config.SectionName)
+		err := setupFirehoseHandler(ctx, &inputWg, globalCollectionOpts, prefixedLogger, listenServers, cloudWatchLogStream)
+		if err != nil {
+			if globalCollectionOpts.TestRun {
+				return err
+			}
+
+			prefixedLogger.PrintWarning("Skipping logs from Firehose deliveries to %s, could not setup HTTP handler: %s", listenAddress, err)
+			continue
+		}
+	}
+
+	for _, location := range s3Locations {
+		locationServers := serversByS3Location[location]
+		prefixedLogger := logger.WithPrefix(locationServers[0].Config.SectionName)
+		err := setupS3LogReader(ctx, &inputWg, globalCollectionOpts, prefixedLogger, locationServers, cloudWatchLogStream)
+		if err != nil {
+			if globalCollectionOpts.TestRun {
+				return err
+			}
+
+			prefixedLogger.PrintWarning("Skipping logs from S3 bucket %s, could not setup log reader: %s", locationServers[0].Config.AwsS3LogBucket, err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func setupLogTransformer(wg *sync.WaitGroup, servers []*state.Server, in <-chan cloudWatchLogStreamItem, out chan state.ParsedLogStreamItem, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// Instances we've already warned about in test runs
+		unmatched := make(map[string]bool)
+
+		// This runs until the log stream is closed, to avoid losing log lines on shutdown
+		for item := range in {
+			// We ignore failures here since we want the per-backend stitching logic
+			// that runs later on (continuation lines have no log_line_prefix)
+			logLine, _ := logs.ParseLogLineWithPrefix("", item.Content+"\n")
+			if logLine.OccurredAt.IsZero() {
+				logLine.OccurredAt = item.OccurredAt
+			}
+
+			matched := false
+			for _, server := range servers {
+				if matchesLogSource(server.Config, item.Source) && server.Config.AwsDbInstanceID == item.DbInstanceID {
+					out <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
+					matched = true
+				}
+			}
+
+			if !matched && globalCollectionOpts.TestRun && !unmatched[item.DbInstanceID] {
+				logger.PrintError("Discarding log lines because of unknown instance (did you set the correct aws_db_instance_id?): %s", item.DbInstanceID)
+				unmatched[item.DbInstanceID] = true
+			}
+		}
+	}()
+}
@@ -1,5 +1,16 @@
 package rds
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
 // http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Monitoring.html
 type RdsOsSnapshot struct {
 	Engine             string  `json:"engine"`             // The database engine for the DB instance.
@@ -17,6 +28,8 @@ type RdsOsSnapshot struct {
 	Network           []RdsOsNetworkInterface `json:"network"`
 	DiskIO            []RdsOsDiskIO           `json:"diskIO"`
 	FileSystems       []RdsOsFileSystem       `json:"fileSys"`
+	Tasks             RdsOsTasks              `json:"tasks"`
+	ProcessList       []RdsOsProcess          `json:"processList"`
 }
 
 type RdsOsCPUUtilization struct {
@@ -83,6 +96,13 @@ type RdsOsDiskIO struct {
 	WriteKb     float32 `json:"writeKb"`     // The total number of kilobytes written.
 	WriteKbPS   float32 `json:"writeKbPS"`   // The number of kilobytes written per second.
 	WrqmPS      float32 `json:"wrqmPS"`      // The number of merged write requests queued per second.
+
+	// Aurora reports different metrics for its cluster storage volume
+	ReadLatency     float32 `json:"readLatency"`     // The average amount of time taken per disk I/O read operation, in milliseconds.
+	WriteLatency    float32 `json:"writeLatency"`    // The average amount of time taken per disk I/O write operation, in milliseconds.
+	ReadThroughput  float32 `json:"readThroughput"`  // The amount of network throughput used by requests to the DB cluster, in bytes per second.
+	WriteThroughput float32 `json:"writeThroughput"` // The amount of network throughput used by responses from the DB cluster, in bytes per second.
+	DiskQueueDepth  float32 `json:"diskQueueDepth"`  // The number of outstanding read/write requests waiting to access the disk.
 }
 
 type RdsOsFileSystem struct {
@@ -95,3 +115,185 @@ type RdsOsFileSystem struct {
 	UsedFiles       int64   `json:"usedFiles"`       // The number of files in the file system.
 	UsedPercent     float32 `json:"usedPercent"`     // The percentage of the file-system disk space in use.
 }
+
+type RdsOsTasks struct {
+	Blocked  int32 `json:"blocked"`  // The number of tasks that are blocked.
+	Running  int32 `json:"running"`  // The number of tasks that are running.
+	Sleeping int32 `json:"sleeping"` // The number of tasks that are sleeping.
+	Stopped  int32 `json:"stopped"`  // The number of tasks that are stopped.
+	Total    int32 `json:"total"`    // The total number of tasks.
+	Zombie   int32 `json:"zombie"`   // The number of child tasks that are inactive with an active parent task.
+}
+
+type RdsOsProcess struct {
+	CPUUsedPc    float32 `json:"cpuUsedPc"`    // The percentage of CPU used by the process.
+	ID           int32   `json:"id"`           // The identifier of the process.
+	MemoryUsedPc float32 `json:"memoryUsedPc"` // The percentage of memory used by the process.
+	Name         string  `json:"name"`         // The name of the process.
+	ParentID     int32   `json:"parentID"`     // The process identifier for the parent process of the process.
+	Rss          int64   `json:"rss"`          // The amount of RAM allocated to the process, in kilobytes.
+	Tgid         int32   `json:"tgid"`         // The thread group identifier.
+	Vss          int64   `json:"vss"`          // The amount of virtual memory allocated to the process, in kilobytes.
+}
+
+// Enhanced Monitoring publishes a snapshot every 1 to 60 seconds, anything older
+// means that it was turned off in the meantime
+const maxEnhancedMonitoringSnapshotAge = 5 * time.Minute
+
+// getEnhancedMonitoringSnapshot - Gets the most recent OS metrics that Enhanced Monitoring
+// published to CloudWatch Logs (the log stream is named after the instance's resource ID)
+func getEnhancedMonitoringSnapshot(sess *session.Session, resourceID string) (*RdsOsSnapshot, error) {
+	svc := cloudwatchlogs.New(sess)
+
+	params := &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String("RDSOSMetrics"),
+		LogStreamName: aws.String(resourceID),
+		Limit:         aws.Int64(1),
+	}
+
+	resp, err := svc.GetLogEvents(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Events) == 0 || resp.Events[0].Message == nil {
+		return nil, nil
+	}
+
+	var osSnapshot RdsOsSnapshot
+	err = json.Unmarshal([]byte(*resp.Events[0].Message), &osSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, osSnapshot.Timestamp)
+	if err == nil && time.Since(timestamp) > maxEnhancedMonitoringSnapshotAge {
+		return nil, nil
+	}
+
+	return &osSnapshot, nil
+}
+
+func applyEnhancedMonitoring(system *state.SystemState, osSnapshot RdsOsSnapshot, isAurora bool, cloudWatchReader awsutil.RdsCloudWatchReader) {
+	system.CPUStats = make(state.CPUStatisticMap)
+	system.CPUStats["all"] = state.CPUStatistic{
+		DiffedOnInput: true,
+		DiffedValues: &state.DiffedSystemCPUStats{
+			GuestPercent:  float64(osSnapshot.CPUUtilization.Guest),
+			IdlePercent:   float64(osSnapshot.CPUUtilization.Idle),
+			IrqPercent:    float64(osSnapshot.CPUUtilization.Irq),
+			IowaitPercent: float64(osSnapshot.CPUUtilization.Wait),
+			SystemPercent: float64(osSnapshot.CPUUtilization.System),
+			UserPercent:   float64(osSnapshot.CPUUtilization.User),
+			StealPercent:  float64(osSnapshot.CPUUtilization.Steal),
+			NicePercent:   float64(osSnapshot.CPUUtilization.Nice),
+		},
+	}
+
+	system.CPUInfo.SocketCount = 1
+	system.CPUInfo.LogicalCoreCount = int32(osSnapshot.NumVCPUs)
+	system.CPUInfo.PhysicalCoreCount = int32(osSnapshot.NumVCPUs)
+
+	system.Scheduler.Loadavg1min = float64(osSnapshot.LoadAverageMinute.One)
+	system.Scheduler.Loadavg5min = float64(osSnapshot.LoadAverageMinute.Five)
+	system.Scheduler.Loadavg15min = float64(osSnapshot.LoadAverageMinute.Fifteen)
+
+	system.Memory.ActiveBytes = uint64(osSnapshot.Memory.Active * 1024)
+	system.Memory.BuffersBytes = uint64(osSnapshot.Memory.Buffers * 1024)
+	system.Memory.CachedBytes = uint64(osSnapshot.Memory.Cached * 1024)
+	system.Memory.DirtyBytes = uint64(osSnapshot.Memory.Dirty * 1024)
+	system.Memory.FreeBytes = uint64(osSnapshot.Memory.Free * 1024)
+	system.Memory.HugePagesFree = uint64(osSnapshot.Memory.HugePagesFree)
+	system.Memory.HugePagesReserved = uint64(osSnapshot.Memory.HugePagesRsvd)
+	system.Memory.HugePagesSizeBytes = uint64(osSnapshot.Memory.HugePagesSize * 1024)
+	system.Memory.HugePagesSurplus = uint64(osSnapshot.Memory.HugePagesSurp)
+	system.Memory.HugePagesTotal = uint64(osSnapshot.Memory.HugePagesTotal)
+	system.Memory.InactiveBytes = uint64(osSnapshot.Memory.Inactive * 1024)
+	system.Memory.MappedBytes = uint64(osSnapshot.Memory.Mapped * 1024)
+	system.Memory.PageTablesBytes = uint64(osSnapshot.Memory.PageTables * 1024)
+	system.Memory.SlabBytes = uint64(osSnapshot.Memory.Slab * 1024)
+	system.Memory.SwapTotalBytes = uint64(osSnapshot.Swap.Total) * 1024
+	system.Memory.SwapUsedBytes = uint64(osSnapshot.Swap.Total-osSnapshot.Swap.Free) * 1024
+	system.Memory.TotalBytes = uint64(osSnapshot.Memory.Total * 1024)
+	system.Memory.WritebackBytes = uint64(osSnapshot.Memory.Writeback * 1024)
+
+	system.NetworkStats = make(state.NetworkStatsMap)
+	for _, networkIf := range osSnapshot.Network {
+		// Practically this always has one entry, and oddly enough we don't have
+		// the throughput numbers on a per interface basis...
+		system.NetworkStats[networkIf.Interface] = state.NetworkStats{
+			DiffedOnInput: true,
+			DiffedValues: &state.DiffedNetworkStats{
+				ReceiveThroughputBytesPerSecond:  uint64(cloudWatchReader.GetRdsIntMetric("NetworkReceiveThroughput", "Bytes/Second")),
+				TransmitThroughputBytesPerSecond: uint64(cloudWatchReader.GetRdsIntMetric("NetworkTransmitThroughput", "Bytes/Second")),
+			},
+		}
+	}
+
+	diskStats := system.DiskStats["default"].DiffedValues
+	for _, disk := range osSnapshot.DiskIO {
+		if isAurora {
+			// Aurora only reports a single entry for the cluster volume, without a device name
+			if disk.Device != "" {
+				continue
+			}
+			diskStats.ReadOperationsPerSecond = float64(disk.ReadIOsPS)
+			diskStats.WriteOperationsPerSecond = float64(disk.WriteIOsPS)
+			diskStats.BytesReadPerSecond = float64(disk.ReadThroughput)
+			diskStats.BytesWrittenPerSecond = float64(disk.WriteThroughput)
+			diskStats.AvgReadLatency = float64(disk.ReadLatency)
+			diskStats.AvgWriteLatency = float64(disk.WriteLatency)
+			diskStats.AvgQueueSize = int32(disk.DiskQueueDepth)
+			continue
+		}
+
+		// "The rdsdev device relates to the /rdsdbdata file system, where all database files and logs are stored"
+		// Source: https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Monitoring.OS.html
+		if disk.Device == "rdsdev" {
+			diskStats.ReadOperationsPerSecond = float64(disk.ReadIOsPS)
+			diskStats.ReadsMergedPerSecond = float64(disk.RrqmPS)
+			diskStats.BytesReadPerSecond = float64(disk.ReadKbPS * 1024)
+			diskStats.WriteOperationsPerSecond = float64(disk.WriteIOsPS)
+			diskStats.WritesMergedPerSecond = float64(disk.WrqmPS)
+			diskStats.BytesWrittenPerSecond = float64(disk.WriteKbPS * 1024)
+			diskStats.AvgQueueSize = int32(disk.AvgQueueLen)
+			diskStats.UtilizationPercent = float64(disk.Util)
+		}
+	}
+
+	system.DataDirectoryPartition = "/rdsdbdata"
+	system.DiskPartitions = make(state.DiskPartitionMap)
+	for _, diskPartition := range osSnapshot.FileSystems {
+		totalBytes := uint64(diskPartition.Total * 1024)
+		if isAurora {
+			totalBytes = AuroraMaxStorage
+		}
+		system.DiskPartitions[diskPartition.MountPoint] = state.DiskPartition{
+			DiskName:      "default",
+			PartitionName: diskPartition.Name,
+			UsedBytes:     uint64(diskPartition.Used * 1024),
+			TotalBytes:    totalBytes,
+		}
+	}
+
+	system.Processes = make([]state.SystemProcess, 0, len(osSnapshot.ProcessList))
+	for _, process := range osSnapshot.ProcessList {
+		system.Processes = append(system.Processes, state.SystemProcess{
+			Pid:           process.ID,
+			ParentPid:     process.ParentID,
+			Name:          process.Name,
+			CPUPercent:    float64(process.CPUUsedPc),
+			MemoryPercent: float64(process.MemoryUsedPc),
+			RssBytes:      uint64(process.Rss * 1024),
+			VssBytes:      uint64(process.Vss * 1024),
+		})
+	}
+	system.Tasks = state.SystemTasks{
+		Total:    osSnapshot.Tasks.Total,
+		Running:  osSnapshot.Tasks.Running,
+		Sleeping: osSnapshot.Tasks.Sleeping,
+		Blocked:  osSnapshot.Tasks.Blocked,
+		Stopped:  osSnapshot.Tasks.Stopped,
+		Zombie:   osSnapshot.Tasks.Zombie,
+	}
+}
@@ -0,0 +1,188 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// RDS events are usually available within a minute after they occurred
+const rdsEventPollInterval = 1 * time.Minute
+
+// Events are emitted as log lines, marked so they can be told apart from Postgres' own log output
+const rdsEventLogPrefix = "Amazon RDS event"
+
+// classifyRdsEvent - Maps RDS event categories to the closest log line classification
+//
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Events.Messages.html
+func classifyRdsEvent(categories []string, message string) pganalyze_collector.LogLineInformation_LogClassification {
+	lowerMessage := strings.ToLower(message)
+	for _, category := range categories {
+		switch category {
+		case "configuration change":
+			return pganalyze_collector.LogLineInformation_SERVER_RELOAD
+		case "availability":
+			if strings.Contains(lowerMessage, "shutdown") || strings.Contains(lowerMessage, "shut down") {
+				return pganalyze_collector.LogLineInformation_SERVER_SHUTDOWN
+			}
+			return pganalyze_collector.LogLineInformation_SERVER_START
+		}
+	}
+	return pganalyze_collector.LogLineInformation_SERVER_MISC
+}
+
+func rdsEventLogLine(event *rds.Event) state.LogLine {
+	var categories []string
+	for _, category := range event.EventCategories {
+		categories = append(categories, util.StringPtrToString(category))
+	}
+	message := util.StringPtrToString(event.Message)
+
+	content := fmt.Sprintf("%s: %s", rdsEventLogPrefix, message)
+	if len(categories) > 0 {
+		content = fmt.Sprintf("%s (%s): %s", rdsEventLogPrefix, strings.Join(categories, ", "), message)
+	}
+
+	return state.LogLine{
+		OccurredAt:     util.TimePtrToTime(event.Date),
+		LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+		Content:        content + "\n",
+		Classification: classifyRdsEvent(categories, message),
+		Details: map[string]interface{}{
+			"source_type":       util.StringPtrToString(event.SourceType),
+			"source_identifier": util.StringPtrToString(event.SourceIdentifier),
+		},
+	}
+}
+
+type rdsEventSource struct {
+	sourceType       string
+	sourceIdentifier string
+}
+
+// rdsEventPoller - Polls the events of one server's instance (and its Aurora cluster, where
+// failovers are reported), remembering which events were already emitted
+type rdsEventPoller struct {
+	server  *state.Server
+	sources []rdsEventSource
+	svc     *rds.RDS
+	logger  *util.Logger
+	out     chan<- state.ParsedLogStreamItem
+
+	lastEventAt time.Time
+	seenAtLast  map[string]bool // Events at lastEventAt, since DescribeEvents has second granularity
+}
+
+func (p *rdsEventPoller) poll(ctx context.Context) error {
+	now := time.Now()
+	var events []*rds.Event
+
+	for _, source := range p.sources {
+		params := &rds.DescribeEventsInput{
+			SourceType:       aws.String(source.sourceType),
+			SourceIdentifier: aws.String(source.sourceIdentifier),
+			StartTime:        aws.Time(p.lastEventAt),
+			EndTime:          aws.Time(now),
+		}
+		err := p.svc.DescribeEventsPagesWithContext(ctx, params, func(resp *rds.DescribeEventsOutput, lastPage bool) bool {
+			events = append(events, resp.Events...)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("Error describing RDS events: %s", err)
+		}
+	}
+
+	for _, event := range events {
+		if event.Date == nil {
+			continue
+		}
+		key := util.StringPtrToString(event.SourceIdentifier) + "/" + util.StringPtrToString(event.Message)
+		if event.Date.Before(p.lastEventAt) || (event.Date.Equal(p.lastEventAt) && p.seenAtLast[key]) {
+			continue
+		}
+		if event.Date.After(p.lastEventAt) {
+			p.lastEventAt = *event.Date
+			p.seenAtLast = make(map[string]bool)
+		}
+		p.seenAtLast[key] = true
+
+		p.out <- state.ParsedLogStreamItem{Identifier: p.server.Config.Identifier, LogLine: rdsEventLogLine(event)}
+	}
+
+	return nil
+}
+
+func newRdsEventPoller(server *state.Server, logger *util.Logger, out chan<- state.ParsedLogStreamItem) (*rdsEventPoller, error) {
+	sess, err := awsutil.GetAwsSession(server.Config)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting session: %s", err)
+	}
+
+	identifier, err := awsutil.FindRdsIdentifier(server.Config, sess)
+	if err != nil {
+		return nil, fmt.Errorf("Error finding RDS instance: %s", err)
+	}
+
+	sources := []rdsEventSource{{sourceType: rds.SourceTypeDbInstance, sourceIdentifier: identifier}}
+	if server.Config.AwsDbClusterID != "" {
+		sources = append(sources, rdsEventSource{sourceType: rds.SourceTypeDbCluster, sourceIdentifier: server.Config.AwsDbClusterID})
+	}
+
+	return &rdsEventPoller{
+		server:      server,
+		sources:     sources,
+		svc:         rds.New(sess),
+		logger:      logger,
+		out:         out,
+		lastEventAt: time.Now().Add(-server.Config.GetLogReplayWindow()),
+		seenAtLast:  make(map[string]bool),
+	}, nil
+}
+
+// SetupEventPoller - Emits RDS events (e.g. failovers, restarts and parameter group changes) of the
+// servers that have aws_db_events enabled as log lines, so they show up next to the Postgres logs
+func SetupEventPoller(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) {
+	for _, server := range servers {
+		if !server.Config.AwsDbEvents || server.Config.DisableLogs {
+			continue
+		}
+
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		poller, err := newRdsEventPoller(server, prefixedLogger, parsedLogStream)
+		if err != nil {
+			prefixedLogger.PrintWarning("Skipping RDS events, could not set up event polling: %s", err)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ticker := time.NewTicker(rdsEventPollInterval)
+			defer ticker.Stop()
+
+			for {
+				err := poller.poll(ctx)
+				if err != nil && ctx.Err() == nil {
+					poller.logger.PrintWarning("%s", err)
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+	}
+}
@@ -0,0 +1,170 @@
+package rds
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// Firehose sends at most 64 MiB of (uncompressed) data per request, see
+// https://docs.aws.amazon.com/firehose/latest/dev/httpdeliveryrequestresponse.html
+const maxFirehoseRequestSize = 64 * 1024 * 1024
+
+// How long in-flight deliveries may take to finish on shutdown
+const firehoseShutdownTimeout = 30 * time.Second
+
+type firehoseRequest struct {
+	RequestID string `json:"requestId"`
+	Timestamp int64  `json:"timestamp"`
+	Records   []struct {
+		Data []byte `json:"data"` // base64 in the JSON body
+	} `json:"records"`
+}
+
+type firehoseResponse struct {
+	RequestID    string `json:"requestId"`
+	Timestamp    int64  `json:"timestamp"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// writeFirehoseResponse - Responds in the format Firehose requires, anything else causes the delivery to be retried
+func writeFirehoseResponse(w http.ResponseWriter, requestID string, status int, errorMessage string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(firehoseResponse{
+		RequestID:    requestID,
+		Timestamp:    time.Now().UnixNano() / int64(time.Millisecond),
+		ErrorMessage: errorMessage,
+	})
+}
+
+func firehoseHandler(accessKeys []string, source string, logger *util.Logger, out chan<- cloudWatchLogStreamItem) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Amz-Firehose-Request-Id")
+
+		if r.Method != http.MethodPost {
+			writeFirehoseResponse(w, requestID, http.StatusMethodNotAllowed, "Only POST requests are supported")
+			return
+		}
+
+		authorized := false
+		accessKey := []byte(r.Header.Get("X-Amz-Firehose-Access-Key"))
+		for _, key := range accessKeys {
+			if subtle.ConstantTimeCompare(accessKey, []byte(key)) == 1 {
+				authorized = true
+			}
+		}
+		if !authorized {
+			logger.PrintWarning("Rejecting Firehose delivery %s from %s: invalid access key", requestID, r.RemoteAddr)
+			writeFirehoseResponse(w, requestID, http.StatusUnauthorized, "Invalid access key")
+			return
+		}
+
+		var body io.Reader = http.MaxBytesReader(w, r.Body, maxFirehoseRequestSize)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gzipReader, err := gzip.NewReader(body)
+			if err != nil {
+				writeFirehoseResponse(w, requestID, http.StatusBadRequest, fmt.Sprintf("Could not decompress request body: %s", err))
+				return
+			}
+			defer gzipReader.Close()
+			body = io.LimitReader(gzipReader, maxFirehoseRequestSize)
+		}
+
+		var request firehoseRequest
+		err := json.NewDecoder(body).Decode(&request)
+		if err != nil {
+			writeFirehoseResponse(w, requestID, http.StatusBadRequest, fmt.Sprintf("Could not parse request body: %s", err))
+			return
+		}
+
+		// The delivery is acknowledged only once all of its log lines were handed to the
+		// log transformer. Records that can't be decoded are skipped, since they would
+		// fail again when Firehose retries.
+		for _, record := range request.Records {
+			err = processCloudWatchLogsData(record.Data, source, logger, out)
+			if err != nil {
+				logger.PrintWarning("Skipping record of Firehose delivery %s: %s", requestID, err)
+			}
+		}
+
+		writeFirehoseResponse(w, requestID, http.StatusOK, "")
+	}
+}
+
+func setupFirehoseHandler(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, out chan<- cloudWatchLogStreamItem) error {
+	listenAddress := servers[0].Config.AwsFirehoseListenAddress
+
+	var accessKeys []string
+	for _, server := range servers {
+		if server.Config.AwsFirehoseAccessKey != "" {
+			accessKeys = append(accessKeys, server.Config.AwsFirehoseAccessKey)
+		}
+	}
+	if len(accessKeys) == 0 {
+		return fmt.Errorf("aws_firehose_access_key must be set when using aws_firehose_listen_address")
+	}
+
+	var tlsConfig *tls.Config
+	cfg := servers[0].Config
+	if cfg.AwsFirehoseTLSCert != "" || cfg.AwsFirehoseTLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.AwsFirehoseTLSCert, cfg.AwsFirehoseTLSKey)
+		if err != nil {
+			return fmt.Errorf("Could not load Firehose TLS certificate: %s", err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	// The background collector is likely already listening on the address, so
+	// test runs only verify the configuration
+	if globalCollectionOpts.TestRun {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return fmt.Errorf("Could not listen on %s: %s", listenAddress, err)
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", firehoseHandler(accessKeys, firehoseLogSource(listenAddress), logger, out))
+	server := &http.Server{Handler: mux}
+
+	logger.PrintVerbose("Listening for Firehose deliveries on %s", listenAddress)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			logger.PrintError("Firehose HTTP handler on %s failed: %s", listenAddress, err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+
+		// Let in-flight deliveries finish, so Firehose doesn't need to retry them
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), firehoseShutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	return nil
+}
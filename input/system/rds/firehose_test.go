@@ -0,0 +1,147 @@
+package rds
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pganalyze/collector/util"
+)
+
+func gzipData(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func cloudWatchLogsRecord(t *testing.T, messageType string, events ...string) []byte {
+	payload := map[string]interface{}{
+		"messageType": messageType,
+		"logGroup":    "/aws/rds/instance/mydb/postgresql",
+		"logStream":   "mydb.0",
+	}
+	var logEvents []map[string]interface{}
+	for i, message := range events {
+		logEvents = append(logEvents, map[string]interface{}{"id": string(rune('a' + i)), "timestamp": 1609459200000 + int64(i), "message": message})
+	}
+	payload["logEvents"] = logEvents
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gzipData(t, data)
+}
+
+func firehoseRequestBody(t *testing.T, records ...[]byte) []byte {
+	var request firehoseRequest
+	request.RequestID = "request-1"
+	request.Timestamp = 1609459200000
+	for _, record := range records {
+		request.Records = append(request.Records, struct {
+			Data []byte `json:"data"`
+		}{record})
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestFirehoseHandler(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+	validBody := firehoseRequestBody(t,
+		cloudWatchLogsRecord(t, "CONTROL_MESSAGE", "CWL CONTROL MESSAGE: Checking health of destination Firehose."),
+		cloudWatchLogsRecord(t, "DATA_MESSAGE", "2021-01-01 00:00:00 UTC::@:[123]:LOG:  checkpoint starting: time", "2021-01-01 00:00:01 UTC:10.0.0.1(5432):app@db:[456]:ERROR:  syntax error\n\tat character 8\n"),
+		[]byte("not gzip"),
+	)
+
+	tests := []struct {
+		name            string
+		method          string
+		accessKey       string
+		contentEncoding string
+		body            []byte
+		expectedStatus  int
+		expectedLines   []string
+	}{
+		{"valid delivery", "POST", "key-b", "", validBody, http.StatusOK, []string{
+			"2021-01-01 00:00:00 UTC::@:[123]:LOG:  checkpoint starting: time",
+			"2021-01-01 00:00:01 UTC:10.0.0.1(5432):app@db:[456]:ERROR:  syntax error",
+			"\tat character 8",
+		}},
+		{"gzip encoded delivery", "POST", "key-a", "gzip", gzipData(t, validBody), http.StatusOK, []string{
+			"2021-01-01 00:00:00 UTC::@:[123]:LOG:  checkpoint starting: time",
+			"2021-01-01 00:00:01 UTC:10.0.0.1(5432):app@db:[456]:ERROR:  syntax error",
+			"\tat character 8",
+		}},
+		{"invalid access key", "POST", "key-c", "", validBody, http.StatusUnauthorized, nil},
+		{"missing access key", "POST", "", "", validBody, http.StatusUnauthorized, nil},
+		{"wrong method", "GET", "key-a", "", nil, http.StatusMethodNotAllowed, nil},
+		{"invalid gzip", "POST", "key-a", "gzip", validBody, http.StatusBadRequest, nil},
+		{"invalid JSON", "POST", "key-a", "", []byte("{"), http.StatusBadRequest, nil},
+	}
+
+	for _, test := range tests {
+		out := make(chan cloudWatchLogStreamItem, 10)
+		handler := firehoseHandler([]string{"key-a", "key-b"}, firehoseLogSource(":8443"), logger, out)
+
+		req := httptest.NewRequest(test.method, "/", bytes.NewReader(test.body))
+		req.Header.Set("X-Amz-Firehose-Request-Id", "request-1")
+		if test.accessKey != "" {
+			req.Header.Set("X-Amz-Firehose-Access-Key", test.accessKey)
+		}
+		if test.contentEncoding != "" {
+			req.Header.Set("Content-Encoding", test.contentEncoding)
+		}
+		recorder := httptest.NewRecorder()
+		handler(recorder, req)
+		close(out)
+
+		if recorder.Code != test.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", test.name, test.expectedStatus, recorder.Code)
+		}
+		if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("%s: expected JSON response, got content type %q", test.name, contentType)
+		}
+		var response firehoseResponse
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Errorf("%s: could not parse response: %s", test.name, err)
+		}
+		if response.RequestID != "request-1" || response.Timestamp == 0 {
+			t.Errorf("%s: expected response to echo the request ID with a timestamp, got %+v", test.name, response)
+		}
+		if (test.expectedStatus == http.StatusOK) != (response.ErrorMessage == "") {
+			t.Errorf("%s: unexpected error message %q", test.name, response.ErrorMessage)
+		}
+
+		var lines []string
+		for item := range out {
+			if item.DbInstanceID != "mydb" || item.Source != "firehose::8443" {
+				t.Errorf("%s: unexpected log stream item %+v", test.name, item)
+			}
+			lines = append(lines, item.Content)
+		}
+		if len(lines) != len(test.expectedLines) {
+			t.Errorf("%s: expected %d log lines, got %d: %q", test.name, len(test.expectedLines), len(lines), lines)
+			continue
+		}
+		for i := range lines {
+			if lines[i] != test.expectedLines[i] {
+				t.Errorf("%s: line %d: expected %q, got %q", test.name, i, test.expectedLines[i], lines[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// Kinesis allows 5 GetRecords calls per second per shard, shared by all consumers of the stream
+const kinesisPollInterval = 1 * time.Second
+const kinesisGetRecordsLimit = 1000
+
+// How often we check for new shards (e.g. after the stream was resharded)
+const kinesisShardRefreshInterval = 1 * time.Minute
+
+const kinesisRetryWait = 10 * time.Second
+
+// kinesisCheckpointer - Remembers the last processed record of each shard in the log
+// state of all servers that share the stream, so it gets persisted in the state file
+type kinesisCheckpointer struct {
+	servers    []*state.Server
+	streamName string
+	disabled   bool
+}
+
+func (c kinesisCheckpointer) key(shardID string) string {
+	return c.streamName + "/" + shardID
+}
+
+func (c kinesisCheckpointer) get(shardID string) (string, bool) {
+	if c.disabled {
+		return "", false
+	}
+	for _, server := range c.servers {
+		server.LogStateMutex.Lock()
+		sequenceNumber, ok := server.LogPrevState.KinesisCheckpoints[c.key(shardID)]
+		server.LogStateMutex.Unlock()
+		if ok {
+			return sequenceNumber, true
+		}
+	}
+	return "", false
+}
+
+func (c kinesisCheckpointer) save(shardID string, sequenceNumber string) {
+	if c.disabled {
+		return
+	}
+	for _, server := range c.servers {
+		// The state file writer may hold a reference to the current map, so replace it instead of modifying it
+		server.LogStateMutex.Lock()
+		checkpoints := make(map[string]string)
+		for k, v := range server.LogPrevState.KinesisCheckpoints {
+			checkpoints[k] = v
+		}
+		checkpoints[c.key(shardID)] = sequenceNumber
+		server.LogPrevState.KinesisCheckpoints = checkpoints
+		server.LogStateMutex.Unlock()
+	}
+}
+
+func listKinesisShards(ctx context.Context, client *awsutil.KinesisClient, streamName string) ([]*awsutil.KinesisShard, error) {
+	var shards []*awsutil.KinesisShard
+
+	input := &awsutil.KinesisListShardsInput{StreamName: aws.String(streamName)}
+	for {
+		resp, err := client.ListShardsWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Kinesis shards: %s", err)
+		}
+		shards = append(shards, resp.Shards...)
+		if resp.NextToken == nil {
+			break
+		}
+		input = &awsutil.KinesisListShardsInput{NextToken: resp.NextToken}
+	}
+
+	return shards, nil
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+type kinesisShardReader struct {
+	client       *awsutil.KinesisClient
+	streamName   string
+	shardID      string
+	checkpointer kinesisCheckpointer
+	logger       *util.Logger
+	out          chan<- cloudWatchLogStreamItem
+
+	// Where to start reading when there is no checkpoint for the shard
+	initialIteratorType string
+	initialTimestamp    time.Time
+}
+
+func (r kinesisShardReader) getIterator(ctx context.Context) (*string, error) {
+	input := &awsutil.KinesisGetShardIteratorInput{
+		StreamName: aws.String(r.streamName),
+		ShardId:    aws.String(r.shardID),
+	}
+	if sequenceNumber, ok := r.checkpointer.get(r.shardID); ok {
+		input.ShardIteratorType = aws.String(awsutil.KinesisShardIteratorTypeAfterSequenceNumber)
+		input.StartingSequenceNumber = aws.String(sequenceNumber)
+	} else {
+		input.ShardIteratorType = aws.String(r.initialIteratorType)
+		if r.initialIteratorType == awsutil.KinesisShardIteratorTypeAtTimestamp {
+			input.Timestamp = aws.Time(r.initialTimestamp)
+		}
+	}
+
+	resp, err := r.client.GetShardIteratorWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ShardIterator, nil
+}
+
+// run - Reads the shard until it is closed (after resharding) or the context is cancelled
+func (r kinesisShardReader) run(ctx context.Context) {
+	iterator, err := r.getIterator(ctx)
+	for err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		r.logger.PrintWarning("Error getting iterator for Kinesis shard %s: %s", r.shardID, err)
+		if !sleepWithContext(ctx, kinesisRetryWait) {
+			return
+		}
+		iterator, err = r.getIterator(ctx)
+	}
+
+	for iterator != nil {
+		resp, err := r.client.GetRecordsWithContext(ctx, &awsutil.KinesisGetRecordsInput{
+			ShardIterator: iterator,
+			Limit:         aws.Int64(kinesisGetRecordsLimit),
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == awsutil.KinesisErrCodeExpiredIteratorException {
+				iterator, err = r.getIterator(ctx)
+				if err == nil {
+					continue
+				}
+			}
+			if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != awsutil.KinesisErrCodeProvisionedThroughputExceededException {
+				r.logger.PrintWarning("Error reading Kinesis shard %s: %s", r.shardID, err)
+			}
+			if !sleepWithContext(ctx, kinesisRetryWait) {
+				return
+			}
+			continue
+		}
+
+		for _, record := range resp.Records {
+			r.processRecord(record)
+			r.checkpointer.save(r.shardID, *record.SequenceNumber)
+		}
+
+		iterator = resp.NextShardIterator
+
+		// Poll again right away if we're behind, to catch up with the stream
+		if len(resp.Records) == 0 || resp.MillisBehindLatest == nil || *resp.MillisBehindLatest == 0 {
+			if !sleepWithContext(ctx, kinesisPollInterval) {
+				return
+			}
+		}
+	}
+
+	r.logger.PrintVerbose("Finished reading closed Kinesis shard %s", r.shardID)
+}
+
+func (r kinesisShardReader) processRecord(record *awsutil.KinesisRecord) {
+	err := processCloudWatchLogsData(record.Data, kinesisLogSource(r.streamName), r.logger, r.out)
+	if err != nil {
+		r.logger.PrintWarning("%s", err)
+	}
+}
+
+// kinesisShardScheduler - Starts a reader for each shard it hasn't seen before. Child
+// shards (the result of resharding) are only read once their parent shards have been
+// read to the end, so that the log lines of a backend stay in order.
+type kinesisShardScheduler struct {
+	read func(ctx context.Context, shard *awsutil.KinesisShard, iteratorType string)
+	done map[string]chan struct{} // Closed once the shard's reader has finished
+	wg   sync.WaitGroup
+}
+
+func newKinesisShardScheduler(read func(ctx context.Context, shard *awsutil.KinesisShard, iteratorType string)) *kinesisShardScheduler {
+	return &kinesisShardScheduler{read: read, done: make(map[string]chan struct{})}
+}
+
+func (s *kinesisShardScheduler) start(ctx context.Context, shards []*awsutil.KinesisShard, iteratorType string) {
+	// Register all new shards first, so that children find their parents regardless of list order
+	var added []*awsutil.KinesisShard
+	for _, shard := range shards {
+		if _, ok := s.done[*shard.ShardId]; ok {
+			continue
+		}
+		s.done[*shard.ShardId] = make(chan struct{})
+		added = append(added, shard)
+	}
+
+	for _, shard := range added {
+		// Parents that we don't know about have already expired from the stream
+		var parents []chan struct{}
+		for _, parentID := range []*string{shard.ParentShardId, shard.AdjacentParentShardId} {
+			if parentID == nil {
+				continue
+			}
+			if parentDone, ok := s.done[*parentID]; ok {
+				parents = append(parents, parentDone)
+			}
+		}
+
+		shard := shard
+		done := s.done[*shard.ShardId]
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer close(done)
+			for _, parentDone := range parents {
+				select {
+				case <-ctx.Done():
+					return
+				case <-parentDone:
+				}
+			}
+			if ctx.Err() == nil {
+				s.read(ctx, shard, iteratorType)
+			}
+		}()
+	}
+}
+
+func (s *kinesisShardScheduler) wait() {
+	s.wg.Wait()
+}
+
+func setupKinesisStreamReader(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, out chan<- cloudWatchLogStreamItem) error {
+	streamName := servers[0].Config.AwsKinesisLogStream
+
+	sess, err := awsutil.GetAwsSession(servers[0].Config)
+	if err != nil {
+		return fmt.Errorf("Error getting session: %s", err)
+	}
+	client := awsutil.NewKinesisClient(sess)
+
+	shards, err := listKinesisShards(ctx, client, streamName)
+	if err != nil {
+		return err
+	}
+
+	// Test runs should neither replay old log data, nor modify the checkpoints of the background collector
+	checkpointer := kinesisCheckpointer{servers: servers, streamName: streamName, disabled: globalCollectionOpts.TestRun}
+
+	// Without a checkpoint, start within the replay window before startup (instead of
+	// reading everything the stream retained)
+	var replayWindow time.Duration
+	for _, server := range servers {
+		if server.Config.GetLogReplayWindow() > replayWindow {
+			replayWindow = server.Config.GetLogReplayWindow()
+		}
+	}
+	startedAt := time.Now()
+
+	logger.PrintVerbose("Reading logs from Kinesis stream %s (%d shards)", streamName, len(shards))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		scheduler := newKinesisShardScheduler(func(ctx context.Context, shard *awsutil.KinesisShard, iteratorType string) {
+			reader := kinesisShardReader{
+				client:              client,
+				streamName:          streamName,
+				shardID:             *shard.ShardId,
+				checkpointer:        checkpointer,
+				logger:              logger,
+				out:                 out,
+				initialIteratorType: iteratorType,
+				initialTimestamp:    startedAt.Add(-replayWindow),
+			}
+			reader.run(ctx)
+		})
+		defer scheduler.wait()
+
+		scheduler.start(ctx, shards, awsutil.KinesisShardIteratorTypeAtTimestamp)
+
+		ticker := time.NewTicker(kinesisShardRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				shards, err := listKinesisShards(ctx, client, streamName)
+				if err != nil {
+					if ctx.Err() == nil {
+						logger.PrintWarning("%s", err)
+					}
+					continue
+				}
+				// Shards created after startup are the result of resharding, and need to be read in full
+				scheduler.start(ctx, shards, awsutil.KinesisShardIteratorTypeTrimHorizon)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package rds
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+var dbInstanceIDTests = []struct {
+	logGroup   string
+	logStream  string
+	expectedID string
+	expectedOk bool
+}{
+	{"/aws/rds/instance/mydb/postgresql", "mydb.0", "mydb", true},
+	{"/aws/rds/cluster/mycluster/postgresql", "mycluster-instance-1.0", "mycluster-instance-1", true},
+	{"/aws/rds/cluster/mycluster/postgresql", "mycluster-instance-1", "mycluster-instance-1", true},
+	{"/aws/rds/instance/mydb/upgrade", "mydb.0", "", false},
+	{"/aws/lambda/myfunction", "2021/01/01/[$LATEST]abc", "", false},
+}
+
+func TestDbInstanceIDFromLogGroup(t *testing.T) {
+	for _, test := range dbInstanceIDTests {
+		id, ok := dbInstanceIDFromLogGroup(test.logGroup, test.logStream)
+		if id != test.expectedID || ok != test.expectedOk {
+			t.Errorf("%s %s: expected (%q, %t), got (%q, %t)", test.logGroup, test.logStream, test.expectedID, test.expectedOk, id, ok)
+		}
+	}
+}
+
+func TestKinesisCheckpointer(t *testing.T) {
+	servers := []*state.Server{
+		{LogStateMutex: &sync.Mutex{}},
+		{LogStateMutex: &sync.Mutex{}, LogPrevState: state.PersistedLogState{KinesisCheckpoints: map[string]string{"other/shardId-000000000000": "1"}}},
+	}
+	checkpointer := kinesisCheckpointer{servers: servers, streamName: "logs"}
+
+	if _, ok := checkpointer.get("shardId-000000000000"); ok {
+		t.Errorf("expected no checkpoint for a shard of a different stream")
+	}
+
+	checkpointer.save("shardId-000000000000", "100")
+	checkpointer.save("shardId-000000000001", "200")
+	checkpointer.save("shardId-000000000000", "150")
+
+	for i, server := range servers {
+		if sequenceNumber := server.LogPrevState.KinesisCheckpoints["logs/shardId-000000000000"]; sequenceNumber != "150" {
+			t.Errorf("server %d: expected checkpoint 150, got %q", i, sequenceNumber)
+		}
+	}
+	if sequenceNumber, ok := checkpointer.get("shardId-000000000001"); !ok || sequenceNumber != "200" {
+		t.Errorf("expected checkpoint 200, got %q (%t)", sequenceNumber, ok)
+	}
+	if sequenceNumber := servers[1].LogPrevState.KinesisCheckpoints["other/shardId-000000000000"]; sequenceNumber != "1" {
+		t.Errorf("expected checkpoint of other stream to be kept, got %q", sequenceNumber)
+	}
+
+	// A checkpoint already persisted for one server is found by a checkpointer that includes it
+	if sequenceNumber, ok := (kinesisCheckpointer{servers: servers[1:], streamName: "logs"}).get("shardId-000000000000"); !ok || sequenceNumber != "150" {
+		t.Errorf("expected checkpoint 150 from second server, got %q (%t)", sequenceNumber, ok)
+	}
+
+	// Test runs neither read nor modify checkpoints
+	disabled := kinesisCheckpointer{servers: servers, streamName: "logs", disabled: true}
+	if _, ok := disabled.get("shardId-000000000000"); ok {
+		t.Errorf("expected disabled checkpointer to not return checkpoints")
+	}
+	disabled.save("shardId-000000000000", "999")
+	if sequenceNumber := servers[0].LogPrevState.KinesisCheckpoints["logs/shardId-000000000000"]; sequenceNumber != "150" {
+		t.Errorf("expected disabled checkpointer to not save, got %q", sequenceNumber)
+	}
+}
+
+func TestKinesisShardSchedulerReadsParentsFirst(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mutex sync.Mutex
+	var events []string
+	record := func(event string) {
+		mutex.Lock()
+		events = append(events, event)
+		mutex.Unlock()
+	}
+
+	// Shards stay open until released, like an open shard on a live stream
+	release := map[string]chan struct{}{
+		"shardId-000000000000": make(chan struct{}),
+		"shardId-000000000001": make(chan struct{}),
+		"shardId-000000000002": make(chan struct{}),
+		"shardId-000000000003": make(chan struct{}),
+	}
+	started := make(chan string, len(release))
+	scheduler := newKinesisShardScheduler(func(ctx context.Context, shard *awsutil.KinesisShard, iteratorType string) {
+		record("start " + *shard.ShardId + " " + iteratorType)
+		started <- *shard.ShardId
+		select {
+		case <-ctx.Done():
+		case <-release[*shard.ShardId]:
+		}
+		record("finish " + *shard.ShardId)
+	})
+
+	scheduler.start(ctx, []*awsutil.KinesisShard{
+		{ShardId: aws.String("shardId-000000000000")},
+		{ShardId: aws.String("shardId-000000000001")},
+	}, awsutil.KinesisShardIteratorTypeAtTimestamp)
+	waitForStart(t, started, "shardId-000000000000", "shardId-000000000001")
+
+	// Merge of both shards into a new shard, listed before its parents, and listed again on the next refresh
+	merged := []*awsutil.KinesisShard{
+		{ShardId: aws.String("shardId-000000000002"), ParentShardId: aws.String("shardId-000000000000"), AdjacentParentShardId: aws.String("shardId-000000000001")},
+		{ShardId: aws.String("shardId-000000000000")},
+		{ShardId: aws.String("shardId-000000000001")},
+	}
+	scheduler.start(ctx, merged, awsutil.KinesisShardIteratorTypeTrimHorizon)
+	scheduler.start(ctx, merged, awsutil.KinesisShardIteratorTypeTrimHorizon)
+
+	close(release["shardId-000000000000"])
+	select {
+	case shardID := <-started:
+		t.Fatalf("expected merged shard to wait for both parents, but %s started", shardID)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release["shardId-000000000001"])
+	waitForStart(t, started, "shardId-000000000002")
+
+	// Parent shards that are no longer retained by the stream are not waited for
+	scheduler.start(ctx, []*awsutil.KinesisShard{
+		{ShardId: aws.String("shardId-000000000003"), ParentShardId: aws.String("shardId-000000000999")},
+	}, awsutil.KinesisShardIteratorTypeTrimHorizon)
+	waitForStart(t, started, "shardId-000000000003")
+
+	close(release["shardId-000000000002"])
+	close(release["shardId-000000000003"])
+	scheduler.wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	position := make(map[string]int)
+	for i, event := range events {
+		position[event] = i
+	}
+	if len(events) != 8 {
+		t.Fatalf("expected each shard to be read exactly once, got %v", events)
+	}
+	if position["start shardId-000000000002 TRIM_HORIZON"] < position["finish shardId-000000000000"] ||
+		position["start shardId-000000000002 TRIM_HORIZON"] < position["finish shardId-000000000001"] {
+		t.Errorf("expected merged shard to start after both parents finished, got %v", events)
+	}
+}
+
+func TestKinesisShardSchedulerCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	started := make(chan string, 2)
+	scheduler := newKinesisShardScheduler(func(ctx context.Context, shard *awsutil.KinesisShard, iteratorType string) {
+		started <- *shard.ShardId
+		<-ctx.Done()
+	})
+	scheduler.start(ctx, []*awsutil.KinesisShard{
+		{ShardId: aws.String("shardId-000000000000")},
+		{ShardId: aws.String("shardId-000000000001"), ParentShardId: aws.String("shardId-000000000000")},
+	}, awsutil.KinesisShardIteratorTypeAtTimestamp)
+	waitForStart(t, started, "shardId-000000000000")
+
+	// Stopping the parent reader on shutdown must not start reading its child
+	cancel()
+	scheduler.wait()
+	select {
+	case shardID := <-started:
+		t.Errorf("expected child shard to not be read after shutdown, but %s started", shardID)
+	default:
+	}
+}
+
+func waitForStart(t *testing.T, started chan string, shardIDs ...string) {
+	t.Helper()
+	expected := make(map[string]bool)
+	for _, shardID := range shardIDs {
+		expected[shardID] = true
+	}
+	for range shardIDs {
+		select {
+		case shardID := <-started:
+			if !expected[shardID] {
+				t.Fatalf("unexpected shard started: %s", shardID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for shards %v to start", shardIDs)
+		}
+	}
+}
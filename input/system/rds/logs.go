@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/logs"
@@ -42,7 +43,21 @@ func DownloadLogFiles(prevState state.PersistedLogState, config config.ServerCon
 
 	// Retrieve all possibly matching logfiles in the last two minutes, assuming
 	// the collector's scheduler that runs more frequently than that
-	linesNewerThan := time.Now().Add(-2 * time.Minute)
+	downloadStartedAt := time.Now()
+	linesNewerThan := downloadStartedAt.Add(-2 * time.Minute)
+
+	// After a collector restart, also check the files written since the markers were
+	// last updated, going back at most the replay window
+	if resumeFrom := psl.AwsMarkersUpdatedAt.Add(-2 * time.Minute); !psl.AwsMarkersUpdatedAt.IsZero() && resumeFrom.Before(linesNewerThan) {
+		maxBackfill := downloadStartedAt.Add(-config.GetLogReplayWindow())
+		if resumeFrom.Before(maxBackfill) {
+			resumeFrom = maxBackfill
+		}
+		if resumeFrom.Before(linesNewerThan) {
+			logger.PrintVerbose("Rds/Logs: Resuming log download from %s", resumeFrom.Format(time.RFC3339))
+			linesNewerThan = resumeFrom
+		}
+	}
 	lastWritten := linesNewerThan.Unix() * 1000
 
 	params := &rds.DescribeDBLogFilesInput{
@@ -65,6 +80,10 @@ func DownloadLogFiles(prevState state.PersistedLogState, config config.ServerCon
 		prevMarker, ok := psl.AwsMarkers[*rdsLogFile.LogFileName]
 		if ok {
 			lastMarker = &prevMarker
+		} else if !psl.AwsMarkersUpdatedAt.IsZero() {
+			// Files that were created since the last download are read from the beginning
+			// (older lines get skipped based on their timestamp)
+			lastMarker = aws.String("0")
 		}
 
 		var logFile state.LogFile
@@ -145,6 +164,7 @@ func DownloadLogFiles(prevState state.PersistedLogState, config config.ServerCon
 		logFiles = append(logFiles, logFile)
 	}
 	psl.AwsMarkers = newMarkers
+	psl.AwsMarkersUpdatedAt = downloadStartedAt
 
 	return psl, logFiles, samples, err
 
@@ -0,0 +1,145 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// Activity snapshots are collected every 10 seconds, but we only import Performance
+// Insights data once per interval, to stay well within the API's rate limits
+const performanceInsightsInterval = 1 * time.Minute
+
+// Number of wait events to import (ordered by their load)
+const performanceInsightsWaitEventLimit = 25
+
+const performanceInsightsTimeout = 10 * time.Second
+
+// Performance Insights identifies instances by their resource ID (db-...)
+var resourceIDMap *util.TTLMap = util.NewTTLMap(60 * 60)
+
+var performanceInsightsMutex sync.Mutex
+var performanceInsightsFetchedUntil = make(map[string]time.Time)
+
+func findRdsResourceID(cfg config.ServerConfig, sess *session.Session) (string, error) {
+	resourceID := resourceIDMap.Get(cfg.AwsDbInstanceID)
+	if resourceID != "" {
+		return resourceID, nil
+	}
+
+	instance, err := awsutil.FindRdsInstance(cfg, sess)
+	if err != nil {
+		return "", err
+	}
+	if instance == nil || instance.DbiResourceId == nil {
+		return "", fmt.Errorf("Could not find RDS instance %s", cfg.AwsDbInstanceID)
+	}
+	if !util.BoolPtrToBool(instance.PerformanceInsightsEnabled) {
+		return "", fmt.Errorf("Performance Insights is not enabled for RDS instance %s", cfg.AwsDbInstanceID)
+	}
+
+	resourceID = *instance.DbiResourceId
+	resourceIDMap.Put(cfg.AwsDbInstanceID, resourceID)
+	return resourceID, nil
+}
+
+// GetPerformanceInsights - Gets the database load that Performance Insights sampled since the last call
+//
+// This returns nil (without an error) when data was imported less than a minute ago.
+func GetPerformanceInsights(cfg config.ServerConfig, logger *util.Logger) (*state.AmazonRdsPerformanceInsights, error) {
+	performanceInsightsMutex.Lock()
+	startTime, ok := performanceInsightsFetchedUntil[cfg.AwsDbInstanceID]
+	performanceInsightsMutex.Unlock()
+
+	endTime := time.Now().Truncate(time.Second)
+	if !ok || startTime.Before(endTime.Add(-5*performanceInsightsInterval)) {
+		startTime = endTime.Add(-performanceInsightsInterval)
+	} else if endTime.Sub(startTime) < performanceInsightsInterval {
+		return nil, nil
+	}
+
+	sess, err := awsutil.GetAwsSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting session: %s", err)
+	}
+
+	resourceID, err := findRdsResourceID(cfg, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), performanceInsightsTimeout)
+	defer cancel()
+
+	client := awsutil.NewPerformanceInsightsClient(sess)
+
+	metrics, err := client.GetResourceMetricsWithContext(ctx, &awsutil.PerformanceInsightsGetResourceMetricsInput{
+		ServiceType:     aws.String("RDS"),
+		Identifier:      aws.String(resourceID),
+		MetricQueries:   []*awsutil.PerformanceInsightsMetricQuery{{Metric: aws.String("db.load.avg")}},
+		StartTime:       aws.Time(startTime),
+		EndTime:         aws.Time(endTime),
+		PeriodInSeconds: aws.Int64(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting Performance Insights metrics: %s", err)
+	}
+
+	keys, err := client.DescribeDimensionKeysWithContext(ctx, &awsutil.PerformanceInsightsDescribeDimensionKeysInput{
+		ServiceType: aws.String("RDS"),
+		Identifier:  aws.String(resourceID),
+		Metric:      aws.String("db.load.avg"),
+		GroupBy: &awsutil.PerformanceInsightsDimensionGroup{
+			Group: aws.String("db.wait_event"),
+			Limit: aws.Int64(performanceInsightsWaitEventLimit),
+		},
+		StartTime: aws.Time(startTime),
+		EndTime:   aws.Time(endTime),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting Performance Insights wait events: %s", err)
+	}
+
+	pi := state.AmazonRdsPerformanceInsights{StartTime: startTime, EndTime: endTime}
+
+	for _, metric := range metrics.MetricList {
+		for _, dataPoint := range metric.DataPoints {
+			// Seconds without any active sessions have no value
+			if dataPoint.Timestamp == nil || dataPoint.Value == nil {
+				continue
+			}
+			pi.LoadSamples = append(pi.LoadSamples, state.AmazonRdsLoadSample{Time: *dataPoint.Timestamp, LoadAvg: *dataPoint.Value})
+		}
+	}
+	sort.Slice(pi.LoadSamples, func(i, j int) bool {
+		return pi.LoadSamples[i].Time.Before(pi.LoadSamples[j].Time)
+	})
+
+	for _, key := range keys.Keys {
+		if key.Total == nil {
+			continue
+		}
+		pi.WaitEvents = append(pi.WaitEvents, state.AmazonRdsWaitEventLoad{
+			WaitEventType: util.StringPtrToString(key.Dimensions["db.wait_event.type"]),
+			WaitEvent:     util.StringPtrToString(key.Dimensions["db.wait_event.name"]),
+			LoadAvg:       *key.Total,
+		})
+	}
+
+	performanceInsightsMutex.Lock()
+	performanceInsightsFetchedUntil[cfg.AwsDbInstanceID] = endTime
+	performanceInsightsMutex.Unlock()
+
+	logger.PrintVerbose("Imported Performance Insights data for %s (%d load samples, %d wait events)", endTime.Sub(startTime), len(pi.LoadSamples), len(pi.WaitEvents))
+
+	return &pi, nil
+}
@@ -0,0 +1,331 @@
+package rds
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+// Firehose buffers log data for at least a minute before writing an object
+const s3PollInterval = 1 * time.Minute
+
+const s3RetryWait = 10 * time.Second
+
+// Maximum SQS long polling duration, which avoids paying for empty receives
+const sqsWaitTimeSeconds = 20
+
+// Messages become visible again if we don't delete them in time (e.g. because of a crash),
+// so this needs to be longer than it takes to process the objects of one message
+const sqsVisibilityTimeoutSeconds = 300
+
+// s3LogMarker - Remembers the last processed object in the log state of all
+// servers that share the bucket and prefix, so it gets persisted in the state file
+type s3LogMarker struct {
+	servers  []*state.Server
+	location string
+}
+
+func (m s3LogMarker) get() (string, bool) {
+	for _, server := range m.servers {
+		server.LogStateMutex.Lock()
+		key, ok := server.LogPrevState.S3LogMarkers[m.location]
+		server.LogStateMutex.Unlock()
+		if ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func (m s3LogMarker) save(key string) {
+	for _, server := range m.servers {
+		// The state file writer may hold a reference to the current map, so replace it instead of modifying it
+		server.LogStateMutex.Lock()
+		markers := make(map[string]string)
+		for k, v := range server.LogPrevState.S3LogMarkers {
+			markers[k] = v
+		}
+		markers[m.location] = key
+		server.LogPrevState.S3LogMarkers = markers
+		server.LogStateMutex.Unlock()
+	}
+}
+
+// decodeS3LogObject - Passes on the log lines of an object that Firehose wrote for a CloudWatch Logs subscription
+//
+// Firehose writes the (already gzip-compressed) subscription records as they are, so an object
+// consists of multiple gzip members, each holding one JSON payload. When the delivery stream
+// is configured to compress data as well, there is an additional layer of gzip around that.
+func decodeS3LogObject(body io.Reader, source string, logger *util.Logger, out chan<- cloudWatchLogStreamItem) error {
+	gzipReader, err := gzip.NewReader(bufio.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Error decompressing S3 object: %s", err)
+	}
+	defer gzipReader.Close()
+
+	reader := bufio.NewReader(gzipReader)
+	if magic, err := reader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		innerReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return fmt.Errorf("Error decompressing S3 object: %s", err)
+		}
+		defer innerReader.Close()
+		reader = bufio.NewReader(innerReader)
+	}
+
+	decoder := json.NewDecoder(reader)
+	for {
+		var payload cloudWatchLogsSubscriptionData
+		err = decoder.Decode(&payload)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Error parsing CloudWatch Logs data: %s", err)
+		}
+		emitCloudWatchLogsPayload(&payload, source, logger, out)
+	}
+}
+
+type s3LogReader struct {
+	svc    *s3.S3
+	bucket string
+	prefix string
+	marker s3LogMarker
+	logger *util.Logger
+	out    chan<- cloudWatchLogStreamItem
+
+	// Objects written before this time are skipped when there is no marker yet
+	initialTime time.Time
+}
+
+// processObject - Downloads and processes one object, only returning errors that are worth retrying
+func (r s3LogReader) processObject(ctx context.Context, key string) error {
+	resp, err := r.svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("Error downloading S3 object %s: %s", key, err)
+	}
+	defer resp.Body.Close()
+
+	// Objects that can't be decoded would fail again, so they are skipped
+	err = decodeS3LogObject(resp.Body, s3LogSource(r.bucket, r.prefix), r.logger, r.out)
+	if err != nil {
+		r.logger.PrintWarning("Skipping S3 object %s: %s", key, err)
+	}
+
+	return nil
+}
+
+// poll - Processes all objects that were written since the last processed object
+func (r s3LogReader) poll(ctx context.Context) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucket),
+		Prefix: aws.String(r.prefix),
+	}
+	lastKey, hasMarker := r.marker.get()
+	if hasMarker {
+		input.StartAfter = aws.String(lastKey)
+	}
+
+	var processErr error
+	err := r.svc.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if object.Key == nil {
+				continue
+			}
+			if hasMarker || object.LastModified == nil || !object.LastModified.Before(r.initialTime) {
+				processErr = r.processObject(ctx, *object.Key)
+				if processErr != nil {
+					return false
+				}
+			}
+			r.marker.save(*object.Key)
+		}
+		return true
+	})
+	if processErr != nil {
+		return processErr
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing S3 objects: %s", err)
+	}
+
+	return nil
+}
+
+func (r s3LogReader) runPolling(ctx context.Context) {
+	for {
+		err := r.poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.logger.PrintWarning("%s", err)
+		}
+		if !sleepWithContext(ctx, s3PollInterval) {
+			return
+		}
+	}
+}
+
+// s3EventNotification - S3 event notification, as delivered to SQS
+type s3EventNotification struct {
+	Event   string `json:"Event"` // Only set for the test event sent when notifications are configured
+	Records []struct {
+		EventName string `json:"eventName"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key string `json:"key"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// processNotification - Processes the objects referenced in the message, returning whether it can be deleted
+func (r s3LogReader) processNotification(ctx context.Context, message *awsutil.SQSMessage) bool {
+	var notification s3EventNotification
+	err := json.Unmarshal([]byte(util.StringPtrToString(message.Body)), &notification)
+	if err != nil {
+		r.logger.PrintWarning("Ignoring SQS message %s, could not parse S3 event notification: %s", util.StringPtrToString(message.MessageId), err)
+		return true
+	}
+
+	for _, record := range notification.Records {
+		if !strings.HasPrefix(record.EventName, "ObjectCreated:") || record.S3.Bucket.Name != r.bucket {
+			continue
+		}
+		// Object keys are URL-encoded in event notifications
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil || !strings.HasPrefix(key, r.prefix) {
+			continue
+		}
+
+		err = r.processObject(ctx, key)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.PrintWarning("%s", err)
+			}
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r s3LogReader) runNotifications(ctx context.Context, sqsClient *awsutil.SQSClient, queueURL string) {
+	for {
+		resp, err := sqsClient.ReceiveMessageWithContext(ctx, &awsutil.SQSReceiveMessageInput{
+			QueueUrl:            aws.String(queueURL),
+			MaxNumberOfMessages: aws.Int64(10),
+			VisibilityTimeout:   aws.Int64(sqsVisibilityTimeoutSeconds),
+			WaitTimeSeconds:     aws.Int64(sqsWaitTimeSeconds),
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.logger.PrintWarning("Error receiving S3 event notifications from SQS: %s", err)
+			if !sleepWithContext(ctx, s3RetryWait) {
+				return
+			}
+			continue
+		}
+
+		for _, message := range resp.Messages {
+			if !r.processNotification(ctx, message) {
+				// Leave the message in the queue, so it gets delivered again after the visibility timeout
+				continue
+			}
+
+			_, err = sqsClient.DeleteMessageWithContext(ctx, &awsutil.SQSDeleteMessageInput{
+				QueueUrl:      aws.String(queueURL),
+				ReceiptHandle: message.ReceiptHandle,
+			})
+			if err != nil && ctx.Err() == nil {
+				r.logger.PrintWarning("Error deleting SQS message %s: %s", util.StringPtrToString(message.MessageId), err)
+			}
+		}
+	}
+}
+
+func setupS3LogReader(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, out chan<- cloudWatchLogStreamItem) error {
+	cfg := servers[0].Config
+
+	sess, err := awsutil.GetAwsSession(cfg)
+	if err != nil {
+		return fmt.Errorf("Error getting session: %s", err)
+	}
+	svc := s3.New(sess)
+
+	// Verify we can access the bucket before starting to read from it
+	_, err = svc.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:  aws.String(cfg.AwsS3LogBucket),
+		Prefix:  aws.String(cfg.AwsS3LogPrefix),
+		MaxKeys: aws.Int64(1),
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing S3 objects: %s", err)
+	}
+
+	// Test runs should neither replay old log data, nor modify the markers (or consume the
+	// notifications) of the background collector
+	if globalCollectionOpts.TestRun {
+		return nil
+	}
+
+	// Without a marker, start within the replay window before startup (instead of
+	// reading everything the bucket holds)
+	var replayWindow time.Duration
+	for _, server := range servers {
+		if server.Config.GetLogReplayWindow() > replayWindow {
+			replayWindow = server.Config.GetLogReplayWindow()
+		}
+	}
+
+	reader := s3LogReader{
+		svc:         svc,
+		bucket:      cfg.AwsS3LogBucket,
+		prefix:      cfg.AwsS3LogPrefix,
+		marker:      s3LogMarker{servers: servers, location: s3LogSource(cfg.AwsS3LogBucket, cfg.AwsS3LogPrefix)},
+		logger:      logger,
+		out:         out,
+		initialTime: time.Now().Add(-replayWindow),
+	}
+
+	wg.Add(1)
+	if cfg.AwsS3LogSqsQueueURL != "" {
+		logger.PrintVerbose("Reading logs from S3 bucket %s through event notifications from %s", cfg.AwsS3LogBucket, cfg.AwsS3LogSqsQueueURL)
+		sqsClient := awsutil.NewSQSClient(sess)
+		go func() {
+			defer wg.Done()
+			reader.runNotifications(ctx, sqsClient, cfg.AwsS3LogSqsQueueURL)
+		}()
+	} else {
+		logger.PrintVerbose("Reading logs from S3 bucket %s (polling every %s)", cfg.AwsS3LogBucket, s3PollInterval)
+		go func() {
+			defer wg.Done()
+			reader.runPolling(ctx)
+		}()
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package rds
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+)
+
+func s3TestObject(t *testing.T) []byte {
+	// Firehose concatenates the gzip-compressed subscription records as they are
+	var object []byte
+	object = append(object, cloudWatchLogsRecord(t, "CONTROL_MESSAGE", "CWL CONTROL MESSAGE: Checking health of destination Firehose.")...)
+	object = append(object, cloudWatchLogsRecord(t, "DATA_MESSAGE", "2021-01-01 00:00:00 UTC::@:[123]:LOG:  checkpoint starting: time")...)
+	object = append(object, cloudWatchLogsRecord(t, "DATA_MESSAGE", "2021-01-01 00:00:01 UTC::@:[123]:LOG:  checkpoint complete\n")...)
+	return object
+}
+
+func TestDecodeS3LogObject(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+	object := s3TestObject(t)
+
+	tests := []struct {
+		name          string
+		body          []byte
+		expectErr     bool
+		expectedLines []string
+	}{
+		{"concatenated records", object, false, []string{
+			"2021-01-01 00:00:00 UTC::@:[123]:LOG:  checkpoint starting: time",
+			"2021-01-01 00:00:01 UTC::@:[123]:LOG:  checkpoint complete",
+		}},
+		{"compressed by the delivery stream", gzipData(t, object), false, []string{
+			"2021-01-01 00:00:00 UTC::@:[123]:LOG:  checkpoint starting: time",
+			"2021-01-01 00:00:01 UTC::@:[123]:LOG:  checkpoint complete",
+		}},
+		{"not compressed", []byte(`{"messageType":"DATA_MESSAGE"}`), true, nil},
+		{"invalid JSON", gzipData(t, []byte("{")), true, nil},
+	}
+
+	for _, test := range tests {
+		out := make(chan cloudWatchLogStreamItem, 10)
+		err := decodeS3LogObject(bytes.NewReader(test.body), "s3:bucket/logs/", logger, out)
+		close(out)
+		if test.expectErr && err == nil {
+			t.Errorf("%s: expected error, got none", test.name)
+		} else if !test.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+		}
+
+		var lines []string
+		for item := range out {
+			if item.DbInstanceID != "mydb" || item.Source != "s3:bucket/logs/" {
+				t.Errorf("%s: unexpected log stream item %+v", test.name, item)
+			}
+			lines = append(lines, item.Content)
+		}
+		if strings.Join(lines, "\n") != strings.Join(test.expectedLines, "\n") {
+			t.Errorf("%s: expected lines %q, got %q", test.name, test.expectedLines, lines)
+		}
+	}
+}
+
+func TestS3LogMarker(t *testing.T) {
+	servers := []*state.Server{
+		{LogStateMutex: &sync.Mutex{}},
+		{LogStateMutex: &sync.Mutex{}, LogPrevState: state.PersistedLogState{S3LogMarkers: map[string]string{"s3:other/": "b"}}},
+	}
+	marker := s3LogMarker{servers: servers, location: "s3:bucket/logs/"}
+
+	if _, ok := marker.get(); ok {
+		t.Errorf("expected no marker for a different bucket")
+	}
+
+	previous := servers[1].LogPrevState.S3LogMarkers
+	marker.save("logs/2021/01/01/00/a")
+	marker.save("logs/2021/01/01/00/b")
+
+	for i, server := range servers {
+		if key := server.LogPrevState.S3LogMarkers["s3:bucket/logs/"]; key != "logs/2021/01/01/00/b" {
+			t.Errorf("server %d: expected marker logs/2021/01/01/00/b, got %q", i, key)
+		}
+	}
+	if key := servers[1].LogPrevState.S3LogMarkers["s3:other/"]; key != "b" {
+		t.Errorf("expected marker of other bucket to be kept, got %q", key)
+	}
+	if _, ok := previous["s3:bucket/logs/"]; ok {
+		t.Errorf("expected marker map to be replaced instead of modified")
+	}
+	if key, ok := (s3LogMarker{servers: servers[1:], location: "s3:bucket/logs/"}).get(); !ok || key != "logs/2021/01/01/00/b" {
+		t.Errorf("expected marker logs/2021/01/01/00/b from second server, got %q (%t)", key, ok)
+	}
+}
+
+// fakeS3 - Serves the list and get requests of the S3 API for a single bucket
+type fakeS3 struct {
+	objects map[string][]byte
+	keys    []string // Sorted, like S3 lists them
+	updated map[string]time.Time
+
+	startAfter []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/bucket" || r.URL.Path == "/bucket/" {
+		startAfter := r.URL.Query().Get("start-after")
+		f.startAfter = append(f.startAfter, startAfter)
+		var contents string
+		for _, key := range f.keys {
+			if strings.HasPrefix(key, r.URL.Query().Get("prefix")) && key > startAfter {
+				contents += fmt.Sprintf("<Contents><Key>%s</Key><LastModified>%s</LastModified></Contents>", key, f.updated[key].Format(time.RFC3339))
+			}
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>bucket</Name><IsTruncated>false</IsTruncated>%s</ListBucketResult>`, contents)
+		return
+	}
+	object, ok := f.objects[strings.TrimPrefix(r.URL.Path, "/bucket/")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+		return
+	}
+	w.Write(object)
+}
+
+func TestS3LogReaderPoll(t *testing.T) {
+	logger := &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+	initialTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	fake := &fakeS3{
+		objects: map[string][]byte{
+			"logs/2020/12/31/23/old": cloudWatchLogsRecord(t, "DATA_MESSAGE", "old"),
+			"logs/2021/01/01/00/a":   cloudWatchLogsRecord(t, "DATA_MESSAGE", "a"),
+			"logs/2021/01/01/00/b":   []byte("not gzip"),
+			"other/2021/01/01/00/c":  cloudWatchLogsRecord(t, "DATA_MESSAGE", "c"),
+		},
+		keys: []string{"logs/2020/12/31/23/old", "logs/2021/01/01/00/a", "logs/2021/01/01/00/b", "other/2021/01/01/00/c"},
+		updated: map[string]time.Time{
+			"logs/2020/12/31/23/old": initialTime.Add(-time.Hour),
+			"logs/2021/01/01/00/a":   initialTime.Add(time.Minute),
+			"logs/2021/01/01/00/b":   initialTime.Add(2 * time.Minute),
+			"other/2021/01/01/00/c":  initialTime.Add(2 * time.Minute),
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	}))
+
+	servers := []*state.Server{{LogStateMutex: &sync.Mutex{}}}
+	out := make(chan cloudWatchLogStreamItem, 10)
+	reader := s3LogReader{
+		svc:         s3.New(sess),
+		bucket:      "bucket",
+		prefix:      "logs/",
+		marker:      s3LogMarker{servers: servers, location: s3LogSource("bucket", "logs/")},
+		logger:      logger,
+		out:         out,
+		initialTime: initialTime,
+	}
+
+	// Without a marker, objects from before the replay window are skipped, and
+	// objects that can't be decoded don't hold up the ones after them
+	if err := reader.poll(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if key := servers[0].LogPrevState.S3LogMarkers["s3:bucket/logs/"]; key != "logs/2021/01/01/00/b" {
+		t.Errorf("expected marker after last object, got %q", key)
+	}
+
+	// With a marker, listing resumes after it, and objects are processed regardless of their age
+	fake.keys = []string{"logs/2020/12/31/23/old", "logs/2021/01/01/00/a", "logs/2021/01/01/00/b", "logs/2021/01/01/00/d", "other/2021/01/01/00/c"}
+	fake.objects["logs/2021/01/01/00/d"] = cloudWatchLogsRecord(t, "DATA_MESSAGE", "d")
+	fake.updated["logs/2021/01/01/00/d"] = initialTime.Add(-2 * time.Hour)
+	if err := reader.poll(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	close(out)
+
+	var lines []string
+	for item := range out {
+		lines = append(lines, item.Content)
+	}
+	if strings.Join(lines, ",") != "a,d" {
+		t.Errorf("expected lines of objects a and d, got %q", lines)
+	}
+	if strings.Join(fake.startAfter, ",") != ",logs/2021/01/01/00/b" {
+		t.Errorf("expected listing to start after the marker, got %q", fake.startAfter)
+	}
+	if key := servers[0].LogPrevState.S3LogMarkers["s3:bucket/logs/"]; key != "logs/2021/01/01/00/d" {
+		t.Errorf("expected marker logs/2021/01/01/00/d, got %q", key)
+	}
+}
+
+func TestS3LogReaderProcessNotification(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{
+		"logs/2021/01/01/00/a b": cloudWatchLogsRecord(t, "DATA_MESSAGE", "a"),
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	}))
+	out := make(chan cloudWatchLogStreamItem, 10)
+	reader := s3LogReader{
+		svc:    s3.New(sess),
+		bucket: "bucket",
+		prefix: "logs/",
+		logger: &util.Logger{Destination: log.New(ioutil.Discard, "", 0)},
+		out:    out,
+	}
+
+	tests := []struct {
+		body         string
+		expectDelete bool
+	}{
+		{`{"Event":"s3:TestEvent"}`, true},
+		{`not json`, true},
+		{`{"Records":[{"eventName":"ObjectRemoved:Delete","s3":{"bucket":{"name":"bucket"},"object":{"key":"logs/2021/01/01/00/missing"}}}]}`, true},
+		{`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"other"},"object":{"key":"logs/2021/01/01/00/missing"}}}]}`, true},
+		{`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"bucket"},"object":{"key":"other/2021/01/01/00/missing"}}}]}`, true},
+		{`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"bucket"},"object":{"key":"logs/2021/01/01/00/a+b"}}}]}`, true},
+		{`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"bucket"},"object":{"key":"logs/2021/01/01/00/missing"}}}]}`, false},
+	}
+	for _, test := range tests {
+		if deleted := reader.processNotification(context.Background(), &awsutil.SQSMessage{Body: aws.String(test.body), MessageId: aws.String("1")}); deleted != test.expectDelete {
+			t.Errorf("%s: expected delete %t, got %t", test.body, test.expectDelete, deleted)
+		}
+	}
+	close(out)
+
+	var lines []string
+	for item := range out {
+		lines = append(lines, item.Content)
+	}
+	if strings.Join(lines, ",") != "a" {
+		t.Errorf("expected line of the created object, got %q", lines)
+	}
+}
@@ -1,12 +1,8 @@
 package rds
 
 import (
-	"encoding/json"
-	"fmt"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/state"
@@ -18,6 +14,9 @@ import (
 // report that limit as the total disk space (to avoid bogus disk space warnings)
 const AuroraMaxStorage = 64 * 1024 * 1024 * 1024 * 1024
 
+// Aurora Serverless v2 instances use a special instance class, and scale in place
+const auroraServerlessInstanceClass = "db.serverless"
+
 // GetSystemState - Gets system information about an Amazon RDS instance
 func GetSystemState(config config.ServerConfig, logger *util.Logger) (system state.SystemState) {
 	system.Info.Type = state.AmazonRdsSystem
@@ -42,6 +41,7 @@ func GetSystemState(config config.ServerConfig, logger *util.Logger) (system sta
 	}
 
 	isAurora := util.StringPtrToString(instance.Engine) == "aurora-postgresql"
+	isServerless := isAurora && util.StringPtrToString(instance.DBInstanceClass) == auroraServerlessInstanceClass
 
 	system.Info.AmazonRds = &state.SystemInfoAmazonRds{
 		Region:                     config.AwsRegion,
@@ -65,6 +65,7 @@ func GetSystemState(config config.ServerConfig, logger *util.Logger) (system sta
 		IAMAuthentication:          util.BoolPtrToBool(instance.IAMDatabaseAuthenticationEnabled),
 		DeletionProtection:         util.BoolPtrToBool(instance.DeletionProtection),
 		IsAuroraPostgres:           isAurora,
+		IsAuroraServerless:         isServerless,
 	}
 
 	for _, exportName := range instance.EnabledCloudwatchLogsExports {
@@ -89,7 +90,7 @@ func GetSystemState(config config.ServerConfig, logger *util.Logger) (system sta
 	system.Info.AmazonRds.ParameterApplyStatus = *group.ParameterApplyStatus
 
 	dbInstanceID := *instance.DBInstanceIdentifier
-	cloudWatchReader := awsutil.NewRdsCloudWatchReader(sess, logger, dbInstanceID)
+	cloudWatchReader := awsutil.NewRdsCloudWatchReader(sess, config, logger, dbInstanceID)
 
 	system.Disks = make(state.DiskMap)
 	system.Disks["default"] = state.Disk{
@@ -114,112 +115,34 @@ func GetSystemState(config config.ServerConfig, logger *util.Logger) (system sta
 
 	system.XlogUsedBytes = uint64(cloudWatchReader.GetRdsIntMetric("TransactionLogsDiskUsage", "Bytes"))
 
-	if instance.EnhancedMonitoringResourceArn != nil {
-		system.Info.AmazonRds.EnhancedMonitoring = true
-
-		svc := cloudwatchlogs.New(sess)
+	if isServerless {
+		system.ServerlessCapacity = &state.AuroraServerlessCapacity{
+			CapacityUnits:      cloudWatchReader.GetRdsFloatMetric("ServerlessDatabaseCapacity", "Count"),
+			UtilizationPercent: cloudWatchReader.GetRdsFloatMetric("ACUUtilization", "Percent"),
+		}
+	}
 
-		params := &cloudwatchlogs.GetLogEventsInput{
-			LogGroupName:  aws.String("RDSOSMetrics"),
-			LogStreamName: instance.DbiResourceId,
-			Limit:         aws.Int64(1),
+	if config.AwsDbProxyName != "" {
+		// QueryRequests is a count for each period, the connection metrics are point-in-time values
+		queryRequests := cloudWatchReader.GetProxyFloatMetric(config.AwsDbProxyName, "QueryRequests", "Count", "Sum")
+		system.ProxyStats = &state.RdsProxyStats{
+			ProxyName:              config.AwsDbProxyName,
+			ClientConnections:      int64(cloudWatchReader.GetProxyFloatMetric(config.AwsDbProxyName, "ClientConnections", "Count", "Average")),
+			DatabaseConnections:    int64(cloudWatchReader.GetProxyFloatMetric(config.AwsDbProxyName, "DatabaseConnections", "Count", "Average")),
+			QueryRequestsPerSecond: queryRequests / cloudWatchReader.Period().Seconds(),
 		}
+	}
 
-		resp, err := svc.GetLogEvents(params)
+	if instance.EnhancedMonitoringResourceArn != nil {
+		system.Info.AmazonRds.EnhancedMonitoring = true
+
+		osSnapshot, err := getEnhancedMonitoringSnapshot(sess, util.StringPtrToString(instance.DbiResourceId))
 		if err != nil {
-			fmt.Printf("Error: %v\n", err)
+			logger.PrintWarning("Rds/System: Could not get Enhanced Monitoring metrics: %s", err)
 			return
 		}
-
-		if len(resp.Events) > 0 {
-			event := resp.Events[0]
-			str := event.Message
-			if str != nil {
-				var osSnapshot RdsOsSnapshot
-				err = json.Unmarshal([]byte(*str), &osSnapshot)
-				if err != nil {
-					fmt.Printf("Error: %v\n", err)
-					return
-				}
-
-				system.CPUStats = make(state.CPUStatisticMap)
-				system.CPUStats["all"] = state.CPUStatistic{
-					DiffedOnInput: true,
-					DiffedValues: &state.DiffedSystemCPUStats{
-						GuestPercent:  float64(osSnapshot.CPUUtilization.Guest),
-						IdlePercent:   float64(osSnapshot.CPUUtilization.Idle),
-						IrqPercent:    float64(osSnapshot.CPUUtilization.Irq),
-						IowaitPercent: float64(osSnapshot.CPUUtilization.Wait),
-						SystemPercent: float64(osSnapshot.CPUUtilization.System),
-						UserPercent:   float64(osSnapshot.CPUUtilization.User),
-						StealPercent:  float64(osSnapshot.CPUUtilization.Steal),
-						NicePercent:   float64(osSnapshot.CPUUtilization.Nice),
-					},
-				}
-
-				system.CPUInfo.SocketCount = 1
-				system.CPUInfo.LogicalCoreCount = int32(osSnapshot.NumVCPUs)
-				system.CPUInfo.PhysicalCoreCount = int32(osSnapshot.NumVCPUs)
-
-				system.Scheduler.Loadavg1min = float64(osSnapshot.LoadAverageMinute.One)
-				system.Scheduler.Loadavg5min = float64(osSnapshot.LoadAverageMinute.Five)
-				system.Scheduler.Loadavg15min = float64(osSnapshot.LoadAverageMinute.Fifteen)
-
-				system.Memory.ActiveBytes = uint64(osSnapshot.Memory.Active * 1024)
-				system.Memory.BuffersBytes = uint64(osSnapshot.Memory.Buffers * 1024)
-				system.Memory.CachedBytes = uint64(osSnapshot.Memory.Cached * 1024)
-				system.Memory.DirtyBytes = uint64(osSnapshot.Memory.Dirty * 1024)
-				system.Memory.FreeBytes = uint64(osSnapshot.Memory.Free * 1024)
-				system.Memory.HugePagesFree = uint64(osSnapshot.Memory.HugePagesFree)
-				system.Memory.HugePagesReserved = uint64(osSnapshot.Memory.HugePagesRsvd)
-				system.Memory.HugePagesSizeBytes = uint64(osSnapshot.Memory.HugePagesSize * 1024)
-				system.Memory.HugePagesSurplus = uint64(osSnapshot.Memory.HugePagesSurp)
-				system.Memory.HugePagesTotal = uint64(osSnapshot.Memory.HugePagesTotal)
-				system.Memory.InactiveBytes = uint64(osSnapshot.Memory.Inactive * 1024)
-				system.Memory.MappedBytes = uint64(osSnapshot.Memory.Mapped * 1024)
-				system.Memory.PageTablesBytes = uint64(osSnapshot.Memory.PageTables * 1024)
-				system.Memory.SlabBytes = uint64(osSnapshot.Memory.Slab * 1024)
-				system.Memory.SwapTotalBytes = uint64(osSnapshot.Swap.Total) * 1024
-				system.Memory.SwapUsedBytes = uint64(osSnapshot.Swap.Total-osSnapshot.Swap.Free) * 1024
-				system.Memory.TotalBytes = uint64(osSnapshot.Memory.Total * 1024)
-				system.Memory.WritebackBytes = uint64(osSnapshot.Memory.Writeback * 1024)
-
-				system.NetworkStats = make(state.NetworkStatsMap)
-				for _, networkIf := range osSnapshot.Network {
-					// Practically this always has one entry, and oddly enough we don't have
-					// the throughput numbers on a per interface basis...
-					system.NetworkStats[networkIf.Interface] = state.NetworkStats{
-						DiffedOnInput: true,
-						DiffedValues: &state.DiffedNetworkStats{
-							ReceiveThroughputBytesPerSecond:  uint64(cloudWatchReader.GetRdsIntMetric("NetworkReceiveThroughput", "Bytes/Second")),
-							TransmitThroughputBytesPerSecond: uint64(cloudWatchReader.GetRdsIntMetric("NetworkTransmitThroughput", "Bytes/Second")),
-						},
-					}
-				}
-
-				for _, disk := range osSnapshot.DiskIO {
-					// "The rdsdev device relates to the /rdsdbdata file system, where all database files and logs are stored"
-					// Source: https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Monitoring.OS.html
-					if disk.Device == "rdsdev" {
-						system.DiskStats["default"].DiffedValues.UtilizationPercent = float64(disk.Util)
-					}
-				}
-
-				system.DataDirectoryPartition = "/rdsdbdata"
-				system.DiskPartitions = make(state.DiskPartitionMap)
-				for _, diskPartition := range osSnapshot.FileSystems {
-					totalBytes := uint64(diskPartition.Total * 1024)
-					if isAurora {
-						totalBytes = AuroraMaxStorage
-					}
-					system.DiskPartitions[diskPartition.MountPoint] = state.DiskPartition{
-						DiskName:      "default",
-						PartitionName: diskPartition.Name,
-						UsedBytes:     uint64(diskPartition.Used * 1024),
-						TotalBytes:    totalBytes,
-					}
-				}
-			}
+		if osSnapshot != nil {
+			applyEnhancedMonitoring(&system, *osSnapshot, isAurora, cloudWatchReader)
 		}
 	} else {
 		system.CPUStats = make(state.CPUStatisticMap)
@@ -242,6 +165,13 @@ func GetSystemState(config config.ServerConfig, logger *util.Logger) (system sta
 		system.Memory.FreeBytes = uint64(cloudWatchReader.GetRdsIntMetric("FreeableMemory", "Bytes"))
 		system.Memory.SwapUsedBytes = uint64(cloudWatchReader.GetRdsIntMetric("SwapUsage", "Bytes"))
 
+		// Aurora reports the lag of its readers in milliseconds, regular read replicas in seconds
+		if isAurora {
+			system.ReplicaLagSeconds = cloudWatchReader.GetRdsFloatMetric("AuroraReplicaLag", "Milliseconds") / 1000
+		} else if instance.ReadReplicaSourceDBInstanceIdentifier != nil {
+			system.ReplicaLagSeconds = cloudWatchReader.GetRdsFloatMetric("ReplicaLag", "Seconds")
+		}
+
 		var bytesTotal, bytesFree int64
 		if instance.AllocatedStorage != nil {
 			bytesTotal = *instance.AllocatedStorage * 1024 * 1024 * 1024
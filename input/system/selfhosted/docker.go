@@ -0,0 +1,330 @@
+package selfhosted
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+const defaultDockerHost = "unix:///var/run/docker.sock"
+
+// How often we check for containers that were started (or restarted) since the last check
+const dockerContainerCheckInterval = 10 * time.Second
+
+const dockerRequestTimeout = 10 * time.Second
+
+// dockerClient - Minimal client for the parts of the Docker Engine API needed to follow
+// container logs, talking to the daemon of DOCKER_HOST (unix:// or tcp://)
+type dockerClient struct {
+	client  *http.Client
+	baseURL string
+}
+
+func newDockerClient() (*dockerClient, error) {
+	dockerHost := os.Getenv("DOCKER_HOST")
+	if dockerHost == "" {
+		dockerHost = defaultDockerHost
+	}
+	u, err := url.Parse(dockerHost)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid DOCKER_HOST: %s", err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		socketPath := u.Path
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		}
+		return &dockerClient{client: &http.Client{Transport: transport}, baseURL: "http://docker"}, nil
+	case "tcp", "http":
+		return &dockerClient{client: &http.Client{}, baseURL: "http://" + u.Host}, nil
+	default:
+		return nil, fmt.Errorf("Unsupported DOCKER_HOST scheme \"%s\" (supported: unix, tcp)", u.Scheme)
+	}
+}
+
+func (c *dockerClient) get(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error accessing Docker API: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("Unexpected status code %d from Docker API %s: %s", resp.StatusCode, path, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
+
+func (c *dockerClient) getJSON(ctx context.Context, path string, query url.Values, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, dockerRequestTimeout)
+	defer cancel()
+	resp, err := c.get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// runningContainers - Returns the IDs of the running containers with the given name/ID, or label
+func (c *dockerClient) runningContainers(ctx context.Context, container string, label string) ([]string, error) {
+	if label != "" {
+		filters, _ := json.Marshal(map[string][]string{"label": {label}, "status": {"running"}})
+		var containers []struct {
+			ID string `json:"Id"`
+		}
+		err := c.getJSON(ctx, "/containers/json", url.Values{"filters": {string(filters)}}, &containers)
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, entry := range containers {
+			ids = append(ids, entry.ID)
+		}
+		return ids, nil
+	}
+
+	var inspect struct {
+		ID    string `json:"Id"`
+		State struct {
+			Running bool
+		}
+	}
+	err := c.getJSON(ctx, "/containers/"+url.PathEscape(container)+"/json", nil, &inspect)
+	if err != nil {
+		return nil, err
+	}
+	if !inspect.State.Running {
+		return nil, nil
+	}
+	return []string{inspect.ID}, nil
+}
+
+// containerUsesTty - Whether the container output is sent as-is, instead of in stdout/stderr frames
+func (c *dockerClient) containerUsesTty(ctx context.Context, id string) (bool, error) {
+	var inspect struct {
+		Config struct {
+			Tty bool
+		}
+	}
+	err := c.getJSON(ctx, "/containers/"+url.PathEscape(id)+"/json", nil, &inspect)
+	return inspect.Config.Tty, err
+}
+
+func dockerTailDescription(config config.ServerConfig) string {
+	if config.LogDockerLabel != "" {
+		return "containers with label " + config.LogDockerLabel
+	}
+	return config.LogDockerTail
+}
+
+// setupDockerTail - Follows the log output of the container (or all containers with the label),
+// picking up containers again when they get restarted or recreated
+func setupDockerTail(ctx context.Context, container string, label string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	client, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.runningContainers(ctx, container, label)
+	if err != nil {
+		return fmt.Errorf("Error starting docker log tail: %s", err)
+	}
+
+	go func() {
+		var mutex sync.Mutex
+		following := make(map[string]bool)
+		// Restarted containers continue from the last line received, to avoid duplicates
+		lastReceived := make(map[string]time.Time)
+		startedAt := time.Now()
+
+		ticker := time.NewTicker(dockerContainerCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			ids, err := client.runningContainers(ctx, container, label)
+			if err != nil {
+				prefixedLogger.PrintVerbose("Could not check for docker containers to tail: %s", err)
+			}
+			for _, id := range ids {
+				mutex.Lock()
+				if following[id] {
+					mutex.Unlock()
+					continue
+				}
+				following[id] = true
+				since, ok := lastReceived[id]
+				if !ok {
+					since = startedAt
+				}
+				mutex.Unlock()
+
+				prefixedLogger.PrintVerbose("Following docker logs of container %s", shortDockerID(id))
+				go func(id string, since time.Time) {
+					last, err := client.followLogs(ctx, id, since, out)
+					if err != nil && ctx.Err() == nil {
+						prefixedLogger.PrintVerbose("Docker log tail for container %s stopped: %s", shortDockerID(id), err)
+					}
+					mutex.Lock()
+					delete(following, id)
+					if !last.IsZero() {
+						lastReceived[id] = last
+					}
+					mutex.Unlock()
+				}(id, since)
+			}
+
+			select {
+			case <-ctx.Done():
+				prefixedLogger.PrintVerbose("Docker log tail received stop signal")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// followLogs - Streams the stderr output of the container (where Postgres logs to) until the
+// container stops or the context is cancelled, and returns the time of the last line received
+func (c *dockerClient) followLogs(ctx context.Context, id string, since time.Time, out chan<- SelfHostedLogStreamItem) (time.Time, error) {
+	var last time.Time
+
+	tty, err := c.containerUsesTty(ctx, id)
+	if err != nil {
+		return last, err
+	}
+
+	query := url.Values{
+		"follow":     {"1"},
+		"stderr":     {"1"},
+		"timestamps": {"1"},
+		"since":      {fmt.Sprintf("%d.%09d", since.Unix(), since.Nanosecond())},
+	}
+	// With a TTY, stdout and stderr are combined and can't be told apart
+	if tty {
+		query.Set("stdout", "1")
+	}
+	resp, err := c.get(ctx, "/containers/"+url.PathEscape(id)+"/logs", query)
+	if err != nil {
+		return last, err
+	}
+	defer resp.Body.Close()
+
+	var reader io.Reader = resp.Body
+	if !tty {
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		go func() {
+			pw.CloseWithError(demuxDockerStream(resp.Body, pw))
+		}()
+		reader = pr
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		item, occurredAt := dockerLogLineToItem(scanner.Text())
+		// Lines at exactly the "since" time were already received by a previous tail
+		if !occurredAt.IsZero() && !occurredAt.After(since) {
+			continue
+		}
+		if !occurredAt.IsZero() {
+			last = occurredAt
+		}
+		select {
+		case out <- item:
+		case <-ctx.Done():
+			return last, nil
+		}
+	}
+	return last, scanner.Err()
+}
+
+// demuxDockerStream - Unwraps the frames of a non-TTY log stream, which each have an 8 byte
+// header (stream type, 3 bytes padding, big endian payload size), writing out the payloads
+//
+// Long lines are split across multiple frames, so this doesn't assume frames end in a newline.
+func demuxDockerStream(in io.Reader, out io.Writer) error {
+	header := make([]byte, 8)
+	for {
+		_, err := io.ReadFull(in, header)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		size := int64(binary.BigEndian.Uint32(header[4:8]))
+		_, err = io.CopyN(out, in, size)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// dockerLogLineToItem - Removes the timestamp added by the Docker API (timestamps=1), as well
+// as json-file log driver framing ({"log":"...","stream":"stderr","time":"..."}) that lines
+// forwarded from another Docker host may still have
+func dockerLogLineToItem(line string) (SelfHostedLogStreamItem, time.Time) {
+	item := SelfHostedLogStreamItem{Line: line}
+
+	var occurredAt time.Time
+	if idx := strings.IndexByte(line, ' '); idx > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, line[:idx]); err == nil {
+			occurredAt = t
+			item.Line = line[idx+1:]
+		}
+	}
+
+	if strings.HasPrefix(item.Line, `{"log":`) {
+		var jsonFileLine struct {
+			Log  string    `json:"log"`
+			Time time.Time `json:"time"`
+		}
+		if json.Unmarshal([]byte(item.Line), &jsonFileLine) == nil {
+			item.Line = strings.TrimRight(jsonFileLine.Log, "\n")
+			if !jsonFileLine.Time.IsZero() {
+				occurredAt = jsonFileLine.Time
+			}
+		}
+	}
+
+	item.Line = strings.TrimRight(item.Line, "\r")
+	item.OccurredAt = occurredAt
+	return item, occurredAt
+}
+
+func shortDockerID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
@@ -0,0 +1,59 @@
+package selfhosted
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Postgres reports its events to the Application log, under the source set by event_source
+const eventLogChannel = "Application"
+
+// How often the Event Log is checked for new events
+const eventLogPollInterval = 1 * time.Second
+
+// eventLogEvent - The parts of the XML rendering of an event that are relevant to us
+//
+// Postgres reports each log message (including its log_line_prefix and any DETAIL, HINT,
+// etc lines) as the single insertion string of an event, from the backend that logged it.
+type eventLogEvent struct {
+	System struct {
+		EventRecordID uint64 `xml:"EventRecordID"`
+		TimeCreated   struct {
+			SystemTime string `xml:"SystemTime,attr"`
+		} `xml:"TimeCreated"`
+		Execution struct {
+			ProcessID int32 `xml:"ProcessID,attr"`
+		} `xml:"Execution"`
+	} `xml:"System"`
+	EventData struct {
+		Data []string `xml:"Data"`
+	} `xml:"EventData"`
+}
+
+// eventLogQuery - Returns the XPath query for the events of the source that come after
+// the given record ID
+func eventLogQuery(source string, afterRecordID uint64) (string, error) {
+	if source == "" || strings.ContainsAny(source, `'"<>&[]`) {
+		return "", fmt.Errorf("Invalid db_log_eventlog_source \"%s\"", source)
+	}
+	return fmt.Sprintf("*[System[Provider[@Name='%s'] and EventRecordID > %d]]", source, afterRecordID), nil
+}
+
+// eventLogXMLToItems - Converts the XML rendering of an event into the log lines it contains,
+// and returns its record ID
+func eventLogXMLToItems(data string) (uint64, []SelfHostedLogStreamItem, error) {
+	var event eventLogEvent
+	err := xml.Unmarshal([]byte(data), &event)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	occurredAt, _ := time.Parse(time.RFC3339Nano, event.System.TimeCreated.SystemTime)
+	items := logTextToItems(strings.Join(event.EventData.Data, "\n"), occurredAt)
+	for idx := range items {
+		items[idx].BackendPid = event.System.Execution.ProcessID
+	}
+	return event.System.EventRecordID, items, nil
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+// +build !windows
+
+package selfhosted
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pganalyze/collector/util"
+)
+
+func setupEventLogTail(ctx context.Context, source string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	return errors.New("The Windows Event Log input (db_log_eventlog_source) is only supported on Windows")
+}
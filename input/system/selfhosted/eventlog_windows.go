@@ -0,0 +1,174 @@
+//go:build windows
+// +build windows
+
+package selfhosted
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// Windows Event Log API (wevtapi.dll), which golang.org/x/sys/windows doesn't wrap
+var (
+	modwevtapi    = windows.NewLazySystemDLL("wevtapi.dll")
+	procEvtQuery  = modwevtapi.NewProc("EvtQuery")
+	procEvtNext   = modwevtapi.NewProc("EvtNext")
+	procEvtRender = modwevtapi.NewProc("EvtRender")
+	procEvtClose  = modwevtapi.NewProc("EvtClose")
+)
+
+const (
+	evtQueryChannelPath      = 0x1
+	evtQueryForwardDirection = 0x100
+	evtQueryReverseDirection = 0x200
+	evtRenderEventXml        = 1
+
+	// How long EvtNext waits for results, in milliseconds
+	evtNextTimeout = 1000
+
+	// Events retrieved with each EvtNext call
+	evtNextBatchSize = 50
+)
+
+func evtQuery(channel string, query string, flags uint32) (windows.Handle, error) {
+	channelp, err := windows.UTF16PtrFromString(channel)
+	if err != nil {
+		return 0, err
+	}
+	queryp, err := windows.UTF16PtrFromString(query)
+	if err != nil {
+		return 0, err
+	}
+	r, _, err := procEvtQuery.Call(0, uintptr(unsafe.Pointer(channelp)), uintptr(unsafe.Pointer(queryp)), uintptr(flags))
+	if r == 0 {
+		return 0, err
+	}
+	return windows.Handle(r), nil
+}
+
+// evtNext - Fills events with the next events of the result set, returning how many there
+// were (zero once all events were read)
+func evtNext(resultSet windows.Handle, events []windows.Handle) (int, error) {
+	var returned uint32
+	r, _, err := procEvtNext.Call(uintptr(resultSet), uintptr(len(events)), uintptr(unsafe.Pointer(&events[0])), evtNextTimeout, 0, uintptr(unsafe.Pointer(&returned)))
+	if r == 0 {
+		if err == windows.ERROR_NO_MORE_ITEMS || err == windows.ERROR_TIMEOUT {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return int(returned), nil
+}
+
+func evtRenderXML(event windows.Handle) (string, error) {
+	buf := make([]uint16, 4096)
+	for {
+		var bufferUsed, propertyCount uint32
+		r, _, err := procEvtRender.Call(0, uintptr(event), evtRenderEventXml, uintptr(len(buf)*2), uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&bufferUsed)), uintptr(unsafe.Pointer(&propertyCount)))
+		if r != 0 {
+			return windows.UTF16ToString(buf[:bufferUsed/2]), nil
+		}
+		if err != windows.ERROR_INSUFFICIENT_BUFFER {
+			return "", err
+		}
+		buf = make([]uint16, bufferUsed/2+1)
+	}
+}
+
+func evtClose(handle windows.Handle) {
+	procEvtClose.Call(uintptr(handle))
+}
+
+// queryEventLog - Returns the XML renderings of the events of the source after the given
+// record ID, oldest first (or newest first when reverse is set), up to limit events
+func queryEventLog(source string, afterRecordID uint64, reverse bool, limit int) ([]string, error) {
+	query, err := eventLogQuery(source, afterRecordID)
+	if err != nil {
+		return nil, err
+	}
+	flags := uint32(evtQueryChannelPath | evtQueryForwardDirection)
+	if reverse {
+		flags = evtQueryChannelPath | evtQueryReverseDirection
+	}
+	resultSet, err := evtQuery(eventLogChannel, query, flags)
+	if err != nil {
+		return nil, err
+	}
+	defer evtClose(resultSet)
+
+	var result []string
+	events := make([]windows.Handle, evtNextBatchSize)
+	for limit <= 0 || len(result) < limit {
+		n, err := evtNext(resultSet, events)
+		if err != nil {
+			return result, err
+		}
+		if n == 0 {
+			break
+		}
+		for _, event := range events[:n] {
+			data, err := evtRenderXML(event)
+			evtClose(event)
+			if err == nil {
+				result = append(result, data)
+			}
+		}
+	}
+	return result, nil
+}
+
+// setupEventLogTail - Follows the events the given source (i.e. Postgres) reports to the
+// Windows Event Log, starting with events that are reported after this is called
+func setupEventLogTail(ctx context.Context, source string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	var lastRecordID uint64
+	latest, err := queryEventLog(source, 0, true, 1)
+	if err != nil {
+		return fmt.Errorf("Error reading Windows Event Log: %s", err)
+	}
+	if len(latest) > 0 {
+		lastRecordID, _, _ = eventLogXMLToItems(latest[0])
+	}
+	prefixedLogger.PrintVerbose("Reading Windows Event Log entries of source %s", source)
+
+	go func() {
+		ticker := time.NewTicker(eventLogPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				prefixedLogger.PrintVerbose("Windows Event Log tail received stop signal")
+				return
+			case <-ticker.C:
+			}
+
+			events, err := queryEventLog(source, lastRecordID, false, 0)
+			if err != nil {
+				prefixedLogger.PrintVerbose("Could not read Windows Event Log: %s", err)
+			}
+			for _, data := range events {
+				recordID, items, err := eventLogXMLToItems(data)
+				if err != nil {
+					prefixedLogger.PrintVerbose("Skipping unparseable Windows Event Log entry: %s", err)
+					continue
+				}
+				lastRecordID = recordID
+				for _, item := range items {
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
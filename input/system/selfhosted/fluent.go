@@ -0,0 +1,357 @@
+package selfhosted
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// Hostname we identify as in the shared key handshake - Fluentd refuses to forward to a
+// server that reports the same hostname as its own, so this intentionally isn't os.Hostname()
+const fluentServerHostname = "pganalyze-collector"
+
+// How long a client has to complete the shared key handshake
+const fluentHandshakeTimeout = 10 * time.Second
+
+// fluentTarget - A server receiving log records from a (possibly shared) Fluent forward receiver
+type fluentTarget struct {
+	config config.ServerConfig
+	out    chan<- SelfHostedLogStreamItem
+}
+
+// fluentTagMatches - Whether the tag matches the Fluentd-style pattern, where "*" matches a
+// single tag part (e.g. "postgres.*" matches "postgres.db1"), and "**" matches zero or more
+// tag parts (e.g. "postgres.**" also matches "postgres" and "postgres.db1.stderr")
+func fluentTagMatches(pattern string, tag string) bool {
+	return fluentTagPartsMatch(strings.Split(pattern, "."), strings.Split(tag, "."))
+}
+
+func fluentTagPartsMatch(pattern []string, tag []string) bool {
+	if len(pattern) == 0 {
+		return len(tag) == 0
+	}
+	if pattern[0] == "**" {
+		for skip := 0; skip <= len(tag); skip++ {
+			if fluentTagPartsMatch(pattern[1:], tag[skip:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(tag) == 0 {
+		return false
+	}
+	matched, err := path.Match(pattern[0], tag[0])
+	return err == nil && matched && fluentTagPartsMatch(pattern[1:], tag[1:])
+}
+
+// routeFluentRecord - Returns the index of the target the record belongs to, or -1 if none matches
+//
+// Targets with db_log_fluent_tag are matched by tag (in the order they are configured),
+// otherwise the record's hostname field (if any) is compared to the database host.
+func routeFluentRecord(targets []fluentTarget, tag string, record map[string]interface{}) int {
+	if len(targets) == 1 && targets[0].config.LogFluentTag == "" {
+		return 0
+	}
+	for idx, target := range targets {
+		if target.config.LogFluentTag != "" && fluentTagMatches(target.config.LogFluentTag, tag) {
+			return idx
+		}
+	}
+	var hostName string
+	for _, key := range []string{"host", "hostname", "_HOSTNAME"} {
+		if hostName = msgpackString(record[key]); hostName != "" {
+			break
+		}
+	}
+	if hostName == "" {
+		return -1
+	}
+	for idx, target := range targets {
+		dbHost := target.config.GetDbHost()
+		if target.config.LogFluentTag == "" && dbHost != "" && strings.EqualFold(dbHost, hostName) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// fluentRecordToItems - Returns the Postgres log lines in the record
+//
+// Records from tailing a log file (or container logs) have the line in "log", and records
+// from the systemd input have it in "MESSAGE". Records that were parsed from jsonlog output
+// (e.g. with Fluent Bit's json parser) are turned back into JSON.
+func fluentRecordToItems(record map[string]interface{}, occurredAt time.Time) []SelfHostedLogStreamItem {
+	if _, ok := record["error_severity"]; ok && record["message"] != nil {
+		jsonRecord := make(map[string]interface{}, len(record))
+		for key, value := range record {
+			if b, ok := value.([]byte); ok {
+				value = string(b)
+			}
+			jsonRecord[key] = value
+		}
+		encoded, err := json.Marshal(jsonRecord)
+		if err != nil {
+			return nil
+		}
+		return logTextToItems(string(encoded), occurredAt)
+	}
+	for _, key := range []string{"log", "message", "MESSAGE", "msg"} {
+		if value, ok := record[key]; ok {
+			return logTextToItems(msgpackString(value), occurredAt)
+		}
+	}
+	return nil
+}
+
+// fluentEventTime - Returns the time of an event, which is either an integer (seconds since
+// epoch), an EventTime, or (since Fluent Bit 2.1) an array of the time and event metadata
+func fluentEventTime(value interface{}) time.Time {
+	switch t := value.(type) {
+	case time.Time:
+		return t
+	case int64:
+		return time.Unix(t, 0)
+	case uint64:
+		return time.Unix(int64(t), 0)
+	case float64:
+		seconds, fraction := math.Modf(t)
+		return time.Unix(int64(seconds), int64(fraction*1e9))
+	case []interface{}:
+		if len(t) > 0 {
+			return fluentEventTime(t[0])
+		}
+	}
+	return time.Now()
+}
+
+// fluentReceiver - Passes on the records of decoded forward protocol messages to the matching targets
+type fluentReceiver struct {
+	ctx       context.Context
+	targets   []fluentTarget
+	sharedKey string
+	logger    *util.Logger
+}
+
+func (r *fluentReceiver) receiveEntry(tag string, eventTime interface{}, record interface{}) bool {
+	recordMap, ok := record.(map[string]interface{})
+	if !ok {
+		return true
+	}
+	target := routeFluentRecord(r.targets, tag, recordMap)
+	if target == -1 {
+		r.logger.PrintVerbose("Ignoring Fluent record with unknown tag %s", tag)
+		return true
+	}
+	for _, item := range fluentRecordToItems(recordMap, fluentEventTime(eventTime)) {
+		select {
+		case r.targets[target].out <- item:
+		case <-r.ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// receive - Handles a message in any of the forward protocol's modes, and returns the chunk ID
+// the client wants acknowledged (if any)
+//
+// See https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1
+func (r *fluentReceiver) receive(message []interface{}) (string, error) {
+	if len(message) < 2 {
+		return "", fmt.Errorf("Invalid forward protocol message")
+	}
+	tag := msgpackString(message[0])
+
+	var option map[string]interface{}
+	switch entries := message[1].(type) {
+	case []interface{}: // Forward mode: [tag, [[time, record], ...], option]
+		if len(message) > 2 {
+			option, _ = message[2].(map[string]interface{})
+		}
+		for _, entry := range entries {
+			if e, ok := entry.([]interface{}); ok && len(e) == 2 {
+				if !r.receiveEntry(tag, e[0], e[1]) {
+					return "", nil
+				}
+			}
+		}
+	case string, []byte: // (Compressed)PackedForward mode: [tag, <concatenated entries>, option]
+		if len(message) > 2 {
+			option, _ = message[2].(map[string]interface{})
+		}
+		var stream io.Reader = strings.NewReader(msgpackString(entries))
+		if msgpackString(option["compressed"]) == "gzip" {
+			gzipReader, err := gzip.NewReader(stream)
+			if err != nil {
+				return "", fmt.Errorf("Invalid compressed entries: %s", err)
+			}
+			defer gzipReader.Close()
+			stream = gzipReader
+		}
+		reader := bufio.NewReader(stream)
+		for {
+			entry, err := msgpackDecode(reader)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return "", fmt.Errorf("Invalid packed entries: %s", err)
+			}
+			if e, ok := entry.([]interface{}); ok && len(e) == 2 {
+				if !r.receiveEntry(tag, e[0], e[1]) {
+					return "", nil
+				}
+			}
+		}
+	default: // Message mode: [tag, time, record, option]
+		if len(message) < 3 {
+			return "", fmt.Errorf("Invalid forward protocol message")
+		}
+		if len(message) > 3 {
+			option, _ = message[3].(map[string]interface{})
+		}
+		if !r.receiveEntry(tag, message[1], message[2]) {
+			return "", nil
+		}
+	}
+
+	return msgpackString(option["chunk"]), nil
+}
+
+func fluentSharedKeyDigest(salt string, hostname string, nonce []byte, sharedKey string) string {
+	digest := sha512.New()
+	digest.Write([]byte(salt))
+	digest.Write([]byte(hostname))
+	digest.Write(nonce)
+	digest.Write([]byte(sharedKey))
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+// handshake - Runs the server side of the shared key handshake (HELO, PING and PONG)
+func (r *fluentReceiver) handshake(conn net.Conn, reader *bufio.Reader) error {
+	conn.SetDeadline(time.Now().Add(fluentHandshakeTimeout))
+	defer conn.SetDeadline(time.Time{})
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	helo := []interface{}{"HELO", map[string]interface{}{"nonce": nonce, "auth": []byte{}, "keepalive": true}}
+	if _, err := conn.Write(msgpackEncode(nil, helo)); err != nil {
+		return err
+	}
+
+	message, err := msgpackDecode(reader)
+	if err != nil {
+		return err
+	}
+	ping, ok := message.([]interface{})
+	if !ok || len(ping) < 4 || msgpackString(ping[0]) != "PING" {
+		return fmt.Errorf("Expected PING message")
+	}
+	clientHostname := msgpackString(ping[1])
+	salt := msgpackString(ping[2])
+	if msgpackString(ping[3]) != fluentSharedKeyDigest(salt, clientHostname, nonce, r.sharedKey) {
+		pong := []interface{}{"PONG", false, "shared_key mismatch", fluentServerHostname, ""}
+		conn.Write(msgpackEncode(nil, pong))
+		return fmt.Errorf("Shared key mismatch for client %s", clientHostname)
+	}
+	pong := []interface{}{"PONG", true, "", fluentServerHostname, fluentSharedKeyDigest(salt, fluentServerHostname, nonce, r.sharedKey)}
+	_, err = conn.Write(msgpackEncode(nil, pong))
+	return err
+}
+
+func (r *fluentReceiver) handleConnection(conn net.Conn) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-r.ctx.Done():
+		case <-done:
+		}
+		conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	if r.sharedKey != "" {
+		if err := r.handshake(conn, reader); err != nil {
+			r.logger.PrintVerbose("Fluent forward handshake with %s failed: %s", conn.RemoteAddr(), err)
+			return
+		}
+	}
+
+	for {
+		message, err := msgpackDecode(reader)
+		if err != nil {
+			if err != io.EOF && r.ctx.Err() == nil {
+				r.logger.PrintVerbose("Could not read Fluent forward message from %s: %s", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		entries, ok := message.([]interface{})
+		if !ok {
+			r.logger.PrintVerbose("Ignoring invalid Fluent forward message from %s", conn.RemoteAddr())
+			continue
+		}
+		chunk, err := r.receive(entries)
+		if err != nil {
+			r.logger.PrintVerbose("Could not decode Fluent forward message from %s: %s", conn.RemoteAddr(), err)
+			return
+		}
+		if chunk != "" {
+			if _, err := conn.Write(msgpackEncode(nil, map[string]interface{}{"ack": chunk})); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// setupFluentReceiver - Starts a Fluent forward protocol receiver on the given address, and passes
+// the Postgres log lines it receives to the target server they belong to
+//
+// Listener settings (the shared key) are taken from the first target.
+func setupFluentReceiver(ctx context.Context, address string, targets []fluentTarget, logger *util.Logger) error {
+	prefixedLogger := logger
+	if len(targets) == 1 {
+		prefixedLogger = logger.WithPrefix(targets[0].config.SectionName)
+	}
+	receiver := &fluentReceiver{ctx: ctx, targets: targets, sharedKey: targets[0].config.LogFluentSharedKey, logger: prefixedLogger}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	prefixedLogger.PrintVerbose("Listening for Fluent forward messages on %s", address)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if ctx.Err() == nil {
+					prefixedLogger.PrintError("Fluent forward receiver on %s stopped: %s", address, err)
+				}
+				return
+			}
+			go receiver.handleConnection(conn)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	return nil
+}
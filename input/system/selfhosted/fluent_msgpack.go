@@ -0,0 +1,253 @@
+package selfhosted
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// The Fluent forward protocol is based on MessagePack (https://github.com/msgpack/msgpack/blob/master/spec.md),
+// of which we only need a small part, so this implements just enough to read the messages
+// sent by Fluentd and Fluent Bit, and write our replies.
+
+// Objects (strings, arrays, etc) larger than this are rejected, to not allocate unbounded
+// amounts of memory for bogus length prefixes
+const msgpackMaxLength = 64 * 1024 * 1024
+
+const msgpackMaxDepth = 64
+
+// fluentEventTimeExtType - Extension type of EventTime, a timestamp with nanoseconds
+const fluentEventTimeExtType = 0
+
+// msgpackDecode - Reads the next value, returning nil, bool, int64, uint64, float64, string,
+// []byte, []interface{}, map[string]interface{} or time.Time (for EventTime)
+//
+// Map keys are converted to strings, since we only look up keys by name.
+func msgpackDecode(r *bufio.Reader) (interface{}, error) {
+	return msgpackDecodeValue(r, 0)
+}
+
+func msgpackDecodeValue(r *bufio.Reader, depth int) (interface{}, error) {
+	if depth > msgpackMaxDepth {
+		return nil, fmt.Errorf("MessagePack value nested too deeply")
+	}
+	b, err := r.ReadByte()
+	if err == io.EOF && depth > 0 {
+		return nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case b <= 0x7f:
+		return int64(b), nil
+	case b >= 0xe0:
+		return int64(int8(b)), nil
+	case b&0xf0 == 0x80:
+		return msgpackDecodeMap(r, int(b&0x0f), depth)
+	case b&0xf0 == 0x90:
+		return msgpackDecodeArray(r, int(b&0x0f), depth)
+	case b&0xe0 == 0xa0:
+		data, err := msgpackReadBytes(r, int(b&0x1f))
+		return string(data), err
+	}
+
+	switch b {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := msgpackReadLength(r, b-0xc4)
+		if err != nil {
+			return nil, err
+		}
+		return msgpackReadBytes(r, n)
+	case 0xc7, 0xc8, 0xc9:
+		n, err := msgpackReadLength(r, b-0xc7)
+		if err != nil {
+			return nil, err
+		}
+		return msgpackDecodeExt(r, n)
+	case 0xca:
+		v, err := msgpackReadUint(r, 4)
+		return float64(math.Float32frombits(uint32(v))), err
+	case 0xcb:
+		v, err := msgpackReadUint(r, 8)
+		return math.Float64frombits(v), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		v, err := msgpackReadUint(r, 1<<(b-0xcc))
+		if v <= math.MaxInt64 {
+			return int64(v), err
+		}
+		return v, err
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (b - 0xd0)
+		v, err := msgpackReadUint(r, size)
+		// Sign-extend from the size of the value
+		shift := uint(64 - 8*size)
+		return int64(v<<shift) >> shift, err
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return msgpackDecodeExt(r, 1<<(b-0xd4))
+	case 0xd9, 0xda, 0xdb:
+		n, err := msgpackReadLength(r, b-0xd9)
+		if err != nil {
+			return nil, err
+		}
+		data, err := msgpackReadBytes(r, n)
+		return string(data), err
+	case 0xdc, 0xdd:
+		n, err := msgpackReadLength(r, b-0xdc+1)
+		if err != nil {
+			return nil, err
+		}
+		return msgpackDecodeArray(r, n, depth)
+	case 0xde, 0xdf:
+		n, err := msgpackReadLength(r, b-0xde+1)
+		if err != nil {
+			return nil, err
+		}
+		return msgpackDecodeMap(r, n, depth)
+	}
+	return nil, fmt.Errorf("Unsupported MessagePack type 0x%02x", b)
+}
+
+// msgpackReadLength - Reads a length prefix of 1, 2 or 4 bytes (for sizeClass 0, 1 and 2)
+func msgpackReadLength(r *bufio.Reader, sizeClass byte) (int, error) {
+	v, err := msgpackReadUint(r, 1<<sizeClass)
+	if err != nil {
+		return 0, err
+	}
+	if v > msgpackMaxLength {
+		return 0, fmt.Errorf("MessagePack value too large (%d)", v)
+	}
+	return int(v), nil
+}
+
+func msgpackReadUint(r *bufio.Reader, size int) (uint64, error) {
+	buf := make([]byte, 8)
+	_, err := io.ReadFull(r, buf[8-size:])
+	return binary.BigEndian.Uint64(buf), err
+}
+
+func msgpackReadBytes(r *bufio.Reader, n int) ([]byte, error) {
+	data := make([]byte, n)
+	_, err := io.ReadFull(r, data)
+	return data, err
+}
+
+func msgpackDecodeArray(r *bufio.Reader, n int, depth int) ([]interface{}, error) {
+	values := make([]interface{}, 0, minInt(n, 1024))
+	for i := 0; i < n; i++ {
+		value, err := msgpackDecodeValue(r, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func msgpackDecodeMap(r *bufio.Reader, n int, depth int) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, minInt(n, 1024))
+	for i := 0; i < n; i++ {
+		key, err := msgpackDecodeValue(r, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		value, err := msgpackDecodeValue(r, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		values[msgpackString(key)] = value
+	}
+	return values, nil
+}
+
+func msgpackDecodeExt(r *bufio.Reader, n int) (interface{}, error) {
+	extType, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	data, err := msgpackReadBytes(r, n)
+	if err != nil {
+		return nil, err
+	}
+	if int8(extType) == fluentEventTimeExtType && n == 8 {
+		return time.Unix(int64(binary.BigEndian.Uint32(data[0:4])), int64(binary.BigEndian.Uint32(data[4:8]))), nil
+	}
+	return data, nil
+}
+
+// msgpackString - Returns string and binary values as a string, and formats other values
+func msgpackString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// msgpackEncode - Appends the encoding of the value, which may be a nil, bool, string, []byte,
+// []interface{} or map[string]interface{} (the types used in our replies)
+func msgpackEncode(b []byte, value interface{}) []byte {
+	switch v := value.(type) {
+	case nil:
+		return append(b, 0xc0)
+	case bool:
+		if v {
+			return append(b, 0xc3)
+		}
+		return append(b, 0xc2)
+	case string:
+		b = msgpackAppendLength(b, len(v), 0xa0, 31, 0xd9, 0xda, 0xdb)
+		return append(b, v...)
+	case []byte:
+		b = msgpackAppendLength(b, len(v), 0, -1, 0xc4, 0xc5, 0xc6)
+		return append(b, v...)
+	case []interface{}:
+		b = msgpackAppendLength(b, len(v), 0x90, 15, 0, 0xdc, 0xdd)
+		for _, element := range v {
+			b = msgpackEncode(b, element)
+		}
+		return b
+	case map[string]interface{}:
+		b = msgpackAppendLength(b, len(v), 0x80, 15, 0, 0xde, 0xdf)
+		for key, element := range v {
+			b = msgpackEncode(b, key)
+			b = msgpackEncode(b, element)
+		}
+		return b
+	}
+	panic(fmt.Sprintf("msgpackEncode: unsupported type %T", value))
+}
+
+// msgpackAppendLength - Appends the type and length, using the fix type for lengths up to
+// fixMax, and otherwise the smallest of the 8 bit (if the type has one), 16 or 32 bit variants
+func msgpackAppendLength(b []byte, n int, fixType byte, fixMax int, type8 byte, type16 byte, type32 byte) []byte {
+	switch {
+	case n <= fixMax:
+		return append(b, fixType|byte(n))
+	case n <= math.MaxUint8 && type8 != 0:
+		return append(b, type8, byte(n))
+	case n <= math.MaxUint16:
+		return append(b, type16, byte(n>>8), byte(n))
+	}
+	return append(b, type32, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
@@ -0,0 +1,498 @@
+package selfhosted
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// testMsgpackEncode - Like msgpackEncode, but also supports the integers and EventTime values
+// that clients send
+func testMsgpackEncode(b []byte, value interface{}) []byte {
+	switch v := value.(type) {
+	case int:
+		return testMsgpackEncodeInt(b, int64(v))
+	case int64:
+		return testMsgpackEncodeInt(b, v)
+	case float64:
+		return testMsgpackAppendUint(append(b, 0xcb), math.Float64bits(v), 8)
+	case time.Time:
+		b = testMsgpackAppendUint(append(b, 0xd7, fluentEventTimeExtType), uint64(v.Unix()), 4)
+		return testMsgpackAppendUint(b, uint64(v.Nanosecond()), 4)
+	case []interface{}:
+		b = msgpackAppendLength(b, len(v), 0x90, 15, 0, 0xdc, 0xdd)
+		for _, element := range v {
+			b = testMsgpackEncode(b, element)
+		}
+		return b
+	case map[string]interface{}:
+		b = msgpackAppendLength(b, len(v), 0x80, 15, 0, 0xde, 0xdf)
+		for key, element := range v {
+			b = testMsgpackEncode(b, key)
+			b = testMsgpackEncode(b, element)
+		}
+		return b
+	}
+	return msgpackEncode(b, value)
+}
+
+func testMsgpackEncodeInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v <= 0x7f:
+		return append(b, byte(v))
+	case v < 0 && v >= -32:
+		return append(b, byte(int8(v)))
+	case v >= 0 && v <= math.MaxUint32:
+		return testMsgpackAppendUint(append(b, 0xce), uint64(v), 4)
+	}
+	return testMsgpackAppendUint(append(b, 0xd3), uint64(v), 8)
+}
+
+func testMsgpackAppendUint(b []byte, v uint64, size int) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, v)
+	return append(b, buf[8-size:]...)
+}
+
+func testMsgpackDecode(data []byte) (interface{}, error) {
+	return msgpackDecode(bufio.NewReader(bytes.NewReader(data)))
+}
+
+var msgpackDecodeTests = []struct {
+	name     string
+	data     []byte
+	expected interface{}
+}{
+	{"positive fixint", []byte{0x05}, int64(5)},
+	{"negative fixint", []byte{0xff}, int64(-1)},
+	{"uint8", []byte{0xcc, 0xff}, int64(255)},
+	{"uint16", []byte{0xcd, 0x01, 0x00}, int64(256)},
+	{"uint64 beyond int64", []byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, uint64(math.MaxUint64)},
+	{"int8", []byte{0xd0, 0x80}, int64(-128)},
+	{"int16", []byte{0xd1, 0xff, 0x00}, int64(-256)},
+	{"int32", []byte{0xd2, 0xff, 0xff, 0xff, 0xfe}, int64(-2)},
+	{"float32", []byte{0xca, 0x3f, 0xc0, 0x00, 0x00}, float64(1.5)},
+	{"float64", []byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, float64(1.5)},
+	{"nil", []byte{0xc0}, nil},
+	{"bool", []byte{0xc3}, true},
+	{"fixstr", []byte{0xa3, 'l', 'o', 'g'}, "log"},
+	{"str8", append([]byte{0xd9, 0x03}, "log"...), "log"},
+	{"str16", append([]byte{0xda, 0x00, 0x03}, "log"...), "log"},
+	{"bin8", []byte{0xc4, 0x02, 0x01, 0x02}, []byte{0x01, 0x02}},
+	{"fixarray", []byte{0x92, 0x01, 0xa1, 'a'}, []interface{}{int64(1), "a"}},
+	{"array16", []byte{0xdc, 0x00, 0x01, 0xc2}, []interface{}{false}},
+	{"fixmap with non-string key", []byte{0x82, 0xa1, 'a', 0x01, 0x02, 0xc0}, map[string]interface{}{"a": int64(1), "2": nil}},
+	{"map16", []byte{0xde, 0x00, 0x01, 0xa1, 'a', 0xa1, 'b'}, map[string]interface{}{"a": "b"}},
+	{"EventTime", []byte{0xd7, 0x00, 0x5f, 0xee, 0x66, 0x00, 0x00, 0x00, 0x00, 0x7b}, time.Unix(1609459200, 123)},
+	{"EventTime as ext8", []byte{0xc7, 0x08, 0x00, 0x5f, 0xee, 0x66, 0x00, 0x00, 0x00, 0x00, 0x7b}, time.Unix(1609459200, 123)},
+	{"other extension type", []byte{0xd4, 0x01, 0x2a}, []byte{0x2a}},
+}
+
+func TestMsgpackDecode(t *testing.T) {
+	for _, test := range msgpackDecodeTests {
+		value, err := testMsgpackDecode(test.data)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if diff := pretty.Compare(test.expected, value); diff != "" {
+			t.Errorf("%s: unexpected value (-want +got)\n%s", test.name, diff)
+		}
+		if expectedTime, ok := test.expected.(time.Time); ok && !expectedTime.Equal(value.(time.Time)) {
+			t.Errorf("%s: expected %s, got %s", test.name, expectedTime, value)
+		}
+	}
+}
+
+var msgpackDecodeErrorTests = []struct {
+	name string
+	data []byte
+}{
+	{"truncated string", []byte{0xa3, 'l', 'o'}},
+	{"truncated array", []byte{0x92, 0x01}},
+	{"truncated map", []byte{0x81, 0xa1, 'a'}},
+	{"truncated integer", []byte{0xcd, 0x01}},
+	{"length beyond limit", []byte{0xc6, 0x7f, 0xff, 0xff, 0xff}},
+	{"unsupported type", []byte{0xc1}},
+	{"nested too deeply", bytes.Repeat([]byte{0x91}, msgpackMaxDepth+2)},
+}
+
+func TestMsgpackDecodeErrors(t *testing.T) {
+	for _, test := range msgpackDecodeErrorTests {
+		if _, err := testMsgpackDecode(test.data); err == nil || err == io.EOF {
+			t.Errorf("%s: expected error, got %v", test.name, err)
+		}
+	}
+	if _, err := testMsgpackDecode(nil); err != io.EOF {
+		t.Errorf("expected EOF at the end of the stream, got %v", err)
+	}
+}
+
+func TestMsgpackEncode(t *testing.T) {
+	values := []interface{}{
+		nil,
+		true,
+		"",
+		strings.Repeat("a", 31),
+		strings.Repeat("a", 32),
+		strings.Repeat("a", 256),
+		strings.Repeat("a", 70000),
+		[]byte{0x01},
+		[]interface{}{"HELO", map[string]interface{}{"nonce": []byte{0x01, 0x02}, "keepalive": true}},
+		make([]interface{}, 16),
+	}
+	for _, value := range values {
+		decoded, err := testMsgpackDecode(msgpackEncode(nil, value))
+		if err != nil {
+			t.Errorf("unexpected error decoding %v: %s", value, err)
+			continue
+		}
+		if diff := pretty.Compare(value, decoded); diff != "" {
+			t.Errorf("unexpected value after round trip (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestFluentTagMatches(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		tag      string
+		expected bool
+	}{
+		{"postgres", "postgres", true},
+		{"postgres", "postgres.db1", false},
+		{"postgres.*", "postgres.db1", true},
+		{"postgres.*", "postgres", false},
+		{"postgres.*", "postgres.db1.stderr", false},
+		{"postgres.**", "postgres", true},
+		{"postgres.**", "postgres.db1.stderr", true},
+		{"**.stderr", "postgres.db1.stderr", true},
+		{"postgres.db?", "postgres.db1", true},
+		{"postgres.db2", "postgres.db1", false},
+	}
+	for _, test := range tests {
+		if matches := fluentTagMatches(test.pattern, test.tag); matches != test.expected {
+			t.Errorf("%s with %s: expected %t, got %t", test.pattern, test.tag, test.expected, matches)
+		}
+	}
+}
+
+func TestRouteFluentRecord(t *testing.T) {
+	targets := []fluentTarget{
+		{config: config.ServerConfig{LogFluentTag: "postgres.db1.**", DbHost: "db2"}},
+		{config: config.ServerConfig{DbHost: "db2"}},
+		{config: config.ServerConfig{DbURL: "postgres://user@db3.example.com:5432/app"}},
+	}
+	tests := []struct {
+		name     string
+		tag      string
+		record   map[string]interface{}
+		expected int
+	}{
+		{"tag matches", "postgres.db1", map[string]interface{}{"host": "db2"}, 0},
+		{"host field matches", "postgres.other", map[string]interface{}{"host": "DB2"}, 1},
+		{"systemd hostname matches", "systemd", map[string]interface{}{"_HOSTNAME": []byte("db3.example.com")}, 2},
+		{"unknown host", "postgres.other", map[string]interface{}{"hostname": "db4"}, -1},
+		{"no host", "postgres.other", map[string]interface{}{"log": "LOG:  test"}, -1},
+	}
+	for _, test := range tests {
+		if idx := routeFluentRecord(targets, test.tag, test.record); idx != test.expected {
+			t.Errorf("%s: expected target %d, got %d", test.name, test.expected, idx)
+		}
+	}
+
+	if idx := routeFluentRecord(targets[1:2], "anything", nil); idx != 0 {
+		t.Errorf("expected single target without tag to receive all records, got %d", idx)
+	}
+}
+
+func TestFluentRecordToItems(t *testing.T) {
+	occurredAt := time.Unix(1609459200, 0)
+	tests := []struct {
+		name     string
+		record   map[string]interface{}
+		expected []SelfHostedLogStreamItem
+	}{
+		{
+			"tailed log file",
+			map[string]interface{}{"log": []byte("2021-01-01 00:00:00 UTC [123] LOG:  test\n")},
+			[]SelfHostedLogStreamItem{{Line: "2021-01-01 00:00:00 UTC [123] LOG:  test", OccurredAt: occurredAt}},
+		},
+		{
+			"systemd journal",
+			map[string]interface{}{"MESSAGE": "LOG:  first\nLOG:  second", "_HOSTNAME": "db1"},
+			[]SelfHostedLogStreamItem{{Line: "LOG:  first", OccurredAt: occurredAt}, {Line: "LOG:  second", OccurredAt: occurredAt}},
+		},
+		{
+			"parsed jsonlog",
+			map[string]interface{}{"error_severity": []byte("LOG"), "message": "test", "pid": int64(123)},
+			[]SelfHostedLogStreamItem{{Line: `{"error_severity":"LOG","message":"test","pid":123}`, OccurredAt: occurredAt}},
+		},
+		{
+			"no log line",
+			map[string]interface{}{"other": "value"},
+			nil,
+		},
+	}
+	for _, test := range tests {
+		items := fluentRecordToItems(test.record, occurredAt)
+		if diff := pretty.Compare(test.expected, items); diff != "" {
+			t.Errorf("%s: unexpected items (-want +got)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestFluentEventTime(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected time.Time
+	}{
+		{int64(1609459200), time.Unix(1609459200, 0)},
+		{uint64(1609459200), time.Unix(1609459200, 0)},
+		{float64(1609459200.5), time.Unix(1609459200, 5e8)},
+		{time.Unix(1609459200, 123), time.Unix(1609459200, 123)},
+		{[]interface{}{time.Unix(1609459200, 123), map[string]interface{}{}}, time.Unix(1609459200, 123)},
+	}
+	for _, test := range tests {
+		if eventTime := fluentEventTime(test.value); !eventTime.Equal(test.expected) {
+			t.Errorf("%v: expected %s, got %s", test.value, test.expected, eventTime)
+		}
+	}
+}
+
+func newTestFluentReceiver(ctx context.Context, sharedKey string) (*fluentReceiver, chan SelfHostedLogStreamItem) {
+	out := make(chan SelfHostedLogStreamItem, 100)
+	receiver := &fluentReceiver{
+		ctx:       ctx,
+		targets:   []fluentTarget{{config: config.ServerConfig{LogFluentTag: "postgres.**"}, out: out}},
+		sharedKey: sharedKey,
+		logger:    &util.Logger{Destination: log.New(ioutil.Discard, "", 0)},
+	}
+	return receiver, out
+}
+
+func receivedFluentLines(out chan SelfHostedLogStreamItem) []string {
+	var lines []string
+	for {
+		select {
+		case item := <-out:
+			lines = append(lines, item.Line+" @ "+item.OccurredAt.UTC().Format(time.RFC3339Nano))
+		default:
+			return lines
+		}
+	}
+}
+
+func fluentTestEntries() []interface{} {
+	return []interface{}{
+		[]interface{}{time.Unix(1609459200, 5e8), map[string]interface{}{"log": "LOG:  first"}},
+		[]interface{}{int64(1609459201), map[string]interface{}{"log": "LOG:  second"}},
+	}
+}
+
+func fluentTestPackedEntries() []byte {
+	var packed []byte
+	for _, entry := range fluentTestEntries() {
+		packed = testMsgpackEncode(packed, entry)
+	}
+	return packed
+}
+
+func fluentTestCompressedEntries() []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	writer.Write(fluentTestPackedEntries())
+	writer.Close()
+	return buf.Bytes()
+}
+
+var fluentReceiveTests = []struct {
+	name          string
+	message       []interface{}
+	expectedLines []string
+	expectedChunk string
+}{
+	{
+		"Message mode",
+		[]interface{}{"postgres.db1", int64(1609459200), map[string]interface{}{"log": "LOG:  first"}},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00Z"},
+		"",
+	},
+	{
+		"Message mode with chunk option",
+		[]interface{}{"postgres.db1", int64(1609459200), map[string]interface{}{"log": "LOG:  first"}, map[string]interface{}{"chunk": "YWJj"}},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00Z"},
+		"YWJj",
+	},
+	{
+		"Forward mode",
+		[]interface{}{"postgres.db1", fluentTestEntries()},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00.5Z", "LOG:  second @ 2021-01-01T00:00:01Z"},
+		"",
+	},
+	{
+		"Forward mode with chunk option",
+		[]interface{}{"postgres.db1", fluentTestEntries(), map[string]interface{}{"chunk": "YWJj", "size": 2}},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00.5Z", "LOG:  second @ 2021-01-01T00:00:01Z"},
+		"YWJj",
+	},
+	{
+		"PackedForward mode",
+		[]interface{}{"postgres.db1", fluentTestPackedEntries(), map[string]interface{}{"size": 2}},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00.5Z", "LOG:  second @ 2021-01-01T00:00:01Z"},
+		"",
+	},
+	{
+		"PackedForward mode as string (Fluentd v0.12)",
+		[]interface{}{"postgres.db1", string(fluentTestPackedEntries())},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00.5Z", "LOG:  second @ 2021-01-01T00:00:01Z"},
+		"",
+	},
+	{
+		"CompressedPackedForward mode",
+		[]interface{}{"postgres.db1", fluentTestCompressedEntries(), map[string]interface{}{"compressed": "gzip", "chunk": "YWJj"}},
+		[]string{"LOG:  first @ 2021-01-01T00:00:00.5Z", "LOG:  second @ 2021-01-01T00:00:01Z"},
+		"YWJj",
+	},
+	{
+		"unmatched tag",
+		[]interface{}{"other", fluentTestEntries(), map[string]interface{}{"chunk": "YWJj"}},
+		nil,
+		"YWJj",
+	},
+}
+
+func TestFluentReceive(t *testing.T) {
+	for _, test := range fluentReceiveTests {
+		receiver, out := newTestFluentReceiver(context.Background(), "")
+		chunk, err := receiver.receive(test.message)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if chunk != test.expectedChunk {
+			t.Errorf("%s: expected chunk %q, got %q", test.name, test.expectedChunk, chunk)
+		}
+		if diff := pretty.Compare(test.expectedLines, receivedFluentLines(out)); diff != "" {
+			t.Errorf("%s: unexpected lines (-want +got)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestFluentReceiveErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message []interface{}
+	}{
+		{"too short", []interface{}{"postgres.db1"}},
+		{"Message mode without record", []interface{}{"postgres.db1", 1609459200}},
+		{"invalid packed entries", []interface{}{"postgres.db1", []byte{0x92, 0x01}}},
+		{"invalid compressed entries", []interface{}{"postgres.db1", fluentTestPackedEntries(), map[string]interface{}{"compressed": "gzip"}}},
+	}
+	for _, test := range tests {
+		receiver, _ := newTestFluentReceiver(context.Background(), "")
+		if _, err := receiver.receive(test.message); err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+func readFluentReply(t *testing.T, reader *bufio.Reader) []interface{} {
+	t.Helper()
+	reply, err := msgpackDecode(reader)
+	if err != nil {
+		t.Fatalf("could not read reply: %s", err)
+	}
+	if m, ok := reply.(map[string]interface{}); ok {
+		return []interface{}{m}
+	}
+	return reply.([]interface{})
+}
+
+func TestFluentHandleConnectionAck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	receiver, out := newTestFluentReceiver(ctx, "")
+	server, client := net.Pipe()
+	defer client.Close()
+	go receiver.handleConnection(server)
+	reader := bufio.NewReader(client)
+
+	client.Write(testMsgpackEncode(nil, []interface{}{"postgres.db1", fluentTestEntries(), map[string]interface{}{"chunk": "YWJj"}}))
+	ack := readFluentReply(t, reader)
+	if diff := pretty.Compare([]interface{}{map[string]interface{}{"ack": "YWJj"}}, ack); diff != "" {
+		t.Errorf("unexpected ack (-want +got)\n%s", diff)
+	}
+	if lines := receivedFluentLines(out); len(lines) != 2 {
+		t.Errorf("expected two lines before the ack, got %v", lines)
+	}
+
+	// Messages without a chunk option don't get an ack, the next reply is for the following chunk
+	client.Write(testMsgpackEncode(nil, []interface{}{"postgres.db1", fluentTestPackedEntries()}))
+	client.Write(testMsgpackEncode(nil, []interface{}{"postgres.db1", fluentTestCompressedEntries(), map[string]interface{}{"compressed": "gzip", "chunk": "ZGVm"}}))
+	ack = readFluentReply(t, reader)
+	if diff := pretty.Compare([]interface{}{map[string]interface{}{"ack": "ZGVm"}}, ack); diff != "" {
+		t.Errorf("unexpected ack (-want +got)\n%s", diff)
+	}
+	if lines := receivedFluentLines(out); len(lines) != 4 {
+		t.Errorf("expected four lines before the ack, got %v", lines)
+	}
+}
+
+func TestFluentHandleConnectionSharedKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientKey string
+		accepted  bool
+	}{
+		{"matching shared key", "secret", true},
+		{"wrong shared key", "other", false},
+	}
+	for _, test := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+		receiver, out := newTestFluentReceiver(ctx, "secret")
+		server, client := net.Pipe()
+		go receiver.handleConnection(server)
+		reader := bufio.NewReader(client)
+
+		helo := readFluentReply(t, reader)
+		options, _ := helo[1].(map[string]interface{})
+		nonce, _ := options["nonce"].([]byte)
+		if msgpackString(helo[0]) != "HELO" || len(nonce) == 0 {
+			t.Fatalf("%s: expected HELO with nonce, got %v", test.name, helo)
+		}
+		client.Write(testMsgpackEncode(nil, []interface{}{"PING", "fluentbit", "salt", fluentSharedKeyDigest("salt", "fluentbit", nonce, test.clientKey), "", ""}))
+		pong := readFluentReply(t, reader)
+		if pong[1] != test.accepted {
+			t.Errorf("%s: expected PONG with %t, got %v", test.name, test.accepted, pong)
+		}
+		if test.accepted {
+			if digest := msgpackString(pong[4]); digest != fluentSharedKeyDigest("salt", fluentServerHostname, nonce, "secret") {
+				t.Errorf("%s: unexpected server digest %s", test.name, digest)
+			}
+			client.Write(testMsgpackEncode(nil, []interface{}{"postgres.db1", fluentTestEntries(), map[string]interface{}{"chunk": "YWJj"}}))
+			readFluentReply(t, reader)
+			if lines := receivedFluentLines(out); len(lines) != 2 {
+				t.Errorf("%s: expected two lines, got %v", test.name, lines)
+			}
+		} else if _, err := msgpackDecode(reader); err != io.EOF {
+			t.Errorf("%s: expected connection to be closed, got %v", test.name, err)
+		}
+		client.Close()
+		cancel()
+	}
+}
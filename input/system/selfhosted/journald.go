@@ -0,0 +1,176 @@
+package selfhosted
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// How often the cursor of the last processed journal entry is written to disk
+const journaldCursorSaveInterval = 10 * time.Second
+
+var journaldCursorFileUnsafeRegexp = regexp.MustCompile(`[^A-Za-z0-9@._-]`)
+
+// journaldEntry - The fields of "journalctl --output json" entries that are relevant to us
+//
+// MESSAGE is a JSON string in most cases, but an array of bytes when it isn't valid UTF-8.
+type journaldEntry struct {
+	Cursor            string          `json:"__CURSOR"`
+	RealtimeTimestamp string          `json:"__REALTIME_TIMESTAMP"`
+	Message           json.RawMessage `json:"MESSAGE"`
+	SyslogPid         string          `json:"SYSLOG_PID"`
+}
+
+func (e journaldEntry) message() string {
+	var message string
+	if json.Unmarshal(e.Message, &message) == nil {
+		return message
+	}
+	var messageBytes []byte
+	var messageInts []int
+	if json.Unmarshal(e.Message, &messageInts) == nil {
+		for _, b := range messageInts {
+			messageBytes = append(messageBytes, byte(b))
+		}
+	}
+	return string(messageBytes)
+}
+
+// JournaldCursorFile - Returns where the journal position of the given unit is kept between
+// collector restarts (next to the state file)
+func JournaldCursorFile(stateFilename string, unit string) string {
+	return filepath.Join(filepath.Dir(stateFilename), "journald-"+journaldCursorFileUnsafeRegexp.ReplaceAllString(unit, "_")+".cursor")
+}
+
+// setupJournaldTail - Follows the journal entries of a systemd unit, starting after the
+// entry stored in the cursor file (if any), or with new entries otherwise
+//
+// Pass an empty cursorFile to neither read nor store the journal position.
+func setupJournaldTail(ctx context.Context, unit string, cursorFile string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	args := []string{"--unit", unit, "--follow", "--output", "json", "--no-pager"}
+	cursor := ""
+	if cursorFile != "" {
+		content, err := ioutil.ReadFile(cursorFile)
+		if err == nil {
+			cursor = strings.TrimSpace(string(content))
+		} else if !os.IsNotExist(err) {
+			prefixedLogger.PrintWarning("Could not read journal cursor file, starting with new entries: %s", err)
+		}
+	}
+	resumed := cursor != ""
+	if resumed {
+		args = append(args, "--after-cursor", cursor)
+	} else {
+		args = append(args, "--lines", "0")
+	}
+
+	cmd := exec.Command("journalctl", args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("Error setting up journal tail: %s", err)
+	}
+	err = cmd.Start()
+	if err != nil {
+		return fmt.Errorf("Error starting journal tail: %s", err)
+	}
+	prefixedLogger.PrintVerbose("Reading journal entries for unit %s", unit)
+
+	entries := make(chan journaldEntry)
+	go func() {
+		defer close(entries)
+		scanner := bufio.NewScanner(stdout)
+		scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+		for scanner.Scan() {
+			var entry journaldEntry
+			if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				prefixedLogger.PrintVerbose("Skipping unparseable journal entry: %s", err)
+				continue
+			}
+			entries <- entry
+		}
+	}()
+
+	go func() {
+		defer cmd.Wait()
+
+		ticker := time.NewTicker(journaldCursorSaveInterval)
+		defer ticker.Stop()
+		savedCursor := cursor
+		saveCursor := func() {
+			if cursorFile == "" || cursor == savedCursor {
+				return
+			}
+			if err := ioutil.WriteFile(cursorFile, []byte(cursor+"\n"), 0600); err != nil {
+				prefixedLogger.PrintWarning("Could not write journal cursor file: %s", err)
+				return
+			}
+			savedCursor = cursor
+		}
+
+		for {
+			select {
+			case entry, ok := <-entries:
+				if !ok {
+					saveCursor()
+					prefixedLogger.PrintError("Journal tail for unit %s exited unexpectedly", unit)
+					return
+				}
+				item := journaldEntryToItem(entry)
+				item.Resumed = resumed
+				out <- item
+				cursor = entry.Cursor
+			case <-ticker.C:
+				saveCursor()
+			case <-ctx.Done():
+				prefixedLogger.PrintVerbose("Journal tail received stop signal")
+				saveCursor()
+				if err := cmd.Process.Kill(); err != nil {
+					prefixedLogger.PrintError("Failed to kill journal tail process when stop received: %s", err)
+				}
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func journaldEntryToItem(entry journaldEntry) SelfHostedLogStreamItem {
+	item := SelfHostedLogStreamItem{Line: strings.TrimRight(entry.message(), "\n")}
+
+	if usec, err := strconv.ParseInt(entry.RealtimeTimestamp, 10, 64); err == nil {
+		item.OccurredAt = time.Unix(0, usec*int64(time.Microsecond))
+	}
+
+	// When Postgres logs to syslog (and the journal receives it), messages carry the
+	// backend PID, as well as the "[N-M]" line number and chunk markers. Output that
+	// goes to stderr is instead logged under the PID of the postmaster.
+	if entry.SyslogPid != "" {
+		if s, err := strconv.ParseInt(entry.SyslogPid, 10, 32); err == nil {
+			item.BackendPid = int32(s)
+		}
+		logLineParts := logLinePartsRegexp.FindStringSubmatch(item.Line)
+		if len(logLineParts) != 0 {
+			if s, err := strconv.ParseInt(logLineParts[1], 10, 32); err == nil {
+				item.LogLineNumber = int32(s)
+			}
+			if s, err := strconv.ParseInt(logLineParts[2], 10, 32); err == nil {
+				item.LogLineNumberChunk = int32(s)
+			}
+			item.Line = logLineParts[3]
+		}
+	}
+
+	return item
+}
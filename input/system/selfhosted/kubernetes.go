@@ -0,0 +1,304 @@
+package selfhosted
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/util"
+)
+
+const kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+const defaultKubernetesContainer = "postgres"
+
+// How often we check for pods that were created (or containers that restarted) since the last check
+const kubernetesPodCheckInterval = 10 * time.Second
+
+const kubernetesRequestTimeout = 10 * time.Second
+
+// kubernetesClient - Minimal client for listing pods and following their logs through the
+// Kubernetes API, authenticating with the service account of the collector's own pod
+//
+// The token is read for each request, since the kubelet rotates it regularly.
+type kubernetesClient struct {
+	client  *http.Client
+	baseURL string
+}
+
+func newKubernetesClient() (*kubernetesClient, error) {
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	port := os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("KUBERNETES_SERVICE_HOST/KUBERNETES_SERVICE_PORT are not set - Kubernetes log streaming requires the collector to run inside the cluster")
+	}
+
+	caCert, err := ioutil.ReadFile(kubernetesServiceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, fmt.Errorf("Could not read Kubernetes service account CA certificate: %s", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("Could not parse Kubernetes service account CA certificate")
+	}
+
+	return &kubernetesClient{
+		client:  &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caCertPool}}},
+		baseURL: "https://" + net.JoinHostPort(host, port),
+	}, nil
+}
+
+func (c *kubernetesClient) get(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	token, err := ioutil.ReadFile(kubernetesServiceAccountDir + "/token")
+	if err != nil {
+		return nil, fmt.Errorf("Could not read Kubernetes service account token: %s", err)
+	}
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error accessing Kubernetes API: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("Unexpected status code %d from Kubernetes API %s: %s", resp.StatusCode, path, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
+
+// runningPods - Returns the names of the pods matching the label selector that are running
+func (c *kubernetesClient) runningPods(ctx context.Context, namespace string, labelSelector string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, kubernetesRequestTimeout)
+	defer cancel()
+
+	resp, err := c.get(ctx, "/api/v1/namespaces/"+url.PathEscape(namespace)+"/pods", url.Values{
+		"labelSelector": {labelSelector},
+		"fieldSelector": {"status.phase=Running"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var podList struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		} `json:"items"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&podList)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, pod := range podList.Items {
+		names = append(names, pod.Metadata.Name)
+	}
+	return names, nil
+}
+
+// followLogs - Streams the log output of the pod's container until the container stops, or
+// the kubelet ends the stream (e.g. due to log rotation), and returns the time of the last
+// line received, to continue from there
+func (c *kubernetesClient) followLogs(ctx context.Context, namespace string, pod string, container string, since time.Time, out chan<- SelfHostedLogStreamItem) (time.Time, error) {
+	last := since
+
+	resp, err := c.get(ctx, "/api/v1/namespaces/"+url.PathEscape(namespace)+"/pods/"+url.PathEscape(pod)+"/log", url.Values{
+		"container":  {container},
+		"follow":     {"true"},
+		"timestamps": {"true"},
+		"sinceTime":  {since.UTC().Format(time.RFC3339)},
+	})
+	if err != nil {
+		return last, err
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		item, occurredAt, ok := kubernetesLogLineToItem(scanner.Text())
+		if !ok {
+			continue
+		}
+		// sinceTime only has second precision, so skip what the previous stream already returned
+		if !occurredAt.IsZero() {
+			if !occurredAt.After(since) {
+				continue
+			}
+			last = occurredAt
+		}
+		select {
+		case out <- item:
+		case <-ctx.Done():
+			return last, nil
+		}
+	}
+	return last, scanner.Err()
+}
+
+// kubernetesLogLineToItem - Removes the timestamp added by the Kubernetes API (timestamps=true),
+// and unwraps the JSON log format of CloudNativePG, which logs each csvlog record as an object
+// ({"level":"info","ts":...,"logger":"postgres","msg":"record","record":{"log_time":...}})
+//
+// Returns false for other CloudNativePG log messages (e.g. of its instance manager), which
+// aren't Postgres log output.
+func kubernetesLogLineToItem(line string) (SelfHostedLogStreamItem, time.Time, bool) {
+	item := SelfHostedLogStreamItem{Line: line}
+
+	var occurredAt time.Time
+	if idx := strings.IndexByte(line, ' '); idx > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, line[:idx]); err == nil {
+			occurredAt = t
+			item.Line = line[idx+1:]
+			item.OccurredAt = t
+		}
+	}
+
+	if strings.HasPrefix(item.Line, "{") {
+		var cnpgLine struct {
+			Level  string                 `json:"level"`
+			Logger string                 `json:"logger"`
+			Msg    string                 `json:"msg"`
+			Record map[string]interface{} `json:"record"`
+		}
+		decoder := json.NewDecoder(strings.NewReader(item.Line))
+		decoder.UseNumber()
+		if decoder.Decode(&cnpgLine) == nil && cnpgLine.Level != "" && cnpgLine.Msg != "" {
+			if cnpgLine.Logger != "postgres" || cnpgLine.Record == nil {
+				return item, occurredAt, false
+			}
+			record := make([]string, len(logs.CsvLogColumnNames))
+			for idx, name := range logs.CsvLogColumnNames {
+				switch value := cnpgLine.Record[name].(type) {
+				case string:
+					record[idx] = value
+				case json.Number:
+					record[idx] = value.String()
+				}
+			}
+			item.CsvRecord = record
+		}
+	}
+
+	return item, occurredAt, true
+}
+
+// setupKubernetesLogStream - Follows the logs of all running pods that match the label selector,
+// reconnecting when streams end, and picking up new pods as they get created
+func setupKubernetesLogStream(ctx context.Context, config config.ServerConfig, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	client, err := newKubernetesClient()
+	if err != nil {
+		return err
+	}
+
+	namespace := config.LogKubernetesNamespace
+	if namespace == "" {
+		ns, err := ioutil.ReadFile(kubernetesServiceAccountDir + "/namespace")
+		if err != nil {
+			return fmt.Errorf("Could not determine Kubernetes namespace (set db_log_kubernetes_namespace): %s", err)
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+	container := config.LogKubernetesContainer
+	if container == "" {
+		container = defaultKubernetesContainer
+	}
+	labelSelector := config.LogKubernetesLabelSelector
+
+	_, err = client.runningPods(ctx, namespace, labelSelector)
+	if err != nil {
+		return fmt.Errorf("Error starting Kubernetes log stream: %s", err)
+	}
+
+	go func() {
+		var mutex sync.Mutex
+		following := make(map[string]bool)
+		lastReceived := make(map[string]time.Time)
+		startedAt := time.Now()
+
+		ticker := time.NewTicker(kubernetesPodCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			pods, err := client.runningPods(ctx, namespace, labelSelector)
+			if err != nil {
+				prefixedLogger.PrintVerbose("Could not check for Kubernetes pods to stream logs from: %s", err)
+			}
+			mutex.Lock()
+			// Forget about pods that are gone, so their state doesn't accumulate
+			for pod := range lastReceived {
+				if !following[pod] && !containsString(pods, pod) {
+					delete(lastReceived, pod)
+				}
+			}
+			mutex.Unlock()
+
+			for _, pod := range pods {
+				mutex.Lock()
+				if following[pod] {
+					mutex.Unlock()
+					continue
+				}
+				following[pod] = true
+				since, ok := lastReceived[pod]
+				if !ok {
+					since = startedAt
+				}
+				mutex.Unlock()
+
+				prefixedLogger.PrintVerbose("Streaming logs of Kubernetes pod %s/%s (container %s)", namespace, pod, container)
+				go func(pod string, since time.Time) {
+					last, err := client.followLogs(ctx, namespace, pod, container, since, out)
+					if err != nil && ctx.Err() == nil {
+						prefixedLogger.PrintVerbose("Log stream for Kubernetes pod %s/%s stopped: %s", namespace, pod, err)
+					}
+					mutex.Lock()
+					delete(following, pod)
+					lastReceived[pod] = last
+					mutex.Unlock()
+				}(pod, since)
+			}
+
+			select {
+			case <-ctx.Done():
+				prefixedLogger.PrintVerbose("Kubernetes log stream received stop signal")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
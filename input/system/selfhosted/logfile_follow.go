@@ -0,0 +1,197 @@
+package selfhosted
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// How often a followed log file is checked for new data, truncation and replacement
+//
+// Polling behaves the same on all platforms, whereas file change notifications on Windows
+// would require keeping a handle to the log directory open.
+const logFilePollInterval = 250 * time.Millisecond
+
+// followedLogFile - The currently open file of a followed path
+type followedLogFile struct {
+	file        *os.File
+	info        os.FileInfo
+	fingerprint logFileFingerprint
+	offset      int64
+}
+
+func openFollowedLogFile(path string) (*followedLogFile, error) {
+	file, err := openLogFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	fingerprint, err := readLogFileFingerprint(file, logFileFingerprintMaxBytes)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &followedLogFile{file: file, info: info, fingerprint: fingerprint}, nil
+}
+
+func (f *followedLogFile) seek(offset int64) error {
+	_, err := f.file.Seek(offset, io.SeekStart)
+	f.offset = offset
+	return err
+}
+
+// rewritten - Whether the file no longer starts with the contents it had, which happens when
+// it gets truncated (e.g. by logrotate's copytruncate) and written to again between checks
+//
+// The fingerprint is extended while the file is still shorter than the fingerprint size.
+func (f *followedLogFile) rewritten(size int64) bool {
+	fingerprint, err := readLogFileFingerprint(f.file, f.fingerprint.Size)
+	if err != nil {
+		return false
+	}
+	if fingerprint != f.fingerprint {
+		return true
+	}
+	if f.fingerprint.Size < logFileFingerprintMaxBytes && size > int64(f.fingerprint.Size) {
+		if fingerprint, err = readLogFileFingerprint(f.file, logFileFingerprintMaxBytes); err == nil {
+			f.fingerprint = fingerprint
+		}
+	}
+	return false
+}
+
+// followLogFile - Follows the log file like "tail -F" does, until the context is cancelled
+//
+// Reading starts where a previous collector run left off, if positions has one for this file
+// (in which case resumed is true), and otherwise at the beginning of the file if fromStart is
+// set, or its current end.
+//
+// Files are tracked by inode and content fingerprint: When the file is truncated or rewritten,
+// reading starts over at its beginning. When the file gets renamed or deleted, the remainder of
+// the old file is read before closing it (on Windows a deleted file can't go away while it's
+// open), and the file that gets created in its place is read from the beginning.
+func followLogFile(ctx context.Context, path string, fromStart bool, positions *logFilePositions, out chan<- string, prefixedLogger *util.Logger) (resumed bool, err error) {
+	current, err := openFollowedLogFile(path)
+	if err != nil {
+		return false, err
+	}
+	if position, ok := positions.find(path, current.file, current.info); ok {
+		prefixedLogger.PrintVerbose("Resuming log file %s at offset %d", path, position.Offset)
+		err = current.seek(position.Offset)
+		resumed = true
+	} else if !fromStart {
+		err = current.seek(current.info.Size())
+	}
+	if err != nil {
+		current.file.Close()
+		return false, err
+	}
+
+	go func() {
+		reader := bufio.NewReader(current.file)
+		var partial strings.Builder
+		replaced := false
+		ticker := time.NewTicker(logFilePollInterval)
+		defer ticker.Stop()
+
+		// Only complete lines count as read, a partial one is read again after a restart
+		savePosition := func(force bool) {
+			if current != nil {
+				positions.update(logFilePosition{
+					Path:        path,
+					Inode:       logFileInode(current.info),
+					Fingerprint: current.fingerprint,
+					Offset:      current.offset - int64(partial.Len()),
+				})
+			}
+			positions.save(force)
+		}
+		defer func() {
+			savePosition(true)
+			if current != nil {
+				current.file.Close()
+			}
+		}()
+
+		for {
+			// Pass on all complete lines, keeping what comes after the last newline for later
+			for current != nil {
+				chunk, err := reader.ReadString('\n')
+				current.offset += int64(len(chunk))
+				partial.WriteString(chunk)
+				if err != nil {
+					if err != io.EOF {
+						prefixedLogger.PrintError("Failed log file tail: %s", err)
+					}
+					break
+				}
+				line := strings.TrimRight(partial.String(), "\r\n")
+				partial.Reset()
+				select {
+				case out <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// The old file was read to its end above, so we can move on to the new one
+			if replaced {
+				if partial.Len() > 0 {
+					select {
+					case out <- strings.TrimRight(partial.String(), "\r"):
+					case <-ctx.Done():
+						return
+					}
+					partial.Reset()
+				}
+				current.file.Close()
+				current = nil
+				replaced = false
+			}
+			if current == nil {
+				if newFile, err := openFollowedLogFile(path); err == nil {
+					prefixedLogger.PrintVerbose("Log file %s was replaced, reading the new file", path)
+					current = newFile
+					reader.Reset(current.file)
+					continue
+				}
+			}
+			savePosition(false)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if current == nil {
+				continue
+			}
+			info, err := current.file.Stat()
+			if err != nil {
+				continue
+			}
+			if info.Size() < current.offset || (info.Size() != current.info.Size() && current.rewritten(info.Size())) {
+				prefixedLogger.PrintVerbose("Log file %s was truncated, reading from the beginning", path)
+				current.seek(0)
+				current.fingerprint, _ = readLogFileFingerprint(current.file, logFileFingerprintMaxBytes)
+				reader.Reset(current.file)
+				partial.Reset()
+			}
+			current.info = info
+			pathInfo, err := os.Stat(path)
+			replaced = err != nil || !os.SameFile(pathInfo, info)
+		}
+	}()
+
+	return resumed, nil
+}
@@ -0,0 +1,291 @@
+package selfhosted
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+var testFollowLogger = &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+func tempLogDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "logfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func writeLogFile(t *testing.T, path string, flag int, content string) {
+	t.Helper()
+	file, err := os.OpenFile(path, flag|os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = file.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	if err = file.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectLogLines(t *testing.T, out chan string, lines ...string) {
+	t.Helper()
+	for _, expected := range lines {
+		select {
+		case line := <-out:
+			if line != expected {
+				t.Fatalf("expected line %q, got %q", expected, line)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for line %q", expected)
+		}
+	}
+	select {
+	case line := <-out:
+		t.Fatalf("unexpected line %q", line)
+	case <-time.After(2 * logFilePollInterval):
+	}
+}
+
+// resetLogFilePositions - Forgets the positions loaded from the file, like a collector restart
+func resetLogFilePositions(filename string) {
+	logFilePositionsMutex.Lock()
+	delete(logFilePositionsByFilename, filename)
+	logFilePositionsMutex.Unlock()
+}
+
+func TestFollowLogFile(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	writeLogFile(t, path, os.O_TRUNC, "first\nsecond\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := make(chan string)
+	if _, err := followLogFile(ctx, path, true, nil, out, testFollowLogger); err != nil {
+		t.Fatal(err)
+	}
+	expectLogLines(t, out, "first", "second")
+
+	// Partial lines are passed on once they are complete
+	writeLogFile(t, path, os.O_APPEND, "thi")
+	expectLogLines(t, out)
+	writeLogFile(t, path, os.O_APPEND, "rd\r\n")
+	expectLogLines(t, out, "third")
+}
+
+func TestFollowLogFileFromEnd(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	writeLogFile(t, path, os.O_TRUNC, "old\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := make(chan string)
+	if _, err := followLogFile(ctx, path, false, nil, out, testFollowLogger); err != nil {
+		t.Fatal(err)
+	}
+	writeLogFile(t, path, os.O_APPEND, "new\n")
+	expectLogLines(t, out, "new")
+}
+
+func TestFollowLogFileCopytruncate(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	writeLogFile(t, path, os.O_TRUNC, "2021-01-01 00:00:00 UTC LOG:  first\n2021-01-01 00:00:01 UTC LOG:  second\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := make(chan string)
+	if _, err := followLogFile(ctx, path, true, nil, out, testFollowLogger); err != nil {
+		t.Fatal(err)
+	}
+	expectLogLines(t, out, "2021-01-01 00:00:00 UTC LOG:  first", "2021-01-01 00:00:01 UTC LOG:  second")
+
+	// Truncated to a shorter file
+	writeLogFile(t, path, os.O_TRUNC, "after truncate\n")
+	expectLogLines(t, out, "after truncate")
+
+	// Truncated and written past the previous size between two checks
+	writeLogFile(t, path, os.O_TRUNC, "rewritten with a longer line than before\nand another one\n")
+	expectLogLines(t, out, "rewritten with a longer line than before", "and another one")
+}
+
+func TestFollowLogFileRenameRotation(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	writeLogFile(t, path, os.O_TRUNC, "first\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := make(chan string)
+	if _, err := followLogFile(ctx, path, true, nil, out, testFollowLogger); err != nil {
+		t.Fatal(err)
+	}
+	expectLogLines(t, out, "first")
+
+	// The remainder of the old file (including an incomplete last line) is read before
+	// moving on to the file that replaced it
+	writeLogFile(t, path, os.O_APPEND, "second\nincomplete")
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	writeLogFile(t, path, os.O_TRUNC, "new file\n")
+	expectLogLines(t, out, "second", "incomplete", "new file")
+
+	// Deleted and recreated
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	writeLogFile(t, path, os.O_TRUNC, "recreated\n")
+	expectLogLines(t, out, "recreated")
+}
+
+func TestFollowLogFileResume(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	positionsFile := LogFilePositionsFile(filepath.Join(dir, "state"))
+	defer resetLogFilePositions(positionsFile)
+	writeLogFile(t, path, os.O_TRUNC, "first\nsecond\npart")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan string)
+	resumed, err := followLogFile(ctx, path, true, loadLogFilePositions(positionsFile, testFollowLogger), out, testFollowLogger)
+	if err != nil || resumed {
+		t.Fatalf("expected new file to not be resumed, got %t (err %v)", resumed, err)
+	}
+	expectLogLines(t, out, "first", "second")
+	cancel()
+
+	// The partial line isn't counted as read
+	waitForStoredOffset(t, positionsFile, path, int64(len("first\nsecond\n")))
+
+	writeLogFile(t, path, os.O_APPEND, "ial\nthird\n")
+	resetLogFilePositions(positionsFile)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumed, err = followLogFile(ctx, path, false, loadLogFilePositions(positionsFile, testFollowLogger), out, testFollowLogger)
+	if err != nil || !resumed {
+		t.Fatalf("expected file to be resumed, got %t (err %v)", resumed, err)
+	}
+	expectLogLines(t, out, "partial", "third")
+}
+
+func TestFollowLogFileResumeAfterRotation(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	positionsFile := LogFilePositionsFile(filepath.Join(dir, "state"))
+	defer resetLogFilePositions(positionsFile)
+	writeLogFile(t, path, os.O_TRUNC, "2021-01-01 00:00:00 UTC LOG:  first\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan string)
+	if _, err := followLogFile(ctx, path, true, loadLogFilePositions(positionsFile, testFollowLogger), out, testFollowLogger); err != nil {
+		t.Fatal(err)
+	}
+	expectLogLines(t, out, "2021-01-01 00:00:00 UTC LOG:  first")
+	cancel()
+	waitForStoredOffset(t, positionsFile, path, int64(len("2021-01-01 00:00:00 UTC LOG:  first\n")))
+
+	// Whilst the collector is stopped, the file is replaced with one of the same size
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	writeLogFile(t, path, os.O_TRUNC, "2021-01-02 00:00:00 UTC LOG:  other\n")
+	resetLogFilePositions(positionsFile)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumed, err := followLogFile(ctx, path, true, loadLogFilePositions(positionsFile, testFollowLogger), out, testFollowLogger)
+	if err != nil || resumed {
+		t.Fatalf("expected replaced file to not be resumed, got %t (err %v)", resumed, err)
+	}
+	expectLogLines(t, out, "2021-01-02 00:00:00 UTC LOG:  other")
+}
+
+func waitForStoredOffset(t *testing.T, positionsFile string, path string, offset int64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		content, err := ioutil.ReadFile(positionsFile)
+		if err == nil {
+			var stored []logFilePosition
+			json.Unmarshal(content, &stored)
+			for _, position := range stored {
+				if position.Path == path && position.Offset == offset {
+					return
+				}
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for offset %d of %s to be stored", offset, path)
+}
+
+func TestLogFilePositionMatches(t *testing.T) {
+	dir := tempLogDir(t)
+	path := filepath.Join(dir, "postgresql.log")
+	writeLogFile(t, path, os.O_TRUNC, "2021-01-01 00:00:00 UTC LOG:  first\n2021-01-01 00:00:01 UTC LOG:  second\n")
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fingerprint, err := readLogFileFingerprint(file, logFileFingerprintMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherFingerprint := logFileFingerprint{Size: fingerprint.Size, Hash: "0000"}
+	inode := logFileInode(info)
+
+	tests := []struct {
+		name     string
+		position logFilePosition
+		expected bool
+	}{
+		{"same file", logFilePosition{Path: path, Inode: inode, Fingerprint: fingerprint, Offset: 36}, true},
+		{"same file without inode", logFilePosition{Path: path, Fingerprint: fingerprint, Offset: info.Size()}, true},
+		{"reused inode with different contents", logFilePosition{Path: path, Inode: inode, Fingerprint: otherFingerprint, Offset: 36}, false},
+		{"offset beyond the end of the file", logFilePosition{Path: path, Inode: inode, Fingerprint: fingerprint, Offset: info.Size() + 1}, false},
+		{"fingerprint longer than the file", logFilePosition{Path: path, Inode: inode, Fingerprint: logFileFingerprint{Size: int(info.Size()) + 1}}, false},
+		{"empty file without inode", logFilePosition{Path: path}, false},
+	}
+	if inode != 0 {
+		tests = append(tests, struct {
+			name     string
+			position logFilePosition
+			expected bool
+		}{"different inode with same contents", logFilePosition{Path: path, Inode: inode + 1, Fingerprint: fingerprint, Offset: 36}, false})
+	}
+	for _, test := range tests {
+		if matches := test.position.matches(file, info); matches != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, matches)
+		}
+	}
+
+	// A file that was renamed (without inode information) is found by its contents
+	positions := &logFilePositions{positions: map[string]logFilePosition{
+		path + ".old": {Path: path + ".old", Fingerprint: fingerprint, Offset: 36},
+	}}
+	if position, ok := positions.find(path, file, info); !ok || position.Offset != 36 {
+		t.Errorf("expected position of renamed file to be found, got %+v (%t)", position, ok)
+	}
+	var nilPositions *logFilePositions
+	if _, ok := nilPositions.find(path, file, info); ok {
+		t.Errorf("expected nil positions to not find anything")
+	}
+}
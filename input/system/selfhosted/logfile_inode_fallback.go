@@ -0,0 +1,11 @@
+//go:build !darwin && !linux && !freebsd
+// +build !darwin,!linux,!freebsd
+
+package selfhosted
+
+import "os"
+
+// logFileInode - Returns 0 (unknown), files are recognized by their fingerprint only
+func logFileInode(info os.FileInfo) uint64 {
+	return 0
+}
@@ -0,0 +1,17 @@
+//go:build linux || freebsd || darwin
+// +build linux freebsd darwin
+
+package selfhosted
+
+import (
+	"os"
+	"syscall"
+)
+
+// logFileInode - Returns the inode number of the file, to recognize it across restarts
+func logFileInode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino)
+	}
+	return 0
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package selfhosted
+
+import "os"
+
+// openLogFile - Opens the log file for reading (on POSIX systems, open files can be renamed
+// and deleted without further precautions)
+func openLogFile(path string) (*os.File, error) {
+	return os.Open(path)
+}
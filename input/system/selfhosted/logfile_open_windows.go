@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package selfhosted
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// openLogFile - Opens the log file for reading, without preventing Postgres (or log cleanup
+// tools) from renaming or deleting it while we have it open
+//
+// os.Open doesn't pass FILE_SHARE_DELETE, which causes log rotation on Windows to fail with
+// a sharing violation for as long as the file is being tailed.
+func openLogFile(path string) (*os.File, error) {
+	pathp, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	handle, err := windows.CreateFile(pathp, windows.GENERIC_READ,
+		windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE,
+		nil, windows.OPEN_EXISTING, windows.FILE_ATTRIBUTE_NORMAL, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	return os.NewFile(uintptr(handle), path), nil
+}
@@ -0,0 +1,189 @@
+package selfhosted
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// Number of bytes at the start of a log file that identify it, in addition to its inode
+//
+// Inode numbers get reused quickly after a file is deleted, and copytruncate rotation keeps
+// the inode whilst replacing the contents, so neither is enough on its own.
+const logFileFingerprintMaxBytes = 1024
+
+// How often the read positions of followed log files are written to disk
+const logFilePositionSaveInterval = 10 * time.Second
+
+// logFileFingerprint - Hash of the first Size bytes of a log file
+type logFileFingerprint struct {
+	Size int    `json:"size"`
+	Hash string `json:"hash"`
+}
+
+func readLogFileFingerprint(file *os.File, size int) (logFileFingerprint, error) {
+	if size > logFileFingerprintMaxBytes {
+		size = logFileFingerprintMaxBytes
+	}
+	buf := make([]byte, size)
+	n, err := file.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return logFileFingerprint{}, err
+	}
+	sum := sha256.Sum256(buf[:n])
+	return logFileFingerprint{Size: n, Hash: hex.EncodeToString(sum[:])}, nil
+}
+
+// logFilePosition - How far a log file was read, and how to recognize that file again
+type logFilePosition struct {
+	Path        string             `json:"path"`
+	Inode       uint64             `json:"inode,omitempty"`
+	Fingerprint logFileFingerprint `json:"fingerprint"`
+	Offset      int64              `json:"offset"`
+}
+
+// matches - Whether the open file is the one the position was recorded for, and is still
+// at least as long as the recorded offset
+func (p logFilePosition) matches(file *os.File, info os.FileInfo) bool {
+	if p.Inode != 0 && logFileInode(info) != 0 && p.Inode != logFileInode(info) {
+		return false
+	}
+	// Without any content or inode the file can't be recognized
+	if p.Fingerprint.Size == 0 && (p.Inode == 0 || logFileInode(info) == 0) {
+		return false
+	}
+	if info.Size() < p.Offset || int64(p.Fingerprint.Size) > info.Size() {
+		return false
+	}
+	fingerprint, err := readLogFileFingerprint(file, p.Fingerprint.Size)
+	return err == nil && fingerprint == p.Fingerprint
+}
+
+// logFilePositions - Read positions of the followed log files, kept between collector
+// restarts, so that we continue where we left off
+//
+// A nil *logFilePositions neither resumes nor stores positions (e.g. for test runs).
+type logFilePositions struct {
+	filename  string
+	mutex     sync.Mutex
+	positions map[string]logFilePosition
+	dirty     bool
+	savedAt   time.Time
+	logger    *util.Logger
+}
+
+// LogFilePositionsFile - Returns where the log file read positions are kept (next to the state file)
+func LogFilePositionsFile(stateFilename string) string {
+	return filepath.Join(filepath.Dir(stateFilename), "logfile-positions.json")
+}
+
+var logFilePositionsByFilename = make(map[string]*logFilePositions)
+var logFilePositionsMutex sync.Mutex
+
+// loadLogFilePositions - Returns the positions stored in the given file, which are shared by
+// all servers (and kept when the configuration gets reloaded)
+func loadLogFilePositions(filename string, logger *util.Logger) *logFilePositions {
+	logFilePositionsMutex.Lock()
+	defer logFilePositionsMutex.Unlock()
+
+	if p, ok := logFilePositionsByFilename[filename]; ok {
+		return p
+	}
+
+	p := &logFilePositions{filename: filename, positions: make(map[string]logFilePosition), savedAt: time.Now(), logger: logger}
+	content, err := ioutil.ReadFile(filename)
+	if err == nil {
+		var stored []logFilePosition
+		if err = json.Unmarshal(content, &stored); err != nil {
+			logger.PrintWarning("Could not parse log file positions, starting at the end of log files: %s", err)
+		}
+		for _, position := range stored {
+			// Files that were removed in the meantime won't be needed again
+			if _, err := os.Stat(position.Path); err == nil {
+				p.positions[position.Path] = position
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		logger.PrintWarning("Could not read log file positions, starting at the end of log files: %s", err)
+	}
+	logFilePositionsByFilename[filename] = p
+	return p
+}
+
+// find - Returns the stored position for the open file, which may have been recorded under
+// a different path if the file was renamed in the meantime
+func (p *logFilePositions) find(path string, file *os.File, info os.FileInfo) (logFilePosition, bool) {
+	if p == nil {
+		return logFilePosition{}, false
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if position, ok := p.positions[path]; ok && position.matches(file, info) {
+		return position, true
+	}
+	for _, position := range p.positions {
+		if position.Path != path && position.Fingerprint.Size > 0 && position.matches(file, info) {
+			return position, true
+		}
+	}
+	return logFilePosition{}, false
+}
+
+func (p *logFilePositions) update(position logFilePosition) {
+	if p == nil {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.positions[position.Path] != position {
+		p.positions[position.Path] = position
+		p.dirty = true
+	}
+}
+
+// save - Writes the positions to disk if they changed, and either force is set, or they
+// weren't saved recently
+func (p *logFilePositions) save(force bool) {
+	if p == nil {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if !p.dirty || (!force && time.Since(p.savedAt) < logFilePositionSaveInterval) {
+		return
+	}
+
+	var positions []logFilePosition
+	for path, position := range p.positions {
+		if _, err := os.Stat(path); err != nil {
+			delete(p.positions, path)
+			continue
+		}
+		positions = append(positions, position)
+	}
+	content, err := json.Marshal(positions)
+	if err != nil {
+		p.logger.PrintWarning("Could not write log file positions: %s", err)
+		return
+	}
+	// Write to a temporary file first, so a crash doesn't leave a partially written file behind
+	err = ioutil.WriteFile(p.filename+".tmp", content, 0600)
+	if err == nil {
+		err = os.Rename(p.filename+".tmp", p.filename)
+	}
+	if err != nil {
+		p.logger.PrintWarning("Could not write log file positions: %s", err)
+		return
+	}
+	p.dirty = false
+	p.savedAt = time.Now()
+}
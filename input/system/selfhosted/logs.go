@@ -1,15 +1,13 @@
 package selfhosted
 
 import (
-	"bufio"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
-	"path"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -18,7 +16,6 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
-	"github.com/papertrail/go-tail/follower"
 	"github.com/pganalyze/collector/input/postgres"
 	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
@@ -34,6 +31,23 @@ type SelfHostedLogStreamItem struct {
 	BackendPid         int32
 	LogLineNumber      int32
 	LogLineNumberChunk int32
+
+	// Set for journal entries that were resumed from a stored cursor, which are
+	// sent even if they are older than the startup time window
+	Resumed bool
+
+	// Only set for csvlog files, in which case Line is empty
+	CsvRecord []string
+}
+
+// logTextToItems - Splits log output that was received as one message into its lines (e.g.
+// a log line followed by DETAIL or STATEMENT lines)
+func logTextToItems(text string, occurredAt time.Time) []SelfHostedLogStreamItem {
+	var items []SelfHostedLogStreamItem
+	for _, line := range strings.Split(strings.TrimRight(text, "\r\n"), "\n") {
+		items = append(items, SelfHostedLogStreamItem{Line: strings.TrimRight(line, "\r"), OccurredAt: occurredAt})
+	}
+	return items
 }
 
 const settingValueSQL string = `
@@ -77,7 +91,15 @@ func DiscoverLogLocation(servers []*state.Server, globalCollectionOpts state.Col
 		if logDestination == "syslog" {
 			prefixedLogger.PrintInfo("WARNING: Logging via syslog - please check our setup guide for rsyslogd or syslog-ng instructions")
 			continue
-		} else if logDestination != "stderr" {
+		} else if logDestination == "eventlog" {
+			eventSource, err := getPostgresSetting("event_source", server, globalCollectionOpts, prefixedLogger)
+			if err != nil {
+				prefixedLogger.PrintError("ERROR - %s", err)
+				continue
+			}
+			prefixedLogger.PrintInfo("Found Windows Event Log destination, add this to your pganalyze-collector.conf in the [%s] section:\ndb_log_eventlog_source = %s", server.Config.SectionName, eventSource)
+			continue
+		} else if logDestination != "stderr" && !logs.UsesStructuredLogFormat(logDestination) {
 			prefixedLogger.PrintError("ERROR - Unsupported log_destination \"%s\"", logDestination)
 			continue
 		}
@@ -108,7 +130,7 @@ func DiscoverLogLocation(servers []*state.Server, globalCollectionOpts state.Col
 				continue
 			}
 
-			if strings.HasPrefix(logDirectory, "/") {
+			if filepath.IsAbs(logDirectory) {
 				prefixedLogger.PrintInfo("Found log location, add this to your pganalyze-collector.conf in the [%s] section:\ndb_log_location = %s", server.Config.SectionName, logDirectory)
 			} else {
 				prefixedLogger.PrintInfo("WARNING: Found relative log location \"%s\" inside data directory - please check our setup guide for instructions\n", logDirectory)
@@ -126,87 +148,224 @@ func DiscoverLogLocation(servers []*state.Server, globalCollectionOpts state.Col
 }
 
 func SetupLogTailForServer(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, server *state.Server, parsedLogStream chan state.ParsedLogStreamItem) error {
+	if server.Config.LogLocation == "" && server.Config.LogJournaldUnit != "" {
+		if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
+			logger.PrintInfo("Setting up journal tail for unit %s", server.Config.LogJournaldUnit)
+		}
+
+		// Test runs only look for new entries, and shouldn't move the position of the regular collector
+		cursorFile := ""
+		if !globalCollectionOpts.TestRun {
+			cursorFile = JournaldCursorFile(globalCollectionOpts.StateFilename, server.Config.LogJournaldUnit)
+		}
+		logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, logger, parsedLogStream)
+		return setupJournaldTail(ctx, server.Config.LogJournaldUnit, cursorFile, logStream, logger)
+	}
+
+	if server.Config.LogLocation == "" && server.Config.LogEventLogSource != "" {
+		if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
+			logger.PrintInfo("Setting up Windows Event Log tail for source %s", server.Config.LogEventLogSource)
+		}
+
+		logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, logger, parsedLogStream)
+		return setupEventLogTail(ctx, server.Config.LogEventLogSource, logStream, logger)
+	}
+
 	if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
 		logger.PrintInfo("Setting up log tail for %s", server.Config.LogLocation)
 	}
 
+	// Test runs only look for new lines, and shouldn't move the positions of the regular collector
+	var positions *logFilePositions
+	if !globalCollectionOpts.TestRun {
+		positions = loadLogFilePositions(LogFilePositionsFile(globalCollectionOpts.StateFilename), logger)
+	}
 	logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, logger, parsedLogStream)
-	return setupLogLocationTail(ctx, server.Config.LogLocation, logStream, logger)
+	return setupLogLocationTail(ctx, server.Config.LogLocation, positions, logStream, logger)
 }
 
-// SetupLogTails - Sets up continuously running log tails for all servers with a
-// local log directory or file specified
+// SetupLogTails - Sets up continuously running log tails for all servers with a local log
+// directory or file, systemd journal unit, Windows Event Log source, docker container, Kubernetes
+// pod label selector, pipe, syslog server, OTLP receiver or Fluent forward receiver specified
 func SetupLogTails(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) {
+	// Servers with the same db_log_syslog_server share one listener
+	var syslogAddresses []string
+	syslogTargets := make(map[string][]syslogTarget)
+	// The same applies to servers with the same db_log_otel_server
+	var otelAddresses []string
+	otelTargets := make(map[string][]otelTarget)
+	// And to servers with the same db_log_fluent_server
+	var fluentAddresses []string
+	fluentTargets := make(map[string][]fluentTarget)
+	stdinServer := ""
+
 	for _, server := range servers {
 		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
 
-		if server.Config.LogLocation != "" {
+		if server.Config.LogLocation != "" || server.Config.LogJournaldUnit != "" || server.Config.LogEventLogSource != "" {
 			err := SetupLogTailForServer(ctx, wg, globalCollectionOpts, logger, server, parsedLogStream)
 			if err != nil {
 				prefixedLogger.PrintError("ERROR - %s", err)
 			}
-		} else if server.Config.LogDockerTail != "" {
+		} else if server.Config.LogDockerTail != "" || server.Config.LogDockerLabel != "" {
+			if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
+				prefixedLogger.PrintInfo("Setting up docker logs tail for %s", dockerTailDescription(server.Config))
+			}
+
+			logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, prefixedLogger, parsedLogStream)
+			err := setupDockerTail(ctx, server.Config.LogDockerTail, server.Config.LogDockerLabel, logStream, prefixedLogger)
+			if err != nil {
+				prefixedLogger.PrintError("ERROR - %s", err)
+			}
+		} else if server.Config.LogKubernetesLabelSelector != "" {
+			if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
+				prefixedLogger.PrintInfo("Setting up Kubernetes log stream for pods matching %s", server.Config.LogKubernetesLabelSelector)
+			}
+
+			logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, prefixedLogger, parsedLogStream)
+			err := setupKubernetesLogStream(ctx, server.Config, logStream, prefixedLogger)
+			if err != nil {
+				prefixedLogger.PrintError("ERROR - %s", err)
+			}
+		} else if server.Config.LogPipe != "" {
+			if server.Config.LogPipe == "-" {
+				if stdinServer != "" {
+					prefixedLogger.PrintError("ERROR - Standard input is already used for the logs of section %s", stdinServer)
+					continue
+				}
+				stdinServer = server.Config.SectionName
+			}
 			if globalCollectionOpts.DebugLogs || globalCollectionOpts.TestRun {
-				prefixedLogger.PrintInfo("Setting up docker logs tail for %s", server.Config.LogDockerTail)
+				prefixedLogger.PrintInfo("Setting up log pipe for %s", pipeDescription(server.Config.LogPipe))
 			}
 
 			logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, prefixedLogger, parsedLogStream)
-			err := setupDockerTail(ctx, server.Config.LogDockerTail, logStream, prefixedLogger)
+			err := setupPipeTail(ctx, server.Config.LogPipe, logStream, prefixedLogger)
 			if err != nil {
 				prefixedLogger.PrintError("ERROR - %s", err)
 			}
 		} else if server.Config.LogSyslogServer != "" {
 			logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, prefixedLogger, parsedLogStream)
-			err := setupSyslogHandler(ctx, server.Config.LogSyslogServer, logStream, prefixedLogger)
+			address := server.Config.LogSyslogServer
+			if _, ok := syslogTargets[address]; !ok {
+				syslogAddresses = append(syslogAddresses, address)
+			}
+			syslogTargets[address] = append(syslogTargets[address], syslogTarget{config: server.Config, out: logStream})
+		} else if server.Config.LogOtelServer != "" {
+			logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, prefixedLogger, parsedLogStream)
+			target, err := newOtelTarget(server.Config, logStream)
 			if err != nil {
 				prefixedLogger.PrintError("ERROR - %s", err)
+				continue
+			}
+			address := server.Config.LogOtelServer
+			if _, ok := otelTargets[address]; !ok {
+				otelAddresses = append(otelAddresses, address)
+			}
+			otelTargets[address] = append(otelTargets[address], target)
+		} else if server.Config.LogFluentServer != "" {
+			logStream := setupLogTransformer(ctx, wg, server, globalCollectionOpts, prefixedLogger, parsedLogStream)
+			address := server.Config.LogFluentServer
+			if _, ok := fluentTargets[address]; !ok {
+				fluentAddresses = append(fluentAddresses, address)
 			}
+			fluentTargets[address] = append(fluentTargets[address], fluentTarget{config: server.Config, out: logStream})
+		}
+	}
+
+	for _, address := range syslogAddresses {
+		err := setupSyslogHandler(ctx, address, syslogTargets[address], logger)
+		if err != nil {
+			logger.PrintError("ERROR - Could not start syslog server on %s: %s", address, err)
+		}
+	}
+
+	for _, address := range otelAddresses {
+		err := setupOtelReceiver(ctx, address, otelTargets[address], logger)
+		if err != nil {
+			logger.PrintError("ERROR - Could not start OTLP receiver on %s: %s", address, err)
+		}
+	}
+
+	for _, address := range fluentAddresses {
+		err := setupFluentReceiver(ctx, address, fluentTargets[address], logger)
+		if err != nil {
+			logger.PrintError("ERROR - Could not start Fluent forward receiver on %s: %s", address, err)
 		}
 	}
 }
 
-func tailFile(ctx context.Context, path string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+func tailFile(ctx context.Context, path string, fromStart bool, positions *logFilePositions, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	csvlog := logFileFormat(path) == logFileFormatCsv
 	prefixedLogger.PrintVerbose("Tailing log file %s", path)
 
-	t, err := follower.New(path, follower.Config{
-		Whence: io.SeekEnd,
-		Offset: 0,
-		Reopen: true,
-	})
+	lines := make(chan string)
+	resumed, err := followLogFile(ctx, path, fromStart, positions, lines, prefixedLogger)
 	if err != nil {
 		return fmt.Errorf("Failed to setup log tail: %s", err)
 	}
 
 	go func() {
-		defer t.Close()
+		// csvlog records span multiple lines when a field contains newlines, which
+		// is the case until all quotes (escaped by doubling them) are closed again
+		var csvBuf strings.Builder
 	TailLoop:
 		for {
 			select {
-			case line := <-t.Lines():
-				out <- SelfHostedLogStreamItem{Line: line.String()}
+			case line := <-lines:
+				if !csvlog {
+					out <- SelfHostedLogStreamItem{Line: line, Resumed: resumed}
+					continue
+				}
+				csvBuf.WriteString(line)
+				csvBuf.WriteString("\n")
+				if strings.Count(csvBuf.String(), `"`)%2 != 0 {
+					continue
+				}
+				record, err := csv.NewReader(strings.NewReader(csvBuf.String())).Read()
+				csvBuf.Reset()
+				if err != nil {
+					prefixedLogger.PrintVerbose("Skipping unparseable csvlog record in %s: %s", path, err)
+					continue
+				}
+				out <- SelfHostedLogStreamItem{CsvRecord: record, Resumed: resumed}
 			case <-ctx.Done():
 				prefixedLogger.PrintVerbose("Stopping log tail for %s (stop requested)", path)
 				break TailLoop
 			}
 		}
-		if t.Err() != nil {
-			prefixedLogger.PrintError("Failed log file tail: %s", t.Err())
-		}
 	}()
 
 	return nil
 }
 
-func isAcceptableLogFile(fileName string, fileNameFilter string) bool {
+// Log file formats, as indicated by the file extension Postgres uses for them
+const (
+	logFileFormatStderr = ""
+	logFileFormatCsv    = "csvlog"
+	logFileFormatJson   = "jsonlog"
+)
+
+func logFileFormat(fileName string) string {
+	if strings.HasSuffix(fileName, ".csv") {
+		return logFileFormatCsv
+	}
+	if strings.HasSuffix(fileName, ".json") {
+		return logFileFormatJson
+	}
+	return logFileFormatStderr
+}
+
+func isAcceptableLogFile(fileName string, fileNameFilter string, format string) bool {
 	if fileNameFilter != "" && fileName != fileNameFilter {
 		return false
 	}
 
-	if strings.HasSuffix(fileName, ".gz") || strings.HasSuffix(fileName, ".bz2") || strings.HasSuffix(fileName, ".csv") {
+	if strings.HasSuffix(fileName, ".gz") || strings.HasSuffix(fileName, ".bz2") {
 		return false
 	}
 
-	return true
+	return logFileFormat(fileName) == format
 }
 
 func filterOutString(strings []string, stringToBeRemoved string) []string {
@@ -221,7 +380,15 @@ func filterOutString(strings []string, stringToBeRemoved string) []string {
 
 const maxOpenTails = 10
 
-func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+// Tails of removed or renamed files are only stopped after this delay, so they can finish
+// reading the file, and follow the new file if one gets created in its place
+const logFileRemovalDelay = 5 * time.Second
+
+// setupLogLocationTail - Tails the log files in the directory (or the single file), starting
+// with the most recently modified ones, and picking up files as they get created
+//
+// Pass nil positions to neither read nor store how far each file was read.
+func setupLogLocationTail(ctx context.Context, logLocation string, positions *logFilePositions, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
 	prefixedLogger.PrintVerbose("Searching for log file(s) in %s", logLocation)
 
 	openFiles := make(map[string]context.CancelFunc)
@@ -241,6 +408,24 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 		return err
 	}
 
+	// With log_destination = jsonlog or csvlog (also when combined with stderr), only
+	// the .json (or otherwise .csv) files are tailed, since they identify the session,
+	// user and database of each line exactly
+	format := logFileFormat(fileNameFilter)
+	if fileNameFilter == "" {
+		for _, f := range files {
+			if f.IsDir() {
+				continue
+			}
+			if fileFormat := logFileFormat(f.Name()); fileFormat == logFileFormatJson || (fileFormat == logFileFormatCsv && format == logFileFormatStderr) {
+				format = fileFormat
+			}
+		}
+	}
+	if format != logFileFormatStderr {
+		prefixedLogger.PrintVerbose("Found %s files, ignoring other log files in %s", format, logLocation)
+	}
+
 	sort.Slice(files, func(i, j int) bool {
 		// Note that we are sorting descending here, i.e. we want the newest files
 		// first
@@ -256,11 +441,11 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 			break
 		}
 
-		fileName := path.Join(logLocation, f.Name())
+		fileName := filepath.Join(logLocation, f.Name())
 
-		if isAcceptableLogFile(fileName, fileNameFilter) {
+		if isAcceptableLogFile(fileName, fileNameFilter, format) {
 			tailCtx, tailCancel := context.WithCancel(ctx)
-			err = tailFile(tailCtx, fileName, out, prefixedLogger)
+			err = tailFile(tailCtx, fileName, false, positions, out, prefixedLogger)
 			if err != nil {
 				tailCancel()
 				prefixedLogger.PrintError("ERROR - %s", err)
@@ -276,6 +461,8 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 		return fmt.Errorf("fsnotify new: %s", err)
 	}
 
+	removedFiles := make(chan string)
+
 	go func() {
 		defer watcher.Close()
 		for {
@@ -284,7 +471,7 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 				//prefixedLogger.PrintVerbose("Received fsnotify event: %s %s", event.Op.String(), event.Name)
 				if event.Op&fsnotify.Create == fsnotify.Create || event.Op&fsnotify.Write == fsnotify.Write {
 					_, exists := openFiles[event.Name]
-					if isAcceptableLogFile(event.Name, fileNameFilter) && !exists {
+					if isAcceptableLogFile(event.Name, fileNameFilter, format) && !exists {
 						if len(openFiles) >= maxOpenTails {
 							var oldestFile string
 							oldestFile, openFilesByAge = openFilesByAge[0], openFilesByAge[1:]
@@ -294,8 +481,9 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 								delete(openFiles, oldestFile)
 							}
 						}
+						// New files are read from the beginning, to not miss what was logged before we noticed them
 						tailCtx, tailCancel := context.WithCancel(ctx)
-						err = tailFile(tailCtx, event.Name, out, prefixedLogger)
+						err = tailFile(tailCtx, event.Name, event.Op&fsnotify.Create == fsnotify.Create, positions, out, prefixedLogger)
 						if err != nil {
 							tailCancel()
 							prefixedLogger.PrintError("ERROR - %s", err)
@@ -305,14 +493,29 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 						}
 					}
 				}
+				// Unlinking a file that is still open is reported as a chmod on Linux
 				if event.Op&fsnotify.Remove == fsnotify.Remove || event.Op&fsnotify.Rename == fsnotify.Rename || event.Op&fsnotify.Chmod == fsnotify.Chmod {
-					tailCancel, ok := openFiles[event.Name]
-					if ok {
-						tailCancel()
-						delete(openFiles, event.Name)
+					if _, ok := openFiles[event.Name]; ok {
+						fileName := event.Name
+						time.AfterFunc(logFileRemovalDelay, func() {
+							select {
+							case removedFiles <- fileName:
+							case <-ctx.Done():
+							}
+						})
 					}
-					openFilesByAge = filterOutString(openFilesByAge, event.Name)
 				}
+			case fileName := <-removedFiles:
+				if _, err := os.Stat(fileName); err == nil {
+					// Still (or again) exists, the tail follows the file now at the path
+					continue
+				}
+				tailCancel, ok := openFiles[fileName]
+				if ok {
+					tailCancel()
+					delete(openFiles, fileName)
+				}
+				openFilesByAge = filterOutString(openFilesByAge, fileName)
 			case err = <-watcher.Errors:
 				prefixedLogger.PrintError("ERROR - fsnotify watcher failure: %s", err)
 			case <-ctx.Done():
@@ -337,41 +540,6 @@ func setupLogLocationTail(ctx context.Context, logLocation string, out chan<- Se
 	return nil
 }
 
-func setupDockerTail(ctx context.Context, containerName string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
-	var err error
-
-	cmd := exec.Command("docker", "logs", containerName, "-f", "--tail", "0")
-	stderr, _ := cmd.StderrPipe()
-
-	scanner := bufio.NewScanner(stderr)
-	go func() {
-		for scanner.Scan() {
-			out <- SelfHostedLogStreamItem{Line: scanner.Text()}
-		}
-	}()
-
-	err = cmd.Start()
-	if err != nil {
-		return fmt.Errorf("Error starting docker log tail: %s", err)
-	}
-
-	go func() {
-		defer cmd.Wait()
-		for {
-			select {
-			case <-ctx.Done():
-				prefixedLogger.PrintVerbose("Docker log tail received stop signal")
-				if err := cmd.Process.Kill(); err != nil {
-					prefixedLogger.PrintError("Failed to kill docker log tail process when stop received: %s", err)
-				}
-				return
-			}
-		}
-	}()
-
-	return nil
-}
-
 func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, prefixedLogger *util.Logger, parsedLogStream chan state.ParsedLogStreamItem) chan<- SelfHostedLogStreamItem {
 	logStream := make(chan SelfHostedLogStreamItem)
 
@@ -391,12 +559,43 @@ func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, server *state.
 					return
 				}
 
+				item.Line = logs.StripLogLinePrefix(server.Config.LogLinePrefixStripRegexp, item.Line)
+
+				var structuredLogLines []state.LogLine
+				var structured bool
+				if item.CsvRecord != nil {
+					structuredLogLines, structured = logs.ParseCsvLogRecord(item.CsvRecord)
+					if !structured {
+						continue
+					}
+				} else {
+					// jsonlog output may be forwarded by any of the inputs, not just log files
+					structuredLogLines, structured = logs.ParseJsonLogLine(item.Line)
+				}
+				if structured {
+					for _, logLine := range structuredLogLines {
+						logLine.CollectedAt = time.Now()
+						logLine.UUID = uuid.NewV4()
+						if !item.Resumed && logLine.OccurredAt.Before(linesNewerThan) {
+							continue
+						}
+						parsedLogStream <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: logLine}
+					}
+					continue
+				}
+
 				// We ignore failures here since we want the per-backend stitching logic
 				// that runs later on (and any other parsing errors will just be ignored)
 				// Note that we need to restore the original trailing newlines since
 				// ProcessLogStream below expects them and they are not present in the tail
 				// log stream.
-				logLine, _ := logs.ParseLogLineWithPrefix("", item.Line+"\n")
+				logLinePrefix := server.Config.LogLinePrefix
+				if logLinePrefix == "" {
+					server.CollectionStatusMutex.Lock()
+					logLinePrefix = server.CollectionStatus.LogLinePrefix
+					server.CollectionStatusMutex.Unlock()
+				}
+				logLine, _ := logs.ParseLogLine(logLinePrefix, item.Line+"\n")
 				logLine.CollectedAt = time.Now()
 				logLine.UUID = uuid.NewV4()
 
@@ -414,7 +613,7 @@ func setupLogTransformer(ctx context.Context, wg *sync.WaitGroup, server *state.
 				}
 
 				// Ignore loglines which are outside our time window
-				if !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
+				if !item.Resumed && !logLine.OccurredAt.IsZero() && logLine.OccurredAt.Before(linesNewerThan) {
 					continue
 				}
 
@@ -0,0 +1,291 @@
+package selfhosted
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// Requests larger than this are rejected, the OpenTelemetry Collector batches far less by default
+const otlpMaxRequestBytes = 32 * 1024 * 1024
+
+// otelTarget - A server receiving log records from a (possibly shared) OTLP receiver
+type otelTarget struct {
+	config     config.ServerConfig
+	attributes map[string]string
+	out        chan<- SelfHostedLogStreamItem
+}
+
+func newOtelTarget(config config.ServerConfig, out chan<- SelfHostedLogStreamItem) (otelTarget, error) {
+	target := otelTarget{config: config, attributes: make(map[string]string), out: out}
+	if config.LogOtelResourceAttributes == "" {
+		return target, nil
+	}
+	for _, pair := range strings.Split(config.LogOtelResourceAttributes, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return target, fmt.Errorf("Invalid db_log_otel_resource_attributes entry \"%s\" (expected key=value)", pair)
+		}
+		target.attributes[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return target, nil
+}
+
+// routeOtelResourceLogs - Returns the index of the target the resource's log records belong to,
+// or -1 if none matches
+//
+// Targets with db_log_otel_resource_attributes take precedence, otherwise the "host.name"
+// resource attribute is compared to the database host.
+func routeOtelResourceLogs(targets []otelTarget, attributes map[string]interface{}) int {
+	if len(targets) == 1 && len(targets[0].attributes) == 0 {
+		return 0
+	}
+	for idx, target := range targets {
+		if len(target.attributes) == 0 {
+			continue
+		}
+		matches := true
+		for key, value := range target.attributes {
+			if fmt.Sprint(attributes[key]) != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return idx
+		}
+	}
+	hostName, _ := attributes["host.name"].(string)
+	if hostName == "" {
+		return -1
+	}
+	for idx, target := range targets {
+		dbHost := target.config.GetDbHost()
+		if len(target.attributes) == 0 && dbHost != "" && strings.EqualFold(dbHost, hostName) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// otlpLogRecordToItems - Returns the Postgres log lines in the body of the log record
+//
+// String bodies are used as-is (and split if they contain multiple lines), whilst map bodies,
+// e.g. from parsing jsonlog output in the OpenTelemetry Collector, are turned back into JSON.
+func otlpLogRecordToItems(record otlpLogRecord) []SelfHostedLogStreamItem {
+	occurredAt := record.Time
+	if occurredAt.IsZero() {
+		occurredAt = record.ObservedTime
+	}
+
+	var text string
+	switch body := record.Body.(type) {
+	case nil:
+		return nil
+	case string:
+		text = body
+	case []byte:
+		text = string(body)
+	case map[string]interface{}:
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil
+		}
+		text = string(encoded)
+	default:
+		text = fmt.Sprint(body)
+	}
+
+	return logTextToItems(text, occurredAt)
+}
+
+// otelReceiver - Passes on the log records of decoded OTLP requests to the matching targets
+type otelReceiver struct {
+	ctx     context.Context
+	targets []otelTarget
+	logger  *util.Logger
+}
+
+func (r *otelReceiver) receive(resourceLogs []otlpResourceLogs) {
+	for _, rl := range resourceLogs {
+		target := routeOtelResourceLogs(r.targets, rl.Attributes)
+		if target == -1 {
+			r.logger.PrintVerbose("Ignoring %d OTLP log records from unknown resource %v", len(rl.Records), rl.Attributes)
+			continue
+		}
+		for _, record := range rl.Records {
+			for _, item := range otlpLogRecordToItems(record) {
+				select {
+				case r.targets[target].out <- item:
+				case <-r.ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// ServeHTTP - Handles OTLP/HTTP requests (POST /v1/logs), in protobuf or JSON encoding
+func (r *otelReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != "/v1/logs" {
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(body, otlpMaxRequestBytes+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(content) > otlpMaxRequestBytes {
+		http.Error(w, "Request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	contentType := strings.TrimSpace(strings.SplitN(req.Header.Get("Content-Type"), ";", 2)[0])
+	var resourceLogs []otlpResourceLogs
+	switch contentType {
+	case "application/x-protobuf":
+		resourceLogs, err = decodeOtlpLogsProtobuf(content)
+	case "application/json":
+		resourceLogs, err = decodeOtlpLogsJSON(content)
+	default:
+		http.Error(w, "Unsupported content type (supported: application/x-protobuf, application/json)", http.StatusUnsupportedMediaType)
+		return
+	}
+	if err != nil {
+		r.logger.PrintVerbose("Could not decode OTLP/HTTP request: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.receive(resourceLogs)
+
+	// An empty ExportLogsServiceResponse, which is encoded the same way in protobuf
+	w.Header().Set("Content-Type", contentType)
+	if contentType == "application/json" {
+		w.Write([]byte("{}"))
+	}
+}
+
+// otlpRawCodec - Passes gRPC messages through as bytes, since we decode them ourselves
+type otlpRawCodec struct{}
+
+func (otlpRawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *(v.(*[]byte)), nil
+}
+
+func (otlpRawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = append([]byte{}, data...)
+	return nil
+}
+
+func (otlpRawCodec) String() string {
+	return "proto"
+}
+
+func (r *otelReceiver) grpcServiceDesc() *grpc.ServiceDesc {
+	return &grpc.ServiceDesc{
+		ServiceName: "opentelemetry.proto.collector.logs.v1.LogsService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Export",
+			Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				var content []byte
+				if err := dec(&content); err != nil {
+					return nil, err
+				}
+				resourceLogs, err := decodeOtlpLogsProtobuf(content)
+				if err != nil {
+					r.logger.PrintVerbose("Could not decode OTLP/gRPC request: %s", err)
+					return nil, err
+				}
+				r.receive(resourceLogs)
+				response := []byte{}
+				return &response, nil
+			},
+		}},
+		Metadata: "opentelemetry/proto/collector/logs/v1/logs_service.proto",
+	}
+}
+
+// setupOtelReceiver - Starts an OTLP logs receiver on the given address, and passes the Postgres
+// log lines it receives to the target server they were sent from
+//
+// Listener settings (the protocol) are taken from the first target.
+func setupOtelReceiver(ctx context.Context, address string, targets []otelTarget, logger *util.Logger) error {
+	prefixedLogger := logger
+	if len(targets) == 1 {
+		prefixedLogger = logger.WithPrefix(targets[0].config.SectionName)
+	}
+	receiver := &otelReceiver{ctx: ctx, targets: targets, logger: prefixedLogger}
+
+	protocol := strings.ToLower(targets[0].config.LogOtelServerProtocol)
+	if protocol == "" {
+		protocol = "http"
+	}
+	if protocol != "http" && protocol != "grpc" {
+		return fmt.Errorf("Unsupported OTLP receiver protocol \"%s\" (supported: http, grpc)", protocol)
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	prefixedLogger.PrintVerbose("Listening for OTLP log records on %s (%s)", address, protocol)
+
+	if protocol == "grpc" {
+		server := grpc.NewServer(grpc.CustomCodec(otlpRawCodec{}), grpc.MaxRecvMsgSize(otlpMaxRequestBytes))
+		server.RegisterService(receiver.grpcServiceDesc(), receiver)
+		go func() {
+			err := server.Serve(listener)
+			if err != nil && ctx.Err() == nil {
+				prefixedLogger.PrintError("OTLP receiver on %s stopped: %s", address, err)
+			}
+		}()
+		go func() {
+			<-ctx.Done()
+			server.Stop()
+		}()
+		return nil
+	}
+
+	server := &http.Server{Handler: receiver, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			prefixedLogger.PrintError("OTLP receiver on %s stopped: %s", address, err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	return nil
+}
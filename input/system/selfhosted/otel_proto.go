@@ -0,0 +1,321 @@
+package selfhosted
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The collector doesn't include the generated OTLP protobuf code, so requests are decoded
+// field by field, following opentelemetry/proto/collector/logs/v1/logs_service.proto (and
+// the messages it references). Only the fields we use are read, others are skipped.
+
+// otlpResourceLogs - The log records sent by one resource (e.g. a Postgres host or pod)
+type otlpResourceLogs struct {
+	Attributes map[string]interface{}
+	Records    []otlpLogRecord
+}
+
+type otlpLogRecord struct {
+	Time         time.Time
+	ObservedTime time.Time
+	SeverityText string
+
+	// string, bool, int64, float64, []byte, []interface{} or map[string]interface{}
+	Body interface{}
+}
+
+// forEachProtobufField - Calls fn for each field of the encoded message, with the contents of
+// length-delimited fields, or the value of varint and fixed size fields
+func forEachProtobufField(b []byte, fn func(num protowire.Number, typ protowire.Type, bytes []byte, value uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var bytes []byte
+		var value uint64
+		switch typ {
+		case protowire.VarintType:
+			value, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			value, n = protowire.ConsumeFixed64(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			value = uint64(v)
+		case protowire.BytesType:
+			bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := fn(num, typ, bytes, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeOtlpLogsProtobuf - Decodes an ExportLogsServiceRequest message
+func decodeOtlpLogsProtobuf(b []byte) ([]otlpResourceLogs, error) {
+	var result []otlpResourceLogs
+	err := forEachProtobufField(b, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+		if num != 1 || typ != protowire.BytesType { // resource_logs
+			return nil
+		}
+		resourceLogs, err := decodeOtlpResourceLogsProtobuf(bytes)
+		if err != nil {
+			return err
+		}
+		result = append(result, resourceLogs)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Invalid OTLP logs request: %s", err)
+	}
+	return result, nil
+}
+
+func decodeOtlpResourceLogsProtobuf(b []byte) (otlpResourceLogs, error) {
+	resourceLogs := otlpResourceLogs{Attributes: make(map[string]interface{})}
+	err := forEachProtobufField(b, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1: // resource
+			return forEachProtobufField(bytes, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+				if num != 1 || typ != protowire.BytesType { // attributes
+					return nil
+				}
+				key, value, err := decodeOtlpKeyValueProtobuf(bytes)
+				resourceLogs.Attributes[key] = value
+				return err
+			})
+		case 2, 1000: // scope_logs, or instrumentation_library_logs (before OTLP 0.19)
+			return forEachProtobufField(bytes, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+				if num != 2 || typ != protowire.BytesType { // log_records
+					return nil
+				}
+				record, err := decodeOtlpLogRecordProtobuf(bytes)
+				resourceLogs.Records = append(resourceLogs.Records, record)
+				return err
+			})
+		}
+		return nil
+	})
+	return resourceLogs, err
+}
+
+func decodeOtlpLogRecordProtobuf(b []byte) (otlpLogRecord, error) {
+	var record otlpLogRecord
+	err := forEachProtobufField(b, func(num protowire.Number, typ protowire.Type, bytes []byte, value uint64) error {
+		var err error
+		switch {
+		case num == 1 && typ == protowire.Fixed64Type: // time_unix_nano
+			record.Time = otlpTime(value)
+		case num == 11 && typ == protowire.Fixed64Type: // observed_time_unix_nano
+			record.ObservedTime = otlpTime(value)
+		case num == 3 && typ == protowire.BytesType: // severity_text
+			record.SeverityText = string(bytes)
+		case num == 5 && typ == protowire.BytesType: // body
+			record.Body, err = decodeOtlpAnyValueProtobuf(bytes)
+		}
+		return err
+	})
+	return record, err
+}
+
+func decodeOtlpKeyValueProtobuf(b []byte) (key string, value interface{}, err error) {
+	err = forEachProtobufField(b, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		var err error
+		switch num {
+		case 1:
+			key = string(bytes)
+		case 2:
+			value, err = decodeOtlpAnyValueProtobuf(bytes)
+		}
+		return err
+	})
+	return
+}
+
+func decodeOtlpAnyValueProtobuf(b []byte) (value interface{}, err error) {
+	err = forEachProtobufField(b, func(num protowire.Number, typ protowire.Type, bytes []byte, v uint64) error {
+		switch num {
+		case 1:
+			value = string(bytes)
+		case 2:
+			value = v != 0
+		case 3:
+			value = int64(v)
+		case 4:
+			value = math.Float64frombits(v)
+		case 5:
+			values := []interface{}{}
+			err := forEachProtobufField(bytes, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+				if num != 1 || typ != protowire.BytesType {
+					return nil
+				}
+				element, err := decodeOtlpAnyValueProtobuf(bytes)
+				values = append(values, element)
+				return err
+			})
+			value = values
+			return err
+		case 6:
+			values := make(map[string]interface{})
+			err := forEachProtobufField(bytes, func(num protowire.Number, typ protowire.Type, bytes []byte, _ uint64) error {
+				if num != 1 || typ != protowire.BytesType {
+					return nil
+				}
+				key, element, err := decodeOtlpKeyValueProtobuf(bytes)
+				values[key] = element
+				return err
+			})
+			value = values
+			return err
+		case 7:
+			value = append([]byte{}, bytes...)
+		}
+		return nil
+	})
+	return
+}
+
+func otlpTime(unixNano uint64) time.Time {
+	if unixNano == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(unixNano))
+}
+
+// OTLP/JSON encoding, see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+//
+// 64 bit integers are encoded as decimal strings, but plain numbers are accepted as well.
+
+type otlpJSONInt string
+
+func (i *otlpJSONInt) UnmarshalJSON(data []byte) error {
+	*i = otlpJSONInt(strings.Trim(string(data), `"`))
+	return nil
+}
+
+type otlpJSONRequest struct {
+	ResourceLogs []struct {
+		Resource struct {
+			Attributes []otlpJSONKeyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeLogs                  []otlpJSONScopeLogs `json:"scopeLogs"`
+		InstrumentationLibraryLogs []otlpJSONScopeLogs `json:"instrumentationLibraryLogs"`
+	} `json:"resourceLogs"`
+}
+
+type otlpJSONScopeLogs struct {
+	LogRecords []struct {
+		TimeUnixNano         otlpJSONInt       `json:"timeUnixNano"`
+		ObservedTimeUnixNano otlpJSONInt       `json:"observedTimeUnixNano"`
+		SeverityText         string            `json:"severityText"`
+		Body                 *otlpJSONAnyValue `json:"body"`
+	} `json:"logRecords"`
+}
+
+type otlpJSONKeyValue struct {
+	Key   string            `json:"key"`
+	Value *otlpJSONAnyValue `json:"value"`
+}
+
+type otlpJSONAnyValue struct {
+	StringValue *string      `json:"stringValue"`
+	BoolValue   *bool        `json:"boolValue"`
+	IntValue    *otlpJSONInt `json:"intValue"`
+	DoubleValue *float64     `json:"doubleValue"`
+	ArrayValue  *struct {
+		Values []*otlpJSONAnyValue `json:"values"`
+	} `json:"arrayValue"`
+	KvlistValue *struct {
+		Values []otlpJSONKeyValue `json:"values"`
+	} `json:"kvlistValue"`
+	BytesValue []byte `json:"bytesValue"`
+}
+
+func (v *otlpJSONAnyValue) value() interface{} {
+	switch {
+	case v == nil:
+		return nil
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.IntValue != nil:
+		i, _ := strconv.ParseInt(string(*v.IntValue), 10, 64)
+		return i
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	case v.ArrayValue != nil:
+		values := []interface{}{}
+		for _, element := range v.ArrayValue.Values {
+			values = append(values, element.value())
+		}
+		return values
+	case v.KvlistValue != nil:
+		return otlpJSONAttributes(v.KvlistValue.Values)
+	case v.BytesValue != nil:
+		return v.BytesValue
+	}
+	return nil
+}
+
+func otlpJSONAttributes(keyValues []otlpJSONKeyValue) map[string]interface{} {
+	attributes := make(map[string]interface{})
+	for _, kv := range keyValues {
+		attributes[kv.Key] = kv.Value.value()
+	}
+	return attributes
+}
+
+func otlpJSONTime(i otlpJSONInt) time.Time {
+	unixNano, _ := strconv.ParseUint(string(i), 10, 64)
+	return otlpTime(unixNano)
+}
+
+// decodeOtlpLogsJSON - Decodes an ExportLogsServiceRequest message in OTLP/JSON encoding
+func decodeOtlpLogsJSON(b []byte) ([]otlpResourceLogs, error) {
+	var request otlpJSONRequest
+	err := json.Unmarshal(b, &request)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid OTLP logs request: %s", err)
+	}
+
+	var result []otlpResourceLogs
+	for _, rl := range request.ResourceLogs {
+		resourceLogs := otlpResourceLogs{Attributes: otlpJSONAttributes(rl.Resource.Attributes)}
+		for _, scopeLogs := range append(rl.ScopeLogs, rl.InstrumentationLibraryLogs...) {
+			for _, r := range scopeLogs.LogRecords {
+				resourceLogs.Records = append(resourceLogs.Records, otlpLogRecord{
+					Time:         otlpJSONTime(r.TimeUnixNano),
+					ObservedTime: otlpJSONTime(r.ObservedTimeUnixNano),
+					SeverityText: r.SeverityText,
+					Body:         r.Body.value(),
+				})
+			}
+		}
+		result = append(result, resourceLogs)
+	}
+	return result, nil
+}
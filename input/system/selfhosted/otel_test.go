@@ -0,0 +1,290 @@
+package selfhosted
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// Helpers to encode OTLP protobuf messages field by field, like the decoder reads them
+
+func appendProtobufBytes(b []byte, num protowire.Number, value []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, value)
+}
+
+func otlpAnyValue(value interface{}) []byte {
+	var b []byte
+	switch v := value.(type) {
+	case string:
+		b = appendProtobufBytes(b, 1, []byte(v))
+	case bool:
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+	case int64:
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	case map[string]interface{}:
+		var kvlist []byte
+		for key, element := range v {
+			kvlist = appendProtobufBytes(kvlist, 1, otlpKeyValue(key, element))
+		}
+		b = appendProtobufBytes(b, 6, kvlist)
+	}
+	return b
+}
+
+func otlpKeyValue(key string, value interface{}) []byte {
+	b := appendProtobufBytes(nil, 1, []byte(key))
+	return appendProtobufBytes(b, 2, otlpAnyValue(value))
+}
+
+func otlpLogRecordProtobuf(timeUnixNano uint64, severityText string, body interface{}) []byte {
+	var b []byte
+	if timeUnixNano != 0 {
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, timeUnixNano)
+	}
+	b = protowire.AppendTag(b, 2, protowire.VarintType) // severity_number (skipped)
+	b = protowire.AppendVarint(b, 9)
+	b = appendProtobufBytes(b, 3, []byte(severityText))
+	b = appendProtobufBytes(b, 5, otlpAnyValue(body))
+	b = protowire.AppendTag(b, 8, protowire.Fixed32Type) // flags (skipped)
+	b = protowire.AppendFixed32(b, 1)
+	b = protowire.AppendTag(b, 11, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, 1609459300000000000)
+}
+
+func otlpResourceLogsProtobuf(scopeLogsField protowire.Number, attributes map[string]interface{}, records ...[]byte) []byte {
+	var resource []byte
+	for key, value := range attributes {
+		resource = appendProtobufBytes(resource, 1, otlpKeyValue(key, value))
+	}
+	scopeLogs := appendProtobufBytes(nil, 1, []byte("scope")) // scope (skipped)
+	for _, record := range records {
+		scopeLogs = appendProtobufBytes(scopeLogs, 2, record)
+	}
+	b := appendProtobufBytes(nil, 1, resource)
+	b = appendProtobufBytes(b, scopeLogsField, scopeLogs)
+	return appendProtobufBytes(b, 3, []byte("https://opentelemetry.io/schemas/1.21.0")) // schema_url (skipped)
+}
+
+func otlpRequestProtobuf(resourceLogs ...[]byte) []byte {
+	var b []byte
+	for _, rl := range resourceLogs {
+		b = appendProtobufBytes(b, 1, rl)
+	}
+	return b
+}
+
+var otlpTestRequestProtobuf = otlpRequestProtobuf(
+	otlpResourceLogsProtobuf(2, map[string]interface{}{"host.name": "db1", "k8s.pod.restart_count": int64(2)},
+		otlpLogRecordProtobuf(1609459200000000000, "LOG", "2021-01-01 00:00:00 UTC [123] LOG:  checkpoint starting: time"),
+		otlpLogRecordProtobuf(0, "ERROR", map[string]interface{}{"error_severity": "ERROR", "pid": int64(456)}),
+	),
+	otlpResourceLogsProtobuf(1000, map[string]interface{}{"host.name": "db2", "cloud.provider": "aws"},
+		otlpLogRecordProtobuf(1609459200000000000, "", "first\nsecond"),
+	),
+)
+
+const otlpTestRequestJSON = `{"resourceLogs":[
+	{"resource":{"attributes":[{"key":"host.name","value":{"stringValue":"db1"}},{"key":"k8s.pod.restart_count","value":{"intValue":"2"}}]},
+	 "scopeLogs":[{"scope":{"name":"scope"},"logRecords":[
+		{"timeUnixNano":"1609459200000000000","observedTimeUnixNano":1609459300000000000,"severityNumber":9,"severityText":"LOG","body":{"stringValue":"2021-01-01 00:00:00 UTC [123] LOG:  checkpoint starting: time"}},
+		{"observedTimeUnixNano":"1609459300000000000","severityText":"ERROR","body":{"kvlistValue":{"values":[{"key":"error_severity","value":{"stringValue":"ERROR"}},{"key":"pid","value":{"intValue":456}}]}}}
+	 ]}]},
+	{"resource":{"attributes":[{"key":"host.name","value":{"stringValue":"db2"}},{"key":"cloud.provider","value":{"stringValue":"aws"}}]},
+	 "instrumentationLibraryLogs":[{"logRecords":[
+		{"timeUnixNano":"1609459200000000000","observedTimeUnixNano":"1609459300000000000","body":{"stringValue":"first\nsecond"}}
+	 ]}]}
+]}`
+
+var otlpTestResourceLogs = []otlpResourceLogs{
+	{
+		Attributes: map[string]interface{}{"host.name": "db1", "k8s.pod.restart_count": int64(2)},
+		Records: []otlpLogRecord{
+			{Time: otlpTime(1609459200000000000), ObservedTime: otlpTime(1609459300000000000), SeverityText: "LOG", Body: "2021-01-01 00:00:00 UTC [123] LOG:  checkpoint starting: time"},
+			{ObservedTime: otlpTime(1609459300000000000), SeverityText: "ERROR", Body: map[string]interface{}{"error_severity": "ERROR", "pid": int64(456)}},
+		},
+	},
+	{
+		Attributes: map[string]interface{}{"host.name": "db2", "cloud.provider": "aws"},
+		Records: []otlpLogRecord{
+			{Time: otlpTime(1609459200000000000), ObservedTime: otlpTime(1609459300000000000), Body: "first\nsecond"},
+		},
+	},
+}
+
+func TestDecodeOtlpLogs(t *testing.T) {
+	actual, err := decodeOtlpLogsProtobuf(otlpTestRequestProtobuf)
+	if err != nil {
+		t.Fatalf("protobuf: unexpected error: %s", err)
+	}
+	if diff := pretty.Compare(otlpTestResourceLogs, actual); diff != "" {
+		t.Errorf("protobuf: unexpected resource logs (-want +got)\n%s", diff)
+	}
+
+	actual, err = decodeOtlpLogsJSON([]byte(otlpTestRequestJSON))
+	if err != nil {
+		t.Fatalf("JSON: unexpected error: %s", err)
+	}
+	if diff := pretty.Compare(otlpTestResourceLogs, actual); diff != "" {
+		t.Errorf("JSON: unexpected resource logs (-want +got)\n%s", diff)
+	}
+
+	if _, err = decodeOtlpLogsProtobuf(otlpTestRequestProtobuf[:len(otlpTestRequestProtobuf)-5]); err == nil {
+		t.Errorf("protobuf: expected error for truncated request")
+	}
+	if _, err = decodeOtlpLogsJSON([]byte(`{"resourceLogs":[`)); err == nil {
+		t.Errorf("JSON: expected error for truncated request")
+	}
+}
+
+func TestOtlpLogRecordToItems(t *testing.T) {
+	occurredAt := otlpTime(1609459200000000000)
+	observedAt := otlpTime(1609459300000000000)
+	tests := []struct {
+		name     string
+		record   otlpLogRecord
+		expected []SelfHostedLogStreamItem
+	}{
+		{"string body", otlpLogRecord{Time: occurredAt, ObservedTime: observedAt, Body: "LOG:  hello\n"},
+			[]SelfHostedLogStreamItem{{Line: "LOG:  hello", OccurredAt: occurredAt}}},
+		{"multi-line string body", otlpLogRecord{Time: occurredAt, Body: "ERROR:  syntax error\r\n\tat character 8"},
+			[]SelfHostedLogStreamItem{{Line: "ERROR:  syntax error", OccurredAt: occurredAt}, {Line: "\tat character 8", OccurredAt: occurredAt}}},
+		{"map body without time", otlpLogRecord{ObservedTime: observedAt, Body: map[string]interface{}{"pid": int64(456), "message": "hello"}},
+			[]SelfHostedLogStreamItem{{Line: `{"message":"hello","pid":456}`, OccurredAt: observedAt}}},
+		{"empty body", otlpLogRecord{Time: occurredAt}, nil},
+	}
+	for _, test := range tests {
+		if diff := pretty.Compare(test.expected, otlpLogRecordToItems(test.record)); diff != "" {
+			t.Errorf("%s: unexpected items (-want +got)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestRouteOtelResourceLogs(t *testing.T) {
+	var targets []otelTarget
+	for _, cfg := range []config.ServerConfig{
+		{DbHost: "db1"},
+		{DbHost: "db2", LogOtelResourceAttributes: "cloud.provider=aws, k8s.pod.restart_count=2"},
+		{DbURL: "postgres://user@DB3:5432/app"},
+	} {
+		target, err := newOtelTarget(cfg, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		targets = append(targets, target)
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		expected   int
+	}{
+		{"host name matches database host", map[string]interface{}{"host.name": "db1"}, 0},
+		{"configured attributes match, including non-string values", map[string]interface{}{"host.name": "db1", "cloud.provider": "aws", "k8s.pod.restart_count": int64(2)}, 1},
+		{"configured attributes partially match", map[string]interface{}{"host.name": "db2", "cloud.provider": "aws"}, -1},
+		{"host name matches database URL host", map[string]interface{}{"host.name": "db3"}, 2},
+		{"no host name", map[string]interface{}{"service.name": "postgresql"}, -1},
+	}
+	for _, test := range tests {
+		if idx := routeOtelResourceLogs(targets, test.attributes); idx != test.expected {
+			t.Errorf("%s: expected target %d, got %d", test.name, test.expected, idx)
+		}
+	}
+
+	if idx := routeOtelResourceLogs(targets[:1], map[string]interface{}{}); idx != 0 {
+		t.Errorf("expected single target to receive all log records, got %d", idx)
+	}
+	if _, err := newOtelTarget(config.ServerConfig{LogOtelResourceAttributes: "cloud.provider"}, nil); err == nil {
+		t.Errorf("expected error for resource attribute without value")
+	}
+}
+
+func TestOtelReceiverServeHTTP(t *testing.T) {
+	var gzipped bytes.Buffer
+	writer := gzip.NewWriter(&gzipped)
+	writer.Write(otlpTestRequestProtobuf)
+	writer.Close()
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		contentType     string
+		contentEncoding string
+		body            []byte
+		expectedStatus  int
+		expectedLines   [2][]string
+	}{
+		{"protobuf", "POST", "/v1/logs", "application/x-protobuf", "", otlpTestRequestProtobuf, http.StatusOK,
+			[2][]string{{"2021-01-01 00:00:00 UTC [123] LOG:  checkpoint starting: time", `{"error_severity":"ERROR","pid":456}`}, {"first", "second"}}},
+		{"gzip encoded protobuf", "POST", "/v1/logs", "application/x-protobuf", "gzip", gzipped.Bytes(), http.StatusOK,
+			[2][]string{{"2021-01-01 00:00:00 UTC [123] LOG:  checkpoint starting: time", `{"error_severity":"ERROR","pid":456}`}, {"first", "second"}}},
+		{"JSON", "POST", "/v1/logs", "application/json; charset=utf-8", "", []byte(otlpTestRequestJSON), http.StatusOK,
+			[2][]string{{"2021-01-01 00:00:00 UTC [123] LOG:  checkpoint starting: time", `{"error_severity":"ERROR","pid":456}`}, {"first", "second"}}},
+		{"wrong path", "POST", "/v1/traces", "application/x-protobuf", "", otlpTestRequestProtobuf, http.StatusNotFound, [2][]string{}},
+		{"wrong method", "GET", "/v1/logs", "", "", nil, http.StatusMethodNotAllowed, [2][]string{}},
+		{"unsupported content type", "POST", "/v1/logs", "text/plain", "", otlpTestRequestProtobuf, http.StatusUnsupportedMediaType, [2][]string{}},
+		{"invalid protobuf", "POST", "/v1/logs", "application/x-protobuf", "", []byte{0x0a, 0xff}, http.StatusBadRequest, [2][]string{}},
+		{"invalid gzip", "POST", "/v1/logs", "application/x-protobuf", "gzip", otlpTestRequestProtobuf, http.StatusBadRequest, [2][]string{}},
+	}
+
+	for _, test := range tests {
+		db1 := make(chan SelfHostedLogStreamItem, 10)
+		db2 := make(chan SelfHostedLogStreamItem, 10)
+		receiver := &otelReceiver{
+			ctx:     context.Background(),
+			targets: []otelTarget{{config: config.ServerConfig{DbHost: "db1"}, out: db1}, {config: config.ServerConfig{DbHost: "db2"}, out: db2}},
+			logger:  &util.Logger{Destination: log.New(ioutil.Discard, "", 0)},
+		}
+
+		req := httptest.NewRequest(test.method, test.path, bytes.NewReader(test.body))
+		req.Header.Set("Content-Type", test.contentType)
+		if test.contentEncoding != "" {
+			req.Header.Set("Content-Encoding", test.contentEncoding)
+		}
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, req)
+		close(db1)
+		close(db2)
+
+		if recorder.Code != test.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", test.name, test.expectedStatus, recorder.Code)
+		}
+		for i, out := range []chan SelfHostedLogStreamItem{db1, db2} {
+			var lines []string
+			for item := range out {
+				if item.OccurredAt.IsZero() {
+					t.Errorf("%s: expected time of log line %q to be set", test.name, item.Line)
+				}
+				lines = append(lines, item.Line)
+			}
+			if diff := pretty.Compare(test.expectedLines[i], lines); diff != "" {
+				t.Errorf("%s: unexpected lines for target %d (-want +got)\n%s", test.name, i, diff)
+			}
+		}
+	}
+}
+
+func TestOtlpJSONTime(t *testing.T) {
+	if actual := otlpJSONTime("1609459200000000000"); !actual.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2021-01-01, got %s", actual)
+	}
+	if actual := otlpJSONTime(""); !actual.IsZero() {
+		t.Errorf("expected zero time for missing timestamp, got %s", actual)
+	}
+}
@@ -0,0 +1,108 @@
+package selfhosted
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/pganalyze/collector/util"
+)
+
+// Standard input can't be reopened, so a single reader is shared across config reloads
+var stdinLines chan string
+var stdinOnce sync.Once
+
+func pipeDescription(path string) string {
+	if path == "-" {
+		return "standard input"
+	}
+	return path
+}
+
+// setupPipeTail - Reads log lines from the named pipe at the given path, or from standard
+// input if the path is "-", until the context is cancelled
+func setupPipeTail(ctx context.Context, path string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+	if path == "-" {
+		stdinOnce.Do(func() {
+			stdinLines = make(chan string)
+			go func() {
+				defer close(stdinLines)
+				scanLines(context.Background(), os.Stdin, stdinLines)
+			}()
+		})
+
+		go func() {
+			for {
+				select {
+				case line, ok := <-stdinLines:
+					if !ok {
+						prefixedLogger.PrintWarning("Standard input was closed, no longer receiving log lines")
+						return
+					}
+					out <- SelfHostedLogStreamItem{Line: line}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return nil
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("Could not access log pipe (it can be created using \"mkfifo %s\"): %s", path, err)
+	}
+	if stat.Mode()&os.ModeNamedPipe == 0 {
+		return fmt.Errorf("Log pipe %s is not a named pipe", path)
+	}
+
+	// Opening the pipe for writing as well means we never see EOF when the writer goes
+	// away (e.g. a restarting log shipper), and the open doesn't block until one connects
+	pipe, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("Could not open log pipe: %s", err)
+	}
+	prefixedLogger.PrintVerbose("Reading log lines from pipe %s", path)
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanLines(ctx, pipe, lines)
+	}()
+
+	go func() {
+		defer pipe.Close()
+		for {
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					if ctx.Err() == nil {
+						prefixedLogger.PrintError("Failed to read from log pipe %s", path)
+					}
+					return
+				}
+				out <- SelfHostedLogStreamItem{Line: line}
+			case <-ctx.Done():
+				prefixedLogger.PrintVerbose("Stopping log pipe reader for %s (stop requested)", path)
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func scanLines(ctx context.Context, in io.Reader, out chan<- string) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		select {
+		case out <- scanner.Text():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+// +build !linux
+
+package selfhosted
+
+// GetProcessPrivateMemory - Returns no memory information, since shared memory can only be told
+// apart on Linux
+func GetProcessPrivateMemory(pids []int32) map[int32]uint64 {
+	return nil
+}
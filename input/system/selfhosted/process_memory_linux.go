@@ -0,0 +1,26 @@
+//go:build linux
+// +build linux
+
+package selfhosted
+
+import "github.com/shirou/gopsutil/process"
+
+// GetProcessPrivateMemory - Returns the resident memory of each process that is not shared (in
+// bytes), which excludes the shared buffers a Postgres backend has touched
+//
+// Processes that have since exited (or can't be inspected) are left out.
+func GetProcessPrivateMemory(pids []int32) map[int32]uint64 {
+	memory := make(map[int32]uint64)
+	for _, pid := range pids {
+		p, err := process.NewProcess(pid)
+		if err != nil {
+			continue
+		}
+		mem, err := p.MemoryInfoEx()
+		if err != nil || mem.Shared > mem.RSS {
+			continue
+		}
+		memory[pid] = mem.RSS - mem.Shared
+	}
+	return memory
+}
@@ -2,79 +2,324 @@ package selfhosted
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"gopkg.in/mcuadros/go-syslog.v2"
+	"gopkg.in/mcuadros/go-syslog.v2/format"
 
+	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/util"
 )
 
 var logLinePartsRegexp = regexp.MustCompile(`^\[(\d+)-(\d+)\] (.*)`)
 var logLineNumberPartsRegexp = regexp.MustCompile(`^\[(\d+)-(\d+)\]$`)
 
-func setupSyslogHandler(ctx context.Context, logSyslogServer string, out chan<- SelfHostedLogStreamItem, prefixedLogger *util.Logger) error {
+// RFC3164 tags look like "postgres[123]:", but the syslog library doesn't parse out the PID
+var rfc3164TagPidRegexp = regexp.MustCompile(`\s[^\s\[\]:]+\[(\d+)\]:`)
+
+// Postgres splits messages sent to syslog at each newline, and lines longer than this
+// (PG_SYSLOG_LIMIT) into multiple chunks, at the last whitespace character that fits
+const syslogLineLimit = 900
+
+// Whitespace characters Postgres splits long lines at (isspace in the C locale)
+const syslogWhitespace = " \t\n\v\f\r"
+
+// How long to wait for further chunks of a log line, before passing on what was received
+const syslogChunkTimeout = 1 * time.Second
+
+// syslogTarget - A server receiving log messages from a (possibly shared) syslog listener
+type syslogTarget struct {
+	config config.ServerConfig
+	out    chan<- SelfHostedLogStreamItem
+}
+
+// postgresSyslogFormat - Detects the message format like syslog.Automatic does (RFC3164 or
+// RFC5424, optionally with RFC6587 octet counting), and adds the PID of RFC3164 messages
+type postgresSyslogFormat struct {
+	format.Automatic
+}
+
+type postgresSyslogParser struct {
+	format.LogParser
+	line []byte
+}
+
+func (f *postgresSyslogFormat) GetParser(line []byte) format.LogParser {
+	return &postgresSyslogParser{LogParser: f.Automatic.GetParser(line), line: line}
+}
+
+func (p *postgresSyslogParser) Dump() format.LogParts {
+	logParts := p.LogParser.Dump()
+	if _, ok := logParts["proc_id"]; !ok {
+		if parts := rfc3164TagPidRegexp.FindSubmatch(p.line); parts != nil {
+			logParts["proc_id"] = string(parts[1])
+		}
+	}
+	return logParts
+}
+
+type syslogChunkKey struct {
+	target int
+	host   string
+	pid    int32
+}
+
+type syslogPendingLine struct {
+	item          SelfHostedLogStreamItem
+	lastChunkLen  int
+	lastChunkNum  int32
+	lastReceiveAt time.Time
+}
+
+// syslogLineAssembler - Reassembles log lines that Postgres split into multiple syslog
+// messages, keeping the order of the lines sent by each backend
+type syslogLineAssembler struct {
+	pending map[syslogChunkKey]*syslogPendingLine
+	send    func(target int, item SelfHostedLogStreamItem)
+}
+
+func newSyslogLineAssembler(send func(target int, item SelfHostedLogStreamItem)) *syslogLineAssembler {
+	return &syslogLineAssembler{pending: make(map[syslogChunkKey]*syslogPendingLine), send: send}
+}
+
+func (a *syslogLineAssembler) add(target int, host string, item SelfHostedLogStreamItem, now time.Time) {
+	key := syslogChunkKey{target: target, host: host, pid: item.BackendPid}
+	if item.LogLineNumberChunk == 0 {
+		// Lines received before this one (from the same backend) have to be passed on first
+		a.flush(key)
+		a.send(target, item)
+		return
+	}
+
+	line, ok := a.pending[key]
+	if ok && line.item.LogLineNumber == item.LogLineNumber && item.LogLineNumberChunk > line.lastChunkNum {
+		if syslogIsLengthSplit(line.lastChunkLen, item.Line) {
+			line.item.Line += item.Line
+		} else {
+			line.item.Line += "\n" + item.Line
+		}
+		line.lastChunkLen = len(item.Line)
+		line.lastChunkNum = item.LogLineNumberChunk
+		line.lastReceiveAt = now
+		return
+	}
+	a.flush(key)
+	a.pending[key] = &syslogPendingLine{item: item, lastChunkLen: len(item.Line), lastChunkNum: item.LogLineNumberChunk, lastReceiveAt: now}
+}
+
+// flushExpired - Passes on lines that haven't received further chunks within syslogChunkTimeout
+func (a *syslogLineAssembler) flushExpired(now time.Time) {
+	for key, line := range a.pending {
+		if now.Sub(line.lastReceiveAt) >= syslogChunkTimeout {
+			a.flush(key)
+		}
+	}
+}
+
+func (a *syslogLineAssembler) flushAll() {
+	for key := range a.pending {
+		a.flush(key)
+	}
+}
+
+func (a *syslogLineAssembler) flush(key syslogChunkKey) {
+	if line, ok := a.pending[key]; ok {
+		a.send(key.target, line.item)
+		delete(a.pending, key)
+	}
+}
+
+// syslogIsLengthSplit - Whether a chunk continues the line of the previous chunk (of the given
+// length) because Postgres split it by length, instead of starting the next line of the message
+//
+// Postgres only splits by length when the rest of the line doesn't fit the limit, cutting it at
+// the last whitespace character that fits (or mid-word if there is none), so the first word of
+// the next chunk would not have fit into the previous chunk. Multi-line messages (e.g. indented
+// query text) are split at the newline, and their next line may fit into the previous chunk.
+func syslogIsLengthSplit(prevChunkLen int, chunk string) bool {
+	// Chunks may be shortened by up to three bytes, to not split a multibyte character
+	limit := syslogLineLimit - (utf8.UTFMax - 1)
+	if prevChunkLen >= limit {
+		return true
+	}
+	if strings.IndexAny(chunk, syslogWhitespace) != 0 {
+		return false
+	}
+	wordEnd := strings.IndexAny(chunk[1:], syslogWhitespace) + 1
+	if wordEnd == 0 {
+		wordEnd = len(chunk)
+	}
+	return prevChunkLen+wordEnd >= limit
+}
+
+// setupSyslogHandler - Starts a syslog server on the given address, and passes the Postgres
+// log lines it receives to the target server they were sent from
+//
+// When there are multiple targets, messages are routed based on the syslog hostname (or the
+// client IP), compared first to db_log_syslog_server_hostname, and then to the database host.
+// Listener settings (protocol and TLS) are taken from the first target.
+func setupSyslogHandler(ctx context.Context, address string, targets []syslogTarget, logger *util.Logger) error {
+	prefixedLogger := logger
+	if len(targets) == 1 {
+		prefixedLogger = logger.WithPrefix(targets[0].config.SectionName)
+	}
+	serverConfig := targets[0].config
+
 	channel := make(syslog.LogPartsChannel)
 	handler := syslog.NewChannelHandler(channel)
 
 	server := syslog.NewServer()
-	server.SetFormat(syslog.RFC5424)
+	server.SetFormat(&postgresSyslogFormat{})
 	server.SetHandler(handler)
-	err := server.ListenTCP(logSyslogServer)
+
+	protocols := serverConfig.LogSyslogServerProtocol
+	if protocols == "" {
+		protocols = "tcp"
+	}
+	for _, protocol := range strings.Split(protocols, ",") {
+		switch strings.ToLower(strings.TrimSpace(protocol)) {
+		case "tcp":
+			if serverConfig.LogSyslogServerTLSCert != "" || serverConfig.LogSyslogServerTLSKey != "" {
+				cert, err := tls.LoadX509KeyPair(serverConfig.LogSyslogServerTLSCert, serverConfig.LogSyslogServerTLSKey)
+				if err != nil {
+					return fmt.Errorf("Failed to load syslog server TLS certificate: %s", err)
+				}
+				err = server.ListenTCPTLS(address, &tls.Config{Certificates: []tls.Certificate{cert}})
+				if err != nil {
+					return err
+				}
+			} else {
+				err := server.ListenTCP(address)
+				if err != nil {
+					return err
+				}
+			}
+		case "udp":
+			err := server.ListenUDP(address)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("Unsupported syslog server protocol \"%s\" (supported: tcp, udp)", protocol)
+		}
+	}
+	err := server.Boot()
 	if err != nil {
 		return err
 	}
-	server.Boot()
+	prefixedLogger.PrintVerbose("Listening for syslog messages on %s (%s)", address, protocols)
 
 	go func(ctx context.Context, server *syslog.Server, channel syslog.LogPartsChannel) {
+		assembler := newSyslogLineAssembler(func(target int, item SelfHostedLogStreamItem) {
+			targets[target].out <- item
+		})
+		ticker := time.NewTicker(syslogChunkTimeout)
+		defer ticker.Stop()
+
 		for {
 			select {
 			case logParts := <-channel:
-				item := SelfHostedLogStreamItem{}
-
-				item.OccurredAt, _ = logParts["timestamp"].(time.Time)
-
-				pidStr, _ := logParts["proc_id"].(string)
-				if s, err := strconv.ParseInt(pidStr, 10, 32); err == nil {
-					item.BackendPid = int32(s)
-				}
-
-				logLine, _ := logParts["message"].(string)
-				logLineParts := logLinePartsRegexp.FindStringSubmatch(logLine)
-				if len(logLineParts) != 0 {
-					if s, err := strconv.ParseInt(logLineParts[1], 10, 32); err == nil {
-						item.LogLineNumber = int32(s)
-					}
-					if s, err := strconv.ParseInt(logLineParts[2], 10, 32); err == nil {
-						item.LogLineNumberChunk = int32(s)
-					}
-					item.Line = logLineParts[3]
-				} else {
-					item.Line = logLine
-
-					logLineNumberStr, _ := logParts["structured_data"].(string)
-					logLineNumberParts := logLineNumberPartsRegexp.FindStringSubmatch(logLineNumberStr)
-					if len(logLineNumberParts) != 0 {
-						if s, err := strconv.ParseInt(logLineNumberParts[1], 10, 32); err == nil {
-							item.LogLineNumber = int32(s)
-						}
-						if s, err := strconv.ParseInt(logLineNumberParts[2], 10, 32); err == nil {
-							item.LogLineNumberChunk = int32(s)
-						}
-					}
+				host := syslogSourceHost(logParts)
+				target := routeSyslogMessage(targets, host, logParts)
+				if target == -1 {
+					prefixedLogger.PrintVerbose("Ignoring syslog message from unknown host %s", host)
+					continue
 				}
-
-				out <- item
-
-				// TODO: Support using the same syslog server for different source Postgres servers,
-				// and disambiguate based on logParts["client"]
+				assembler.add(target, host, syslogLogPartsToItem(logParts), time.Now())
+			case <-ticker.C:
+				assembler.flushExpired(time.Now())
 			case <-ctx.Done():
+				assembler.flushAll()
 				server.Kill()
-				break
+				return
 			}
 		}
 	}(ctx, server, channel)
 
 	return nil
 }
+
+// syslogSourceHost - Returns the hostname the message was sent from, falling back to the client IP
+func syslogSourceHost(logParts format.LogParts) string {
+	if hostname, _ := logParts["hostname"].(string); hostname != "" && hostname != "-" {
+		return hostname
+	}
+	return syslogClientIP(logParts)
+}
+
+func syslogClientIP(logParts format.LogParts) string {
+	client, _ := logParts["client"].(string)
+	if host, _, err := net.SplitHostPort(client); err == nil {
+		return host
+	}
+	return client
+}
+
+// routeSyslogMessage - Returns the index of the target the message belongs to, or -1 if none matches
+func routeSyslogMessage(targets []syslogTarget, host string, logParts format.LogParts) int {
+	if len(targets) == 1 {
+		return 0
+	}
+	clientIP := syslogClientIP(logParts)
+	for idx, target := range targets {
+		if target.config.LogSyslogServerHostname != "" && (strings.EqualFold(target.config.LogSyslogServerHostname, host) || target.config.LogSyslogServerHostname == clientIP) {
+			return idx
+		}
+	}
+	for idx, target := range targets {
+		dbHost := target.config.GetDbHost()
+		if dbHost != "" && (strings.EqualFold(dbHost, host) || dbHost == clientIP) {
+			return idx
+		}
+	}
+	return -1
+}
+
+func syslogLogPartsToItem(logParts format.LogParts) SelfHostedLogStreamItem {
+	item := SelfHostedLogStreamItem{}
+
+	item.OccurredAt, _ = logParts["timestamp"].(time.Time)
+
+	pidStr, _ := logParts["proc_id"].(string)
+	if s, err := strconv.ParseInt(pidStr, 10, 32); err == nil {
+		item.BackendPid = int32(s)
+	}
+
+	// RFC5424 has the log line in "message", RFC3164 in "content"
+	logLine, ok := logParts["message"].(string)
+	if !ok {
+		logLine, _ = logParts["content"].(string)
+	}
+	logLineParts := logLinePartsRegexp.FindStringSubmatch(logLine)
+	if len(logLineParts) != 0 {
+		if s, err := strconv.ParseInt(logLineParts[1], 10, 32); err == nil {
+			item.LogLineNumber = int32(s)
+		}
+		if s, err := strconv.ParseInt(logLineParts[2], 10, 32); err == nil {
+			item.LogLineNumberChunk = int32(s)
+		}
+		item.Line = logLineParts[3]
+	} else {
+		item.Line = logLine
+
+		logLineNumberStr, _ := logParts["structured_data"].(string)
+		logLineNumberParts := logLineNumberPartsRegexp.FindStringSubmatch(logLineNumberStr)
+		if len(logLineNumberParts) != 0 {
+			if s, err := strconv.ParseInt(logLineNumberParts[1], 10, 32); err == nil {
+				item.LogLineNumber = int32(s)
+			}
+			if s, err := strconv.ParseInt(logLineNumberParts[2], 10, 32); err == nil {
+				item.LogLineNumberChunk = int32(s)
+			}
+		}
+	}
+
+	return item
+}
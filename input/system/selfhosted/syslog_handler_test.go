@@ -0,0 +1,216 @@
+package selfhosted
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
+	"gopkg.in/mcuadros/go-syslog.v2/format"
+)
+
+var syslogParseTests = []struct {
+	name     string
+	message  string
+	expected SelfHostedLogStreamItem
+}{
+	{
+		"RFC3164",
+		"<134>Jan  1 00:00:00 db1 postgres[123]: [4-1] LOG:  statement: SELECT 1",
+		SelfHostedLogStreamItem{Line: "LOG:  statement: SELECT 1", BackendPid: 123, LogLineNumber: 4, LogLineNumberChunk: 1},
+	},
+	{
+		"RFC3164 without PID",
+		"<134>Jan  1 00:00:00 db1 postgres: LOG:  database system is ready to accept connections",
+		SelfHostedLogStreamItem{Line: "LOG:  database system is ready to accept connections"},
+	},
+	{
+		"RFC5424 with line number as structured data",
+		"<134>1 2021-01-01T00:00:00Z db1 postgres 123 - [4-1] LOG:  statement: SELECT 1",
+		SelfHostedLogStreamItem{Line: "LOG:  statement: SELECT 1", OccurredAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), BackendPid: 123, LogLineNumber: 4, LogLineNumberChunk: 1},
+	},
+	{
+		"RFC5424 with line number in the message",
+		"<134>1 2021-01-01T00:00:00Z db1 postgres 123 - - [4-2] \tat character 8",
+		SelfHostedLogStreamItem{Line: "\tat character 8", OccurredAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), BackendPid: 123, LogLineNumber: 4, LogLineNumberChunk: 2},
+	},
+}
+
+func TestSyslogLogPartsToItem(t *testing.T) {
+	for _, test := range syslogParseTests {
+		parser := (&postgresSyslogFormat{}).GetParser([]byte(test.message))
+		if err := parser.Parse(); err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		item := syslogLogPartsToItem(parser.Dump())
+		// RFC3164 timestamps don't include the year
+		if test.expected.OccurredAt.IsZero() {
+			item.OccurredAt = time.Time{}
+		}
+		if diff := pretty.Compare(test.expected, item); diff != "" {
+			t.Errorf("%s: unexpected item (-want +got)\n%s", test.name, diff)
+		}
+	}
+}
+
+// postgresSyslogChunks - Splits a message the way Postgres does before sending it to syslog
+// (see write_syslog in elog.c)
+func postgresSyslogChunks(message string) []string {
+	var chunks []string
+	for _, line := range strings.Split(message, "\n") {
+		for len(line) > 0 {
+			n := len(line)
+			if n > syslogLineLimit {
+				n = syslogLineLimit
+				for !utf8.RuneStart(line[n]) {
+					n--
+				}
+				if !strings.ContainsAny(line[n:n+1], syslogWhitespace) {
+					if i := strings.LastIndexAny(line[1:n], syslogWhitespace) + 1; i > 0 {
+						n = i
+					}
+				}
+			}
+			chunks = append(chunks, line[:n])
+			line = line[n:]
+		}
+	}
+	return chunks
+}
+
+func syslogTestWords(prefix string, minLen int) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	for i := 0; b.Len() < minLen; i++ {
+		b.WriteString(" column_")
+		b.WriteString(strings.Repeat("x", i%13))
+		b.WriteString(",")
+	}
+	return b.String()
+}
+
+var syslogReassemblyTests = []struct {
+	name    string
+	message string
+}{
+	{"short line", "LOG:  statement: SELECT 1"},
+	{"long line split by length", syslogTestWords("LOG:  statement: SELECT", 2500)},
+	{"long line split within a run of whitespace", "LOG:  statement: SELECT" + strings.Repeat(" ", 1000) + "1"},
+	{"long word split mid-word", "LOG:  statement: SELECT '" + strings.Repeat("x", 1500) + "'"},
+	{"long word split at a multibyte character", "LOG:  statement: SELECT '" + strings.Repeat("ä", 800) + "'"},
+	{"multi-line message", "ERROR:  syntax error at or near \"FROM\" at character 8\n\tSTATEMENT:  SELECT FROM"},
+	{"indented query text after a long line", syslogTestWords("LOG:  statement: SELECT", 600) + "\n    FROM metrics\n    WHERE id = 1"},
+	{"indented query text after a line close to the limit", syslogTestWords("LOG:  statement: SELECT", 880) + "\n    FROM metrics"},
+	{"long indented lines", syslogTestWords("LOG:  statement: SELECT", 1200) + "\n" + syslogTestWords("    FROM", 1900)},
+}
+
+func TestSyslogLineAssemblerReassembly(t *testing.T) {
+	for _, test := range syslogReassemblyTests {
+		var sent []SelfHostedLogStreamItem
+		assembler := newSyslogLineAssembler(func(target int, item SelfHostedLogStreamItem) {
+			sent = append(sent, item)
+		})
+		now := time.Now()
+		for i, chunk := range postgresSyslogChunks(test.message) {
+			assembler.add(0, "db1", SelfHostedLogStreamItem{Line: chunk, BackendPid: 123, LogLineNumber: 4, LogLineNumberChunk: int32(i + 1)}, now)
+		}
+		assembler.flushAll()
+
+		if len(sent) != 1 {
+			t.Errorf("%s: expected one log line, got %d", test.name, len(sent))
+			continue
+		}
+		if sent[0].Line != test.message {
+			t.Errorf("%s: expected\n%q\ngot\n%q", test.name, test.message, sent[0].Line)
+		}
+	}
+}
+
+func TestSyslogLineAssemblerOrder(t *testing.T) {
+	type sentLine struct {
+		Target int
+		Pid    int32
+		Line   string
+	}
+	var sent []sentLine
+	assembler := newSyslogLineAssembler(func(target int, item SelfHostedLogStreamItem) {
+		sent = append(sent, sentLine{target, item.BackendPid, item.Line})
+	})
+	now := time.Now()
+
+	assembler.add(0, "db1", SelfHostedLogStreamItem{Line: "ERROR:  division by zero", BackendPid: 123, LogLineNumber: 5, LogLineNumberChunk: 1}, now)
+	assembler.add(0, "db1", SelfHostedLogStreamItem{Line: "LOG:  checkpoint starting: time", BackendPid: 456, LogLineNumber: 1, LogLineNumberChunk: 1}, now)
+	assembler.add(0, "db1", SelfHostedLogStreamItem{Line: "STATEMENT:  SELECT 1/0", BackendPid: 123, LogLineNumber: 5, LogLineNumberChunk: 2}, now)
+	// A message without line numbers from the same backend is passed on after its earlier line
+	assembler.add(0, "db1", SelfHostedLogStreamItem{Line: "LOG:  unnumbered", BackendPid: 123}, now)
+	// A new line of the same backend passes on the previous one
+	assembler.add(0, "db1", SelfHostedLogStreamItem{Line: "LOG:  disconnection", BackendPid: 123, LogLineNumber: 6, LogLineNumberChunk: 1}, now)
+	assembler.add(0, "db1", SelfHostedLogStreamItem{Line: "LOG:  next", BackendPid: 123, LogLineNumber: 7, LogLineNumberChunk: 1}, now)
+	// Same PID on a different server
+	assembler.add(1, "db2", SelfHostedLogStreamItem{Line: "LOG:  other server", BackendPid: 123, LogLineNumber: 1, LogLineNumberChunk: 1}, now.Add(time.Second))
+
+	expected := []sentLine{
+		{0, 123, "ERROR:  division by zero\nSTATEMENT:  SELECT 1/0"},
+		{0, 123, "LOG:  unnumbered"},
+		{0, 123, "LOG:  disconnection"},
+	}
+	if diff := pretty.Compare(expected, sent); diff != "" {
+		t.Errorf("unexpected lines (-want +got)\n%s", diff)
+	}
+
+	sent = nil
+	assembler.flushExpired(now.Add(syslogChunkTimeout))
+	expected = []sentLine{
+		{0, 456, "LOG:  checkpoint starting: time"},
+		{0, 123, "LOG:  next"},
+	}
+	if len(sent) != len(expected) {
+		t.Fatalf("expected lines without further chunks to be passed on after the timeout, got %+v", sent)
+	}
+	for _, line := range expected {
+		found := false
+		for _, s := range sent {
+			found = found || s == line
+		}
+		if !found {
+			t.Errorf("expected %+v to be passed on after the timeout, got %+v", line, sent)
+		}
+	}
+
+	sent = nil
+	assembler.flushAll()
+	if diff := pretty.Compare([]sentLine{{1, 123, "LOG:  other server"}}, sent); diff != "" {
+		t.Errorf("unexpected lines on shutdown (-want +got)\n%s", diff)
+	}
+}
+
+func TestRouteSyslogMessage(t *testing.T) {
+	targets := []syslogTarget{
+		{config: config.ServerConfig{LogSyslogServerHostname: "db1", DbHost: "10.0.0.1"}},
+		{config: config.ServerConfig{DbHost: "10.0.0.2"}},
+		{config: config.ServerConfig{DbURL: "postgres://user@db3.example.com:5432/app"}},
+	}
+	tests := []struct {
+		name     string
+		logParts format.LogParts
+		expected int
+	}{
+		{"hostname matches syslog server hostname", format.LogParts{"hostname": "DB1", "client": "10.0.0.9:5140"}, 0},
+		{"client IP matches database host", format.LogParts{"hostname": "-", "client": "10.0.0.2:5140"}, 1},
+		{"hostname matches database URL host", format.LogParts{"hostname": "db3.example.com", "client": "10.0.0.3:5140"}, 2},
+		{"syslog server hostname takes precedence", format.LogParts{"hostname": "db1", "client": "10.0.0.2:5140"}, 0},
+		{"unknown host", format.LogParts{"hostname": "db4", "client": "10.0.0.4:5140"}, -1},
+	}
+	for _, test := range tests {
+		if idx := routeSyslogMessage(targets, syslogSourceHost(test.logParts), test.logParts); idx != test.expected {
+			t.Errorf("%s: expected target %d, got %d", test.name, test.expected, idx)
+		}
+	}
+
+	if idx := routeSyslogMessage(targets[1:2], "db4", format.LogParts{"hostname": "db4"}); idx != 0 {
+		t.Errorf("expected single target to receive all messages, got %d", idx)
+	}
+}
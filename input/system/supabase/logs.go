@@ -0,0 +1,254 @@
+package supabase
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Log drains send batches of at most a few thousand events, anything larger is rejected
+const maxLogDrainRequestSize = 32 * 1024 * 1024
+
+// How long in-flight requests may take to finish on shutdown
+const logDrainShutdownTimeout = 30 * time.Second
+
+// LogDrainEvent - Postgres log event as sent by a Supabase HTTP log drain (a JSON array of these per request)
+//
+// Depending on the log source, the structured fields are either directly in the metadata, or
+// nested in its "parsed" entries.
+type LogDrainEvent struct {
+	ID           string             `json:"id"`
+	Timestamp    int64              `json:"timestamp"` // Microseconds since epoch
+	EventMessage string             `json:"event_message"`
+	Project      string             `json:"project"`
+	Metadata     []logDrainMetadata `json:"metadata"`
+}
+
+type logDrainMetadata struct {
+	Project string               `json:"project"`
+	Parsed  []logDrainParsedLine `json:"parsed"`
+}
+
+type logDrainParsedLine struct {
+	ErrorSeverity  string `json:"error_severity"`
+	ProcessID      int32  `json:"process_id"`
+	SessionLineNum int32  `json:"session_line_num"`
+	UserName       string `json:"user_name"`
+	DatabaseName   string `json:"database_name"`
+}
+
+func (e LogDrainEvent) projectRef() string {
+	if e.Project != "" {
+		return e.Project
+	}
+	for _, metadata := range e.Metadata {
+		if metadata.Project != "" {
+			return metadata.Project
+		}
+	}
+	return ""
+}
+
+func (e LogDrainEvent) parsed() (parsed logDrainParsedLine) {
+	for _, metadata := range e.Metadata {
+		for _, p := range metadata.Parsed {
+			return p
+		}
+	}
+	return
+}
+
+// logLine - Converts the event to a log line, preferring the full message with its log_line_prefix
+// (if present), and otherwise using the structured fields
+func (e LogDrainEvent) logLine() state.LogLine {
+	logLine, ok := logs.ParseLogLineWithPrefix("", e.EventMessage)
+	if !ok {
+		parsed := e.parsed()
+		logLine = state.LogLine{
+			BackendPid:    parsed.ProcessID,
+			LogLineNumber: parsed.SessionLineNum,
+			Username:      parsed.UserName,
+			Database:      parsed.DatabaseName,
+			Content:       e.EventMessage,
+		}
+		if parsed.ErrorSeverity != "" {
+			logLine.LogLevel = pganalyze_collector.LogLineInformation_LogLevel(pganalyze_collector.LogLineInformation_LogLevel_value[strings.ToUpper(parsed.ErrorSeverity)])
+		}
+	}
+	if logLine.OccurredAt.IsZero() && e.Timestamp != 0 {
+		logLine.OccurredAt = time.Unix(0, e.Timestamp*int64(time.Microsecond))
+	}
+	logLine.CollectedAt = time.Now()
+	logLine.UUID = uuid.NewV4()
+	return logLine
+}
+
+func logDrainHandler(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, out chan<- state.ParsedLogStreamItem) http.HandlerFunc {
+	var tokens []string
+	for _, server := range servers {
+		tokens = append(tokens, "Bearer "+server.Config.SupabaseLogDrainToken)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Only POST requests are supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		authorized := false
+		authorization := []byte(r.Header.Get("Authorization"))
+		for _, token := range tokens {
+			if subtle.ConstantTimeCompare(authorization, []byte(token)) == 1 {
+				authorized = true
+			}
+		}
+		if !authorized {
+			logger.PrintWarning("Rejecting Supabase log drain request from %s: invalid token", r.RemoteAddr)
+			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		var body io.Reader = http.MaxBytesReader(w, r.Body, maxLogDrainRequestSize)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gzipReader, err := gzip.NewReader(body)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Could not decompress request body: %s", err), http.StatusBadRequest)
+				return
+			}
+			defer gzipReader.Close()
+			body = io.LimitReader(gzipReader, maxLogDrainRequestSize)
+		}
+
+		var events []LogDrainEvent
+		err := json.NewDecoder(body).Decode(&events)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Could not parse request body: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		for _, event := range events {
+			ref := event.projectRef()
+			foundServer := false
+			for _, server := range servers {
+				if ref != "" && ref != server.Config.SupabaseProjectRef {
+					continue
+				}
+				out <- state.ParsedLogStreamItem{Identifier: server.Config.Identifier, LogLine: event.logLine()}
+				foundServer = true
+				// Different databases of the same project share the log drain, only send each event once
+				break
+			}
+			if !foundServer && globalCollectionOpts.TestRun {
+				logger.PrintError("Discarding log event because of unknown project (did you set the correct supabase_project_ref?): %s", ref)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// SetupLogDrain - Starts receiving Postgres logs from Supabase HTTP log drains, on each of the configured
+// listen addresses (servers of multiple projects can share a listen address)
+func SetupLogDrain(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, parsedLogStream chan state.ParsedLogStreamItem) error {
+	serversByListenAddress := make(map[string][]*state.Server)
+	var listenAddresses []string
+	for _, server := range servers {
+		listenAddress := server.Config.SupabaseLogDrainListenAddress
+		if server.Config.DisableLogs || listenAddress == "" {
+			continue
+		}
+		if _, ok := serversByListenAddress[listenAddress]; !ok {
+			listenAddresses = append(listenAddresses, listenAddress)
+		}
+		serversByListenAddress[listenAddress] = append(serversByListenAddress[listenAddress], server)
+	}
+
+	for _, listenAddress := range listenAddresses {
+		listenServers := serversByListenAddress[listenAddress]
+		prefixedLogger := logger.WithPrefix(listenServers[0].Config.SectionName)
+		err := setupLogDrainHandler(ctx, wg, globalCollectionOpts, prefixedLogger, listenAddress, listenServers, parsedLogStream)
+		if err != nil {
+			if globalCollectionOpts.TestRun {
+				return err
+			}
+
+			prefixedLogger.PrintWarning("Skipping logs, could not set up Supabase log drain on %s: %s", listenAddress, err)
+		}
+	}
+
+	return nil
+}
+
+func setupLogDrainHandler(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, listenAddress string, servers []*state.Server, out chan<- state.ParsedLogStreamItem) error {
+	for _, server := range servers {
+		if server.Config.SupabaseLogDrainToken == "" {
+			return fmt.Errorf("supabase_log_drain_token must be set when using supabase_log_drain_listen_address")
+		}
+	}
+
+	var tlsConfig *tls.Config
+	cfg := servers[0].Config
+	if cfg.SupabaseLogDrainTLSCert != "" || cfg.SupabaseLogDrainTLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.SupabaseLogDrainTLSCert, cfg.SupabaseLogDrainTLSKey)
+		if err != nil {
+			return fmt.Errorf("Could not load log drain TLS certificate: %s", err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	// The background collector is likely already listening on the address, so
+	// test runs only verify the configuration
+	if globalCollectionOpts.TestRun {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return fmt.Errorf("Could not listen on %s: %s", listenAddress, err)
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", logDrainHandler(servers, globalCollectionOpts, logger, out))
+	server := &http.Server{Handler: mux}
+
+	logger.PrintVerbose("Listening for Supabase log drain requests on %s", listenAddress)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			logger.PrintError("Supabase log drain HTTP handler on %s failed: %s", listenAddress, err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), logDrainShutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"os"
 
 	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/input/system/azure"
 	"github.com/pganalyze/collector/input/system/crunchy_bridge"
+	"github.com/pganalyze/collector/input/system/google_cloudsql"
 	"github.com/pganalyze/collector/input/system/rds"
 	"github.com/pganalyze/collector/input/system/selfhosted"
 	"github.com/pganalyze/collector/state"
@@ -33,16 +35,19 @@ func DownloadLogFiles(server *state.Server, globalCollectionOpts state.Collectio
 // GetSystemState - Retrieves a system snapshot for this system and returns it
 func GetSystemState(config config.ServerConfig, logger *util.Logger) (system state.SystemState) {
 	dbHost := config.GetDbHost()
-	if config.SystemType == "amazon_rds" {
+	if config.DbServerless {
+		// Serverless endpoints run on shared infrastructure that doesn't expose system metrics
+		system.Info.Type = state.SelfHostedSystem
+	} else if config.SystemType == "amazon_rds" {
 		system = rds.GetSystemState(config, logger)
 	} else if config.SystemType == "google_cloudsql" {
-		system.Info.Type = state.GoogleCloudSQLSystem
+		system = google_cloudsql.GetSystemState(config, logger)
 	} else if config.SystemType == "azure_database" {
-		system.Info.Type = state.AzureDatabaseSystem
+		system = azure.GetSystemState(config, logger)
 	} else if config.SystemType == "heroku" {
 		system.Info.Type = state.HerokuSystem
 	} else if config.SystemType == "crunchy_bridge" {
-		system.Info.Type = state.CrunchyBridgeSystem
+		system = crunchy_bridge.GetSystemState(config, logger)
 	} else if dbHost == "" || dbHost == "localhost" || dbHost == "127.0.0.1" || os.Getenv("PGA_ALWAYS_COLLECT_SYSTEM_DATA") != "" {
 		system = selfhosted.GetSystemState(config, logger)
 	}
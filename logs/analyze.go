@@ -10,6 +10,7 @@ import (
 	"github.com/guregu/null"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
 )
 
 type match struct {
@@ -76,18 +77,38 @@ var autoVacuum = analyzeGroup{
 	primary: match{
 		prefixes: []string{"automatic vacuum of table", "automatic aggressive vacuum of table", "automatic aggressive vacuum to prevent wraparound of table"},
 		regexp: regexp.MustCompile(`^automatic (aggressive )?vacuum (to prevent wraparound )?of table "(.+?)": index scans: (\d+)\s*` +
-			`pages: (\d+) removed, (\d+) remain(?:, (\d+) skipped due to pins)?(?:, (\d+) skipped frozen)?\s*` +
+			`pages: (\d+) removed, (\d+) remain(?:, (\d+) skipped due to pins)?(?:, (\d+) skipped frozen)?(?:, \d+ scanned \([\d.]+% of total\))?\s*` +
 			`tuples: (\d+) removed, (\d+) remain, (\d+) are dead but not yet removable(?:, oldest xmin: (\d+))?\s*` +
+			// Postgres 15+ details are matched separately (see autoVacuumPg15Details), to keep the part numbers stable
+			`(?:tuples missed: \d+ dead from \d+ pages not removed due to cleanup lock contention\s*)?` + // Postgres 15+
+			`(?:removable cutoff: \d+, which was -?\d+ XIDs old when operation ended\s*)?` + // Postgres 15+
+			`(?:new relfrozenxid: \d+, which is -?\d+ XIDs ahead of previous value\s*)?` + // Postgres 15+
+			`(?:new relminmxid: \d+, which is -?\d+ MXIDs ahead of previous value\s*)?` + // Postgres 15+
+			`(?:frozen: \d+ pages from table \([\d.]+% of total\) had \d+ tuples frozen\s*)?` + // Postgres 16+
 			`(?:index scan (not needed|needed|bypassed|bypassed by failsafe): (\d+) pages from table \(([\d.]+)% of total\) (?:have|had) (\d+) dead item identifiers(?: removed)?)?\s*` + // Postgres 14+
+			`(?:index ".+?": pages: \d+ in total, \d+ newly deleted, \d+ currently deleted, \d+ reusable\s*)*` + // Postgres 14+
 			`(?:I/O timings: read: ([\d.]+) ms, write: ([\d.]+) ms)?\s*` + // Postgres 14+
 			`(?:avg read rate: ([\d.]+) MB/s, avg write rate: ([\d.]+) MB/s)?\s*` + // Postgres 14+
 			`buffer usage: (\d+) hits, (\d+) misses, (\d+) dirtied\s*` +
 			`(?:avg read rate: ([\d.]+) MB/s, avg write rate: ([\d.]+) MB/s)?\s*` + // Postgres 13 and older
-			`(?:WAL usage: (\d+) records, (\d+) full page images, (\d+) bytes)?\s*` + // Postgres 14+
+			`(?:WAL usage: (\d+) records, (\d+) full page images, (\d+) bytes(?:, \d+ [a-z ]+)*)?\s*` + // Postgres 14+ (with more counters on 18+)
 			`system usage: CPU(?:(?: ([\d.]+)s/([\d.]+)u sec elapsed ([\d.]+) sec)|(?:: user: ([\d.]+) s, system: ([\d.]+) s, elapsed: ([\d.]+) s))`),
 		secrets: []state.LogSecretKind{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 	},
 }
+
+// Details of the autovacuum log output that were added in Postgres 15 and 16
+var autoVacuumPg15Details = []struct {
+	regexp *regexp.Regexp
+	keys   []string
+}{
+	{regexp.MustCompile(`, (\d+) scanned \(([\d.]+)% of total\)`), []string{"scanned_pages", "scanned_pages_percent"}},
+	{regexp.MustCompile(`tuples missed: (\d+) dead from (\d+) pages not removed due to cleanup lock contention`), []string{"missed_dead_tuples", "missed_dead_pages"}},
+	{regexp.MustCompile(`removable cutoff: (\d+), which was (-?\d+) XIDs old when operation ended`), []string{"oldest_xmin", "oldest_xmin_age"}},
+	{regexp.MustCompile(`new relfrozenxid: (\d+), which is (-?\d+) XIDs ahead of previous value`), []string{"new_relfrozenxid", "relfrozenxid_advanced"}},
+	{regexp.MustCompile(`new relminmxid: (\d+), which is (-?\d+) MXIDs ahead of previous value`), []string{"new_relminmxid", "relminmxid_advanced"}},
+	{regexp.MustCompile(`frozen: (\d+) pages from table \(([\d.]+)% of total\) had (\d+) tuples frozen`), []string{"frozen_pages", "frozen_pages_percent", "tuples_frozen"}},
+}
 var autoAnalyze = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_AUTOANALYZE_COMPLETED,
 	primary: match{
@@ -109,12 +130,13 @@ var checkpointStarting = analyzeGroup{
 }
 var checkpointComplete = analyzeGroup{
 	primary: match{
-		regexp: regexp.MustCompile(`^(checkpoint|restartpoint) complete: wrote (\d+) buffers \(([\d\.]+)%\); ` +
+		regexp: regexp.MustCompile(`^(checkpoint|restartpoint) complete: wrote (\d+) buffers \(([\d\.]+)%\)(?:, wrote \d+ SLRU buffers)?; ` + // SLRU buffers on Postgres 18+
 			`(\d+) (?:transaction log|WAL) file\(s\) added, (\d+) removed, (\d+) recycled; ` +
 			`write=([\d\.]+) s, sync=([\d\.]+) s, total=([\d\.]+) s; ` +
 			`sync files=(\d+), longest=([\d\.]+) s, average=([\d\.]+) s` +
-			`(; distance=(\d+) kB, estimate=(\d+) kB)?`),
-		secrets: []state.LogSecretKind{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			`(; distance=(\d+) kB, estimate=(\d+) kB)?` +
+			`(?:; lsn=(\w+/\w+), redo lsn=(\w+/\w+))?`), // Postgres 17+
+		secrets: []state.LogSecretKind{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 	},
 }
 var checkpointsTooFrequent = analyzeGroup{
@@ -136,8 +158,8 @@ var restartpointAt = analyzeGroup{
 		secrets:  []state.LogSecretKind{0, 0},
 	},
 	detail: match{
-		prefixes: []string{"last completed transaction was at log time "},
-		regexp:   regexp.MustCompile(`^last completed transaction was at log time (\d+-\d+-\d+ \d+:\d+:\d+\.\d+[\d:+-]+)`),
+		prefixes: []string{"last completed transaction was at log time ", "Last completed transaction was at log time "},
+		regexp:   regexp.MustCompile(`^[Ll]ast completed transaction was at log time (\d+-\d+-\d+ \d+:\d+:\d+\.\d+[\d:+-]+)`), // Capitalized on Postgres 15+
 		secrets:  []state.LogSecretKind{0},
 	},
 }
@@ -153,8 +175,16 @@ var connectionAuthorized = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
 	primary: match{
 		prefixes: []string{"connection authorized: "},
-		regexp:   regexp.MustCompile(`^connection authorized: user=\w+( database=\w+)?( application_name=.+)?( SSL enabled \(protocol=([\w.]+), cipher=[\w-]+, compression=\w+\))?`),
-		secrets:  []state.LogSecretKind{0, 0, 0, 0},
+		regexp:   regexp.MustCompile(`^connection authorized: user=(\S+)(?: database=(\S+))?( application_name=.+?)?( SSL enabled \(protocol=([\w.]+), cipher=[\w-]+(?:, bits=\d+)?(?:, compression=\w+)?\))?\s*$`),
+		secrets:  []state.LogSecretKind{0, 0, 0, 0, 0},
+	},
+}
+var connectionAuthenticated = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
+	primary: match{
+		prefixes: []string{"connection authenticated: "},
+		regexp:   regexp.MustCompile(`^connection authenticated: identity="(.*)" method=([\w-]+) \((.+):(\d+)\)`),
+		secrets:  []state.LogSecretKind{0, 0, state.OpsLogSecret, 0},
 	},
 }
 var connectionRejected = analyzeGroup{
@@ -334,6 +364,29 @@ var walBaseBackupComplete = analyzeGroup{
 		prefixes: []string{"pg_stop_backup complete, all required WAL segments have been archived"},
 	},
 }
+var replicationSlotInvalidated = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+	primary: match{
+		prefixes: []string{"invalidating obsolete replication slot ", "invalidating slot "},
+		regexp:   regexp.MustCompile(`^invalidating (?:obsolete replication slot "(.+?)"|slot "(.+?)" because its restart_lsn (\w+/\w+) exceeds max_slot_wal_keep_size)`),
+		secrets:  []state.LogSecretKind{0, 0, 0},
+	},
+	detail: match{ // Postgres 16+
+		regexp:  regexp.MustCompile(`^(?:The slot's restart_lsn (\w+/\w+) exceeds the limit by (\d+) bytes\.|The slot conflicted with xid horizon (\d+)\.|Logical decoding on standby requires "?wal_level"? >= "?logical"? on the primary server\.)`),
+		secrets: []state.LogSecretKind{0, 0, 0},
+	},
+	hint: match{
+		regexp: regexp.MustCompile(`^You might need to increase "?max_slot_wal_keep_size"?\.`),
+	},
+}
+var replicationSlotInvalidationTerminating = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+	primary: match{
+		prefixes: []string{"terminating process "},
+		regexp:   regexp.MustCompile(`^terminating process (\d+) to (?:invalidate|release) replication slot "(.+?)"`),
+		secrets:  []state.LogSecretKind{0, 0},
+	},
+}
 var lockAcquired = analyzeGroup{
 	primary: match{
 		prefixes: []string{"process"},
@@ -360,13 +413,48 @@ var deadlock = analyzeGroup{
 		secrets:  []state.LogSecretKind{},
 	},
 	detail: match{
-		regexp:  regexp.MustCompile(`(?m)^Process (\d+)(?: waits for \w+ on transaction \d+; blocked by process \d+.\s+|: (.+))`),
-		secrets: []state.LogSecretKind{0, state.StatementTextLogSecret},
+		regexp:  regexp.MustCompile(`(?m)^Process (\d+)(?: waits for (\w+) on (.+?); blocked by process (\d+)\.\s*|: (.+))`),
+		secrets: []state.LogSecretKind{0, 0, 0, 0, state.StatementTextLogSecret},
 	},
 	hint: match{
 		prefixes: []string{"See server log for query details."},
 	},
 }
+
+var deadlockLockTargetRegexp = regexp.MustCompile(`^(?:(transaction) (\d+)|(virtual transaction) ([\d/]+)|(advisory lock) \[[\d,]+\]|(?:(relation)|(extension) of relation|(page) \d+ of relation|(tuple) \(\d+,\d+\) of relation) (\d+) of database (\d+)|(object) \d+ of class \d+ of database (\d+))$`)
+
+// deadlockLockTarget - Returns the lock type (as in pg_locks.locktype) and the OIDs or
+// transaction ID of the lock a deadlock participant waits for (e.g. "relation 16385 of database 16384")
+func deadlockLockTarget(target string) map[string]interface{} {
+	parts := deadlockLockTargetRegexp.FindStringSubmatch(target)
+	if parts == nil {
+		return map[string]interface{}{}
+	}
+	details := map[string]interface{}{}
+	switch {
+	case parts[1] != "":
+		details["lock_type"] = "transactionid"
+		details["transaction_id"], _ = strconv.ParseInt(parts[2], 10, 64)
+	case parts[3] != "":
+		details["lock_type"] = "virtualxid"
+		details["virtual_transaction_id"] = parts[4]
+	case parts[5] != "":
+		details["lock_type"] = "advisory"
+	case parts[12] != "":
+		details["lock_type"] = "object"
+		details["database_oid"], _ = strconv.ParseInt(parts[13], 10, 64)
+	default:
+		for idx, lockType := range map[int]string{6: "relation", 7: "extend", 8: "page", 9: "tuple"} {
+			if parts[idx] != "" {
+				details["lock_type"] = lockType
+			}
+		}
+		details["relation_oid"], _ = strconv.ParseInt(parts[10], 10, 64)
+		details["database_oid"], _ = strconv.ParseInt(parts[11], 10, 64)
+	}
+	return details
+}
+
 var lockTimeout = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_LOCK_TIMEOUT,
 	primary: match{
@@ -386,6 +474,20 @@ var wraparoundWarning = analyzeGroup{
 		secrets:  []state.LogSecretKind{0},
 	},
 }
+var vacuumFailsafe = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_TXID_WRAPAROUND_WARNING,
+	primary: match{
+		prefixes: []string{"bypassing nonessential maintenance of table "},
+		regexp:   regexp.MustCompile(`^bypassing nonessential maintenance of table "(.+?)" as a failsafe after (\d+) index scans`),
+		secrets:  []state.LogSecretKind{0, 0},
+	},
+	detail: match{
+		prefixes: []string{"The table's relfrozenxid or relminmxid is too far in the past."},
+	},
+	hint: match{
+		regexp: regexp.MustCompile(`^Consider increasing configuration parameter "\w+" or "\w+"\.\s+You might also need to consider other ways for VACUUM to keep up with the allocation of transaction IDs\.`),
+	},
+}
 var wraparoundError = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_TXID_WRAPAROUND_ERROR,
 	primary: match{
@@ -455,12 +557,25 @@ var serverOutOfMemoryCrash = analyzeGroup{
 		secrets:  []state.LogSecretKind{0, 0, 0},
 	},
 }
+var serverOutOfMemorySharedMemory = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_OUT_OF_MEMORY,
+	primary: match{
+		prefixes: []string{"could not map anonymous shared memory"},
+		regexp:   regexp.MustCompile(`^could not map anonymous shared memory: ([\w ]+)`),
+		secrets:  []state.LogSecretKind{0},
+	},
+	hint: match{
+		regexp:  regexp.MustCompile(`^This error usually means that PostgreSQL's request for a shared memory segment exceeded available memory, swap space, or huge pages. To reduce the request size \(currently (\d+) bytes\), reduce PostgreSQL's shared memory usage, perhaps by reducing "?shared_buffers"? or "?max_connections"?\.`),
+		secrets: []state.LogSecretKind{0},
+	},
+}
 var serverStart = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_SERVER_START,
 	primary: match{
 		prefixes: []string{
 			"database system is ready to accept connections",
 			"database system is ready to accept read only connections",
+			"database system is ready to accept read-only connections",
 			"MultiXact member wraparound protections are now enabled",
 			"entering standby mode",
 			"redirecting log output to logging collector process",
@@ -506,6 +621,14 @@ var temporaryFile = analyzeGroup{
 		secrets:  []state.LogSecretKind{0, 0},
 	},
 }
+var serverMiscHugePagesDisabled = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+	primary: match{
+		prefixes: []string{"mmap(", "CreateFileMapping("},
+		regexp:   regexp.MustCompile(`^(?:mmap|CreateFileMapping)\((\d+)\) with (?:MAP_HUGETLB|SEC_LARGE_PAGES) failed, huge pages disabled(?:: ([\w ]+))?`),
+		secrets:  []state.LogSecretKind{0, 0},
+	},
+}
 var serverMiscCouldNotOpenUsermap = analyzeGroup{
 	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
 	primary: match{
@@ -1140,6 +1263,33 @@ var otherContextPatterns = []match{
 	},
 }
 
+// Node state changes, as logged by the pg_auto_failover monitor when keepers report in
+var pgAutoFailoverNewState = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+	primary: match{
+		prefixes: []string{"New state for node "},
+		regexp:   regexp.MustCompile(`^New state for node (\d+) "([^"]*)" \(([^:]+):(\d+)\): (\w+) (?:➜|->) (\w+)`),
+		secrets:  []state.LogSecretKind{0, 0, 0, 0, 0, 0},
+	},
+}
+var pgAutoFailoverGoalState = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+	primary: match{
+		prefixes:      []string{"Setting goal state of node "},
+		regexp:        regexp.MustCompile(`^Setting goal state of node (\d+) "([^"]*)" \(([^:]+):(\d+)\) to (\w+)`),
+		secrets:       []state.LogSecretKind{0, 0, 0, 0, 0},
+		remainderKind: state.OpsLogSecret, // Reason for the change, which may reference other nodes
+	},
+}
+var pgAutoFailoverHealth = analyzeGroup{
+	classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+	primary: match{
+		prefixes: []string{"Node "},
+		regexp:   regexp.MustCompile(`^Node (\d+) "([^"]*)" \(([^:]+):(\d+)\) is marked as (healthy|unhealthy) by the monitor`),
+		secrets:  []state.LogSecretKind{0, 0, 0, 0, 0},
+	},
+}
+
 type autoExplainJSONPlanDetails struct {
 	QueryText string                 `json:"Query Text"`
 	Plan      map[string]interface{} `json:"Plan"`
@@ -1163,6 +1313,7 @@ func AnalyzeLogLines(logLinesIn []state.LogLine) (logLinesOut []state.LogLine, s
 			logLinesOut = append(logLinesOut, logLine)
 		}
 		for _, sample := range backendSamples {
+			sample.Tags = util.ParseQueryTags(sample.Query)
 			samples = append(samples, sample)
 		}
 	}
@@ -1170,11 +1321,23 @@ func AnalyzeLogLines(logLinesIn []state.LogLine) (logLinesOut []state.LogLine, s
 	return
 }
 
+func pgAutoFailoverNodeDetails(parts []string) map[string]interface{} {
+	nodeID, _ := strconv.ParseInt(parts[1], 10, 64)
+	nodePort, _ := strconv.ParseInt(parts[4], 10, 32)
+	return map[string]interface{}{
+		"pg_auto_failover_node_id": nodeID,
+		"node_name":                parts[2],
+		"node_host":                parts[3],
+		"node_port":                nodePort,
+	}
+}
+
 func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, detailLine state.LogLine, contextLine state.LogLine, hintLine state.LogLine, samples []state.PostgresQuerySample) (state.LogLine, state.LogLine, state.LogLine, state.LogLine, state.LogLine, []state.PostgresQuerySample) {
 	var parts []string
 
 	// Generic handlers
 	groupX := []analyzeGroup{
+		connectionAuthenticated,
 		connectionRejected,
 		authenticationFailed,
 		databaseNotAcceptingConnections,
@@ -1195,11 +1358,14 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 		walRedo,
 		archiverProcessExited,
 		walBaseBackupComplete,
+		replicationSlotInvalidationTerminating,
 		lockTimeout,
 		statementCanceledUser,
 		statementCanceledTimeout,
 		serverCrashedOtherProcesses,
 		serverOutOfMemory,
+		serverOutOfMemorySharedMemory,
+		serverMiscHugePagesDisabled,
 		serverMiscCouldNotOpenUsermap,
 		serverMiscCouldNotLinkFile,
 		serverMiscUnexpectedAddr,
@@ -1271,6 +1437,71 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 		}
 	}
 
+	// Localized messages (lc_messages other than English)
+	for _, m := range localizedGroups {
+		if matchesPrefix(logLine, m.primary.prefixes) {
+			logLine, parts = matchLogLine(logLine, m.primary)
+			if parts != nil {
+				logLine.Classification = m.classification
+				contextLine = matchOtherContextLogLine(contextLine)
+				return logLine, statementLine, detailLine, contextLine, hintLine, samples
+			}
+		}
+	}
+	for _, m := range localizedConstraintViolations {
+		if matchesPrefix(logLine, m.primary.prefixes) {
+			logLine, parts = matchLogLine(logLine, m.primary)
+			if parts != nil {
+				logLine.Classification = m.classification
+				detailLine, _ = matchLogLine(detailLine, m.detail)
+				statementLine = markLineAsSecret(statementLine, state.StatementTextLogSecret)
+				contextLine = matchOtherContextLogLine(contextLine)
+				return logLine, statementLine, detailLine, contextLine, hintLine, samples
+			}
+		}
+	}
+
+	// Audit events (pgaudit)
+	if logLine, ok := analyzePgauditLine(logLine); ok {
+		contextLine = matchOtherContextLogLine(contextLine)
+		return logLine, statementLine, detailLine, contextLine, hintLine, samples
+	}
+
+	// PgBouncer connection events (when PgBouncer logs to the same syslog server or file)
+	if logLine, ok := analyzePgBouncerLine(logLine); ok {
+		return logLine, statementLine, detailLine, contextLine, hintLine, samples
+	}
+
+	// pg_auto_failover monitor
+	if matchesPrefix(logLine, pgAutoFailoverNewState.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, pgAutoFailoverNewState.primary)
+		if len(parts) == 7 {
+			logLine.Classification = pgAutoFailoverNewState.classification
+			logLine.Details = pgAutoFailoverNodeDetails(parts)
+			logLine.Details["previous_state"] = parts[5]
+			logLine.Details["reported_state"] = parts[6]
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, pgAutoFailoverGoalState.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, pgAutoFailoverGoalState.primary)
+		if len(parts) == 6 {
+			logLine.Classification = pgAutoFailoverGoalState.classification
+			logLine.Details = pgAutoFailoverNodeDetails(parts)
+			logLine.Details["goal_state"] = parts[5]
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+	if matchesPrefix(logLine, pgAutoFailoverHealth.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, pgAutoFailoverHealth.primary)
+		if len(parts) == 6 {
+			logLine.Classification = pgAutoFailoverHealth.classification
+			logLine.Details = pgAutoFailoverNodeDetails(parts)
+			logLine.Details["health"] = parts[5]
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+
 	// Connects/Disconnects
 	if matchesPrefix(logLine, connectionReceived.primary.prefixes) {
 		logLine.Classification = connectionReceived.classification
@@ -1284,8 +1515,17 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 	if matchesPrefix(logLine, connectionAuthorized.primary.prefixes) {
 		logLine.Classification = connectionAuthorized.classification
 		logLine, parts = matchLogLine(logLine, connectionAuthorized.primary)
-		if len(parts) == 5 && parts[4] != "" {
-			logLine.Details = map[string]interface{}{"ssl_protocol": parts[4]}
+		if len(parts) == 6 {
+			// Usually already known from log_line_prefix, but needed for the login counts otherwise
+			if logLine.Username == "" {
+				logLine.Username = parts[1]
+			}
+			if logLine.Database == "" {
+				logLine.Database = parts[2]
+			}
+			if parts[5] != "" {
+				logLine.Details = map[string]interface{}{"ssl_protocol": parts[5]}
+			}
 		}
 		contextLine = matchOtherContextLogLine(contextLine)
 		return logLine, statementLine, detailLine, contextLine, hintLine, samples
@@ -1319,7 +1559,7 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 		}
 
 		logLine, parts = matchLogLine(logLine, checkpointComplete.primary)
-		if len(parts) == 16 {
+		if len(parts) == 18 {
 			if parts[1] == "checkpoint" {
 				logLine.Classification = pganalyze_collector.LogLineInformation_CHECKPOINT_COMPLETE
 			} else if parts[1] == "restartpoint" {
@@ -1355,6 +1595,12 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 				estimateKb, _ := strconv.ParseInt(parts[15], 10, 64)
 				logLine.Details["estimate_kb"] = estimateKb
 			}
+
+			// Postgres 17 and newer
+			if parts[16] != "" {
+				logLine.Details["lsn"] = parts[16]
+				logLine.Details["redo_lsn"] = parts[17]
+			}
 			contextLine = matchOtherContextLogLine(contextLine)
 			return logLine, statementLine, detailLine, contextLine, hintLine, samples
 		}
@@ -1404,6 +1650,41 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 		}
 	}
 
+	if matchesPrefix(logLine, replicationSlotInvalidated.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, replicationSlotInvalidated.primary)
+		if len(parts) == 4 {
+			logLine.Classification = replicationSlotInvalidated.classification
+			logLine.Details = map[string]interface{}{}
+			if parts[1] != "" {
+				logLine.Details["slot_name"] = parts[1]
+			} else {
+				// Postgres 13 to 15 only invalidate slots for exceeding max_slot_wal_keep_size
+				logLine.Details["slot_name"] = parts[2]
+				logLine.Details["restart_lsn"] = parts[3]
+				logLine.Details["reason"] = "wal_removed"
+			}
+			var detailParts []string
+			detailLine, detailParts = matchLogLine(detailLine, replicationSlotInvalidated.detail)
+			if len(detailParts) == 4 {
+				if detailParts[1] != "" {
+					excessBytes, _ := strconv.ParseInt(detailParts[2], 10, 64)
+					logLine.Details["reason"] = "wal_removed"
+					logLine.Details["restart_lsn"] = detailParts[1]
+					logLine.Details["excess_bytes"] = excessBytes
+				} else if detailParts[3] != "" {
+					xidHorizon, _ := strconv.ParseInt(detailParts[3], 10, 64)
+					logLine.Details["reason"] = "rows_removed"
+					logLine.Details["xid_horizon"] = xidHorizon
+				} else {
+					logLine.Details["reason"] = "wal_level_insufficient"
+				}
+			}
+			hintLine, _ = matchLogLine(hintLine, replicationSlotInvalidated.hint)
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
+
 	// Lock waits
 	if matchesPrefix(logLine, lockAcquired.primary.prefixes) {
 		logLine, parts = matchLogLine(logLine, lockAcquired.primary)
@@ -1470,9 +1751,31 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 		logLine.RelatedPids = []int32{}
 		var allParts [][]string
 		detailLine, allParts = matchLogLineAll(detailLine, deadlock.detail)
+		participants := []map[string]interface{}{}
+		participantsByPid := make(map[int32]map[string]interface{})
 		for _, parts = range allParts {
 			pid, _ := strconv.ParseInt(parts[1], 10, 32)
 			logLine.RelatedPids = append(logLine.RelatedPids, int32(pid))
+			participant, ok := participantsByPid[int32(pid)]
+			if !ok {
+				participant = map[string]interface{}{"pid": int32(pid)}
+				participantsByPid[int32(pid)] = participant
+				participants = append(participants, participant)
+			}
+			if parts[2] != "" {
+				blockedBy, _ := strconv.ParseInt(parts[4], 10, 32)
+				participant["lock_mode"] = parts[2]
+				participant["blocked_by_pid"] = int32(blockedBy)
+				for key, value := range deadlockLockTarget(parts[3]) {
+					participant[key] = value
+				}
+			} else {
+				// Only the normalized query is kept, since the details are not subject to filter_log_secret
+				participant["query"] = util.NormalizeQuery(parts[5], "unparsable", -1)
+			}
+		}
+		if len(participants) > 0 {
+			logLine.Details = map[string]interface{}{"participants": participants}
 		}
 		hintLine, _ = matchLogLineAll(hintLine, deadlock.hint)
 		contextLine = matchOtherContextLogLine(contextLine)
@@ -1648,6 +1951,26 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 			return logLine, statementLine, detailLine, contextLine, hintLine, samples
 		}
 	}
+	if matchesPrefix(logLine, vacuumFailsafe.primary.prefixes) {
+		logLine, parts = matchLogLine(logLine, vacuumFailsafe.primary)
+		if len(parts) == 3 {
+			logLine.Classification = vacuumFailsafe.classification
+			subParts := strings.SplitN(parts[1], ".", 3)
+			logLine.Database = subParts[0]
+			if len(subParts) >= 2 {
+				logLine.SchemaName = subParts[1]
+			}
+			if len(subParts) >= 3 {
+				logLine.RelationName = subParts[2]
+			}
+			numIndexScans, _ := strconv.ParseInt(parts[2], 10, 64)
+			logLine.Details = map[string]interface{}{"num_index_scans": numIndexScans}
+			detailLine, _ = matchLogLine(detailLine, vacuumFailsafe.detail)
+			hintLine, _ = matchLogLine(hintLine, vacuumFailsafe.hint)
+			contextLine = matchOtherContextLogLine(contextLine)
+			return logLine, statementLine, detailLine, contextLine, hintLine, samples
+		}
+	}
 	if matchesPrefix(logLine, wraparoundError.primary.prefixes) {
 		logLine, parts = matchLogLine(logLine, wraparoundError.primary)
 		if len(parts) == 4 {
@@ -1781,6 +2104,21 @@ func classifyAndSetDetails(logLine state.LogLine, statementLine state.LogLine, d
 				logLine.Details["wal_fpi"] = walFpi
 				logLine.Details["wal_bytes"] = walBytes
 			}
+			for _, d := range autoVacuumPg15Details {
+				detailParts := d.regexp.FindStringSubmatch(logLine.Content)
+				if detailParts == nil {
+					continue
+				}
+				for idx, key := range d.keys {
+					if strings.HasSuffix(key, "_percent") {
+						value, _ := strconv.ParseFloat(detailParts[idx+1], 64)
+						logLine.Details[key] = value
+					} else {
+						value, _ := strconv.ParseInt(detailParts[idx+1], 10, 64)
+						logLine.Details[key] = value
+					}
+				}
+			}
 			contextLine = matchOtherContextLogLine(contextLine)
 			return logLine, statementLine, detailLine, contextLine, hintLine, samples
 		}
@@ -18,6 +18,295 @@ type testpair struct {
 }
 
 var tests = []testpair{
+	// Audit events (pgaudit)
+	{
+		[]state.LogLine{{
+			Content:  "AUDIT: SESSION,1,1,READ,SELECT,TABLE,public.account,\"SELECT * FROM account WHERE id = 1\",<not logged>",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Query:    "SELECT * FROM account WHERE id = 1",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			Details: map[string]interface{}{
+				"audit_type":      "SESSION",
+				"statement_id":    "1",
+				"substatement_id": "1",
+				"class":           "READ",
+				"command":         "SELECT",
+				"object_type":     "TABLE",
+				"object_name":     "public.account",
+			},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 52,
+				ByteEnd:   88,
+				Kind:      state.StatementTextLogSecret,
+			}},
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "AUDIT: SESSION,2,1,DDL,CREATE TABLE,,,CREATE TABLE account (id int),<not logged>",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Query:    "CREATE TABLE account (id int)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			Details: map[string]interface{}{
+				"audit_type":      "SESSION",
+				"statement_id":    "2",
+				"substatement_id": "1",
+				"class":           "DDL",
+				"command":         "CREATE TABLE",
+			},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 38,
+				ByteEnd:   67,
+				Kind:      state.StatementTextLogSecret,
+			}},
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "AUDIT: OBJECT,3,1,WRITE,INSERT,TABLE,\"public.\"\"a,b\"\"\",\"INSERT INTO \"\"a,b\"\" (id, name)\n  VALUES ($1, $2)\",\"1,Alice\",1\n",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Query:    "INSERT INTO \"a,b\" (id, name)\n  VALUES ($1, $2)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			Details: map[string]interface{}{
+				"audit_type":      "OBJECT",
+				"statement_id":    "3",
+				"substatement_id": "1",
+				"class":           "WRITE",
+				"command":         "INSERT",
+				"object_type":     "TABLE",
+				"object_name":     "public.\"a,b\"",
+				"rows":            int64(1),
+			},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 54,
+				ByteEnd:   104,
+				Kind:      state.StatementTextLogSecret,
+			}, {
+				ByteStart: 105,
+				ByteEnd:   114,
+				Kind:      state.StatementParameterLogSecret,
+			}},
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "AUDIT: SESSION,4,2,READ,SELECT,,,<previously logged>,<none>\n",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			Details: map[string]interface{}{
+				"audit_type":      "SESSION",
+				"statement_id":    "4",
+				"substatement_id": "2",
+				"class":           "READ",
+				"command":         "SELECT",
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	// Localized messages (lc_messages)
+	{
+		[]state.LogLine{{
+			Content:  "doppelter Schlüsselwert verletzt Unique-Constraint »test_pkey«",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+		}, {
+			Content:  "Schlüssel »(id)=(1)« existiert bereits.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "INSERT INTO test (id) VALUES (1)",
+			LogLevel: pganalyze_collector.LogLineInformation_STATEMENT,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			Query:              "INSERT INTO test (id) VALUES (1)",
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 19,
+				ByteEnd:   20,
+				Kind:      state.TableDataLogSecret,
+			}},
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_STATEMENT,
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 0,
+				ByteEnd:   32,
+				Kind:      state.StatementTextLogSecret,
+			}},
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "リレーション\"foo\"は存在しません",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_RELATION_DOES_NOT_EXIST,
+			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	// PgBouncer connection events
+	{
+		[]state.LogLine{{
+			Content:  "C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 login attempt: db=mydb user=myuser tls=no",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 closing because: password authentication failed (age=0s)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 pooler error: password authentication failed",
+			LogLevel: pganalyze_collector.LogLineInformation_WARNING,
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_CONNECTION_RECEIVED,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Database:       "mydb",
+			Username:       "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "client",
+				"client_addr":     "10.0.0.12:53418",
+			},
+			ReviewedForSecrets: true,
+		}, {
+			Classification: pganalyze_collector.LogLineInformation_CONNECTION_REJECTED,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Database:       "mydb",
+			Username:       "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "client",
+				"client_addr":     "10.0.0.12:53418",
+				"reason":          "password authentication failed",
+				"age_secs":        int64(0),
+			},
+			ReviewedForSecrets: true,
+		}, {
+			Classification: pganalyze_collector.LogLineInformation_CONNECTION_REJECTED,
+			LogLevel:       pganalyze_collector.LogLineInformation_WARNING,
+			Database:       "mydb",
+			Username:       "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "client",
+				"client_addr":     "10.0.0.12:53418",
+				"reason":          "password authentication failed",
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "C-0x55f6c0b8a1e0: (nodb)/(nouser)@10.0.0.12:53420 closing because: no more connections allowed (max_client_conn) (age=0s)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "C-0x55f6c0b8a2f0: mydb/myuser@10.0.0.12:53422 closing because: query_wait_timeout (age=120s)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "client",
+				"client_addr":     "10.0.0.12:53420",
+				"reason":          "no more connections allowed (max_client_conn)",
+				"age_secs":        int64(0),
+			},
+			ReviewedForSecrets: true,
+		}, {
+			Classification: pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Database:       "mydb",
+			Username:       "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "client",
+				"client_addr":     "10.0.0.12:53422",
+				"reason":          "query_wait_timeout",
+				"age_secs":        int64(120),
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "S-0x55f6c0b91c40: mydb/myuser@10.0.0.5:5432 closing because: server conn crashed? (age=3600s)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "S-0x55f6c0b91d50: mydb/myuser@10.0.0.5:5432 closing because: server idle timeout (age=600s)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "S-0x55f6c0b91e60: mydb/myuser@10.0.0.5:5432 new connection to server (from 10.0.0.2:41234)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_CONNECTION_LOST,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Database:       "mydb",
+			Username:       "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "server",
+				"server_addr":     "10.0.0.5:5432",
+				"reason":          "server conn crashed?",
+				"age_secs":        int64(3600),
+			},
+			ReviewedForSecrets: true,
+		}, {
+			Classification: pganalyze_collector.LogLineInformation_CONNECTION_DISCONNECTED,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Database:       "mydb",
+			Username:       "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "server",
+				"server_addr":     "10.0.0.5:5432",
+				"reason":          "server idle timeout",
+				"age_secs":        int64(600),
+			},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			Database: "mydb",
+			Username: "myuser",
+			Details: map[string]interface{}{
+				"pooler":          "pgbouncer",
+				"connection_type": "server",
+				"server_addr":     "10.0.0.5:5432",
+			},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 44,
+				ByteEnd:   90,
+				Kind:      state.UnidentifiedLogSecret,
+			}},
+		}},
+		nil,
+	},
 	// Statement duration
 	{
 		[]state.LogLine{{
@@ -294,12 +583,16 @@ var tests = []testpair{
 			},
 			ReviewedForSecrets: true,
 		}, {
+			Username:       "myuser",
+			Database:       "mydb",
 			Classification: pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
 			Details: map[string]interface{}{
 				"ssl_protocol": "TLSv1.2",
 			},
 			ReviewedForSecrets: true,
 		}, {
+			Username:           "myuser",
+			Database:           "myuser",
 			Classification:     pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
 			ReviewedForSecrets: true,
 		}, {
@@ -587,6 +880,23 @@ var tests = []testpair{
 		}},
 		nil,
 	},
+	{ // Postgres 17+ syntax (with LSN and redo LSN)
+		[]state.LogLine{{
+			Content: "checkpoint complete: wrote 4523 buffers (27.6%); 0 WAL file(s) added, 0 removed, 2 recycled; write=269.942 s, sync=0.012 s, total=270.025 s; sync files=39, longest=0.004 s, average=0.001 s; distance=31622 kB, estimate=39193 kB; lsn=0/2AF7F6B0, redo lsn=0/2A1B3C28",
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_CHECKPOINT_COMPLETE,
+			Details: map[string]interface{}{
+				"bufs_written_pct": 27.6, "write_secs": 269.942, "sync_secs": 0.012,
+				"total_secs": 270.025, "longest_secs": 0.004, "average_secs": 0.001,
+				"bufs_written": 4523, "segs_added": 0, "segs_removed": 0, "segs_recycled": 2,
+				"sync_rels": 39, "distance_kb": 31622, "estimate_kb": 39193,
+				"lsn": "0/2AF7F6B0", "redo_lsn": "0/2A1B3C28",
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
 	{ // Pre 10 syntax (WAL instead of transaction files)
 		[]state.LogLine{{
 			Content: "checkpoint complete: wrote 111906 buffers (10.9%); 0 transaction log file(s) added, 22 removed, 29 recycled; write=215.895 s, sync=0.014 s, total=216.130 s; sync files=94, longest=0.014 s, average=0.000 s; distance=850730 kB, estimate=910977 kB",
@@ -1024,10 +1334,29 @@ var tests = []testpair{
 			LogLevel: pganalyze_collector.LogLineInformation_STATEMENT,
 		}},
 		[]state.LogLine{{
-			LogLevel:           pganalyze_collector.LogLineInformation_ERROR,
-			Classification:     pganalyze_collector.LogLineInformation_LOCK_DEADLOCK_DETECTED,
-			Query:              "INSERT INTO x (id, name, email) VALUES (1, 'ABC', 'abc@example.com') ON CONFLICT(email) DO UPDATE SET name = excluded.name RETURNING id",
-			UUID:               uuid.UUID{1},
+			LogLevel:       pganalyze_collector.LogLineInformation_ERROR,
+			Classification: pganalyze_collector.LogLineInformation_LOCK_DEADLOCK_DETECTED,
+			Query:          "INSERT INTO x (id, name, email) VALUES (1, 'ABC', 'abc@example.com') ON CONFLICT(email) DO UPDATE SET name = excluded.name RETURNING id",
+			UUID:           uuid.UUID{1},
+			Details: map[string]interface{}{
+				"participants": []map[string]interface{}{{
+					"pid":            9788,
+					"lock_mode":      "ShareLock",
+					"lock_type":      "transactionid",
+					"transaction_id": 1035,
+					"blocked_by_pid": 91,
+				}, {
+					"pid":            91,
+					"lock_mode":      "ShareLock",
+					"lock_type":      "transactionid",
+					"transaction_id": 1045,
+					"blocked_by_pid": 98,
+					"query":          "<unparsable query>",
+				}, {
+					"pid":   98,
+					"query": "<unparsable query>",
+				}},
+			},
 			RelatedPids:        []int32{9788, 91, 98, 91},
 			ReviewedForSecrets: true,
 		}, {
@@ -1056,6 +1385,65 @@ var tests = []testpair{
 			ParentUUID: uuid.UUID{1},
 		}},
 		nil,
+	}, {
+		[]state.LogLine{{
+			Content:  "deadlock detected",
+			LogLevel: pganalyze_collector.LogLineInformation_ERROR,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content: "Process 1234 waits for AccessExclusiveLock on relation 16385 of database 16384; blocked by process 5678." +
+				"\nProcess 5678 waits for ExclusiveLock on tuple (0,1) of relation 16390 of database 16384; blocked by process 1234." +
+				"\nProcess 1234: LOCK TABLE a" +
+				"\nProcess 5678: UPDATE b SET x = 1 WHERE id = 2",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "See server log for query details.",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			LogLevel:       pganalyze_collector.LogLineInformation_ERROR,
+			Classification: pganalyze_collector.LogLineInformation_LOCK_DEADLOCK_DETECTED,
+			UUID:           uuid.UUID{1},
+			Details: map[string]interface{}{
+				"participants": []map[string]interface{}{{
+					"pid":            1234,
+					"lock_mode":      "AccessExclusiveLock",
+					"lock_type":      "relation",
+					"relation_oid":   16385,
+					"database_oid":   16384,
+					"blocked_by_pid": 5678,
+					"query":          "LOCK TABLE a",
+				}, {
+					"pid":            5678,
+					"lock_mode":      "ExclusiveLock",
+					"lock_type":      "tuple",
+					"relation_oid":   16390,
+					"database_oid":   16384,
+					"blocked_by_pid": 1234,
+					"query":          "UPDATE b SET x = $1 WHERE id = $2",
+				}},
+			},
+			RelatedPids:        []int32{1234, 5678, 1234, 5678},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 233,
+				ByteEnd:   245,
+				Kind:      state.StatementTextLogSecret,
+			}, {
+				ByteStart: 260,
+				ByteEnd:   291,
+				Kind:      state.StatementTextLogSecret,
+			}},
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
 	}, {
 		[]state.LogLine{{
 			Content:  "process 663 still waiting for ShareLock on virtual transaction 2/7 after 1000.123 ms",
@@ -1536,6 +1924,69 @@ var tests = []testpair{
 		}},
 		nil,
 	},
+	{ // Postgres 16+ syntax
+		[]state.LogLine{{
+			Content: "automatic vacuum of table \"mydb.public.pgbench_accounts\": index scans: 1\n" +
+				"	pages: 0 removed, 16394 remain, 16394 scanned (100.00% of total)\n" +
+				"	tuples: 10 removed, 1000000 remain, 0 are dead but not yet removable\n" +
+				"	tuples missed: 2 dead from 1 pages not removed due to cleanup lock contention\n" +
+				"	removable cutoff: 760, which was 0 XIDs old when operation ended\n" +
+				"	new relfrozenxid: 758, which is 3 XIDs ahead of previous value\n" +
+				"	frozen: 12 pages from table (0.07% of total) had 640 tuples frozen\n" +
+				"	index scan needed: 1 pages from table (0.01% of total) had 10 dead item identifiers removed\n" +
+				"	index \"pgbench_accounts_pkey\": pages: 2745 in total, 0 newly deleted, 0 currently deleted, 0 reusable\n" +
+				"	avg read rate: 0.000 MB/s, avg write rate: 0.214 MB/s\n" +
+				"	buffer usage: 35232 hits, 0 misses, 3 dirtied\n" +
+				"	WAL usage: 5 records, 3 full page images, 25038 bytes\n" +
+				"	system usage: CPU: user: 0.01 s, system: 0.00 s, elapsed: 0.08 s",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_AUTOVACUUM_COMPLETED,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Database:       "mydb",
+			SchemaName:     "public",
+			RelationName:   "pgbench_accounts",
+			Details: map[string]interface{}{
+				"aggressive":               false,
+				"anti_wraparound":          false,
+				"num_index_scans":          1,
+				"pages_removed":            0,
+				"rel_pages":                16394,
+				"scanned_pages":            16394,
+				"scanned_pages_percent":    100.0,
+				"tuples_deleted":           10,
+				"new_rel_tuples":           1000000,
+				"new_dead_tuples":          0,
+				"missed_dead_tuples":       2,
+				"missed_dead_pages":        1,
+				"oldest_xmin":              760,
+				"oldest_xmin_age":          0,
+				"new_relfrozenxid":         758,
+				"relfrozenxid_advanced":    3,
+				"frozen_pages":             12,
+				"frozen_pages_percent":     0.07,
+				"tuples_frozen":            640,
+				"lpdead_index_scan":        "needed",
+				"lpdead_item_pages":        1,
+				"lpdead_item_page_percent": 0.01,
+				"lpdead_items":             10,
+				"read_rate_mb":             0,
+				"write_rate_mb":            0.214,
+				"vacuum_page_hit":          35232,
+				"vacuum_page_miss":         0,
+				"vacuum_page_dirty":        3,
+				"wal_records":              5,
+				"wal_fpi":                  3,
+				"wal_bytes":                25038,
+				"rusage_user":              0.01,
+				"rusage_kernel":            0.00,
+				"elapsed_secs":             0.08,
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
 	{
 		[]state.LogLine{{
 			Content: "automatic aggressive vacuum of table \"demo_pgbench.public.pgbench_tellers\": index scans: 0" +
@@ -2000,6 +2451,58 @@ var tests = []testpair{
 			ReviewedForSecrets: true,
 		}},
 		nil,
+	}, {
+		[]state.LogLine{{
+			Content:  "New state for node 2 \"node_2\" (10.0.0.2:5432): catchingup ➜ secondary",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "Setting goal state of node 1 \"node_1\" (10.0.0.1:5432) to primary after node 2 \"node_2\" (10.0.0.2:5432) converged to secondary.",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "Node 1 \"node_1\" (10.0.0.1:5432) is marked as unhealthy by the monitor",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+			Details: map[string]interface{}{
+				"pg_auto_failover_node_id": int64(2),
+				"node_name":                "node_2",
+				"node_host":                "10.0.0.2",
+				"node_port":                int64(5432),
+				"previous_state":           "catchingup",
+				"reported_state":           "secondary",
+			},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+			Details: map[string]interface{}{
+				"pg_auto_failover_node_id": int64(1),
+				"node_name":                "node_1",
+				"node_host":                "10.0.0.1",
+				"node_port":                int64(5432),
+				"goal_state":               "primary",
+			},
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 64,
+				ByteEnd:   126,
+				Kind:      state.OpsLogSecret,
+			}},
+		}, {
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+			Details: map[string]interface{}{
+				"pg_auto_failover_node_id": int64(1),
+				"node_name":                "node_1",
+				"node_host":                "10.0.0.1",
+				"node_port":                int64(5432),
+				"health":                   "unhealthy",
+			},
+			ReviewedForSecrets: true,
+		}},
+		nil,
 	}, {
 		[]state.LogLine{{
 			Content:  "out of memory",
@@ -3583,6 +4086,181 @@ var tests = []testpair{
 				"          Index Cond: (pgbench_branches.bid = 59)",
 		}},
 	},
+	// Postgres 15+ events
+	{
+		[]state.LogLine{{
+			Content:  "database system is ready to accept read-only connections",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "connection authorized: user=myuser database=mydb application_name=psql SSL enabled (protocol=TLSv1.3, cipher=TLS_AES_256_GCM_SHA384, bits=256)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "connection authenticated: identity=\"myuser\" method=scram-sha-256 (/etc/postgresql/16/main/pg_hba.conf:102)",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_START,
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			ReviewedForSecrets: true,
+		}, {
+			Username:           "myuser",
+			Database:           "mydb",
+			Classification:     pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			Details:            map[string]interface{}{"ssl_protocol": "TLSv1.3"},
+			ReviewedForSecrets: true,
+		}, {
+			Classification:     pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			ReviewedForSecrets: true,
+			SecretMarkers: []state.LogSecretMarker{{
+				ByteStart: 66,
+				ByteEnd:   101,
+				Kind:      state.OpsLogSecret,
+			}},
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "invalidating obsolete replication slot \"logical_slot\"",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "The slot's restart_lsn 0/4A000060 exceeds the limit by 16777120 bytes.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "You might need to increase \"max_slot_wal_keep_size\".",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			UUID:           uuid.UUID{1},
+			Details: map[string]interface{}{
+				"slot_name":    "logical_slot",
+				"reason":       "wal_removed",
+				"restart_lsn":  "0/4A000060",
+				"excess_bytes": 16777120,
+			},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "invalidating obsolete replication slot \"standby_slot\"",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "The slot conflicted with xid horizon 748.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content:  "invalidating slot \"old_slot\" because its restart_lsn 0/1A2B3C4D exceeds max_slot_wal_keep_size",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}, {
+			Content:  "terminating process 12345 to invalidate replication slot \"standby_slot\"",
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+		}},
+		[]state.LogLine{{
+			Classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			UUID:           uuid.UUID{1},
+			Details: map[string]interface{}{
+				"slot_name":   "standby_slot",
+				"reason":      "rows_removed",
+				"xid_horizon": 748,
+			},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}, {
+			Classification: pganalyze_collector.LogLineInformation_SERVER_MISC,
+			LogLevel:       pganalyze_collector.LogLineInformation_LOG,
+			Details: map[string]interface{}{
+				"slot_name":   "old_slot",
+				"reason":      "wal_removed",
+				"restart_lsn": "0/1A2B3C4D",
+			},
+			ReviewedForSecrets: true,
+		}, {
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_MISC,
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "bypassing nonessential maintenance of table \"mydb.public.events\" as a failsafe after 2 index scans",
+			LogLevel: pganalyze_collector.LogLineInformation_WARNING,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "The table's relfrozenxid or relminmxid is too far in the past.",
+			LogLevel: pganalyze_collector.LogLineInformation_DETAIL,
+		}, {
+			Content: "Consider increasing configuration parameter \"maintenance_work_mem\" or \"autovacuum_work_mem\".\n" +
+				"You might also need to consider other ways for VACUUM to keep up with the allocation of transaction IDs.",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_TXID_WRAPAROUND_WARNING,
+			LogLevel:           pganalyze_collector.LogLineInformation_WARNING,
+			UUID:               uuid.UUID{1},
+			Database:           "mydb",
+			SchemaName:         "public",
+			RelationName:       "events",
+			Details:            map[string]interface{}{"num_index_scans": 2},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_DETAIL,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
+	{
+		[]state.LogLine{{
+			Content:  "mmap(1157627904) with MAP_HUGETLB failed, huge pages disabled: Cannot allocate memory",
+			LogLevel: pganalyze_collector.LogLineInformation_DEBUG,
+		}, {
+			Content:  "could not map anonymous shared memory: Cannot allocate memory",
+			LogLevel: pganalyze_collector.LogLineInformation_FATAL,
+			UUID:     uuid.UUID{1},
+		}, {
+			Content:  "This error usually means that PostgreSQL's request for a shared memory segment exceeded available memory, swap space, or huge pages. To reduce the request size (currently 1157627904 bytes), reduce PostgreSQL's shared memory usage, perhaps by reducing \"shared_buffers\" or \"max_connections\".",
+			LogLevel: pganalyze_collector.LogLineInformation_HINT,
+		}},
+		[]state.LogLine{{
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_MISC,
+			LogLevel:           pganalyze_collector.LogLineInformation_DEBUG,
+			ReviewedForSecrets: true,
+		}, {
+			Classification:     pganalyze_collector.LogLineInformation_SERVER_OUT_OF_MEMORY,
+			LogLevel:           pganalyze_collector.LogLineInformation_FATAL,
+			UUID:               uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}, {
+			LogLevel:           pganalyze_collector.LogLineInformation_HINT,
+			ParentUUID:         uuid.UUID{1},
+			ReviewedForSecrets: true,
+		}},
+		nil,
+	},
 	// pganalyze-collector-identify
 	{
 		[]state.LogLine{{
@@ -0,0 +1,91 @@
+package logs
+
+import (
+	"strconv"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// Columns of log_destination = csvlog output, see
+// https://www.postgresql.org/docs/current/runtime-config-logging.html#RUNTIME-CONFIG-LOGGING-CSVLOG
+//
+// Postgres 13 added backend_type, and Postgres 14 added leader_pid and query_id at the
+// end, the columns up to application_name are the same in all supported versions.
+const (
+	csvLogTime = iota
+	csvUserName
+	csvDatabaseName
+	csvProcessID
+	csvConnectionFrom
+	csvSessionID
+	csvSessionLineNum
+	csvCommandTag
+	csvSessionStartTime
+	csvVirtualTransactionID
+	csvTransactionID
+	csvErrorSeverity
+	csvSqlStateCode
+	csvMessage
+	csvDetail
+	csvHint
+	csvInternalQuery
+	csvInternalQueryPos
+	csvContext
+	csvQuery
+	csvQueryPos
+	csvLocation
+	csvApplicationName
+	csvBackendType
+	csvLeaderPid
+	csvQueryID
+)
+
+// CsvLogColumnNames - Names of the csvlog columns, in order (as used e.g. by CloudNativePG,
+// which logs csvlog records as JSON objects)
+var CsvLogColumnNames = []string{
+	"log_time", "user_name", "database_name", "process_id", "connection_from", "session_id",
+	"session_line_num", "command_tag", "session_start_time", "virtual_transaction_id",
+	"transaction_id", "error_severity", "sql_state_code", "message", "detail", "hint",
+	"internal_query", "internal_query_pos", "context", "query", "query_pos", "location",
+	"application_name", "backend_type", "leader_pid", "query_id",
+}
+
+// Columns up to (and including) application_name
+const csvMinColumns = csvBackendType
+
+// ParseCsvLogRecord - Converts a csvlog record into log lines (see structuredLogLines)
+func ParseCsvLogRecord(record []string) (logLines []state.LogLine, ok bool) {
+	if len(record) < csvMinColumns {
+		return nil, false
+	}
+
+	level, ok := structuredLogLevel(record[csvErrorSeverity])
+	if !ok {
+		return nil, false
+	}
+
+	occurredAt, err := parseStructuredLogTime(record[csvLogTime])
+	if err != nil {
+		return nil, false
+	}
+
+	base := state.LogLine{
+		OccurredAt:  occurredAt,
+		Username:    record[csvUserName],
+		Database:    record[csvDatabaseName],
+		Application: record[csvApplicationName],
+	}
+	backendPid, _ := strconv.ParseInt(record[csvProcessID], 10, 32)
+	base.BackendPid = int32(backendPid)
+	logLineNumber, _ := strconv.ParseInt(record[csvSessionLineNum], 10, 32)
+	base.LogLineNumber = int32(logLineNumber)
+	if len(record) > csvBackendType {
+		base.BackendType = record[csvBackendType]
+	}
+	if len(record) > csvQueryID {
+		base.QueryID, _ = strconv.ParseInt(record[csvQueryID], 10, 64)
+	}
+
+	logLines = structuredLogLines(base, level, record[csvMessage], record[csvDetail], record[csvHint], record[csvInternalQuery], record[csvContext], record[csvQuery])
+	return logLines, true
+}
@@ -0,0 +1,76 @@
+package logs
+
+import (
+	"strings"
+)
+
+// AutoExplainJSONScanner - Tracks whether an auto_explain plan in JSON format is complete, as
+// its parts get added (e.g. when the plan is split across multiple syslog messages)
+//
+// This only follows the nesting of objects and arrays (and strings, which may contain braces),
+// and doesn't validate the JSON otherwise.
+type AutoExplainJSONScanner struct {
+	depth    int
+	inString bool
+	escaped  bool
+}
+
+// NewAutoExplainJSONScanner - Starts scanning the log line content if it's an auto_explain plan
+// in JSON format, returns false otherwise
+func NewAutoExplainJSONScanner(content string) (*AutoExplainJSONScanner, bool) {
+	if !strings.HasPrefix(content, "duration: ") {
+		return nil, false
+	}
+	loc := autoExplain.primary.regexp.FindStringIndex(content)
+	if loc == nil || !strings.HasPrefix(content[loc[1]:], "{") {
+		return nil, false
+	}
+	s := &AutoExplainJSONScanner{}
+	s.Scan(content[loc[1]:])
+	return s, true
+}
+
+// Scan - Continues scanning with the next part of the plan
+func (s *AutoExplainJSONScanner) Scan(text string) {
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if s.inString {
+			if s.escaped {
+				s.escaped = false
+			} else if c == '\\' {
+				s.escaped = true
+			} else if c == '"' {
+				s.inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			s.inString = true
+		case '{', '[':
+			s.depth++
+		case '}', ']':
+			s.depth--
+		}
+	}
+}
+
+// Complete - Whether all objects and arrays of the plan have been closed
+func (s *AutoExplainJSONScanner) Complete() bool {
+	return s.depth <= 0 && !s.inString
+}
+
+// InString - Whether the text scanned so far ends inside a JSON string
+//
+// Postgres escapes line breaks in JSON strings, so a line break at that point was added when
+// the message was split (e.g. into separate log entries), and needs to be removed when stitching.
+func (s *AutoExplainJSONScanner) InString() bool {
+	return s.inString
+}
+
+// IsIncompleteAutoExplainJSON - Whether the log line content is the beginning of an auto_explain
+// plan in JSON format, whose remainder hasn't been added yet
+func IsIncompleteAutoExplainJSON(content string) bool {
+	s, ok := NewAutoExplainJSONScanner(content)
+	return ok && !s.Complete() && !strings.HasSuffix(strings.TrimSpace(content), "[Your log message was truncated]")
+}
@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// jsonLogRecord - The fields of log_destination = jsonlog output (Postgres 15+) that are
+// relevant to us, see
+// https://www.postgresql.org/docs/current/runtime-config-logging.html#RUNTIME-CONFIG-LOGGING-JSONLOG
+//
+// Fields without a value are left out by Postgres.
+type jsonLogRecord struct {
+	Timestamp       string `json:"timestamp"`
+	User            string `json:"user"`
+	DbName          string `json:"dbname"`
+	Pid             int32  `json:"pid"`
+	LineNum         int32  `json:"line_num"`
+	ErrorSeverity   string `json:"error_severity"`
+	Message         string `json:"message"`
+	Detail          string `json:"detail"`
+	Hint            string `json:"hint"`
+	InternalQuery   string `json:"internal_query"`
+	Context         string `json:"context"`
+	Statement       string `json:"statement"`
+	ApplicationName string `json:"application_name"`
+	BackendType     string `json:"backend_type"`
+	QueryID         int64  `json:"query_id"`
+}
+
+// ParseJsonLogLine - Converts a jsonlog line into log lines (see structuredLogLines), returns
+// false if this isn't a jsonlog line
+func ParseJsonLogLine(line string) (logLines []state.LogLine, ok bool) {
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+
+	var record jsonLogRecord
+	if err := json.Unmarshal([]byte(line), &record); err != nil {
+		return nil, false
+	}
+
+	level, ok := structuredLogLevel(record.ErrorSeverity)
+	if !ok || record.Timestamp == "" {
+		return nil, false
+	}
+
+	occurredAt, err := parseStructuredLogTime(record.Timestamp)
+	if err != nil {
+		return nil, false
+	}
+
+	base := state.LogLine{
+		OccurredAt:    occurredAt,
+		Username:      record.User,
+		Database:      record.DbName,
+		Application:   record.ApplicationName,
+		BackendPid:    record.Pid,
+		LogLineNumber: record.LineNum,
+		BackendType:   record.BackendType,
+		QueryID:       record.QueryID,
+	}
+
+	logLines = structuredLogLines(base, level, record.Message, record.Detail, record.Hint, record.InternalQuery, record.Context, record.Statement)
+	return logLines, true
+}
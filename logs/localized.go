@@ -0,0 +1,302 @@
+package logs
+
+import (
+	"regexp"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// Postgres translates log messages, including the log level, when lc_messages is set to a
+// language other than English (e.g. de_DE or ja_JP). To classify these log lines, and to check
+// them for secrets, the translated message templates from the Postgres message catalogs are
+// matched in addition to the English ones.
+//
+// Only the most common messages are translated here. Since the translations of different
+// languages don't overlap, all of them are tried, independent of the server's lc_messages.
+
+// localizedLogLevels - Translated log level names, and the English name they map to
+var localizedLogLevels = map[string]string{
+	// German (de)
+	"FEHLER":       "ERROR",
+	"WARNUNG":      "WARNING",
+	"HINWEIS":      "NOTICE",
+	"PANIK":        "PANIC",
+	"TIPP":         "HINT",
+	"ZUSAMMENHANG": "CONTEXT",
+	"ANWEISUNG":    "STATEMENT",
+	"ANFRAGE":      "QUERY",
+
+	// French (fr)
+	"ERREUR":      "ERROR",
+	"ATTENTION":   "WARNING",
+	"DÉTAIL":      "DETAIL",
+	"ASTUCE":      "HINT",
+	"CONTEXTE":    "CONTEXT",
+	"INSTRUCTION": "STATEMENT",
+	"REQUÊTE":     "QUERY",
+
+	// Spanish (es)
+	"ADVERTENCIA": "WARNING",
+	"NOTA":        "NOTICE",
+	"DETALLE":     "DETAIL",
+	"SUGERENCIA":  "HINT",
+	"CONTEXTO":    "CONTEXT",
+	"SENTENCIA":   "STATEMENT",
+	"CONSULTA":    "QUERY",
+
+	// Japanese (ja), which keeps the names of the error levels untranslated
+	"詳細":    "DETAIL",
+	"ヒント":   "HINT",
+	"文脈":    "CONTEXT",
+	"文":     "STATEMENT",
+	"問い合わせ": "QUERY",
+}
+
+// logLevelValue - Returns the log level for the (possibly translated) log level name
+func logLevelValue(levelName string) (pganalyze_collector.LogLineInformation_LogLevel, bool) {
+	if englishName, ok := localizedLogLevels[levelName]; ok {
+		levelName = englishName
+	}
+	level, ok := pganalyze_collector.LogLineInformation_LogLevel_value[levelName]
+	return pganalyze_collector.LogLineInformation_LogLevel(level), ok
+}
+
+// Translations of the generic handlers (see classifyAndSetDetails), with the secrets in the same
+// order as the English message
+var localizedGroups = []analyzeGroup{
+	// German (de)
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_REJECTED,
+		primary: match{
+			prefixes: []string{"Passwort-Authentifizierung für Benutzer", "kein pg_hba.conf-Eintrag für"},
+			regexp:   regexp.MustCompile(`^(?:Passwort-Authentifizierung für Benutzer »([^«]+)« fehlgeschlagen|kein pg_hba.conf-Eintrag für Host »[^«]+«, Benutzer »[^«]+«, Datenbank »[^«]+«(, SSL an|, SSL aus)?)`),
+			secrets:  []state.LogSecretKind{0, 0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_REJECTED,
+		primary: match{
+			prefixes: []string{"Rolle »"},
+			regexp:   regexp.MustCompile(`^Rolle »([^«]+)« hat keine Berechtigung zum Einloggen`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_LOST_OPEN_TX,
+		primary: match{
+			prefixes: []string{"unerwartetes EOF auf Client-Verbindung mit einer offenen Transaktion"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_LOST,
+		primary: match{
+			prefixes: []string{"unerwartetes EOF auf Client-Verbindung", "Verbindung zum Client wurde verloren"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_LOST,
+		primary: match{
+			prefixes: []string{"konnte Daten vom Client nicht empfangen", "konnte Daten nicht an den Client senden"},
+			regexp:   regexp.MustCompile(`^konnte Daten (?:vom Client nicht empfangen|nicht an den Client senden): [\pL ]+`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_TERMINATED,
+		primary: match{
+			prefixes: []string{"Verbindung wird abgebrochen aufgrund von Befehl des Administrators"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS,
+		primary: match{
+			prefixes: []string{"tut mir leid, schon zu viele Verbindungen"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_TOO_MANY_CONNECTIONS_ROLE,
+		primary: match{
+			prefixes: []string{"zu viele Verbindungen für Rolle"},
+			regexp:   regexp.MustCompile(`^zu viele Verbindungen für Rolle »([^«]+)«`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_TOO_MANY_CONNECTIONS_DATABASE,
+		primary: match{
+			prefixes: []string{"zu viele Verbindungen für Datenbank"},
+			regexp:   regexp.MustCompile(`^zu viele Verbindungen für Datenbank »([^«]+)«`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_STATEMENT_CANCELED_TIMEOUT,
+		primary: match{
+			prefixes: []string{"storniere Anfrage wegen Zeitüberschreitung der Anweisung"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_STATEMENT_CANCELED_USER,
+		primary: match{
+			prefixes: []string{"storniere Anfrage wegen Benutzeraufforderung"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_SERVER_START,
+		primary: match{
+			prefixes: []string{"Datenbanksystem ist bereit, um Verbindungen anzunehmen"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_SYNTAX_ERROR,
+		primary: match{
+			prefixes: []string{"Syntaxfehler "},
+			regexp:   regexp.MustCompile(`^Syntaxfehler (?:am Ende der Eingabe|bei »(.+)«)(?: bei Zeichen \d+)?`),
+			secrets:  []state.LogSecretKind{state.ParsingErrorLogSecret},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_RELATION_DOES_NOT_EXIST,
+		primary: match{
+			prefixes: []string{"Relation »"},
+			regexp:   regexp.MustCompile(`^Relation »([^«]+)« existiert nicht(?: bei Zeichen \d+)?`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_COLUMN_DOES_NOT_EXIST,
+		primary: match{
+			prefixes: []string{"Spalte »"},
+			regexp:   regexp.MustCompile(`^Spalte »([^«]+)« existiert nicht(?: bei Zeichen \d+)?`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_PERMISSION_DENIED,
+		primary: match{
+			prefixes: []string{"keine Berechtigung für"},
+			regexp:   regexp.MustCompile(`^keine Berechtigung für (?:Spalte|Relation|Tabelle|Sequenz|Sicht|Datenbank|Funktion|Typ|Sprache|Schema|Tablespace|Erweiterung) ([\w_-]+)(?: bei Zeichen \d+)?`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_TRANSACTION_IS_ABORTED,
+		primary: match{
+			prefixes: []string{"aktuelle Transaktion wurde abgebrochen, Befehle werden bis zum Ende der Transaktion ignoriert"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_DIVISION_BY_ZERO,
+		primary: match{
+			prefixes: []string{"Division durch Null"},
+		},
+	},
+
+	// Japanese (ja)
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_REJECTED,
+		primary: match{
+			prefixes: []string{"ユーザー\""},
+			regexp:   regexp.MustCompile(`^ユーザー"([^"]+)"のパスワード認証に失敗しました`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_CONNECTION_TERMINATED,
+		primary: match{
+			prefixes: []string{"管理者コマンドにより接続を終了しています"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS,
+		primary: match{
+			prefixes: []string{"現在クライアント数が多すぎます"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_STATEMENT_CANCELED_TIMEOUT,
+		primary: match{
+			prefixes: []string{"ステートメントのタイムアウトのためステートメントをキャンセルしています"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_SERVER_START,
+		primary: match{
+			prefixes: []string{"データベースシステムの接続受け付け準備が整いました"},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_SYNTAX_ERROR,
+		primary: match{
+			prefixes: []string{"\"", "入力の最後で構文エラー"},
+			regexp:   regexp.MustCompile(`^(?:"(.+)"またはその近辺で構文エラー|入力の最後で構文エラー)`),
+			secrets:  []state.LogSecretKind{state.ParsingErrorLogSecret},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_RELATION_DOES_NOT_EXIST,
+		primary: match{
+			prefixes: []string{"リレーション\""},
+			regexp:   regexp.MustCompile(`^リレーション"([^"]+)"は存在しません`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_COLUMN_DOES_NOT_EXIST,
+		primary: match{
+			prefixes: []string{"列\""},
+			regexp:   regexp.MustCompile(`^列"([^"]+)"は存在しません`),
+			secrets:  []state.LogSecretKind{0},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_TRANSACTION_IS_ABORTED,
+		primary: match{
+			prefixes: []string{"現在のトランザクションがアボートしました"},
+		},
+	},
+}
+
+// Translations of the constraint violations, whose details (and statement) contain table data
+var localizedConstraintViolations = []analyzeGroup{
+	// German (de)
+	{
+		classification: pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+		primary: match{
+			prefixes: []string{"doppelter Schlüsselwert verletzt Unique-Constraint"},
+			regexp:   regexp.MustCompile(`^doppelter Schlüsselwert verletzt Unique-Constraint »(.+)«`),
+			secrets:  []state.LogSecretKind{0},
+		},
+		detail: match{
+			regexp:  regexp.MustCompile(`^Schlüssel »\((.+)\)=\((.+)\)« existiert bereits.`),
+			secrets: []state.LogSecretKind{0, state.TableDataLogSecret},
+		},
+	},
+	{
+		classification: pganalyze_collector.LogLineInformation_NOT_NULL_CONSTRAINT_VIOLATION,
+		primary: match{
+			prefixes: []string{"NULL-Wert in Spalte"},
+			regexp:   regexp.MustCompile(`^NULL-Wert in Spalte »(.+?)«(?: von Relation ».+?«)? verletzt Not-Null-Constraint`),
+			secrets:  []state.LogSecretKind{0},
+		},
+		detail: match{
+			regexp:  regexp.MustCompile(`^Fehlgeschlagene Zeile enthält \((.+)\).`),
+			secrets: []state.LogSecretKind{state.TableDataLogSecret},
+		},
+	},
+
+	// Japanese (ja)
+	{
+		classification: pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+		primary: match{
+			prefixes: []string{"重複したキー値は一意性制約"},
+			regexp:   regexp.MustCompile(`^重複したキー値は一意性制約"(.+)"違反となります`),
+			secrets:  []state.LogSecretKind{0},
+		},
+		detail: match{
+			regexp:  regexp.MustCompile(`^キー \((.+)\)=\((.+)\) はすでに存在します。`),
+			secrets: []state.LogSecretKind{0, state.TableDataLogSecret},
+		},
+	},
+}
@@ -0,0 +1,130 @@
+package logs
+
+import (
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// AddLogMetrics - Sums up the checkpoint, autovacuum and connection activity from the analyzed
+// log lines, which is useful where the statistics views are not accessible
+//
+// Temporary files are summed up by query fingerprint, based on the STATEMENT line, or else the
+// query ID (looked up in queryFingerprints).
+func AddLogMetrics(metrics *state.LogMetrics, logLines []state.LogLine, queryFingerprints map[int64]uint64) {
+	for _, logLine := range logLines {
+		switch logLine.Classification {
+		case pganalyze_collector.LogLineInformation_CHECKPOINT_COMPLETE, pganalyze_collector.LogLineInformation_RESTARTPOINT_COMPLETE:
+			if logLine.Details == nil {
+				continue
+			}
+			metrics.Checkpoints++
+			metrics.CheckpointBufsWritten += detailInt(logLine, "bufs_written")
+			metrics.CheckpointWriteSecs += detailFloat(logLine, "write_secs")
+			metrics.CheckpointSyncSecs += detailFloat(logLine, "sync_secs")
+			metrics.CheckpointTotalSecs += detailFloat(logLine, "total_secs")
+		case pganalyze_collector.LogLineInformation_AUTOVACUUM_COMPLETED:
+			if logLine.Details == nil {
+				continue
+			}
+			metrics.Autovacuums++
+			if antiWraparound, _ := logLine.Details["anti_wraparound"].(bool); antiWraparound {
+				metrics.AutovacuumsAntiWraparound++
+			}
+			metrics.AutovacuumPagesRemoved += detailInt(logLine, "pages_removed")
+			metrics.AutovacuumTuplesDeleted += detailInt(logLine, "tuples_deleted")
+			metrics.AutovacuumFrozenPages += detailInt(logLine, "frozen_pages")
+			metrics.AutovacuumTuplesFrozen += detailInt(logLine, "tuples_frozen")
+			metrics.AutovacuumWalBytes += detailInt(logLine, "wal_bytes")
+			metrics.AutovacuumElapsedSecs += detailFloat(logLine, "elapsed_secs")
+		case pganalyze_collector.LogLineInformation_CONNECTION_RECEIVED:
+			metrics.ConnectionsReceived++
+		case pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED:
+			metrics.ConnectionsAuthorized++
+			if metrics.LoginsByDatabaseRole == nil {
+				metrics.LoginsByDatabaseRole = make(map[state.LogMetricsDatabaseRole]int64)
+			}
+			metrics.LoginsByDatabaseRole[state.LogMetricsDatabaseRole{Database: logLine.Database, Username: logLine.Username}]++
+		case pganalyze_collector.LogLineInformation_CONNECTION_REJECTED:
+			metrics.ConnectionsRejected++
+		case pganalyze_collector.LogLineInformation_CONNECTION_DISCONNECTED:
+			metrics.Disconnections++
+			metrics.SessionSecs += detailFloat(logLine, "session_time_secs")
+		case pganalyze_collector.LogLineInformation_SERVER_TEMP_FILE_CREATED:
+			if logLine.Details == nil {
+				continue
+			}
+			key := state.LogMetricsTempFileQuery{Database: logLine.Database}
+			if logLine.Query != "" {
+				key.Fingerprint = util.FingerprintQuery(logLine.Query, "none", -1)
+			} else if fp, ok := queryFingerprints[logLine.QueryID]; ok && logLine.QueryID != 0 {
+				key.Fingerprint = fp
+			}
+			if metrics.TempFilesByQuery == nil {
+				metrics.TempFilesByQuery = make(map[state.LogMetricsTempFileQuery]state.LogMetricsTempFiles)
+			}
+			tempFiles := metrics.TempFilesByQuery[key]
+			tempFiles.Files++
+			tempFiles.Bytes += detailInt(logLine, "size")
+			metrics.TempFilesByQuery[key] = tempFiles
+		}
+	}
+}
+
+// MergeLogMetrics - Adds log metrics that were taken out for a snapshot back in, in case the
+// snapshot failed to be sent
+func MergeLogMetrics(metrics *state.LogMetrics, other state.LogMetrics) {
+	if other.Since.IsZero() {
+		return
+	}
+	if metrics.Since.IsZero() || other.Since.Before(metrics.Since) {
+		metrics.Since = other.Since
+	}
+
+	metrics.Checkpoints += other.Checkpoints
+	metrics.CheckpointBufsWritten += other.CheckpointBufsWritten
+	metrics.CheckpointWriteSecs += other.CheckpointWriteSecs
+	metrics.CheckpointSyncSecs += other.CheckpointSyncSecs
+	metrics.CheckpointTotalSecs += other.CheckpointTotalSecs
+
+	metrics.Autovacuums += other.Autovacuums
+	metrics.AutovacuumsAntiWraparound += other.AutovacuumsAntiWraparound
+	metrics.AutovacuumPagesRemoved += other.AutovacuumPagesRemoved
+	metrics.AutovacuumTuplesDeleted += other.AutovacuumTuplesDeleted
+	metrics.AutovacuumFrozenPages += other.AutovacuumFrozenPages
+	metrics.AutovacuumTuplesFrozen += other.AutovacuumTuplesFrozen
+	metrics.AutovacuumWalBytes += other.AutovacuumWalBytes
+	metrics.AutovacuumElapsedSecs += other.AutovacuumElapsedSecs
+
+	metrics.ConnectionsReceived += other.ConnectionsReceived
+	metrics.ConnectionsAuthorized += other.ConnectionsAuthorized
+	metrics.ConnectionsRejected += other.ConnectionsRejected
+	metrics.Disconnections += other.Disconnections
+	metrics.SessionSecs += other.SessionSecs
+
+	for key, count := range other.LoginsByDatabaseRole {
+		if metrics.LoginsByDatabaseRole == nil {
+			metrics.LoginsByDatabaseRole = make(map[state.LogMetricsDatabaseRole]int64)
+		}
+		metrics.LoginsByDatabaseRole[key] += count
+	}
+	for key, files := range other.TempFilesByQuery {
+		if metrics.TempFilesByQuery == nil {
+			metrics.TempFilesByQuery = make(map[state.LogMetricsTempFileQuery]state.LogMetricsTempFiles)
+		}
+		f := metrics.TempFilesByQuery[key]
+		f.Files += files.Files
+		f.Bytes += files.Bytes
+		metrics.TempFilesByQuery[key] = f
+	}
+}
+
+func detailInt(logLine state.LogLine, key string) int64 {
+	value, _ := logLine.Details[key].(int64)
+	return value
+}
+
+func detailFloat(logLine state.LogLine, key string) float64 {
+	value, _ := logLine.Details[key].(float64)
+	return value
+}
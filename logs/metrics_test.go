@@ -0,0 +1,156 @@
+package logs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+func TestAddLogMetrics(t *testing.T) {
+	var metrics state.LogMetrics
+	logLines := []state.LogLine{{
+		Classification: pganalyze_collector.LogLineInformation_CHECKPOINT_COMPLETE,
+		Details:        map[string]interface{}{"bufs_written": int64(100), "write_secs": 1.5, "sync_secs": 0.25, "total_secs": 2.0},
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_RESTARTPOINT_COMPLETE,
+		Details:        map[string]interface{}{"bufs_written": int64(20), "write_secs": 0.5, "sync_secs": 0.25, "total_secs": 1.0},
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_AUTOVACUUM_COMPLETED,
+		Details: map[string]interface{}{
+			"anti_wraparound": true, "pages_removed": int64(3), "tuples_deleted": int64(1000),
+			"frozen_pages": int64(7), "tuples_frozen": int64(700), "wal_bytes": int64(4096), "elapsed_secs": 0.5,
+		},
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_AUTOVACUUM_COMPLETED,
+		Details:        map[string]interface{}{"anti_wraparound": false, "tuples_deleted": int64(10), "elapsed_secs": 0.25},
+	}, {
+		// Lines that failed to parse the details are not counted
+		Classification: pganalyze_collector.LogLineInformation_AUTOVACUUM_COMPLETED,
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_STATEMENT_DURATION,
+		Details:        map[string]interface{}{"duration_ms": 1.0},
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_CONNECTION_RECEIVED,
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_CONNECTION_AUTHORIZED,
+		Username:       "app",
+		Database:       "mydb",
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_CONNECTION_REJECTED,
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_CONNECTION_DISCONNECTED,
+		Details:        map[string]interface{}{"session_time_secs": 12.5},
+	}}
+
+	logs.AddLogMetrics(&metrics, logLines, nil)
+	logs.AddLogMetrics(&metrics, logLines[:1], nil)
+	logs.AddLogMetrics(&metrics, logLines[len(logLines)-3:len(logLines)-2], nil)
+
+	expected := state.LogMetrics{
+		Checkpoints:               3,
+		CheckpointBufsWritten:     220,
+		CheckpointWriteSecs:       3.5,
+		CheckpointSyncSecs:        0.75,
+		CheckpointTotalSecs:       5.0,
+		Autovacuums:               2,
+		AutovacuumsAntiWraparound: 1,
+		AutovacuumPagesRemoved:    3,
+		AutovacuumTuplesDeleted:   1010,
+		AutovacuumFrozenPages:     7,
+		AutovacuumTuplesFrozen:    700,
+		AutovacuumWalBytes:        4096,
+		AutovacuumElapsedSecs:     0.75,
+		ConnectionsReceived:       1,
+		ConnectionsAuthorized:     2,
+		ConnectionsRejected:       1,
+		Disconnections:            1,
+		SessionSecs:               12.5,
+		LoginsByDatabaseRole: map[state.LogMetricsDatabaseRole]int64{
+			{Database: "mydb", Username: "app"}: 2,
+		},
+	}
+	if diff := pretty.Compare(expected, metrics); diff != "" {
+		t.Errorf("log metrics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestAddLogMetricsTempFiles(t *testing.T) {
+	var metrics state.LogMetrics
+	fp := util.FingerprintQuery("SELECT * FROM t ORDER BY x", "none", -1)
+	logLines := []state.LogLine{{
+		Classification: pganalyze_collector.LogLineInformation_SERVER_TEMP_FILE_CREATED,
+		Database:       "mydb",
+		Query:          "SELECT * FROM t ORDER BY x",
+		Details:        map[string]interface{}{"size": int64(1024), "file": "base/pgsql_tmp/pgsql_tmp123.0"},
+	}, {
+		// Identified by query ID only (e.g. with csvlog and compute_query_id)
+		Classification: pganalyze_collector.LogLineInformation_SERVER_TEMP_FILE_CREATED,
+		Database:       "mydb",
+		QueryID:        42,
+		Details:        map[string]interface{}{"size": int64(2048), "file": "base/pgsql_tmp/pgsql_tmp123.1"},
+	}, {
+		Classification: pganalyze_collector.LogLineInformation_SERVER_TEMP_FILE_CREATED,
+		Database:       "mydb",
+		Details:        map[string]interface{}{"size": int64(512), "file": "base/pgsql_tmp/pgsql_tmp456.0"},
+	}}
+
+	logs.AddLogMetrics(&metrics, logLines, map[int64]uint64{42: fp})
+
+	expected := map[state.LogMetricsTempFileQuery]state.LogMetricsTempFiles{
+		{Database: "mydb", Fingerprint: fp}: {Files: 2, Bytes: 3072},
+		{Database: "mydb"}:                  {Files: 1, Bytes: 512},
+	}
+	if diff := pretty.Compare(expected, metrics.TempFilesByQuery); diff != "" {
+		t.Errorf("temp files diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestMergeLogMetrics(t *testing.T) {
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	metrics := state.LogMetrics{
+		Since:                 since.Add(10 * time.Minute),
+		Checkpoints:           1,
+		CheckpointTotalSecs:   1.5,
+		ConnectionsAuthorized: 1,
+		LoginsByDatabaseRole:  map[state.LogMetricsDatabaseRole]int64{{Database: "mydb", Username: "app"}: 1},
+	}
+	unsent := state.LogMetrics{
+		Since:                     since,
+		Checkpoints:               2,
+		CheckpointTotalSecs:       2.0,
+		Autovacuums:               1,
+		AutovacuumsAntiWraparound: 1,
+		ConnectionsAuthorized:     3,
+		LoginsByDatabaseRole: map[state.LogMetricsDatabaseRole]int64{
+			{Database: "mydb", Username: "app"}:   2,
+			{Database: "mydb", Username: "admin"}: 1,
+		},
+		TempFilesByQuery: map[state.LogMetricsTempFileQuery]state.LogMetricsTempFiles{{Database: "mydb"}: {Files: 1, Bytes: 512}},
+	}
+
+	logs.MergeLogMetrics(&metrics, unsent)
+	// Metrics of servers without analyzed log lines are empty
+	logs.MergeLogMetrics(&metrics, state.LogMetrics{})
+
+	expected := state.LogMetrics{
+		Since:                     since,
+		Checkpoints:               3,
+		CheckpointTotalSecs:       3.5,
+		Autovacuums:               1,
+		AutovacuumsAntiWraparound: 1,
+		ConnectionsAuthorized:     4,
+		LoginsByDatabaseRole: map[state.LogMetricsDatabaseRole]int64{
+			{Database: "mydb", Username: "app"}:   3,
+			{Database: "mydb", Username: "admin"}: 1,
+		},
+		TempFilesByQuery: map[state.LogMetricsTempFileQuery]state.LogMetricsTempFiles{{Database: "mydb"}: {Files: 1, Bytes: 512}},
+	}
+	if diff := pretty.Compare(expected, metrics); diff != "" {
+		t.Errorf("log metrics diff: (-want +got)\n%s", diff)
+	}
+}
@@ -30,6 +30,7 @@ const LogPrefixCustom11 string = "pid=%p,user=%u,db=%d,app=%a,client=%h "
 const LogPrefixCustom12 string = "user=%u,db=%d,app=%a,client=%h "
 const LogPrefixCustom13 string = "%p-%s-%c-%l-%h-%u-%d-%m "
 const LogPrefixCustom14 string = "%m [%p][%b][%v][%x] %q[user=%u,db=%d,app=%a] "
+const LogPrefixCustom15 string = "%m [%p] %q[user=%u,db=%d,app=%a,query_id=%Q] "
 const LogPrefixSimple string = "%m [%p] "
 const LogPrefixEmpty string = ""
 
@@ -40,7 +41,7 @@ var SupportedPrefixes = []string{
 	LogPrefixCustom3, LogPrefixCustom4, LogPrefixCustom5, LogPrefixCustom6,
 	LogPrefixCustom7, LogPrefixCustom8, LogPrefixCustom9, LogPrefixCustom10,
 	LogPrefixCustom11, LogPrefixCustom12, LogPrefixCustom13, LogPrefixCustom14,
-	LogPrefixSimple, LogPrefixEmpty,
+	LogPrefixCustom15, LogPrefixSimple, LogPrefixEmpty,
 }
 
 // Every one of these regexps should produce exactly one matching group
@@ -59,11 +60,12 @@ var SqlstateRegexp = `(\w{5})`                                               //
 var TransactionIdRegexp = `(\d+)`                                            // %x
 var SessionIdRegexp = `(\w+\.\w+)`                                           // %c
 var BackendTypeRegexp = `([\w ]+)`                                           // %b
+var QueryIdRegexp = `(-?\d+)`                                                // %Q
 // Missing:
 // - %n (unix timestamp)
 // - %i (command tag)
 
-var LevelAndContentRegexp = `(\w+):\s+(.*\n?)$`
+var LevelAndContentRegexp = `([\w\pL]+):\s+(.*\n?)$` // Levels may be translated (see localizedLogLevels)
 var LogPrefixAmazonRdsRegexp = regexp.MustCompile(`(?s)^` + TimeRegexp + `:` + HostAndPortRegexp + `:` + UserRegexp + `@` + DbRegexp + `:\[` + PidRegexp + `\]:` + LevelAndContentRegexp)
 var LogPrefixAzureRegexp = regexp.MustCompile(`(?s)^` + TimeRegexp + `-` + SessionIdRegexp + `-` + LevelAndContentRegexp)
 var LogPrefixCustom1Regexp = regexp.MustCompile(`(?s)^` + TimeRegexp + ` \[` + PidRegexp + `\]\[` + VirtualTxRegexp + `\] : \[` + LogLineCounterRegexp + `-1\] (?:\[app=` + AppInsideBracketsRegexp + `\] )?` + LevelAndContentRegexp)
@@ -80,12 +82,13 @@ var LogPrefixCustom11Regexp = regexp.MustCompile(`(?s)^pid=` + PidRegexp + `,use
 var LogPrefixCustom12Regexp = regexp.MustCompile(`(?s)^user=` + UserRegexp + `,db=` + DbRegexp + `,app=` + AppBeforeCommaRegexp + `,client=` + HostRegexp + ` ` + LevelAndContentRegexp)
 var LogPrefixCustom13Regexp = regexp.MustCompile(`(?s)^` + PidRegexp + `-` + TimeRegexp + `-` + SessionIdRegexp + `-` + LogLineCounterRegexp + `-` + HostRegexp + `-` + UserRegexp + `-` + DbRegexp + `-` + TimeRegexp + ` ` + LevelAndContentRegexp)
 var LogPrefixCustom14Regexp = regexp.MustCompile(`(?s)^` + TimeRegexp + ` \[` + PidRegexp + `\]\[` + BackendTypeRegexp + `\]\[` + VirtualTxRegexp + `\]\[` + TransactionIdRegexp + `\] (?:\[user=` + UserRegexp + `,db=` + DbRegexp + `,app=` + AppInsideBracketsRegexp + `\] )?` + LevelAndContentRegexp)
+var LogPrefixCustom15Regexp = regexp.MustCompile(`(?s)^` + TimeRegexp + ` \[` + PidRegexp + `\] (?:\[user=` + UserRegexp + `,db=` + DbRegexp + `,app=` + AppBeforeCommaRegexp + `,query_id=` + QueryIdRegexp + `\] )?` + LevelAndContentRegexp)
 var LogPrefixSimpleRegexp = regexp.MustCompile(`(?s)^` + TimeRegexp + ` \[` + PidRegexp + `\] ` + LevelAndContentRegexp)
 var LogPrefixNoTimestampUserDatabaseAppRegexp = regexp.MustCompile(`(?s)^\[user=` + UserRegexp + `,db=` + DbRegexp + `,app=` + AppInsideBracketsRegexp + `\] ` + LevelAndContentRegexp)
 
 var SyslogSequenceAndSplitRegexp = `(\[[\d-]+\])?`
 
-var RsyslogLevelAndContentRegexp = `(?:(\w+):\s+)?(.*\n?)$`
+var RsyslogLevelAndContentRegexp = `(?:([\w\pL]+):\s+)?(.*\n?)$`
 var RsyslogTimeRegexp = `(\w+\s+\d+ \d{2}:\d{2}:\d{2})`
 var RsyslogHostnameRegxp = `(\S+)`
 var RsyslogProcessNameRegexp = `(\w+)`
@@ -105,14 +108,121 @@ func IsSupportedPrefix(prefix string) bool {
 	return false
 }
 
+// ClosestSupportedPrefix - Returns the supported log_line_prefix most similar to the given one,
+// to suggest as a replacement when the setting is not supported
+//
+// Prefixes are compared by their escape sequences first, since these determine which details
+// can be extracted from log lines (details that would be lost weigh more than additional ones),
+// and then by their literal text.
+func ClosestSupportedPrefix(prefix string) string {
+	escapes := prefixEscapes(prefix)
+	recommended := SupportedPrefixes[RecommendedPrefixIdx]
+	closest := recommended
+	closestDistance := -1
+	for _, supportedPrefix := range SupportedPrefixes {
+		if supportedPrefix == LogPrefixEmpty {
+			continue
+		}
+		supportedEscapes := prefixEscapes(supportedPrefix)
+		distance := editDistance(strings.Split(prefix, ""), strings.Split(supportedPrefix, ""))
+		for escape := range escapes {
+			if !supportedEscapes[escape] {
+				distance += 20
+			}
+		}
+		for escape := range supportedEscapes {
+			if !escapes[escape] {
+				distance += 10
+			}
+		}
+		if closestDistance == -1 || distance < closestDistance || (distance == closestDistance && supportedPrefix == recommended) {
+			closest = supportedPrefix
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+// prefixEscapes - Returns the escape sequences used in the prefix, treating %m like %t since
+// both are parsed the same way, and ignoring %q (which only ends the prefix early)
+func prefixEscapes(prefix string) map[string]bool {
+	escapes := make(map[string]bool)
+	for i := 0; i < len(prefix)-1; i++ {
+		if prefix[i] != '%' {
+			continue
+		}
+		i++
+		switch prefix[i] {
+		case '%', 'q':
+		case 'm':
+			escapes["%t"] = true
+		default:
+			escapes[prefix[i-1:i+1]] = true
+		}
+	}
+	return escapes
+}
+
+func editDistance(a []string, b []string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// ParseLogLine - Parses the line with the server's log_line_prefix setting if that's supported,
+// and otherwise (or when the line doesn't match it) detects the prefix from the line itself
+func ParseLogLine(logLinePrefix string, line string) (logLine state.LogLine, ok bool) {
+	if logLinePrefix != LogPrefixEmpty && IsSupportedPrefix(logLinePrefix) {
+		logLine, ok = ParseLogLineWithPrefix(logLinePrefix, line)
+		if ok {
+			return
+		}
+	}
+	return ParseLogLineWithPrefix("", line)
+}
+
+// StripLogLinePrefix - Removes the prefix matched by log_line_prefix_strip from the start of the
+// line (e.g. a timestamp and tag added by a log shipper), if the server has it configured
+func StripLogLinePrefix(stripRegexp *regexp.Regexp, line string) string {
+	if stripRegexp == nil {
+		return line
+	}
+	loc := stripRegexp.FindStringIndex(line)
+	if loc == nil || loc[0] != 0 {
+		return line
+	}
+	return line[loc[1]:]
+}
+
 func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine, ok bool) {
-	var timePart, userPart, dbPart, appPart, pidPart, logLineNumberPart, levelPart, contentPart string
+	var timePart, userPart, dbPart, appPart, pidPart, logLineNumberPart, queryIdPart, levelPart, contentPart string
 
 	// Assume Postgres time format unless overriden by the prefix (e.g. syslog)
 	timeFormat := "2006-01-02 15:04:05 -0700"
 	timeFormatAlt := "2006-01-02 15:04:05 MST"
 
 	rsyslog := false
+	pgbouncer := false
 
 	if prefix == "" {
 		if LogPrefixAmazonRdsRegexp.MatchString(line) {
@@ -123,6 +233,8 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 			prefix = LogPrefixCustom1
 		} else if LogPrefixCustom2Regexp.MatchString(line) {
 			prefix = LogPrefixCustom2
+		} else if LogPrefixCustom15Regexp.MatchString(line) { // 15 is more specific than 3 and 4, so needs to go first
+			prefix = LogPrefixCustom15
 		} else if LogPrefixCustom4Regexp.MatchString(line) { // 4 is more specific than 3, so needs to go first
 			prefix = LogPrefixCustom4
 		} else if LogPrefixCustom3Regexp.MatchString(line) {
@@ -151,6 +263,8 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 			prefix = LogPrefixSimple
 		} else if RsyslogRegexp.MatchString(line) {
 			rsyslog = true
+		} else if PgBouncerLogLineRegexp.MatchString(line) || PgBouncerSyslogRegexp.MatchString(line) || PgBouncerRsyslogRegexp.MatchString(line) {
+			pgbouncer = true
 		}
 	}
 
@@ -177,6 +291,24 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 			levelPart = parts[4]
 			contentPart = parts[5]
 		}
+	} else if pgbouncer {
+		if parts := PgBouncerLogLineRegexp.FindStringSubmatch(line); len(parts) != 0 {
+			timePart = parts[1]
+			pidPart = parts[2]
+			levelPart = pgbouncerLogLevel(parts[3])
+			contentPart = parts[4]
+		} else if parts := PgBouncerRsyslogRegexp.FindStringSubmatch(line); len(parts) != 0 {
+			timeFormat = "2006 Jan  2 15:04:05"
+			timeFormatAlt = ""
+			timePart = fmt.Sprintf("%d %s", time.Now().Year(), parts[1])
+			// ignore syslog hostname
+			pidPart = parts[3]
+			levelPart = pgbouncerLogLevel(parts[4])
+			contentPart = parts[5]
+		} else if parts := PgBouncerSyslogRegexp.FindStringSubmatch(line); len(parts) != 0 {
+			levelPart = pgbouncerLogLevel(parts[1])
+			contentPart = parts[2]
+		}
 	} else {
 		switch prefix {
 		case LogPrefixAmazonRds: // "%t:%r:%u@%d:[%p]:"
@@ -381,6 +513,19 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 			appPart = parts[8]
 			levelPart = parts[9]
 			contentPart = parts[10]
+		case LogPrefixCustom15: // "%m [%p] %q[user=%u,db=%d,app=%a,query_id=%Q] "
+			parts := LogPrefixCustom15Regexp.FindStringSubmatch(line)
+			if len(parts) == 0 {
+				return
+			}
+			timePart = parts[1]
+			pidPart = parts[2]
+			userPart = parts[3]
+			dbPart = parts[4]
+			appPart = parts[5]
+			queryIdPart = parts[6]
+			levelPart = parts[7]
+			contentPart = parts[8]
 		case LogPrefixSimple: // "%t [%p] "
 			parts := LogPrefixSimpleRegexp.FindStringSubmatch(line)
 			if len(parts) == 0 {
@@ -442,6 +587,10 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 		logLineNumber, _ := strconv.ParseInt(logLineNumberPart, 10, 32)
 		logLine.LogLineNumber = int32(logLineNumber)
 	}
+	if queryIdPart != "" {
+		// Zero when the query ID was not computed (compute_query_id is off) or for utility statements
+		logLine.QueryID, _ = strconv.ParseInt(queryIdPart, 10, 64)
+	}
 
 	backendPid, _ := strconv.ParseInt(pidPart, 10, 32)
 	logLine.BackendPid = int32(backendPid)
@@ -452,7 +601,7 @@ func ParseLogLineWithPrefix(prefix string, line string) (logLine state.LogLine,
 		return
 	}
 
-	logLine.LogLevel = pganalyze_collector.LogLineInformation_LogLevel(pganalyze_collector.LogLineInformation_LogLevel_value[levelPart])
+	logLine.LogLevel, _ = logLevelValue(levelPart)
 	ok = true
 
 	return
@@ -1,6 +1,9 @@
 package logs_test
 
 import (
+	"encoding/csv"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -54,6 +57,61 @@ var parseTests = []parseTestpair{
 		},
 		true,
 	},
+	// Localized log levels (lc_messages)
+	{
+		"",
+		"2018-05-04 03:06:18.360 UTC [3184] FEHLER:  Relation »foo« existiert nicht bei Zeichen 15",
+		state.LogLine{
+			OccurredAt: time.Date(2018, time.May, 4, 3, 6, 18, 360*1000*1000, time.UTC),
+			LogLevel:   pganalyze_collector.LogLineInformation_ERROR,
+			BackendPid: 3184,
+			Content:    "Relation »foo« existiert nicht bei Zeichen 15",
+		},
+		true,
+	},
+	{
+		"",
+		"2018-05-04 03:06:18.360 UTC [3184] 詳細:  キー (id)=(1) はすでに存在します。",
+		state.LogLine{
+			OccurredAt: time.Date(2018, time.May, 4, 3, 6, 18, 360*1000*1000, time.UTC),
+			LogLevel:   pganalyze_collector.LogLineInformation_DETAIL,
+			BackendPid: 3184,
+			Content:    "キー (id)=(1) はすでに存在します。",
+		},
+		true,
+	},
+	// PgBouncer log file, syslog and rsyslog formats
+	{
+		"",
+		"2023-06-15 10:11:12.345 UTC [4433] WARNING C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 pooler error: no more connections allowed (max_client_conn)",
+		state.LogLine{
+			OccurredAt: time.Date(2023, time.June, 15, 10, 11, 12, 345*1000*1000, time.UTC),
+			LogLevel:   pganalyze_collector.LogLineInformation_WARNING,
+			BackendPid: 4433,
+			Content:    "C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 pooler error: no more connections allowed (max_client_conn)",
+		},
+		true,
+	},
+	{
+		"",
+		"C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 closing because: client close request (age=5s)",
+		state.LogLine{
+			LogLevel: pganalyze_collector.LogLineInformation_LOG,
+			Content:  "C-0x55f6c0b8a1e0: mydb/myuser@10.0.0.12:53418 closing because: client close request (age=5s)",
+		},
+		true,
+	},
+	{
+		"",
+		"Feb  1 21:48:31 ip-172-31-14-41 pgbouncer[4433]: S-0x55f6c0b91c40: mydb/myuser@10.0.0.5:5432 closing because: server conn crashed? (age=3600s)",
+		state.LogLine{
+			OccurredAt: time.Date(time.Now().Year(), time.February, 1, 21, 48, 31, 0, time.UTC),
+			LogLevel:   pganalyze_collector.LogLineInformation_LOG,
+			BackendPid: 4433,
+			Content:    "S-0x55f6c0b91c40: mydb/myuser@10.0.0.5:5432 closing because: server conn crashed? (age=3600s)",
+		},
+		true,
+	},
 	// Amazon RDS format
 	{
 		"",
@@ -185,6 +243,33 @@ var parseTests = []parseTestpair{
 		},
 		true,
 	},
+	// Custom 15 format
+	{
+		"",
+		"2023-02-10 14:03:11.456 UTC [20194] [user=postgres,db=postgres,app=psql,query_id=-6453546968531381403] ERROR:  canceling statement due to user request",
+		state.LogLine{
+			OccurredAt:  time.Date(2023, time.February, 10, 14, 3, 11, 456*1000*1000, time.UTC),
+			Username:    "postgres",
+			Database:    "postgres",
+			Application: "psql",
+			QueryID:     -6453546968531381403,
+			LogLevel:    pganalyze_collector.LogLineInformation_ERROR,
+			BackendPid:  20194,
+			Content:     "canceling statement due to user request",
+		},
+		true,
+	},
+	{
+		"",
+		"2023-02-10 14:03:10.101 UTC [20194] [user=[unknown],db=[unknown],app=[unknown],query_id=0] LOG:  connection received: host=[local]",
+		state.LogLine{
+			OccurredAt: time.Date(2023, time.February, 10, 14, 3, 10, 101*1000*1000, time.UTC),
+			LogLevel:   pganalyze_collector.LogLineInformation_LOG,
+			BackendPid: 20194,
+			Content:    "connection received: host=[local]",
+		},
+		true,
+	},
 	// Custom 5 format
 	{
 		"",
@@ -468,3 +553,308 @@ func TestParseLogLineWithPrefix(t *testing.T) {
 		}
 	}
 }
+
+var parseLogLineTests = []parseTestpair{
+	// Lines are parsed with the server's log_line_prefix setting
+	{
+		"%m [%p] %q[user=%u,db=%d,app=%a] ",
+		"2018-05-04 03:06:18.360 UTC [3184] [user=postgres,db=postgres,app=psql] LOG:  pganalyze-collector-identify: server1\n",
+		state.LogLine{
+			OccurredAt:  time.Date(2018, time.May, 4, 3, 6, 18, 360*1000*1000, time.UTC),
+			Username:    "postgres",
+			Database:    "postgres",
+			Application: "psql",
+			LogLevel:    pganalyze_collector.LogLineInformation_LOG,
+			BackendPid:  3184,
+			Content:     "pganalyze-collector-identify: server1\n",
+		},
+		true,
+	},
+	// Lines that don't match the setting (e.g. because it changed) fall back to detecting the prefix
+	{
+		"%m [%p] %q[user=%u,db=%d,app=%a] ",
+		"2018-05-04 03:06:18 UTC:127.0.0.1(36404):postgres@postgres:[3184]:LOG:  pganalyze-collector-identify: server1\n",
+		state.LogLine{
+			OccurredAt: time.Date(2018, time.May, 4, 3, 6, 18, 0, time.UTC),
+			Username:   "postgres",
+			Database:   "postgres",
+			LogLevel:   pganalyze_collector.LogLineInformation_LOG,
+			BackendPid: 3184,
+			Content:    "pganalyze-collector-identify: server1\n",
+		},
+		true,
+	},
+	// Unsupported settings detect the prefix from each line
+	{
+		"%m [%p] user=%u ",
+		"2018-05-04 03:06:18.360 UTC [3184] LOG:  pganalyze-collector-identify: server1\n",
+		state.LogLine{
+			OccurredAt: time.Date(2018, time.May, 4, 3, 6, 18, 360*1000*1000, time.UTC),
+			LogLevel:   pganalyze_collector.LogLineInformation_LOG,
+			BackendPid: 3184,
+			Content:    "pganalyze-collector-identify: server1\n",
+		},
+		true,
+	},
+	// Continuation lines keep their content for stitching
+	{
+		"%m [%p] %q[user=%u,db=%d,app=%a] ",
+		"\tFROM pg_stat_activity\n",
+		state.LogLine{
+			Content: "\tFROM pg_stat_activity\n",
+		},
+		false,
+	},
+}
+
+func TestParseLogLine(t *testing.T) {
+	for _, pair := range parseLogLineTests {
+		l, lOk := logs.ParseLogLine(pair.prefixIn, pair.lineIn)
+
+		cfg := pretty.CompareConfig
+		cfg.SkipZeroFields = true
+
+		if pair.lineOutOk != lOk {
+			t.Errorf("For \"%v\": expected parsing ok? to be %v, but was %v\n", pair.lineIn, pair.lineOutOk, lOk)
+		}
+
+		if diff := cfg.Compare(l, pair.lineOut); diff != "" {
+			t.Errorf("For \"%v\": log line diff: (-got +want)\n%s", pair.lineIn, diff)
+		}
+	}
+}
+
+var stripLogLinePrefixTests = []struct {
+	stripIn string
+	lineIn  string
+	lineOut string
+}{
+	{
+		"",
+		"2018-03-11 20:00:02 UTC [8] LOG:  checkpoint starting: time",
+		"2018-03-11 20:00:02 UTC [8] LOG:  checkpoint starting: time",
+	},
+	{
+		`\w+ \d+ [\d:]+ \S+ postgres: `,
+		"Mar 11 20:00:02 db1 postgres: 2018-03-11 20:00:02 UTC [8] LOG:  checkpoint starting: time",
+		"2018-03-11 20:00:02 UTC [8] LOG:  checkpoint starting: time",
+	},
+	{
+		// Only removed from the start of the line
+		`postgres: `,
+		"2018-03-11 20:00:02 UTC [8] LOG:  statement: SELECT 'postgres: '",
+		"2018-03-11 20:00:02 UTC [8] LOG:  statement: SELECT 'postgres: '",
+	},
+}
+
+func TestStripLogLinePrefix(t *testing.T) {
+	for _, test := range stripLogLinePrefixTests {
+		var stripRegexp *regexp.Regexp
+		if test.stripIn != "" {
+			stripRegexp = regexp.MustCompile(`^(?:` + test.stripIn + `)`)
+		}
+		line := logs.StripLogLinePrefix(stripRegexp, test.lineIn)
+		if line != test.lineOut {
+			t.Errorf("For \"%v\": expected %q, but was %q\n", test.lineIn, test.lineOut, line)
+		}
+	}
+}
+
+var closestSupportedPrefixTests = []struct {
+	prefixIn  string
+	prefixOut string
+}{
+	{"%m [%p] %q[user=%u,db=%d] ", logs.LogPrefixCustom3},
+	{"%m [%p] user=%u db=%d app=%a ", logs.LogPrefixCustom3},
+	{"%t [%p]: [%l-1] user=%u,db=%d,app=%a ", logs.LogPrefixCustom6},
+	{"%m:%r:%u@%d:[%p]:", logs.LogPrefixAmazonRds},
+	{"%t [%p]: ", logs.LogPrefixSimple},
+}
+
+func TestClosestSupportedPrefix(t *testing.T) {
+	for _, pair := range closestSupportedPrefixTests {
+		prefix := logs.ClosestSupportedPrefix(pair.prefixIn)
+		if prefix != pair.prefixOut {
+			t.Errorf("For \"%v\": expected closest supported prefix to be \"%v\", but was \"%v\"\n", pair.prefixIn, pair.prefixOut, prefix)
+		}
+	}
+}
+
+type parseCsvTestpair struct {
+	recordIn   string
+	linesOut   []state.LogLine
+	linesOutOk bool
+}
+
+var parseCsvTests = []parseCsvTestpair{
+	{
+		`2023-02-07 10:12:56.123 UTC,"app","mydb",1234,"127.0.0.1:51234",63e2a3f8.4d2,5,"SELECT",2023-02-07 10:12:40 UTC,3/42,0,ERROR,42P01,"relation ""foo"" does not exist",,,,,,"SELECT *
+  FROM foo",15,,"psql","client backend",,-6410563772857272356`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 12, 56, 123*1000*1000, time.UTC),
+				Username:      "app",
+				Database:      "mydb",
+				Application:   "psql",
+				BackendPid:    1234,
+				BackendType:   "client backend",
+				QueryID:       -6410563772857272356,
+				LogLineNumber: 5,
+				LogLevel:      pganalyze_collector.LogLineInformation_ERROR,
+				Content:       "relation \"foo\" does not exist\n",
+			},
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 12, 56, 123*1000*1000, time.UTC),
+				Username:      "app",
+				Database:      "mydb",
+				Application:   "psql",
+				BackendPid:    1234,
+				BackendType:   "client backend",
+				QueryID:       -6410563772857272356,
+				LogLineNumber: 5,
+				LogLevel:      pganalyze_collector.LogLineInformation_STATEMENT,
+				Content:       "SELECT *\n  FROM foo\n",
+			},
+		},
+		true,
+	},
+	{
+		`2023-02-07 10:13:01.456 UTC,,,987,,63e2a3e0.3db,2,,2023-02-07 10:12:16 UTC,,0,DEBUG2,00000,"autovacuum: processing database ""mydb""",,,,,,,,,"","autovacuum worker",,0`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 13, 1, 456*1000*1000, time.UTC),
+				BackendPid:    987,
+				BackendType:   "autovacuum worker",
+				LogLineNumber: 2,
+				LogLevel:      pganalyze_collector.LogLineInformation_DEBUG,
+				Content:       "autovacuum: processing database \"mydb\"\n",
+			},
+		},
+		true,
+	},
+	{
+		`2023-02-07 10:13:01.456 UTC,,,987,,63e2a3e0.3db,2`,
+		nil,
+		false,
+	},
+}
+
+func TestParseCsvLogRecord(t *testing.T) {
+	for _, pair := range parseCsvTests {
+		record, err := csv.NewReader(strings.NewReader(pair.recordIn)).Read()
+		if err != nil {
+			t.Fatalf("For \"%v\": could not read CSV: %s", pair.recordIn, err)
+		}
+		lines, ok := logs.ParseCsvLogRecord(record)
+
+		cfg := pretty.CompareConfig
+		cfg.SkipZeroFields = true
+
+		if pair.linesOutOk != ok {
+			t.Errorf("For \"%v\": expected parsing ok? to be %v, but was %v\n", pair.recordIn, pair.linesOutOk, ok)
+		}
+
+		if diff := cfg.Compare(lines, pair.linesOut); diff != "" {
+			t.Errorf("For \"%v\": log lines diff: (-got +want)\n%s", pair.recordIn, diff)
+		}
+	}
+}
+
+type parseJsonTestpair struct {
+	lineIn     string
+	linesOut   []state.LogLine
+	linesOutOk bool
+}
+
+var parseJsonTests = []parseJsonTestpair{
+	{
+		`{"timestamp":"2023-02-07 10:12:56.123 UTC","user":"app","dbname":"mydb","pid":1234,"remote_host":"127.0.0.1","remote_port":51234,"session_id":"63e2a3f8.4d2","line_num":5,"ps":"UPDATE","session_start":"2023-02-07 10:12:40 UTC","vxid":"3/42","txid":0,"error_severity":"ERROR","state_code":"40P01","message":"deadlock detected","detail":"Process 1234 waits for ShareLock on transaction 740; blocked by process 1235.\nProcess 1235 waits for ShareLock on transaction 739; blocked by process 1234.","hint":"See server log for query details.","statement":"UPDATE foo SET x = 1 WHERE id = 2","application_name":"psql","backend_type":"client backend","query_id":3671231570527813014}`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 12, 56, 123*1000*1000, time.UTC),
+				Username:      "app",
+				Database:      "mydb",
+				Application:   "psql",
+				BackendPid:    1234,
+				BackendType:   "client backend",
+				QueryID:       3671231570527813014,
+				LogLineNumber: 5,
+				LogLevel:      pganalyze_collector.LogLineInformation_ERROR,
+				Content:       "deadlock detected\n",
+			},
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 12, 56, 123*1000*1000, time.UTC),
+				Username:      "app",
+				Database:      "mydb",
+				Application:   "psql",
+				BackendPid:    1234,
+				BackendType:   "client backend",
+				QueryID:       3671231570527813014,
+				LogLineNumber: 5,
+				LogLevel:      pganalyze_collector.LogLineInformation_DETAIL,
+				Content:       "Process 1234 waits for ShareLock on transaction 740; blocked by process 1235.\nProcess 1235 waits for ShareLock on transaction 739; blocked by process 1234.\n",
+			},
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 12, 56, 123*1000*1000, time.UTC),
+				Username:      "app",
+				Database:      "mydb",
+				Application:   "psql",
+				BackendPid:    1234,
+				BackendType:   "client backend",
+				QueryID:       3671231570527813014,
+				LogLineNumber: 5,
+				LogLevel:      pganalyze_collector.LogLineInformation_HINT,
+				Content:       "See server log for query details.\n",
+			},
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 12, 56, 123*1000*1000, time.UTC),
+				Username:      "app",
+				Database:      "mydb",
+				Application:   "psql",
+				BackendPid:    1234,
+				BackendType:   "client backend",
+				QueryID:       3671231570527813014,
+				LogLineNumber: 5,
+				LogLevel:      pganalyze_collector.LogLineInformation_STATEMENT,
+				Content:       "UPDATE foo SET x = 1 WHERE id = 2\n",
+			},
+		},
+		true,
+	},
+	{
+		`{"timestamp":"2023-02-07 10:13:00.001 UTC","pid":42,"session_id":"63e2a3e0.2a","line_num":1,"session_start":"2023-02-07 10:12:16 UTC","txid":0,"error_severity":"LOG","message":"checkpoint starting: time","backend_type":"checkpointer","query_id":0}`,
+		[]state.LogLine{
+			{
+				OccurredAt:    time.Date(2023, time.February, 7, 10, 13, 0, 1000*1000, time.UTC),
+				BackendPid:    42,
+				BackendType:   "checkpointer",
+				LogLineNumber: 1,
+				LogLevel:      pganalyze_collector.LogLineInformation_LOG,
+				Content:       "checkpoint starting: time\n",
+			},
+		},
+		true,
+	},
+	{
+		"2023-02-07 10:13:00 UTC [42] LOG:  checkpoint starting: time",
+		nil,
+		false,
+	},
+}
+
+func TestParseJsonLogLine(t *testing.T) {
+	for _, pair := range parseJsonTests {
+		lines, ok := logs.ParseJsonLogLine(pair.lineIn)
+
+		cfg := pretty.CompareConfig
+		cfg.SkipZeroFields = true
+
+		if pair.linesOutOk != ok {
+			t.Errorf("For \"%v\": expected parsing ok? to be %v, but was %v\n", pair.lineIn, pair.linesOutOk, ok)
+		}
+
+		if diff := cfg.Compare(lines, pair.linesOut); diff != "" {
+			t.Errorf("For \"%v\": log lines diff: (-got +want)\n%s", pair.lineIn, diff)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package logs
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// pgaudit session and object audit logging, see https://github.com/pgaudit/pgaudit#format
+//
+// The payload is CSV formatted: AUDIT_TYPE, STATEMENT_ID, SUBSTATEMENT_ID, CLASS, COMMAND,
+// OBJECT_TYPE, OBJECT_NAME, STATEMENT, PARAMETER, and ROWS (only with pgaudit.log_rows).
+const pgauditPrefix = "AUDIT: "
+
+// Placeholders pgaudit writes instead of the statement or its parameters
+const pgauditNotLogged = "<not logged>"
+const pgauditPreviouslyLogged = "<previously logged>"
+const pgauditNoParameters = "<none>"
+
+// pgauditField - A field of the pgaudit payload, and where it's located in the log line
+// (including any quotes)
+type pgauditField struct {
+	value string
+	start int
+	end   int
+}
+
+// splitPgauditFields - Splits the CSV formatted content (starting at offset) into its fields,
+// returns nil if the quoting is invalid
+//
+// pgaudit quotes fields that contain commas, quotes or newlines, so statements spanning multiple
+// lines and object names containing commas are kept intact.
+func splitPgauditFields(content string, offset int) []pgauditField {
+	var fields []pgauditField
+	i := offset
+	for {
+		field := pgauditField{start: i}
+		if i < len(content) && content[i] == '"' {
+			var value strings.Builder
+			for i++; ; i++ {
+				if i >= len(content) {
+					return nil
+				}
+				if content[i] == '"' {
+					if i+1 < len(content) && content[i+1] == '"' {
+						value.WriteByte('"')
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteByte(content[i])
+			}
+			if i < len(content) && content[i] != ',' && content[i] != '\n' {
+				return nil
+			}
+			field.value = value.String()
+		} else {
+			end := strings.IndexByte(content[i:], ',')
+			if end == -1 {
+				end = len(strings.TrimRight(content[i:], "\n"))
+			}
+			field.value = content[i : i+end]
+			i += end
+		}
+		field.end = i
+		fields = append(fields, field)
+		if i >= len(content) || content[i] != ',' {
+			return fields
+		}
+		i++
+	}
+}
+
+// analyzePgauditLine - Extracts the audit details from a pgaudit log line, returns false if the
+// line is not a (valid) pgaudit log line
+func analyzePgauditLine(logLine state.LogLine) (state.LogLine, bool) {
+	if !strings.HasPrefix(logLine.Content, pgauditPrefix) {
+		return logLine, false
+	}
+	fields := splitPgauditFields(logLine.Content, len(pgauditPrefix))
+	if len(fields) < 9 || (fields[0].value != "SESSION" && fields[0].value != "OBJECT") {
+		return logLine, false
+	}
+
+	logLine.ReviewedForSecrets = true
+	logLine.Details = map[string]interface{}{
+		"audit_type":      fields[0].value,
+		"statement_id":    fields[1].value,
+		"substatement_id": fields[2].value,
+		"class":           fields[3].value,
+		"command":         fields[4].value,
+	}
+	if fields[5].value != "" {
+		logLine.Details["object_type"] = fields[5].value
+	}
+	if fields[6].value != "" {
+		logLine.Details["object_name"] = fields[6].value
+	}
+	if len(fields) > 9 {
+		if rows, err := strconv.ParseInt(fields[9].value, 10, 64); err == nil {
+			logLine.Details["rows"] = rows
+		}
+	}
+
+	statement := fields[7]
+	if statement.value != pgauditNotLogged && statement.value != pgauditPreviouslyLogged && statement.value != "" {
+		logLine.Query = strings.TrimSpace(statement.value)
+		logLine.SecretMarkers = append(logLine.SecretMarkers, state.LogSecretMarker{
+			ByteStart: statement.start,
+			ByteEnd:   statement.end,
+			Kind:      state.StatementTextLogSecret,
+		})
+	}
+	parameter := fields[8]
+	if parameter.value != pgauditNotLogged && parameter.value != pgauditNoParameters && parameter.value != "" {
+		logLine.SecretMarkers = append(logLine.SecretMarkers, state.LogSecretMarker{
+			ByteStart: parameter.start,
+			ByteEnd:   parameter.end,
+			Kind:      state.StatementParameterLogSecret,
+		})
+	}
+
+	return logLine, true
+}
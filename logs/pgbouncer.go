@@ -0,0 +1,130 @@
+package logs
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// PgBouncer log lines, which end up in the same log stream as the Postgres log lines when both
+// log to the same syslog server (or file)
+//
+// PgBouncer writes "%m [%p] LEVEL message" to its log file (note the missing colon after the log
+// level, unlike Postgres), and only the message to syslog. Messages about a connection start with
+// the connection ("C-0x..." for clients, "S-0x..." for servers), followed by "database/user@address".
+var PgBouncerLevelRegexp = `(LOG|WARNING|ERROR|FATAL|DEBUG|NOISE)`
+var PgBouncerConnectionRegexp = `[CS]-0x[0-9a-f]+: `
+var PgBouncerLogLineRegexp = regexp.MustCompile(`(?s)^` + TimeRegexp + ` \[` + PidRegexp + `\] ` + PgBouncerLevelRegexp + ` (.*\n?)$`)
+var PgBouncerSyslogRegexp = regexp.MustCompile(`(?s)^(?:` + PgBouncerLevelRegexp + ` )?(` + PgBouncerConnectionRegexp + `.*\n?)$`)
+var PgBouncerRsyslogRegexp = regexp.MustCompile(`(?s)^` + RsyslogTimeRegexp + ` ` + RsyslogHostnameRegxp + ` pgbouncer\[` + PidRegexp + `\]: (?:` + PgBouncerLevelRegexp + ` )?(.*\n?)$`)
+
+// pgbouncerLogLevel - Returns the Postgres log level name for the PgBouncer log level, which
+// defaults to LOG for syslog messages (where the log level is only in the syslog priority)
+func pgbouncerLogLevel(level string) string {
+	switch level {
+	case "":
+		return "LOG"
+	case "NOISE":
+		return "DEBUG"
+	}
+	return level
+}
+
+var pgbouncerConnectionMessageRegexp = regexp.MustCompile(`^([CS])-0x[0-9a-f]+: ([^/\s]*)/([^@\s]*)@(\S+) (.*?)(?: \(age=(\d+)s\))?\n?$`)
+
+// pgbouncerReason - Classification of the reasons PgBouncer gives when closing a connection
+// ("closing because: ...") or when returning an error to the client ("pooler error: ...")
+type pgbouncerReason struct {
+	prefix         string
+	classification pganalyze_collector.LogLineInformation_LogClassification
+}
+
+var pgbouncerReasons = []pgbouncerReason{
+	{"password authentication failed", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"SASL authentication failed", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"auth failed", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"no such user", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"no such database", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"login failed", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"server login has been failing", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"pgbouncer cannot connect to server", pganalyze_collector.LogLineInformation_CONNECTION_REJECTED},
+	{"no more connections allowed", pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS},
+	{"query_wait_timeout", pganalyze_collector.LogLineInformation_OUT_OF_CONNECTIONS},
+	{"server conn crashed?", pganalyze_collector.LogLineInformation_CONNECTION_LOST},
+	{"client unexpected eof", pganalyze_collector.LogLineInformation_CONNECTION_LOST},
+	{"client disconnect while server was not ready", pganalyze_collector.LogLineInformation_CONNECTION_LOST},
+}
+
+// analyzePgBouncerLine - Classifies a PgBouncer connection log line (login failures, pool
+// exhaustion and disconnects), returns false if the line is not a PgBouncer connection log line
+func analyzePgBouncerLine(logLine state.LogLine) (state.LogLine, bool) {
+	loc := pgbouncerConnectionMessageRegexp.FindStringSubmatchIndex(logLine.Content)
+	if loc == nil {
+		return logLine, false
+	}
+	part := func(idx int) string {
+		if loc[2*idx] < 0 {
+			return ""
+		}
+		return logLine.Content[loc[2*idx]:loc[2*idx+1]]
+	}
+
+	logLine.ReviewedForSecrets = true
+	logLine.Details = map[string]interface{}{"pooler": "pgbouncer"}
+	if part(1) == "S" {
+		logLine.Details["connection_type"] = "server"
+		logLine.Details["server_addr"] = part(4)
+	} else {
+		logLine.Details["connection_type"] = "client"
+		logLine.Details["client_addr"] = part(4)
+	}
+	if logLine.Database == "" && part(2) != "(nodb)" {
+		logLine.Database = part(2)
+	}
+	if logLine.Username == "" && part(3) != "(nouser)" {
+		logLine.Username = part(3)
+	}
+	if age, err := strconv.ParseInt(part(6), 10, 64); err == nil {
+		logLine.Details["age_secs"] = age
+	}
+
+	message := part(5)
+	known := false
+	if strings.HasPrefix(message, "login attempt: ") {
+		logLine.Classification = pganalyze_collector.LogLineInformation_CONNECTION_RECEIVED
+		known = true
+	}
+	for _, kind := range []string{"closing because: ", "pooler error: "} {
+		if !strings.HasPrefix(message, kind) {
+			continue
+		}
+		reason := strings.TrimPrefix(message, kind)
+		logLine.Details["reason"] = reason
+		if kind == "closing because: " {
+			// Any other reason is a regular disconnect (e.g. "client close request" or "server idle timeout")
+			logLine.Classification = pganalyze_collector.LogLineInformation_CONNECTION_DISCONNECTED
+		}
+		for _, r := range pgbouncerReasons {
+			if strings.HasPrefix(reason, r.prefix) {
+				logLine.Classification = r.classification
+				known = true
+				break
+			}
+		}
+		known = known || kind == "closing because: "
+	}
+
+	// Messages we don't know may include anything (e.g. errors returned by the server)
+	if !known {
+		logLine.SecretMarkers = append(logLine.SecretMarkers, state.LogSecretMarker{
+			ByteStart: loc[10],
+			ByteEnd:   loc[11],
+			Kind:      state.UnidentifiedLogSecret,
+		})
+	}
+
+	return logLine, true
+}
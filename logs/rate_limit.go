@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RateLimitLogLines - Drops the log lines of each classification that exceed the limit within
+// the interval (together with their DETAIL/HINT/STATEMENT lines and query samples), and returns
+// how many log lines were dropped
+//
+// The number of dropped lines is reported as "suppressed_count" in the details of the next log
+// line of the same classification that is kept, which is the first one of a later interval.
+func RateLimitLogLines(logLines []state.LogLine, samples []state.PostgresQuerySample, limitState *state.LogRateLimitState, limit int, interval time.Duration, now time.Time) ([]state.LogLine, []state.PostgresQuerySample, int) {
+	if limitState.Seen == nil || now.Sub(limitState.IntervalStart) >= interval {
+		limitState.IntervalStart = now
+		limitState.Seen = make(map[pganalyze_collector.LogLineInformation_LogClassification]int)
+	}
+	if limitState.Suppressed == nil {
+		limitState.Suppressed = make(map[pganalyze_collector.LogLineInformation_LogClassification]int)
+	}
+
+	dropped := make(map[uuid.UUID]bool)
+	keptLines := make([]state.LogLine, 0, len(logLines))
+	for _, logLine := range logLines {
+		if logLine.ParentUUID != uuid.Nil && dropped[logLine.ParentUUID] {
+			continue
+		}
+		if logLine.Classification == pganalyze_collector.LogLineInformation_UNKNOWN_LOG_CLASSIFICATION {
+			keptLines = append(keptLines, logLine)
+			continue
+		}
+		limitState.Seen[logLine.Classification]++
+		if limitState.Seen[logLine.Classification] > limit {
+			limitState.Suppressed[logLine.Classification]++
+			if logLine.UUID != uuid.Nil {
+				dropped[logLine.UUID] = true
+			}
+			continue
+		}
+		if count := limitState.Suppressed[logLine.Classification]; count > 0 {
+			details := map[string]interface{}{"suppressed_count": count}
+			for key, value := range logLine.Details {
+				details[key] = value
+			}
+			logLine.Details = details
+			delete(limitState.Suppressed, logLine.Classification)
+		}
+		keptLines = append(keptLines, logLine)
+	}
+
+	droppedCount := len(logLines) - len(keptLines)
+	if droppedCount == 0 {
+		return logLines, samples, 0
+	}
+
+	keptSamples := make([]state.PostgresQuerySample, 0, len(samples))
+	for _, sample := range samples {
+		if !dropped[sample.LogLineUUID] {
+			keptSamples = append(keptSamples, sample)
+		}
+	}
+
+	return keptLines, keptSamples, droppedCount
+}
@@ -0,0 +1,70 @@
+package logs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/logs"
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestRateLimitLogLines(t *testing.T) {
+	var limitState state.LogRateLimitState
+	now := time.Date(2018, 3, 11, 20, 0, 0, 0, time.UTC)
+
+	uniqueViolation := func(id byte) state.LogLine {
+		return state.LogLine{
+			UUID:           uuid.UUID{id},
+			Classification: pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+		}
+	}
+	logLines := []state.LogLine{
+		uniqueViolation(1),
+		{ParentUUID: uuid.UUID{1}, LogLevel: pganalyze_collector.LogLineInformation_DETAIL},
+		uniqueViolation(2),
+		{ParentUUID: uuid.UUID{2}, LogLevel: pganalyze_collector.LogLineInformation_DETAIL},
+		uniqueViolation(3),
+		{ParentUUID: uuid.UUID{3}, LogLevel: pganalyze_collector.LogLineInformation_DETAIL},
+		{UUID: uuid.UUID{4}},
+		{UUID: uuid.UUID{5}, Classification: pganalyze_collector.LogLineInformation_STATEMENT_DURATION},
+	}
+	samples := []state.PostgresQuerySample{{LogLineUUID: uuid.UUID{3}}, {LogLineUUID: uuid.UUID{5}}}
+
+	cfg := pretty.CompareConfig
+	cfg.SkipZeroFields = true
+
+	keptLines, keptSamples, droppedCount := logs.RateLimitLogLines(logLines, samples, &limitState, 1, time.Minute, now)
+	expectedLines := []state.LogLine{logLines[0], logLines[1], logLines[6], logLines[7]}
+	if diff := cfg.Compare(expectedLines, keptLines); diff != "" {
+		t.Errorf("log lines diff: (-want +got)\n%s", diff)
+	}
+	if diff := cfg.Compare([]state.PostgresQuerySample{samples[1]}, keptSamples); diff != "" {
+		t.Errorf("query samples diff: (-want +got)\n%s", diff)
+	}
+	if droppedCount != 4 {
+		t.Errorf("want 4 dropped lines; got %d", droppedCount)
+	}
+
+	// Still within the interval
+	keptLines, _, droppedCount = logs.RateLimitLogLines([]state.LogLine{uniqueViolation(6)}, nil, &limitState, 1, time.Minute, now.Add(30*time.Second))
+	if len(keptLines) != 0 || droppedCount != 1 {
+		t.Errorf("want all lines dropped; got %d kept, %d dropped", len(keptLines), droppedCount)
+	}
+
+	// The next interval reports the lines that were dropped
+	keptLines, _, droppedCount = logs.RateLimitLogLines([]state.LogLine{uniqueViolation(7), uniqueViolation(8)}, nil, &limitState, 1, time.Minute, now.Add(time.Minute))
+	expectedLines = []state.LogLine{{
+		UUID:           uuid.UUID{7},
+		Classification: pganalyze_collector.LogLineInformation_UNIQUE_CONSTRAINT_VIOLATION,
+		Details:        map[string]interface{}{"suppressed_count": 3},
+	}}
+	if diff := cfg.Compare(expectedLines, keptLines); diff != "" {
+		t.Errorf("log lines diff: (-want +got)\n%s", diff)
+	}
+	if droppedCount != 1 {
+		t.Errorf("want 1 dropped line; got %d", droppedCount)
+	}
+}
@@ -3,6 +3,7 @@ package logs
 import (
 	"sort"
 
+	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/state"
 )
 
@@ -72,3 +73,29 @@ func ReplaceSecrets(input []byte, logLines []state.LogLine, filterLogSecret []st
 	}
 	return input
 }
+
+// RedactContent - Applies the redaction rules to the contents of the log lines in the text
+//
+// Each replacement is padded with the replacement character (or cut off) to the length of the
+// match, so the byte offsets of the log lines remain valid.
+func RedactContent(input []byte, logLines []state.LogLine, rules []config.LogRedactRule) []byte {
+	for _, logLine := range logLines {
+		if logLine.ByteContentStart >= logLine.ByteEnd || logLine.ByteEnd > int64(len(input)) {
+			continue
+		}
+		content := input[logLine.ByteContentStart:logLine.ByteEnd]
+		for _, rule := range rules {
+			for _, m := range rule.Regexp.FindAllSubmatchIndex(content, -1) {
+				replacement := rule.Regexp.Expand(nil, []byte(rule.Replacement), content, m)
+				for i := m[0]; i < m[1]; i++ {
+					if i-m[0] < len(replacement) {
+						content[i] = replacement[i-m[0]]
+					} else {
+						content[i] = replacementChar
+					}
+				}
+			}
+		}
+	}
+	return input
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/state"
 )
@@ -71,3 +72,45 @@ func TestReplaceSecrets(t *testing.T) {
 		}
 	}
 }
+
+type redactTestpair struct {
+	filterLogRedact string
+	input           string
+	output          string
+}
+
+var redactTests = []redactTestpair{
+	{
+		filterLogRedact: `cust_\d+ => cust_?`,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  processing order for cust_12345 (cust_7)\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  processing order for cust_?XXXX (cust_?)\n",
+	},
+	{
+		filterLogRedact: "(email)=\\S+ => $1=<redacted>\n\\d{3}-\\d{4} => N",
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  signup email=a@example.com phone=555-1234\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  signup email=<redacted>XXX phone=NXXXXXXX\n",
+	},
+	{ // Only the log line contents are redacted, not the prefix
+		filterLogRedact: `\d+ => 0`,
+		input:           "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  job 42 done\n",
+		output:          "2018-03-11 20:00:02 UTC:1.1.1.1(2):a@b:[3]:LOG:  job 0X done\n",
+	},
+}
+
+func TestRedactContent(t *testing.T) {
+	for _, pair := range redactTests {
+		rules, err := config.ParseLogRedactRules(pair.filterLogRedact)
+		if err != nil {
+			t.Fatalf("For rules \"%s\": %s", pair.filterLogRedact, err)
+		}
+		logLines, _, _ := logs.ParseAndAnalyzeBuffer(string(pair.input), 0, time.Time{})
+		output := logs.RedactContent([]byte(pair.input), logLines, rules)
+
+		cfg := pretty.CompareConfig
+		cfg.SkipZeroFields = true
+
+		if diff := cfg.Compare(pair.output, string(output)); diff != "" {
+			t.Errorf("For rules \"%s\", text:\n%vdiff: (-want +got)\n%s", pair.filterLogRedact, pair.input, diff)
+		}
+	}
+}
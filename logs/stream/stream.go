@@ -41,6 +41,12 @@ import (
 // - Always has the log line number, allowing association of related log lines
 // - Multi-line messages are already combined together
 //   (as of Sept 14, 2021 - see https://cloud.google.com/sql/docs/release-notes#September_14_2021)
+// - Very large messages (e.g. auto_explain plans) may be split into multiple entries, of which only
+//   the first has the log_line_prefix
+//
+// auto_explain plans in JSON format are stitched together per PID before anything else (see
+// stitchAutoExplainJSON), since their parts may be interleaved with lines of other backends, or
+// arrive after the first part would otherwise be considered ready.
 
 const InvalidPid int32 = -1
 const UnknownPid int32 = 0
@@ -53,11 +59,19 @@ const (
 	LogLineDiscard
 )
 
+// How long to wait for the remainder of an auto_explain plan in JSON format, and how large the
+// plan may get, before giving up and sending it as-is (which likely means it was truncated)
+const AutoExplainStitchTimeout time.Duration = 30 * time.Second
+const AutoExplainStitchMaxBytes = 10 * 1024 * 1024
+
 func determineLogLineReadiness(logLine state.LogLine, threshold time.Duration, now time.Time, lastReadyMainLogLinePid int32, lastReadyLogLineWithPrefixPid int32) LogLineReadiness {
 	// The easy case: We have a log line with a log_line_prefix, and only need
 	// to check if we are ready to send or whether there could still be
 	// subsequent lines that will show up within the threshold
 	if logLine.LogLevel != pganalyze_collector.LogLineInformation_UNKNOWN {
+		if now.Sub(logLine.CollectedAt) <= AutoExplainStitchTimeout && len(logLine.Content) <= AutoExplainStitchMaxBytes && logs.IsIncompleteAutoExplainJSON(logLine.Content) {
+			return LogLineDefer
+		}
 		if now.Sub(logLine.CollectedAt) > threshold || (isAdditionalLineLevel(logLine.LogLevel) && logLine.BackendPid == lastReadyMainLogLinePid) {
 			return LogLineReady
 		}
@@ -214,6 +228,65 @@ func handleLogAnalysis(analyzableLogLines []state.LogLine) ([]state.LogLine, []s
 	return logLinesOut, querySamples
 }
 
+// stitchAutoExplainJSON - Appends continuation lines to the auto_explain plans in JSON format
+// they belong to, as long as the plan is incomplete
+//
+// Continuation lines with a PID are added to the incomplete plan of that backend (even if lines
+// of other backends come in between). Continuation lines without a PID are added to the plan of
+// the line right before them, or otherwise to a plan with the same timestamp (since entries that
+// a message was split into share its timestamp).
+func stitchAutoExplainJSON(logLines []state.LogLine) []state.LogLine {
+	type pendingPlan struct {
+		idx     int
+		scanner *logs.AutoExplainJSONScanner
+	}
+	var logLinesOut []state.LogLine
+	pending := make(map[int32]*pendingPlan)
+	var lastLogLineWithPrefixPid int32 = InvalidPid
+
+	for _, logLine := range logLines {
+		if logLine.LogLevel != pganalyze_collector.LogLineInformation_UNKNOWN {
+			// A new message of the backend ends its previous one
+			delete(pending, logLine.BackendPid)
+			lastLogLineWithPrefixPid = logLine.BackendPid
+			logLinesOut = append(logLinesOut, logLine)
+			if scanner, ok := logs.NewAutoExplainJSONScanner(logLine.Content); ok && !scanner.Complete() && len(logLine.Content) <= AutoExplainStitchMaxBytes {
+				pending[logLine.BackendPid] = &pendingPlan{idx: len(logLinesOut) - 1, scanner: scanner}
+			}
+			continue
+		}
+
+		var plan *pendingPlan
+		if logLine.BackendPid != UnknownPid {
+			plan = pending[logLine.BackendPid]
+		} else if p, ok := pending[lastLogLineWithPrefixPid]; ok {
+			plan = p
+		} else if !logLine.OccurredAt.IsZero() {
+			for _, p := range pending {
+				if logLinesOut[p.idx].OccurredAt.Equal(logLine.OccurredAt) && (plan == nil || p.idx > plan.idx) {
+					plan = p
+				}
+			}
+		}
+		if plan == nil {
+			logLinesOut = append(logLinesOut, logLine)
+			continue
+		}
+
+		planLine := &logLinesOut[plan.idx]
+		if plan.scanner.InString() {
+			planLine.Content = strings.TrimSuffix(planLine.Content, "\n")
+		}
+		planLine.Content += logLine.Content
+		plan.scanner.Scan(logLine.Content)
+		if plan.scanner.Complete() || len(planLine.Content) > AutoExplainStitchMaxBytes {
+			delete(pending, planLine.BackendPid)
+		}
+	}
+
+	return logLinesOut
+}
+
 func stitchLogLines(readyLogLines []state.LogLine) (analyzableLogLines []state.LogLine) {
 	var linesToAppend []int
 	var linesToAppendLenSum int
@@ -295,6 +368,8 @@ func AnalyzeStreamInGroups(logLines []state.LogLine, now time.Time) (state.Trans
 		return false // Keep initial order
 	})
 
+	logLines = stitchAutoExplainJSON(logLines)
+
 	readyLogLines, tooFreshLogLines := findReadyLogLines(logLines, now, StreamReadyThreshold)
 	if len(readyLogLines) == 0 {
 		return state.TransientLogState{}, state.LogFile{}, tooFreshLogLines, nil
@@ -365,3 +365,132 @@ func TestAnalyzeStreamInGroups(t *testing.T) {
 		}
 	}
 }
+
+type autoExplainStitchTestpair struct {
+	description string
+	// Log lines passed in the first call, of which the (incomplete) plan has to be kept for the second
+	firstLogLines []state.LogLine
+	// Log lines received in the meantime
+	secondLogLines []state.LogLine
+	samplesOut     []state.PostgresQuerySample
+}
+
+var autoExplainStitchTests = []autoExplainStitchTestpair{
+	{
+		"Cloud SQL: large message split into multiple entries (inside a JSON string), with only the first having the log_line_prefix",
+		[]state.LogLine{{
+			CollectedAt: now.Add(-5 * time.Second),
+			OccurredAt:  now.Add(-10 * time.Second),
+			LogLevel:    pganalyze_collector.LogLineInformation_LOG,
+			BackendPid:  42,
+			Content:     "duration: 2001.5 ms  plan:\n{\"Query Text\": \"SELECT pg_sl\n",
+		}, {
+			CollectedAt: now.Add(-5 * time.Second),
+			OccurredAt:  now.Add(-10 * time.Second),
+			LogLevel:    pganalyze_collector.LogLineInformation_LOG,
+			BackendPid:  43,
+			Content:     "duration: 10.0 ms  statement: SELECT 1\n",
+		}},
+		[]state.LogLine{{
+			CollectedAt: now.Add(-4 * time.Second),
+			OccurredAt:  now.Add(-10 * time.Second),
+			Content:     "eep(2)\", \"Plan\": {\"Node Type\": \"Result\"}}\n",
+		}},
+		[]state.PostgresQuerySample{{
+			OccurredAt:    now.Add(-10 * time.Second),
+			Query:         "SELECT pg_sleep(2)",
+			RuntimeMs:     2001.5,
+			HasExplain:    true,
+			ExplainSource: pganalyze_collector.QuerySample_AUTO_EXPLAIN_EXPLAIN_SOURCE,
+			ExplainFormat: pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT,
+			ExplainOutput: "[{\"Plan\":{\"Node Type\":\"Result\"}}]",
+		}},
+	},
+	{
+		"Syslog: plan split into chunks, interleaved with another backend, and continued after the chunk timeout",
+		[]state.LogLine{{
+			CollectedAt:        now.Add(-5 * time.Second),
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			BackendPid:         42,
+			LogLineNumber:      7,
+			LogLineNumberChunk: 1,
+			Content:            "duration: 2001.5 ms  plan:\n{\"Query Text\": \"SELECT pg_sleep(2)\",\n",
+		}, {
+			CollectedAt:        now.Add(-5 * time.Second),
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			BackendPid:         43,
+			LogLineNumber:      3,
+			LogLineNumberChunk: 1,
+			Content:            "duration: 10.0 ms  statement: SELECT 1\n",
+		}, {
+			CollectedAt:        now.Add(-5 * time.Second),
+			BackendPid:         42,
+			LogLineNumber:      7,
+			LogLineNumberChunk: 2,
+			Content:            "  \"Plan\": {\n",
+		}},
+		[]state.LogLine{{
+			CollectedAt:        now.Add(-4 * time.Second),
+			BackendPid:         42,
+			LogLineNumber:      7,
+			LogLineNumberChunk: 3,
+			Content:            "    \"Node Type\": \"Result\"\n",
+		}, {
+			CollectedAt:        now.Add(-4 * time.Second),
+			BackendPid:         43,
+			LogLineNumber:      4,
+			LogLineNumberChunk: 1,
+			LogLevel:           pganalyze_collector.LogLineInformation_LOG,
+			Content:            "duration: 11.0 ms  statement: SELECT 2\n",
+		}, {
+			CollectedAt:        now.Add(-4 * time.Second),
+			BackendPid:         42,
+			LogLineNumber:      7,
+			LogLineNumberChunk: 4,
+			Content:            "  }\n}\n",
+		}},
+		[]state.PostgresQuerySample{{
+			Query:         "SELECT pg_sleep(2)",
+			RuntimeMs:     2001.5,
+			HasExplain:    true,
+			ExplainSource: pganalyze_collector.QuerySample_AUTO_EXPLAIN_EXPLAIN_SOURCE,
+			ExplainFormat: pganalyze_collector.QuerySample_JSON_EXPLAIN_FORMAT,
+			ExplainOutput: "[{\"Plan\":{\"Node Type\":\"Result\"}}]",
+		}},
+	},
+}
+
+func TestAnalyzeStreamInGroupsAutoExplainJSON(t *testing.T) {
+	for _, pair := range autoExplainStitchTests {
+		_, _, tooFreshLogLines, err := stream.AnalyzeStreamInGroups(pair.firstLogLines, now)
+		if err != nil {
+			t.Fatalf("%s: %s", pair.description, err)
+		}
+		if len(tooFreshLogLines) != 1 {
+			t.Errorf("%s: expected the incomplete plan to be kept, but got %d too fresh log lines", pair.description, len(tooFreshLogLines))
+			continue
+		}
+
+		logState, logFile, tooFreshLogLines, err := stream.AnalyzeStreamInGroups(append(tooFreshLogLines, pair.secondLogLines...), now)
+		if err != nil {
+			t.Fatalf("%s: %s", pair.description, err)
+		}
+		logFile.Cleanup()
+
+		var samples []state.PostgresQuerySample
+		for _, sample := range logState.QuerySamples {
+			if sample.HasExplain {
+				sample.LogLineUUID = uuid.UUID{} // Avoid comparing against a generated UUID
+				samples = append(samples, sample)
+			}
+		}
+		cfg := pretty.CompareConfig
+		cfg.SkipZeroFields = true
+		if diff := cfg.Compare(pair.samplesOut, samples); diff != "" {
+			t.Errorf("%s: query samples diff: (-want +got)\n%s", pair.description, diff)
+		}
+		if len(tooFreshLogLines) != 0 {
+			t.Errorf("%s: expected no too fresh log lines, but got %d", pair.description, len(tooFreshLogLines))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package logs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// Time format of the csvlog and jsonlog timestamp fields
+const structuredLogTimeFormat = "2006-01-02 15:04:05.999 MST"
+
+// structuredLogLines - Turns a csvlog/jsonlog message into the log lines that Postgres would
+// have written to stderr: one for the message, followed by one for each of the DETAIL, HINT,
+// QUERY, CONTEXT and STATEMENT fields that are set (all sharing the attributes of base)
+//
+// This way the rest of log processing doesn't need to distinguish the formats.
+func structuredLogLines(base state.LogLine, level pganalyze_collector.LogLineInformation_LogLevel, message string, detail string, hint string, internalQuery string, context string, statement string) []state.LogLine {
+	logLine := base
+	logLine.LogLevel = level
+	logLine.Content = message + "\n"
+	logLines := []state.LogLine{logLine}
+
+	for _, field := range []struct {
+		value string
+		level pganalyze_collector.LogLineInformation_LogLevel
+	}{
+		{detail, pganalyze_collector.LogLineInformation_DETAIL},
+		{hint, pganalyze_collector.LogLineInformation_HINT},
+		{internalQuery, pganalyze_collector.LogLineInformation_QUERY},
+		{context, pganalyze_collector.LogLineInformation_CONTEXT},
+		{statement, pganalyze_collector.LogLineInformation_STATEMENT},
+	} {
+		if field.value == "" {
+			continue
+		}
+		logLine := base
+		logLine.LogLevel = field.level
+		logLine.Content = field.value + "\n"
+		logLines = append(logLines, logLine)
+	}
+
+	return logLines
+}
+
+// structuredLogLevel - Maps the error_severity field, which has the DEBUG level numbered (DEBUG1-5),
+// and is translated in csvlog output
+func structuredLogLevel(severity string) (pganalyze_collector.LogLineInformation_LogLevel, bool) {
+	if strings.HasPrefix(severity, "DEBUG") {
+		severity = "DEBUG"
+	}
+	return logLevelValue(severity)
+}
+
+func parseStructuredLogTime(value string) (time.Time, error) {
+	occurredAt, err := time.Parse(structuredLogTimeFormat, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Named timezones other than UTC need their offset looked up, see ParseLogLineWithPrefix
+	zone, offset := occurredAt.Zone()
+	if offset == 0 && zone != "UTC" && zone != "" {
+		zoneLocation, err := time.LoadLocation(zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.ParseInLocation(structuredLogTimeFormat, value, zoneLocation)
+	}
+	return occurredAt, nil
+}
+
+// UsesStructuredLogFormat - Whether the log_destination setting includes csvlog or jsonlog
+// (in which case the log_line_prefix doesn't matter, as these formats don't use it)
+func UsesStructuredLogFormat(logDestination string) bool {
+	for _, destination := range strings.Split(logDestination, ",") {
+		destination = strings.TrimSpace(destination)
+		if destination == "csvlog" || destination == "jsonlog" {
+			return true
+		}
+	}
+	return false
+}
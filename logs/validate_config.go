@@ -10,6 +10,35 @@ import (
 
 const MinSupportedLogMinDurationStatement = 10
 
+// CheckLogLinePrefix - Returns the server's log_line_prefix setting (or the log_line_prefix
+// configured for the collector), and whether log lines written with it can't be parsed (csvlog
+// and jsonlog output doesn't include the prefix, and Heroku's is handled by the Heroku log receiver)
+func CheckLogLinePrefix(server *state.Server, settings []state.PostgresSetting) (prefix string, unsupported bool) {
+	var logDestination string
+	for _, setting := range settings {
+		if !setting.CurrentValue.Valid {
+			continue
+		}
+		if setting.Name == "log_line_prefix" {
+			prefix = setting.CurrentValue.String
+		} else if setting.Name == "log_destination" {
+			logDestination = setting.CurrentValue.String
+		}
+	}
+	if server.Config.LogLinePrefix != "" {
+		prefix = server.Config.LogLinePrefix
+	}
+	if server.Config.SystemType == "heroku" && (prefix == HerokuLogLinePrefix || prefix == HerokuLogLinePrefixFreeTier) {
+		return prefix, false
+	}
+	return prefix, !IsSupportedPrefix(prefix) && !UsesStructuredLogFormat(logDestination)
+}
+
+// UnsupportedLogLinePrefixHint - Suggests the closest supported alternative to the given log_line_prefix
+func UnsupportedLogLinePrefixHint(prefix string) string {
+	return fmt.Sprintf("HINT - The closest supported setting is '%s', you can find a list of supported settings in the pganalyze documentation: https://pganalyze.com/docs/log-insights/setup/self-managed/troubleshooting", ClosestSupportedPrefix(prefix))
+}
+
 func ValidateLogCollectionConfig(server *state.Server, settings []state.PostgresSetting) (bool, string) {
 	var disabled = false
 	var disabledReasons []string
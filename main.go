@@ -23,17 +23,20 @@ import (
 
 	"github.com/pganalyze/collector/config"
 	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/input/system/rds"
 	"github.com/pganalyze/collector/input/system/selfhosted"
 	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/runner"
 	"github.com/pganalyze/collector/scheduler"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
+	"github.com/pganalyze/collector/util/awsutil"
+	"github.com/pganalyze/collector/util/azureutil"
 
 	_ "github.com/lib/pq" // Enable database package to use Postgres
 )
 
-func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, configFilename string) (keepRunning bool, reloadOkay bool, writeStateFile func()) {
+func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, configFilename string, configChanged chan<- struct{}) (keepRunning bool, reloadOkay bool, writeStateFile func()) {
 	var servers []*state.Server
 
 	keepRunning = false
@@ -53,12 +56,27 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 		return
 	}
 
+	var auroraClusterMembership rds.AuroraClusterMembership
+	conf.Servers, auroraClusterMembership = rds.AddAuroraClusterReaders(conf.Servers, logger)
+	conf.Servers = postgres.AddCitusWorkers(conf.Servers, globalCollectionOpts, logger)
+
+	keyVaultReferences := make([][]string, len(conf.Servers))
 	for idx, server := range conf.Servers {
 		prefixedLogger := logger.WithPrefix(server.SectionName)
 		prefixedLogger.PrintVerbose("Identified as api_system_type: %s, api_system_scope: %s, api_system_id: %s", server.SystemType, server.SystemScope, server.SystemID)
 
 		conf.Servers[idx].HTTPClient = config.CreateHTTPClient(server, prefixedLogger, false)
 		conf.Servers[idx].HTTPClientWithRetry = config.CreateHTTPClient(server, prefixedLogger, true)
+
+		err = awsutil.ResolveSecretReferences(conf.Servers[idx])
+		if err != nil {
+			prefixedLogger.PrintError("Could not resolve secret reference: %s", err)
+		}
+
+		keyVaultReferences[idx], err = azureutil.ResolveKeyVaultReferences(&conf.Servers[idx])
+		if err != nil {
+			prefixedLogger.PrintError("Could not resolve Key Vault reference: %s", err)
+		}
 	}
 
 	// Avoid even running the scheduler when we already know its not needed
@@ -71,7 +89,7 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 
 	serverConfigs := conf.Servers
 	for _, config := range serverConfigs {
-		servers = append(servers, &state.Server{Config: config, StateMutex: &sync.Mutex{}, LogStateMutex: &sync.Mutex{}, ActivityStateMutex: &sync.Mutex{}, CollectionStatusMutex: &sync.Mutex{}})
+		servers = append(servers, &state.Server{Config: config, StateMutex: &sync.Mutex{}, LogStateMutex: &sync.Mutex{}, LogRateLimitMutex: &sync.Mutex{}, LogMetricsMutex: &sync.Mutex{}, ActivityStateMutex: &sync.Mutex{}, ActivitySamplesMutex: &sync.Mutex{}, CollectionStatusMutex: &sync.Mutex{}})
 		if config.EnableReports {
 			hasAnyReportsEnabled = true
 		}
@@ -201,6 +219,7 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 			runner.CollectActivityFromAllServers(servers, globalCollectionOpts, logger)
 			wg.Done()
 		}, logger, "activity snapshot of all servers")
+		runner.SetupActivitySampling(ctx, wg, servers, globalCollectionOpts, logger)
 	}
 
 	schedulerGroups["query_stats"].ScheduleSecondary(ctx, func() {
@@ -209,6 +228,11 @@ func run(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.Col
 		wg.Done()
 	}, logger, "high frequency query statistics of all servers", schedulerGroups["stats"])
 
+	rds.WatchAuroraClusterMembership(ctx, wg, conf.Servers, auroraClusterMembership, logger, configChanged)
+	for idx, server := range conf.Servers {
+		azureutil.WatchKeyVaultReferences(ctx, wg, server, keyVaultReferences[idx], logger.WithPrefix(server.SectionName), configChanged)
+	}
+
 	keepRunning = true
 	return
 }
@@ -404,14 +428,22 @@ func main() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 
+	// Changes in Aurora cluster membership (or in Key Vault secrets) require the server list to be re-created
+	configChanged := make(chan struct{}, 1)
+
 ReadConfigAndRun:
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := sync.WaitGroup{}
-	keepRunning, reloadOkay, writeStateFile := run(ctx, &wg, globalCollectionOpts, logger, configFilename)
+	keepRunning, reloadOkay, writeStateFile := run(ctx, &wg, globalCollectionOpts, logger, configFilename, configChanged)
 
 	if keepRunning {
-		// Block here until we get any of the registered signals
-		s := <-sigs
+		// Block here until we get any of the registered signals (or need to reload)
+		var s os.Signal
+		select {
+		case s = <-sigs:
+		case <-configChanged:
+			s = syscall.SIGHUP
+		}
 
 		if s == syscall.SIGHUP {
 			if writeHeapProfile {
@@ -477,6 +509,7 @@ func checkOneInitialCollectionStatus(server *state.Server, opts state.Collection
 		return err
 	}
 	logsDisabled, logsDisabledReason := logs.ValidateLogCollectionConfig(server, settings)
+	logLinePrefix, logLinePrefixUnsupported := logs.CheckLogLinePrefix(server, settings)
 
 	var isIgnoredReplica bool
 	var collectionDisabledReason string
@@ -493,6 +526,9 @@ func checkOneInitialCollectionStatus(server *state.Server, opts state.Collection
 		logger.PrintInfo("All monitoring suspended for this server: %s", collectionDisabledReason)
 	} else if logsDisabled {
 		logger.PrintInfo("Log collection suspended for this server: %s", logsDisabledReason)
+	} else if logLinePrefixUnsupported && !opts.TestRun {
+		logger.PrintWarning("WARNING - Unsupported log_line_prefix setting: '%s', log lines will likely fail to parse", logLinePrefix)
+		logger.PrintInfo(logs.UnsupportedLogLinePrefixHint(logLinePrefix))
 	}
 
 	server.CollectionStatusMutex.Lock()
@@ -502,6 +538,7 @@ func checkOneInitialCollectionStatus(server *state.Server, opts state.Collection
 		LogSnapshotDisabledReason: logsDisabledReason,
 		CollectionDisabled:        isIgnoredReplica,
 		CollectionDisabledReason:  collectionDisabledReason,
+		LogLinePrefix:             logLinePrefix,
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 func UploadAndSendLogs(server *state.Server, grant state.GrantLogs, collectionOpts state.CollectionOpts, logger *util.Logger, logState state.TransientLogState) error {
 	for idx := range logState.LogFiles {
 		logState.LogFiles[idx].FilterLogSecret = state.ParseFilterLogSecret(server.Config.FilterLogSecret)
+		logState.LogFiles[idx].FilterLogRedact = server.Config.FilterLogRedactRules
 	}
 
 	if server.Config.FilterQuerySample == "all" {
@@ -812,6 +812,59 @@ func (Backend_WaitEvent) EnumDescriptor() ([]byte, []int) {
 	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{1, 1}
 }
 
+// How the backend sent its current (or last) query, based on the query text
+type Backend_QueryProtocol int32
+
+const (
+	Backend_UNKNOWN_QUERY_PROTOCOL      Backend_QueryProtocol = 0
+	Backend_SIMPLE_QUERY_PROTOCOL       Backend_QueryProtocol = 1 // Query without bind parameters
+	Backend_EXTENDED_QUERY_PROTOCOL     Backend_QueryProtocol = 2 // Query with bind parameters ($1, $2, ...)
+	Backend_SQL_PREPARED_QUERY_PROTOCOL Backend_QueryProtocol = 3 // SQL-level PREPARE or EXECUTE, whose prepared statements stay around for the session
+)
+
+// Enum value maps for Backend_QueryProtocol.
+var (
+	Backend_QueryProtocol_name = map[int32]string{
+		0: "UNKNOWN_QUERY_PROTOCOL",
+		1: "SIMPLE_QUERY_PROTOCOL",
+		2: "EXTENDED_QUERY_PROTOCOL",
+		3: "SQL_PREPARED_QUERY_PROTOCOL",
+	}
+	Backend_QueryProtocol_value = map[string]int32{
+		"UNKNOWN_QUERY_PROTOCOL":      0,
+		"SIMPLE_QUERY_PROTOCOL":       1,
+		"EXTENDED_QUERY_PROTOCOL":     2,
+		"SQL_PREPARED_QUERY_PROTOCOL": 3,
+	}
+)
+
+func (x Backend_QueryProtocol) Enum() *Backend_QueryProtocol {
+	p := new(Backend_QueryProtocol)
+	*p = x
+	return p
+}
+
+func (x Backend_QueryProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Backend_QueryProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_compact_activity_snapshot_proto_enumTypes[2].Descriptor()
+}
+
+func (Backend_QueryProtocol) Type() protoreflect.EnumType {
+	return &file_compact_activity_snapshot_proto_enumTypes[2]
+}
+
+func (x Backend_QueryProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Backend_QueryProtocol.Descriptor instead.
+func (Backend_QueryProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{1, 2}
+}
+
 type VacuumProgressStatistic_VacuumPhase int32
 
 const (
@@ -857,11 +910,11 @@ func (x VacuumProgressStatistic_VacuumPhase) String() string {
 }
 
 func (VacuumProgressStatistic_VacuumPhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_compact_activity_snapshot_proto_enumTypes[2].Descriptor()
+	return file_compact_activity_snapshot_proto_enumTypes[3].Descriptor()
 }
 
 func (VacuumProgressStatistic_VacuumPhase) Type() protoreflect.EnumType {
-	return &file_compact_activity_snapshot_proto_enumTypes[2]
+	return &file_compact_activity_snapshot_proto_enumTypes[3]
 }
 
 func (x VacuumProgressStatistic_VacuumPhase) Number() protoreflect.EnumNumber {
@@ -882,9 +935,14 @@ type CompactActivitySnapshot struct {
 	Backends        []*Backend       `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
 	// Timestamp of the previous activity snapshot (collected_at) to support the
 	// receiver marking values as having been last visible with the prior snapshot
-	PrevActivitySnapshotAt     *timestamp.Timestamp         `protobuf:"bytes,3,opt,name=prev_activity_snapshot_at,json=prevActivitySnapshotAt,proto3" json:"prev_activity_snapshot_at,omitempty"`
-	VacuumProgressInformations []*VacuumProgressInformation `protobuf:"bytes,10,rep,name=vacuum_progress_informations,json=vacuumProgressInformations,proto3" json:"vacuum_progress_informations,omitempty"`
-	VacuumProgressStatistics   []*VacuumProgressStatistic   `protobuf:"bytes,11,rep,name=vacuum_progress_statistics,json=vacuumProgressStatistics,proto3" json:"vacuum_progress_statistics,omitempty"`
+	PrevActivitySnapshotAt     *timestamp.Timestamp              `protobuf:"bytes,3,opt,name=prev_activity_snapshot_at,json=prevActivitySnapshotAt,proto3" json:"prev_activity_snapshot_at,omitempty"`
+	VacuumProgressInformations []*VacuumProgressInformation      `protobuf:"bytes,10,rep,name=vacuum_progress_informations,json=vacuumProgressInformations,proto3" json:"vacuum_progress_informations,omitempty"`
+	VacuumProgressStatistics   []*VacuumProgressStatistic        `protobuf:"bytes,11,rep,name=vacuum_progress_statistics,json=vacuumProgressStatistics,proto3" json:"vacuum_progress_statistics,omitempty"`
+	PerformanceInsights        *PerformanceInsightsInformation   `protobuf:"bytes,20,opt,name=performance_insights,json=performanceInsights,proto3" json:"performance_insights,omitempty"` // Only set for Amazon RDS with aws_performance_insights enabled
+	MaintenanceProgress        []*MaintenanceProgressInformation `protobuf:"bytes,21,rep,name=maintenance_progress,json=maintenanceProgress,proto3" json:"maintenance_progress,omitempty"`
+	BlockingLocks              []*BlockingLockInformation        `protobuf:"bytes,22,rep,name=blocking_locks,json=blockingLocks,proto3" json:"blocking_locks,omitempty"`
+	ActivitySamples            *ActivitySampleInformation        `protobuf:"bytes,23,opt,name=activity_samples,json=activitySamples,proto3" json:"activity_samples,omitempty"`
+	LongTransactions           []*LongTransactionInformation     `protobuf:"bytes,24,rep,name=long_transactions,json=longTransactions,proto3" json:"long_transactions,omitempty"`
 }
 
 func (x *CompactActivitySnapshot) Reset() {
@@ -954,32 +1012,71 @@ func (x *CompactActivitySnapshot) GetVacuumProgressStatistics() []*VacuumProgres
 	return nil
 }
 
+func (x *CompactActivitySnapshot) GetPerformanceInsights() *PerformanceInsightsInformation {
+	if x != nil {
+		return x.PerformanceInsights
+	}
+	return nil
+}
+
+func (x *CompactActivitySnapshot) GetMaintenanceProgress() []*MaintenanceProgressInformation {
+	if x != nil {
+		return x.MaintenanceProgress
+	}
+	return nil
+}
+
+func (x *CompactActivitySnapshot) GetBlockingLocks() []*BlockingLockInformation {
+	if x != nil {
+		return x.BlockingLocks
+	}
+	return nil
+}
+
+func (x *CompactActivitySnapshot) GetActivitySamples() *ActivitySampleInformation {
+	if x != nil {
+		return x.ActivitySamples
+	}
+	return nil
+}
+
+func (x *CompactActivitySnapshot) GetLongTransactions() []*LongTransactionInformation {
+	if x != nil {
+		return x.LongTransactions
+	}
+	return nil
+}
+
 type Backend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Identity        uint64               `protobuf:"varint,1,opt,name=identity,proto3" json:"identity,omitempty"` // Server-wide unique identifier (backend_start + PID)
-	Pid             int32                `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
-	HasRoleIdx      bool                 `protobuf:"varint,3,opt,name=has_role_idx,json=hasRoleIdx,proto3" json:"has_role_idx,omitempty"`
-	RoleIdx         int32                `protobuf:"varint,4,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
-	HasDatabaseIdx  bool                 `protobuf:"varint,5,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
-	DatabaseIdx     int32                `protobuf:"varint,6,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
-	HasQueryIdx     bool                 `protobuf:"varint,7,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"`
-	QueryIdx        int32                `protobuf:"varint,8,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
-	QueryText       string               `protobuf:"bytes,9,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
-	ApplicationName string               `protobuf:"bytes,10,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
-	ClientAddr      string               `protobuf:"bytes,11,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
-	ClientPort      int32                `protobuf:"varint,12,opt,name=client_port,json=clientPort,proto3" json:"client_port,omitempty"`
-	BackendStart    *timestamp.Timestamp `protobuf:"bytes,13,opt,name=backend_start,json=backendStart,proto3" json:"backend_start,omitempty"`
-	XactStart       *timestamp.Timestamp `protobuf:"bytes,14,opt,name=xact_start,json=xactStart,proto3" json:"xact_start,omitempty"`
-	QueryStart      *timestamp.Timestamp `protobuf:"bytes,15,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
-	StateChange     *timestamp.Timestamp `protobuf:"bytes,16,opt,name=state_change,json=stateChange,proto3" json:"state_change,omitempty"`
-	Waiting         bool                 `protobuf:"varint,17,opt,name=waiting,proto3" json:"waiting,omitempty"`
-	State           string               `protobuf:"bytes,18,opt,name=state,proto3" json:"state,omitempty"`
-	WaitEventType   string               `protobuf:"bytes,19,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
-	WaitEvent       string               `protobuf:"bytes,20,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
-	BackendType     string               `protobuf:"bytes,21,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	Identity             uint64                `protobuf:"varint,1,opt,name=identity,proto3" json:"identity,omitempty"` // Server-wide unique identifier (backend_start + PID)
+	Pid                  int32                 `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	HasRoleIdx           bool                  `protobuf:"varint,3,opt,name=has_role_idx,json=hasRoleIdx,proto3" json:"has_role_idx,omitempty"`
+	RoleIdx              int32                 `protobuf:"varint,4,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	HasDatabaseIdx       bool                  `protobuf:"varint,5,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
+	DatabaseIdx          int32                 `protobuf:"varint,6,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	HasQueryIdx          bool                  `protobuf:"varint,7,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"`
+	QueryIdx             int32                 `protobuf:"varint,8,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	QueryText            string                `protobuf:"bytes,9,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	ApplicationName      string                `protobuf:"bytes,10,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ClientAddr           string                `protobuf:"bytes,11,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
+	ClientPort           int32                 `protobuf:"varint,12,opt,name=client_port,json=clientPort,proto3" json:"client_port,omitempty"`
+	BackendStart         *timestamp.Timestamp  `protobuf:"bytes,13,opt,name=backend_start,json=backendStart,proto3" json:"backend_start,omitempty"`
+	XactStart            *timestamp.Timestamp  `protobuf:"bytes,14,opt,name=xact_start,json=xactStart,proto3" json:"xact_start,omitempty"`
+	QueryStart           *timestamp.Timestamp  `protobuf:"bytes,15,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
+	StateChange          *timestamp.Timestamp  `protobuf:"bytes,16,opt,name=state_change,json=stateChange,proto3" json:"state_change,omitempty"`
+	Waiting              bool                  `protobuf:"varint,17,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	State                string                `protobuf:"bytes,18,opt,name=state,proto3" json:"state,omitempty"`
+	WaitEventType        string                `protobuf:"bytes,19,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
+	WaitEvent            string                `protobuf:"bytes,20,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	BackendType          string                `protobuf:"bytes,21,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	QueryProtocol        Backend_QueryProtocol `protobuf:"varint,22,opt,name=query_protocol,json=queryProtocol,proto3,enum=pganalyze.collector.Backend_QueryProtocol" json:"query_protocol,omitempty"`
+	PrivateMemoryBytes   uint64                `protobuf:"varint,23,opt,name=private_memory_bytes,json=privateMemoryBytes,proto3" json:"private_memory_bytes,omitempty"`       // Resident memory that isn't shared with other processes, only set for the backends using the most (if log_backend_memory_contexts is set)
+	MemoryContextsLogged bool                  `protobuf:"varint,24,opt,name=memory_contexts_logged,json=memoryContextsLogged,proto3" json:"memory_contexts_logged,omitempty"` // Whether the memory contexts were written to the Postgres log (by pg_log_backend_memory_contexts) in this snapshot
+	QueryFullText        bool                  `protobuf:"varint,25,opt,name=query_full_text,json=queryFullText,proto3" json:"query_full_text,omitempty"`                      // Whether the query text was truncated in pg_stat_activity (at track_activity_query_size), and replaced with the full normalized text from pg_stat_statements
 }
 
 func (x *Backend) Reset() {
@@ -1161,6 +1258,34 @@ func (x *Backend) GetBackendType() string {
 	return ""
 }
 
+func (x *Backend) GetQueryProtocol() Backend_QueryProtocol {
+	if x != nil {
+		return x.QueryProtocol
+	}
+	return Backend_UNKNOWN_QUERY_PROTOCOL
+}
+
+func (x *Backend) GetPrivateMemoryBytes() uint64 {
+	if x != nil {
+		return x.PrivateMemoryBytes
+	}
+	return 0
+}
+
+func (x *Backend) GetMemoryContextsLogged() bool {
+	if x != nil {
+		return x.MemoryContextsLogged
+	}
+	return false
+}
+
+func (x *Backend) GetQueryFullText() bool {
+	if x != nil {
+		return x.QueryFullText
+	}
+	return false
+}
+
 type VacuumProgressInformation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1174,6 +1299,8 @@ type VacuumProgressInformation struct {
 	StartedAt       *timestamp.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
 	Autovacuum      bool                 `protobuf:"varint,7,opt,name=autovacuum,proto3" json:"autovacuum,omitempty"`
 	Toast           bool                 `protobuf:"varint,8,opt,name=toast,proto3" json:"toast,omitempty"`
+	AntiWraparound  bool                 `protobuf:"varint,9,opt,name=anti_wraparound,json=antiWraparound,proto3" json:"anti_wraparound,omitempty"` // Whether this is an autovacuum to prevent transaction ID wraparound
+	Pid             int32                `protobuf:"varint,10,opt,name=pid,proto3" json:"pid,omitempty"`                                            // Process ID of the (auto)vacuum worker
 }
 
 func (x *VacuumProgressInformation) Reset() {
@@ -1264,19 +1391,39 @@ func (x *VacuumProgressInformation) GetToast() bool {
 	return false
 }
 
+func (x *VacuumProgressInformation) GetAntiWraparound() bool {
+	if x != nil {
+		return x.AntiWraparound
+	}
+	return false
+}
+
+func (x *VacuumProgressInformation) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
 type VacuumProgressStatistic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VacuumIdentity   uint64                              `protobuf:"varint,1,opt,name=vacuum_identity,json=vacuumIdentity,proto3" json:"vacuum_identity,omitempty"` // Server-wide unique identifier for this vacuum run (query_start + PID)
-	Phase            VacuumProgressStatistic_VacuumPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=pganalyze.collector.VacuumProgressStatistic_VacuumPhase" json:"phase,omitempty"`
-	HeapBlksTotal    int64                               `protobuf:"varint,3,opt,name=heap_blks_total,json=heapBlksTotal,proto3" json:"heap_blks_total,omitempty"`
-	HeapBlksScanned  int64                               `protobuf:"varint,4,opt,name=heap_blks_scanned,json=heapBlksScanned,proto3" json:"heap_blks_scanned,omitempty"`
-	HeapBlksVacuumed int64                               `protobuf:"varint,5,opt,name=heap_blks_vacuumed,json=heapBlksVacuumed,proto3" json:"heap_blks_vacuumed,omitempty"`
-	IndexVacuumCount int64                               `protobuf:"varint,6,opt,name=index_vacuum_count,json=indexVacuumCount,proto3" json:"index_vacuum_count,omitempty"`
-	MaxDeadTuples    int64                               `protobuf:"varint,7,opt,name=max_dead_tuples,json=maxDeadTuples,proto3" json:"max_dead_tuples,omitempty"`
-	NumDeadTuples    int64                               `protobuf:"varint,8,opt,name=num_dead_tuples,json=numDeadTuples,proto3" json:"num_dead_tuples,omitempty"`
+	VacuumIdentity    uint64                              `protobuf:"varint,1,opt,name=vacuum_identity,json=vacuumIdentity,proto3" json:"vacuum_identity,omitempty"` // Server-wide unique identifier for this vacuum run (query_start + PID)
+	Phase             VacuumProgressStatistic_VacuumPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=pganalyze.collector.VacuumProgressStatistic_VacuumPhase" json:"phase,omitempty"`
+	HeapBlksTotal     int64                               `protobuf:"varint,3,opt,name=heap_blks_total,json=heapBlksTotal,proto3" json:"heap_blks_total,omitempty"`
+	HeapBlksScanned   int64                               `protobuf:"varint,4,opt,name=heap_blks_scanned,json=heapBlksScanned,proto3" json:"heap_blks_scanned,omitempty"`
+	HeapBlksVacuumed  int64                               `protobuf:"varint,5,opt,name=heap_blks_vacuumed,json=heapBlksVacuumed,proto3" json:"heap_blks_vacuumed,omitempty"`
+	IndexVacuumCount  int64                               `protobuf:"varint,6,opt,name=index_vacuum_count,json=indexVacuumCount,proto3" json:"index_vacuum_count,omitempty"`
+	MaxDeadTuples     int64                               `protobuf:"varint,7,opt,name=max_dead_tuples,json=maxDeadTuples,proto3" json:"max_dead_tuples,omitempty"`
+	NumDeadTuples     int64                               `protobuf:"varint,8,opt,name=num_dead_tuples,json=numDeadTuples,proto3" json:"num_dead_tuples,omitempty"`
+	WaitEventType     string                              `protobuf:"bytes,9,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"` // Wait event of the (auto)vacuum worker, empty if not waiting
+	WaitEvent         string                              `protobuf:"bytes,10,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	MaxDeadTupleBytes int64                               `protobuf:"varint,11,opt,name=max_dead_tuple_bytes,json=maxDeadTupleBytes,proto3" json:"max_dead_tuple_bytes,omitempty"` // Postgres 17+
+	DeadTupleBytes    int64                               `protobuf:"varint,12,opt,name=dead_tuple_bytes,json=deadTupleBytes,proto3" json:"dead_tuple_bytes,omitempty"`            // Postgres 17+
+	IndexesTotal      int64                               `protobuf:"varint,13,opt,name=indexes_total,json=indexesTotal,proto3" json:"indexes_total,omitempty"`                    // Postgres 17+
+	IndexesProcessed  int64                               `protobuf:"varint,14,opt,name=indexes_processed,json=indexesProcessed,proto3" json:"indexes_processed,omitempty"`        // Postgres 17+
 }
 
 func (x *VacuumProgressStatistic) Reset() {
@@ -1367,777 +1514,1922 @@ func (x *VacuumProgressStatistic) GetNumDeadTuples() int64 {
 	return 0
 }
 
-var File_compact_activity_snapshot_proto protoreflect.FileDescriptor
+func (x *VacuumProgressStatistic) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
 
-var file_compact_activity_snapshot_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x13, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x03, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
-	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x4f, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x55, 0x0a, 0x19,
-	0x70, 0x72, 0x65, 0x76, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x16, 0x70, 0x72, 0x65,
-	0x76, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x41, 0x74, 0x12, 0x70, 0x0a, 0x1c, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x76, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6a, 0x0a, 0x1a, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x18, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x22, 0x8e, 0x4b, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68,
-	0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
-	0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69,
-	0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61,
-	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f,
-	0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x78, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b,
-	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x69,
-	0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
-	0x14, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x47, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a,
-	0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x50,
-	0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x50,
-	0x43, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x47, 0x5f, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x49, 0x4f, 0x10, 0x0b, 0x22, 0xd7, 0x42, 0x0a, 0x09, 0x57, 0x61, 0x69,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x26,
-	0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x49, 0x44, 0x5f,
-	0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x66, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x58, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x67, 0x12, 0x25,
-	0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x68, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x69, 0x12, 0x28,
-	0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6a, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41,
-	0x4c, 0x5f, 0x42, 0x55, 0x46, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x6b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6c, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43,
-	0x4b, 0x10, 0x6d, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x43, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x6f, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x70, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43,
-	0x54, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x71, 0x12, 0x34, 0x0a, 0x30,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x46, 0x46,
-	0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x72, 0x12, 0x34, 0x0a, 0x30, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41,
-	0x43, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
-	0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45,
-	0x4c, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
-	0x4b, 0x10, 0x74, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x75, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x2c, 0x0a,
-	0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x77, 0x12, 0x27, 0x0a, 0x23, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x78, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x5f,
-	0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55,
-	0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7a, 0x12, 0x2e, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55,
-	0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7c, 0x12, 0x2b,
-	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50,
-	0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7d, 0x12, 0x24, 0x0a, 0x20, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x54, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x7e, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7f, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
-	0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x58, 0x41, 0x43, 0x54,
-	0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x36, 0x0a,
-	0x31, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f,
-	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x10, 0x81, 0x01, 0x12, 0x3c, 0x0a, 0x37, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41,
-	0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x82, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x45, 0x52,
-	0x5f, 0x58, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x83, 0x01, 0x12, 0x24, 0x0a, 0x1f,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x84, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55,
-	0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x85,
-	0x01, 0x12, 0x38, 0x0a, 0x33, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x53,
-	0x48, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x86, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x87, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x88, 0x01, 0x12, 0x34, 0x0a, 0x2f, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f,
-	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x89,
-	0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8a, 0x01,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8b, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50,
-	0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f,
-	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8c, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c,
-	0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8d, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8e, 0x01, 0x12, 0x2a, 0x0a,
-	0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f,
-	0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8f, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
-	0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43,
-	0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x91, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43,
-	0x4c, 0x4f, 0x47, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x92, 0x01, 0x12, 0x2a,
-	0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x53, 0x5f,
-	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x93, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f, 0x42, 0x55, 0x46, 0x46,
-	0x45, 0x52, 0x53, 0x10, 0x94, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d,
-	0x58, 0x41, 0x43, 0x54, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45,
-	0x52, 0x53, 0x10, 0x95, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d, 0x58,
-	0x41, 0x43, 0x54, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52,
-	0x53, 0x10, 0x96, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x41, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x97, 0x01, 0x12, 0x2b, 0x0a,
-	0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52,
-	0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4f, 0x4c, 0x44, 0x53, 0x45, 0x52, 0x58, 0x49, 0x44, 0x5f,
-	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x98, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x99, 0x01,
-	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
-	0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x9a, 0x01, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x9b, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x10, 0x9c, 0x01, 0x12, 0x39, 0x0a, 0x34, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c,
-	0x4f, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53,
-	0x53, 0x10, 0x9d, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52, 0x4f,
-	0x43, 0x10, 0x9e, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46,
-	0x46, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x9f, 0x01, 0x12, 0x26,
-	0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41,
-	0x47, 0x45, 0x52, 0x10, 0xa0, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50,
-	0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41,
-	0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0xa1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4a,
-	0x4f, 0x49, 0x4e, 0x10, 0xa2, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50,
-	0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x53,
-	0x41, 0x10, 0xa3, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa4, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f,
-	0x52, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa5, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x4d,
-	0x4f, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa6, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
-	0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45,
-	0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0xa7, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
-	0x5f, 0x54, 0x42, 0x4d, 0x10, 0xa8, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
-	0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10,
-	0xa9, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0xc8, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x1c, 0x0a,
-	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x54, 0x41, 0x47, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10, 0xca, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
-	0x47, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10, 0xcb, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47,
-	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x01, 0x12,
-	0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcd, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
-	0x47, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x10, 0xce, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x42, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0xcf, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x55, 0x53, 0x45,
-	0x52, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xd0, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x41,
-	0x44, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
-	0x50, 0x49, 0x4e, 0x10, 0xac, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x90,
-	0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x50, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e,
-	0x54, 0x53, 0x10, 0x91, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49,
-	0x4e, 0x10, 0xf4, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4d, 0x41,
-	0x49, 0x4e, 0x10, 0xf5, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x42,
-	0x45, 0x52, 0x4e, 0x41, 0x54, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52,
-	0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf7, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
-	0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf9, 0x03, 0x12,
-	0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f, 0x4d,
-	0x41, 0x49, 0x4e, 0x10, 0xfa, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
-	0x10, 0xfb, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x41,
-	0x4c, 0x4c, 0x10, 0xfc, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c,
-	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0xfd, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x47,
-	0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfe, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43,
-	0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x1f, 0x0a,
-	0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x80, 0x04, 0x12, 0x1f,
-	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x81, 0x04, 0x12,
-	0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a, 0x17,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
-	0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd9, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41,
-	0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x10, 0xda, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49,
-	0x56, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xdb, 0x04, 0x12, 0x1f,
-	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x53, 0x4c,
-	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0xdc, 0x04, 0x12,
-	0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
-	0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xdd, 0x04, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0xde, 0x04, 0x12, 0x25, 0x0a,
-	0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54,
-	0x41, 0x10, 0xdf, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x47, 0x53, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56,
-	0x45, 0x52, 0x10, 0xe0, 0x04, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55,
-	0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0xbc, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x50,
-	0x41, 0x47, 0x45, 0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xbf, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f,
-	0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0xc0, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54,
-	0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc1, 0x05,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0xc2, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4c,
-	0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xc3, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49,
-	0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc4, 0x05,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0xc5, 0x05, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0xc6, 0x05, 0x12,
-	0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47,
-	0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0xc7, 0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f,
-	0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0xc8, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0xc9, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43,
-	0x48, 0x45, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xca, 0x05, 0x12,
-	0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f,
-	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0xcb, 0x05, 0x12, 0x30, 0x0a, 0x2b,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x50,
-	0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0xcc, 0x05, 0x12, 0x2c,
-	0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53,
-	0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x41,
-	0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xcd, 0x05, 0x12, 0x2a, 0x0a, 0x25,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
-	0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x45, 0x4c, 0x45,
-	0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xce, 0x05, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57,
-	0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x49, 0x4e, 0x53, 0x45, 0x52,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0xcf, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x05, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c,
-	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x10, 0xd1, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10,
-	0xd2, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4d, 0x51, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10,
-	0xd3, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xd4, 0x05, 0x12, 0x17,
-	0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x10, 0xd5, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x42,
-	0x49, 0x54, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd6, 0x05, 0x12, 0x2a, 0x0a,
-	0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41,
-	0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45,
-	0x58, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd7, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c,
-	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xd8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x41, 0x52, 0x52,
-	0x41, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
-	0xd9, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0xda, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x44, 0x52, 0x4f,
-	0x50, 0x10, 0xdb, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
-	0x4c, 0x4f, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdc, 0x05, 0x12, 0x1d, 0x0a, 0x18, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x41, 0x46, 0x45, 0x5f, 0x53,
-	0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0xdd, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45,
-	0x50, 0x10, 0xde, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x4f,
-	0x4e, 0x45, 0x10, 0xdf, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x52, 0x54, 0x10, 0xe0, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55,
-	0x50, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12, 0x18, 0x0a,
-	0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f, 0x53,
-	0x4c, 0x45, 0x45, 0x50, 0x10, 0xa1, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x41,
-	0x50, 0x50, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa2, 0x06, 0x12, 0x1c, 0x0a,
-	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x84, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x85, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x86, 0x07, 0x12, 0x21, 0x0a,
-	0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x87, 0x07,
-	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43,
-	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x88, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x89, 0x07, 0x12, 0x29,
-	0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x8a, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x8b, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x8c, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0x8d, 0x07, 0x12, 0x1f, 0x0a, 0x1a,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0x8e, 0x07, 0x12, 0x28, 0x0a,
-	0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0x8f, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
-	0x50, 0x52, 0x45, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x90, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x91, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x92, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x93, 0x07, 0x12,
-	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41,
-	0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x94, 0x07,
-	0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
-	0x53, 0x4d, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x57, 0x52, 0x49,
-	0x54, 0x45, 0x10, 0x95, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44,
-	0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0x96, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f,
-	0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x97, 0x07, 0x12,
-	0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54,
-	0x41, 0x44, 0x49, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x98, 0x07, 0x12, 0x25, 0x0a,
-	0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41,
-	0x44, 0x10, 0x99, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9a, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0x9b, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0x9c, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43,
-	0x10, 0x9d, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54,
-	0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9e,
-	0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9f, 0x07,
-	0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xa0, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xa1, 0x07,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0xa2, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
-	0x41, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa3, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xa4, 0x07, 0x12, 0x22, 0x0a,
-	0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa5,
-	0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0xa6, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46,
-	0x46, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa7, 0x07, 0x12, 0x2c, 0x0a, 0x27,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49,
-	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa8, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa9,
-	0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54,
-	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaa, 0x07,
-	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52,
-	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0xab, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xac, 0x07, 0x12,
-	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c,
-	0x52, 0x55, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xad, 0x07,
-	0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x4c, 0x52, 0x55, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xae, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xaf, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0xb0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0xb1, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
-	0xb2, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0xb3, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f,
-	0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb4, 0x07, 0x12,
-	0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb5, 0x07, 0x12, 0x25, 0x0a, 0x20,
-	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44,
-	0x10, 0xb6, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f,
-	0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb7, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e,
-	0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0xb8, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0xb9, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xba, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53,
-	0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbb, 0x07, 0x12,
-	0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
-	0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbc, 0x07,
-	0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
-	0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e,
-	0x43, 0x10, 0xbd, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbe, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59,
-	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbf, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0xc0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0xc1, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0xc2, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x10, 0xc3, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xc4, 0x07,
-	0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
-	0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc5, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41,
-	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e,
-	0x43, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10,
-	0xc6, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc7, 0x07, 0x12, 0x23, 0x0a,
-	0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43,
-	0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x52, 0x52, 0x49, 0x45, 0x52, 0x10,
-	0xc8, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x49, 0x4f, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x90, 0x4e,
-	0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49,
-	0x4e, 0x10, 0x91, 0x4e, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d,
-	0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x92, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49,
-	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x49, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55,
-	0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x10, 0x93, 0x4e, 0x22, 0x04, 0x08, 0x64,
-	0x10, 0x64, 0x22, 0xc1, 0x02, 0x0a, 0x19, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c,
-	0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x22, 0x9a, 0x04, 0x0a, 0x17, 0x56, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x63,
-	0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x05, 0x70,
-	0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50,
-	0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68,
-	0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73,
-	0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12,
-	0x2c, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x76, 0x61, 0x63,
-	0x75, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x65, 0x61,
-	0x70, 0x42, 0x6c, 0x6b, 0x73, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x2c, 0x0a,
-	0x12, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
-	0x61, 0x78, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70,
-	0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f,
-	0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75,
-	0x6d, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b,
-	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49,
-	0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
-	0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x48, 0x45, 0x41, 0x50, 0x10, 0x03, 0x12,
-	0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50,
-	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05,
-	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55,
-	0x50, 0x10, 0x06, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *VacuumProgressStatistic) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
 }
 
-var (
-	file_compact_activity_snapshot_proto_rawDescOnce sync.Once
-	file_compact_activity_snapshot_proto_rawDescData = file_compact_activity_snapshot_proto_rawDesc
-)
+func (x *VacuumProgressStatistic) GetMaxDeadTupleBytes() int64 {
+	if x != nil {
+		return x.MaxDeadTupleBytes
+	}
+	return 0
+}
 
-func file_compact_activity_snapshot_proto_rawDescGZIP() []byte {
-	file_compact_activity_snapshot_proto_rawDescOnce.Do(func() {
-		file_compact_activity_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(file_compact_activity_snapshot_proto_rawDescData)
-	})
-	return file_compact_activity_snapshot_proto_rawDescData
+func (x *VacuumProgressStatistic) GetDeadTupleBytes() int64 {
+	if x != nil {
+		return x.DeadTupleBytes
+	}
+	return 0
 }
 
-var file_compact_activity_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_compact_activity_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_compact_activity_snapshot_proto_goTypes = []interface{}{
-	(Backend_WaitEventType)(0),               // 0: pganalyze.collector.Backend.WaitEventType
-	(Backend_WaitEvent)(0),                   // 1: pganalyze.collector.Backend.WaitEvent
-	(VacuumProgressStatistic_VacuumPhase)(0), // 2: pganalyze.collector.VacuumProgressStatistic.VacuumPhase
-	(*CompactActivitySnapshot)(nil),          // 3: pganalyze.collector.CompactActivitySnapshot
-	(*Backend)(nil),                          // 4: pganalyze.collector.Backend
-	(*VacuumProgressInformation)(nil),        // 5: pganalyze.collector.VacuumProgressInformation
-	(*VacuumProgressStatistic)(nil),          // 6: pganalyze.collector.VacuumProgressStatistic
-	(*PostgresVersion)(nil),                  // 7: pganalyze.collector.PostgresVersion
-	(*timestamp.Timestamp)(nil),              // 8: google.protobuf.Timestamp
+func (x *VacuumProgressStatistic) GetIndexesTotal() int64 {
+	if x != nil {
+		return x.IndexesTotal
+	}
+	return 0
 }
-var file_compact_activity_snapshot_proto_depIdxs = []int32{
-	7,  // 0: pganalyze.collector.CompactActivitySnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
-	4,  // 1: pganalyze.collector.CompactActivitySnapshot.backends:type_name -> pganalyze.collector.Backend
-	8,  // 2: pganalyze.collector.CompactActivitySnapshot.prev_activity_snapshot_at:type_name -> google.protobuf.Timestamp
-	5,  // 3: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_informations:type_name -> pganalyze.collector.VacuumProgressInformation
-	6,  // 4: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_statistics:type_name -> pganalyze.collector.VacuumProgressStatistic
-	8,  // 5: pganalyze.collector.Backend.backend_start:type_name -> google.protobuf.Timestamp
-	8,  // 6: pganalyze.collector.Backend.xact_start:type_name -> google.protobuf.Timestamp
-	8,  // 7: pganalyze.collector.Backend.query_start:type_name -> google.protobuf.Timestamp
-	8,  // 8: pganalyze.collector.Backend.state_change:type_name -> google.protobuf.Timestamp
-	8,  // 9: pganalyze.collector.VacuumProgressInformation.started_at:type_name -> google.protobuf.Timestamp
-	2,  // 10: pganalyze.collector.VacuumProgressStatistic.phase:type_name -> pganalyze.collector.VacuumProgressStatistic.VacuumPhase
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+
+func (x *VacuumProgressStatistic) GetIndexesProcessed() int64 {
+	if x != nil {
+		return x.IndexesProcessed
+	}
+	return 0
 }
 
-func init() { file_compact_activity_snapshot_proto_init() }
-func file_compact_activity_snapshot_proto_init() {
-	if File_compact_activity_snapshot_proto != nil {
-		return
+// Database load as sampled by Amazon RDS Performance Insights since the previous activity snapshot
+type PerformanceInsightsInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime      *timestamp.Timestamp                            `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamp.Timestamp                            `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	LoadSamples    []*PerformanceInsightsInformation_LoadSample    `protobuf:"bytes,3,rep,name=load_samples,json=loadSamples,proto3" json:"load_samples,omitempty"`
+	WaitEventLoads []*PerformanceInsightsInformation_WaitEventLoad `protobuf:"bytes,4,rep,name=wait_event_loads,json=waitEventLoads,proto3" json:"wait_event_loads,omitempty"`
+}
+
+func (x *PerformanceInsightsInformation) Reset() {
+	*x = PerformanceInsightsInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	file_shared_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_compact_activity_snapshot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompactActivitySnapshot); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *PerformanceInsightsInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceInsightsInformation) ProtoMessage() {}
+
+func (x *PerformanceInsightsInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_compact_activity_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Backend); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceInsightsInformation.ProtoReflect.Descriptor instead.
+func (*PerformanceInsightsInformation) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PerformanceInsightsInformation) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PerformanceInsightsInformation) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PerformanceInsightsInformation) GetLoadSamples() []*PerformanceInsightsInformation_LoadSample {
+	if x != nil {
+		return x.LoadSamples
+	}
+	return nil
+}
+
+func (x *PerformanceInsightsInformation) GetWaitEventLoads() []*PerformanceInsightsInformation_WaitEventLoad {
+	if x != nil {
+		return x.WaitEventLoads
+	}
+	return nil
+}
+
+// Maintenance operation (other than VACUUM) that is currently running (Postgres 12+)
+type MaintenanceProgressInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid         int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Command     string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`                             // e.g. "ANALYZE", "CLUSTER", "VACUUM FULL", "CREATE INDEX CONCURRENTLY", "BASE_BACKUP" or "COPY FROM"
+	DatabaseIdx int32  `protobuf:"varint,3,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"` // -1 if not specific to a database (BASE_BACKUP)
+	RelationIdx int32  `protobuf:"varint,4,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"` // -1 if not specific to a relation
+	Phase       string `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`                                 // Empty for COPY
+	BlocksTotal int64  `protobuf:"varint,6,opt,name=blocks_total,json=blocksTotal,proto3" json:"blocks_total,omitempty"` // Which of the counters are set depends on the kind of operation
+	BlocksDone  int64  `protobuf:"varint,7,opt,name=blocks_done,json=blocksDone,proto3" json:"blocks_done,omitempty"`
+	TuplesTotal int64  `protobuf:"varint,8,opt,name=tuples_total,json=tuplesTotal,proto3" json:"tuples_total,omitempty"`
+	TuplesDone  int64  `protobuf:"varint,9,opt,name=tuples_done,json=tuplesDone,proto3" json:"tuples_done,omitempty"`
+	BytesTotal  int64  `protobuf:"varint,10,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	BytesDone   int64  `protobuf:"varint,11,opt,name=bytes_done,json=bytesDone,proto3" json:"bytes_done,omitempty"`
+}
+
+func (x *MaintenanceProgressInformation) Reset() {
+	*x = MaintenanceProgressInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceProgressInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceProgressInformation) ProtoMessage() {}
+
+func (x *MaintenanceProgressInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceProgressInformation.ProtoReflect.Descriptor instead.
+func (*MaintenanceProgressInformation) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaintenanceProgressInformation) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *MaintenanceProgressInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *MaintenanceProgressInformation) GetBlocksTotal() int64 {
+	if x != nil {
+		return x.BlocksTotal
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetBlocksDone() int64 {
+	if x != nil {
+		return x.BlocksDone
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetTuplesTotal() int64 {
+	if x != nil {
+		return x.TuplesTotal
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetTuplesDone() int64 {
+	if x != nil {
+		return x.TuplesDone
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetBytesTotal() int64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *MaintenanceProgressInformation) GetBytesDone() int64 {
+	if x != nil {
+		return x.BytesDone
+	}
+	return 0
+}
+
+// Backend waiting for a lock held (or requested earlier) by another backend, one per blocker (Postgres 9.6+)
+type BlockingLockInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockedPid   int32                `protobuf:"varint,1,opt,name=blocked_pid,json=blockedPid,proto3" json:"blocked_pid,omitempty"`
+	BlockingPid  int32                `protobuf:"varint,2,opt,name=blocking_pid,json=blockingPid,proto3" json:"blocking_pid,omitempty"`
+	LockType     string               `protobuf:"bytes,3,opt,name=lock_type,json=lockType,proto3" json:"lock_type,omitempty"`             // e.g. "relation", "transactionid" or "tuple" (as in pg_locks.locktype)
+	Mode         string               `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`                                     // Lock mode the blocked backend requested, e.g. "AccessExclusiveLock"
+	DatabaseIdx  int32                `protobuf:"varint,5,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`   // -1 for locks on objects that aren't part of a database (e.g. transaction IDs)
+	RelationIdx  int32                `protobuf:"varint,6,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`   // -1 if not on a relation, or the relation is not in the database the collector connects to
+	WaitingSince *timestamp.Timestamp `protobuf:"bytes,7,opt,name=waiting_since,json=waitingSince,proto3" json:"waiting_since,omitempty"` // Postgres 14+ (from pg_locks.waitstart), otherwise start of the blocked query
+}
+
+func (x *BlockingLockInformation) Reset() {
+	*x = BlockingLockInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockingLockInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingLockInformation) ProtoMessage() {}
+
+func (x *BlockingLockInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingLockInformation.ProtoReflect.Descriptor instead.
+func (*BlockingLockInformation) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlockingLockInformation) GetBlockedPid() int32 {
+	if x != nil {
+		return x.BlockedPid
+	}
+	return 0
+}
+
+func (x *BlockingLockInformation) GetBlockingPid() int32 {
+	if x != nil {
+		return x.BlockingPid
+	}
+	return 0
+}
+
+func (x *BlockingLockInformation) GetLockType() string {
+	if x != nil {
+		return x.LockType
+	}
+	return ""
+}
+
+func (x *BlockingLockInformation) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *BlockingLockInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *BlockingLockInformation) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *BlockingLockInformation) GetWaitingSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.WaitingSince
+	}
+	return nil
+}
+
+// Aggregate of the pg_stat_activity samples taken since the last activity snapshot (if activity_sample_interval is set)
+type ActivitySampleInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SampleCount      int32                                       `protobuf:"varint,1,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	FirstSampleAt    *timestamp.Timestamp                        `protobuf:"bytes,2,opt,name=first_sample_at,json=firstSampleAt,proto3" json:"first_sample_at,omitempty"`
+	LastSampleAt     *timestamp.Timestamp                        `protobuf:"bytes,3,opt,name=last_sample_at,json=lastSampleAt,proto3" json:"last_sample_at,omitempty"`
+	MaxLockWaiters   int32                                       `protobuf:"varint,4,opt,name=max_lock_waiters,json=maxLockWaiters,proto3" json:"max_lock_waiters,omitempty"` // Highest number of backends waiting for a heavyweight lock in a single sample
+	MaxLockWaitersAt *timestamp.Timestamp                        `protobuf:"bytes,5,opt,name=max_lock_waiters_at,json=maxLockWaitersAt,proto3" json:"max_lock_waiters_at,omitempty"`
+	BackendCounts    []*ActivitySampleInformation_BackendCount   `protobuf:"bytes,6,rep,name=backend_counts,json=backendCounts,proto3" json:"backend_counts,omitempty"`
+	WaitEventCounts  []*ActivitySampleInformation_WaitEventCount `protobuf:"bytes,7,rep,name=wait_event_counts,json=waitEventCounts,proto3" json:"wait_event_counts,omitempty"`
+}
+
+func (x *ActivitySampleInformation) Reset() {
+	*x = ActivitySampleInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivitySampleInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySampleInformation) ProtoMessage() {}
+
+func (x *ActivitySampleInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySampleInformation.ProtoReflect.Descriptor instead.
+func (*ActivitySampleInformation) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActivitySampleInformation) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *ActivitySampleInformation) GetFirstSampleAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.FirstSampleAt
+	}
+	return nil
+}
+
+func (x *ActivitySampleInformation) GetLastSampleAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSampleAt
+	}
+	return nil
+}
+
+func (x *ActivitySampleInformation) GetMaxLockWaiters() int32 {
+	if x != nil {
+		return x.MaxLockWaiters
+	}
+	return 0
+}
+
+func (x *ActivitySampleInformation) GetMaxLockWaitersAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.MaxLockWaitersAt
+	}
+	return nil
+}
+
+func (x *ActivitySampleInformation) GetBackendCounts() []*ActivitySampleInformation_BackendCount {
+	if x != nil {
+		return x.BackendCounts
+	}
+	return nil
+}
+
+func (x *ActivitySampleInformation) GetWaitEventCounts() []*ActivitySampleInformation_WaitEventCount {
+	if x != nil {
+		return x.WaitEventCounts
+	}
+	return nil
+}
+
+// Transaction exceeding long_transaction_threshold or idle_in_transaction_threshold
+type LongTransactionInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid               int32                `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	DatabaseIdx       int32                `protobuf:"varint,2,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"` // -1 if not connected to a database
+	RoleIdx           int32                `protobuf:"varint,3,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`             // -1 if not known
+	ApplicationName   string               `protobuf:"bytes,4,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ClientAddr        string               `protobuf:"bytes,5,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
+	BackendType       string               `protobuf:"bytes,6,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	State             string               `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"` // e.g. "active" or "idle in transaction"
+	HasQueryIdx       bool                 `protobuf:"varint,8,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"`
+	QueryIdx          int32                `protobuf:"varint,9,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"` // Most recent query (which is no longer running when idle in transaction)
+	XactStart         *timestamp.Timestamp `protobuf:"bytes,10,opt,name=xact_start,json=xactStart,proto3" json:"xact_start,omitempty"`
+	IdleSeconds       float64              `protobuf:"fixed64,11,opt,name=idle_seconds,json=idleSeconds,proto3" json:"idle_seconds,omitempty"` // Time since the session became idle in transaction, zero if active
+	HasBackendXmin    bool                 `protobuf:"varint,12,opt,name=has_backend_xmin,json=hasBackendXmin,proto3" json:"has_backend_xmin,omitempty"`
+	BackendXmin       int64                `protobuf:"varint,13,opt,name=backend_xmin,json=backendXmin,proto3" json:"backend_xmin,omitempty"`                     // xmin horizon held back by this backend
+	XminAge           int64                `protobuf:"varint,14,opt,name=xmin_age,json=xminAge,proto3" json:"xmin_age,omitempty"`                                 // Number of transactions started since backend_xmin (zero if not known)
+	IdleInTransaction bool                 `protobuf:"varint,15,opt,name=idle_in_transaction,json=idleInTransaction,proto3" json:"idle_in_transaction,omitempty"` // Whether the idle_in_transaction_threshold was exceeded (otherwise long_transaction_threshold)
+}
+
+func (x *LongTransactionInformation) Reset() {
+	*x = LongTransactionInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LongTransactionInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LongTransactionInformation) ProtoMessage() {}
+
+func (x *LongTransactionInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LongTransactionInformation.ProtoReflect.Descriptor instead.
+func (*LongTransactionInformation) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LongTransactionInformation) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetRoleIdx() int32 {
+	if x != nil {
+		return x.RoleIdx
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *LongTransactionInformation) GetClientAddr() string {
+	if x != nil {
+		return x.ClientAddr
+	}
+	return ""
+}
+
+func (x *LongTransactionInformation) GetBackendType() string {
+	if x != nil {
+		return x.BackendType
+	}
+	return ""
+}
+
+func (x *LongTransactionInformation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *LongTransactionInformation) GetHasQueryIdx() bool {
+	if x != nil {
+		return x.HasQueryIdx
+	}
+	return false
+}
+
+func (x *LongTransactionInformation) GetQueryIdx() int32 {
+	if x != nil {
+		return x.QueryIdx
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetXactStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.XactStart
+	}
+	return nil
+}
+
+func (x *LongTransactionInformation) GetIdleSeconds() float64 {
+	if x != nil {
+		return x.IdleSeconds
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetHasBackendXmin() bool {
+	if x != nil {
+		return x.HasBackendXmin
+	}
+	return false
+}
+
+func (x *LongTransactionInformation) GetBackendXmin() int64 {
+	if x != nil {
+		return x.BackendXmin
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetXminAge() int64 {
+	if x != nil {
+		return x.XminAge
+	}
+	return 0
+}
+
+func (x *LongTransactionInformation) GetIdleInTransaction() bool {
+	if x != nil {
+		return x.IdleInTransaction
+	}
+	return false
+}
+
+type PerformanceInsightsInformation_LoadSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time    *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	LoadAvg float64              `protobuf:"fixed64,2,opt,name=load_avg,json=loadAvg,proto3" json:"load_avg,omitempty"` // Average number of active sessions during this second
+}
+
+func (x *PerformanceInsightsInformation_LoadSample) Reset() {
+	*x = PerformanceInsightsInformation_LoadSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerformanceInsightsInformation_LoadSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceInsightsInformation_LoadSample) ProtoMessage() {}
+
+func (x *PerformanceInsightsInformation_LoadSample) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceInsightsInformation_LoadSample.ProtoReflect.Descriptor instead.
+func (*PerformanceInsightsInformation_LoadSample) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *PerformanceInsightsInformation_LoadSample) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PerformanceInsightsInformation_LoadSample) GetLoadAvg() float64 {
+	if x != nil {
+		return x.LoadAvg
+	}
+	return 0
+}
+
+type PerformanceInsightsInformation_WaitEventLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitEventType string  `protobuf:"bytes,1,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"` // Sessions running on CPU have the wait event type "CPU"
+	WaitEvent     string  `protobuf:"bytes,2,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	LoadAvg       float64 `protobuf:"fixed64,3,opt,name=load_avg,json=loadAvg,proto3" json:"load_avg,omitempty"` // Average number of active sessions over the whole period
+}
+
+func (x *PerformanceInsightsInformation_WaitEventLoad) Reset() {
+	*x = PerformanceInsightsInformation_WaitEventLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerformanceInsightsInformation_WaitEventLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceInsightsInformation_WaitEventLoad) ProtoMessage() {}
+
+func (x *PerformanceInsightsInformation_WaitEventLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceInsightsInformation_WaitEventLoad.ProtoReflect.Descriptor instead.
+func (*PerformanceInsightsInformation_WaitEventLoad) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *PerformanceInsightsInformation_WaitEventLoad) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *PerformanceInsightsInformation_WaitEventLoad) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+func (x *PerformanceInsightsInformation_WaitEventLoad) GetLoadAvg() float64 {
+	if x != nil {
+		return x.LoadAvg
+	}
+	return 0
+}
+
+// Number of backends in the same state and waiting for the same event, across all samples
+type ActivitySampleInformation_BackendCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State         string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`                                        // Empty for background processes
+	WaitEventType string `protobuf:"bytes,2,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"` // Empty if not waiting
+	WaitEvent     string `protobuf:"bytes,3,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	Sum           int64  `protobuf:"varint,4,opt,name=sum,proto3" json:"sum,omitempty"` // Sum of the counts in all samples (divide by sample_count for an average)
+	Max           int32  `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"` // Highest count in a single sample
+}
+
+func (x *ActivitySampleInformation_BackendCount) Reset() {
+	*x = ActivitySampleInformation_BackendCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivitySampleInformation_BackendCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySampleInformation_BackendCount) ProtoMessage() {}
+
+func (x *ActivitySampleInformation_BackendCount) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySampleInformation_BackendCount.ProtoReflect.Descriptor instead.
+func (*ActivitySampleInformation_BackendCount) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ActivitySampleInformation_BackendCount) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ActivitySampleInformation_BackendCount) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *ActivitySampleInformation_BackendCount) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+func (x *ActivitySampleInformation_BackendCount) GetSum() int64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *ActivitySampleInformation_BackendCount) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Number of active backends waiting for the same event, while running the same query, across all samples
+type ActivitySampleInformation_WaitEventCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasQueryIdx   bool   `protobuf:"varint,1,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"` // False for processes that don't run a query (e.g. the checkpointer)
+	QueryIdx      int32  `protobuf:"varint,2,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	BackendType   string `protobuf:"bytes,3,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"` // Postgres 10+, e.g. "client backend" or "autovacuum worker"
+	WaitEventType string `protobuf:"bytes,4,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
+	WaitEvent     string `protobuf:"bytes,5,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	Count         int64  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"` // One per backend and sample (divide by sample_count for the average number of backends waiting)
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) Reset() {
+	*x = ActivitySampleInformation_WaitEventCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compact_activity_snapshot_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySampleInformation_WaitEventCount) ProtoMessage() {}
+
+func (x *ActivitySampleInformation_WaitEventCount) ProtoReflect() protoreflect.Message {
+	mi := &file_compact_activity_snapshot_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySampleInformation_WaitEventCount.ProtoReflect.Descriptor instead.
+func (*ActivitySampleInformation_WaitEventCount) Descriptor() ([]byte, []int) {
+	return file_compact_activity_snapshot_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) GetHasQueryIdx() bool {
+	if x != nil {
+		return x.HasQueryIdx
+	}
+	return false
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) GetQueryIdx() int32 {
+	if x != nil {
+		return x.QueryIdx
+	}
+	return 0
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) GetBackendType() string {
+	if x != nil {
+		return x.BackendType
+	}
+	return ""
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+func (x *ActivitySampleInformation_WaitEventCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_compact_activity_snapshot_proto protoreflect.FileDescriptor
+
+var file_compact_activity_snapshot_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x07, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x4f, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x55, 0x0a, 0x19,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x16, 0x70, 0x72, 0x65,
+	0x76, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x41, 0x74, 0x12, 0x70, 0x0a, 0x1c, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x76, 0x61, 0x63, 0x75, 0x75,
+	0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6a, 0x0a, 0x1a, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x18, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x66, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x14, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x6d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x53, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x12, 0x5c, 0x0a, 0x11, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70,
+	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6c,
+	0x6f, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xf8, 0x4d, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73,
+	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x78, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65,
+	0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6c,
+	0x6c, 0x54, 0x65, 0x78, 0x74, 0x22, 0x91, 0x02, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x47, 0x5f, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x47, 0x5f, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x43,
+	0x48, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x47, 0x5f, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x49,
+	0x50, 0x43, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x47, 0x5f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x49, 0x4f, 0x10, 0x0b, 0x22, 0xd7, 0x42, 0x0a, 0x09, 0x57, 0x61,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x49, 0x44,
+	0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x66, 0x12, 0x22, 0x0a, 0x1e, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x58, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x67, 0x12,
+	0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x68, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x69, 0x12,
+	0x28, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6a, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57,
+	0x41, 0x4c, 0x5f, 0x42, 0x55, 0x46, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x6b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6c, 0x12, 0x27, 0x0a, 0x23, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x6d, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x43, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x6f, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x70, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41,
+	0x43, 0x54, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x71, 0x12, 0x34, 0x0a,
+	0x30, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x46,
+	0x46, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x72, 0x12, 0x34, 0x0a, 0x30, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x58,
+	0x41, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52,
+	0x45, 0x4c, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x74, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x75, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x2c,
+	0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x77, 0x12, 0x27, 0x0a, 0x23,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x78, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x44, 0x44, 0x49, 0x4e,
+	0x5f, 0x53, 0x48, 0x4d, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55,
+	0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7a, 0x12, 0x2e, 0x0a, 0x2a, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7b, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7c, 0x12,
+	0x2b, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41,
+	0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7d, 0x12, 0x24, 0x0a, 0x20,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x54, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x7e, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x51, 0x55,
+	0x45, 0x55, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x7f, 0x12, 0x32, 0x0a, 0x2d, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x58, 0x41, 0x43,
+	0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x36,
+	0x0a, 0x31, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x81, 0x01, 0x12, 0x3c, 0x0a, 0x37, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x49,
+	0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41,
+	0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x82, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x45,
+	0x52, 0x5f, 0x58, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x83, 0x01, 0x12, 0x24, 0x0a,
+	0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x84, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x85, 0x01, 0x12, 0x38, 0x0a, 0x33, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x86, 0x01, 0x12, 0x25, 0x0a, 0x20,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x87, 0x01, 0x12, 0x37, 0x0a, 0x32, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x88, 0x01, 0x12, 0x34, 0x0a, 0x2f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c,
+	0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x89, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54,
+	0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8a,
+	0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x53,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8b, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8c, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8d, 0x01, 0x12, 0x31, 0x0a, 0x2c, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8e, 0x01, 0x12, 0x2a,
+	0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x44,
+	0x4f, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x8f, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x4f, 0x52, 0x4b,
+	0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x91, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
+	0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x92, 0x01, 0x12,
+	0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x53,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x93, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
+	0x43, 0x48, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x5f, 0x42, 0x55, 0x46,
+	0x46, 0x45, 0x52, 0x53, 0x10, 0x94, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
+	0x4d, 0x58, 0x41, 0x43, 0x54, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46,
+	0x45, 0x52, 0x53, 0x10, 0x95, 0x01, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4d,
+	0x58, 0x41, 0x43, 0x54, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45,
+	0x52, 0x53, 0x10, 0x96, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x41, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x97, 0x01, 0x12, 0x2b,
+	0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54,
+	0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4f, 0x4c, 0x44, 0x53, 0x45, 0x52, 0x58, 0x49, 0x44,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x98, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
+	0x43, 0x48, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x99,
+	0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x9a, 0x01, 0x12, 0x2f, 0x0a, 0x2a, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e,
+	0x43, 0x48, 0x45, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x9b, 0x01, 0x12, 0x2c, 0x0a, 0x27,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x10, 0x9c, 0x01, 0x12, 0x39, 0x0a, 0x34, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43,
+	0x48, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x4c, 0x4f, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
+	0x53, 0x53, 0x10, 0x9d, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x43, 0x10, 0x9e, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x42, 0x55,
+	0x46, 0x46, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x9f, 0x01, 0x12,
+	0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57,
+	0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x41, 0x4e,
+	0x41, 0x47, 0x45, 0x52, 0x10, 0xa0, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
+	0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d,
+	0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0xa1, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
+	0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f,
+	0x4a, 0x4f, 0x49, 0x4e, 0x10, 0xa2, 0x01, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f,
+	0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x44,
+	0x53, 0x41, 0x10, 0xa3, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x53, 0x41, 0x10, 0xa4, 0x01, 0x12, 0x2e, 0x0a, 0x29,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43,
+	0x4f, 0x52, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa5, 0x01, 0x12, 0x2e, 0x0a, 0x29,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x4d, 0x4f, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa6, 0x01, 0x12, 0x2b, 0x0a, 0x26,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41,
+	0x4e, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x55, 0x50, 0x4c,
+	0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0xa7, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48,
+	0x45, 0x5f, 0x54, 0x42, 0x4d, 0x10, 0xa8, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x57, 0x54, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45,
+	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44,
+	0x10, 0xa9, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0xc8, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x1c,
+	0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x54, 0x41, 0x47, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10, 0xca, 0x01, 0x12, 0x1d, 0x0a, 0x18,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54,
+	0x41, 0x47, 0x5f, 0x54, 0x55, 0x50, 0x4c, 0x45, 0x10, 0xcb, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41,
+	0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcc, 0x01,
+	0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xcd, 0x01, 0x12, 0x29, 0x0a, 0x24,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54,
+	0x41, 0x47, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xce, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x42,
+	0x4a, 0x45, 0x43, 0x54, 0x10, 0xcf, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xd0, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x54, 0x41, 0x47, 0x5f,
+	0x41, 0x44, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52,
+	0x5f, 0x50, 0x49, 0x4e, 0x10, 0xac, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10,
+	0x90, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x53, 0x10, 0x91, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41,
+	0x49, 0x4e, 0x10, 0xf4, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4d,
+	0x41, 0x49, 0x4e, 0x10, 0xf5, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49,
+	0x42, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x45, 0x10, 0xf6, 0x03, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf7, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf8, 0x03, 0x12, 0x22, 0x0a, 0x1d,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43,
+	0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xf9, 0x03,
+	0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x5f,
+	0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfa, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x49,
+	0x4e, 0x10, 0xfb, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x5f,
+	0x41, 0x4c, 0x4c, 0x10, 0xfc, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x41,
+	0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0xfd, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47,
+	0x47, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfe, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xff, 0x03, 0x12, 0x1f,
+	0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
+	0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x80, 0x04, 0x12,
+	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41,
+	0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x81, 0x04,
+	0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a,
+	0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x45,
+	0x4e, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xd9, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57,
+	0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x10, 0xda, 0x04, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x42, 0x50, 0x51, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xdb, 0x04, 0x12,
+	0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x53,
+	0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0xdc, 0x04,
+	0x12, 0x27, 0x0a, 0x22, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
+	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xdd, 0x04, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x10, 0xde, 0x04, 0x12, 0x25,
+	0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c,
+	0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x10, 0xdf, 0x04, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x47, 0x53, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x45, 0x52, 0x10, 0xe0, 0x04, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x48,
+	0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0xbc, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x47, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x54, 0x52, 0x45, 0x45, 0x5f,
+	0x50, 0x41, 0x47, 0x45, 0x10, 0xbe, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x47, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xbf, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45,
+	0x5f, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0xc0, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc1,
+	0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0xc2, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0xc3, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55,
+	0x49, 0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc4,
+	0x05, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0xc5, 0x05, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0xc6, 0x05,
+	0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x49, 0x4e,
+	0x47, 0x5f, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0xc7, 0x05, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52,
+	0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xc8, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57,
+	0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0xc9, 0x05, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x45, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xca, 0x05,
+	0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53,
+	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0xcb, 0x05, 0x12, 0x30, 0x0a,
+	0x2b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0xcc, 0x05, 0x12,
+	0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41,
+	0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xcd, 0x05, 0x12, 0x2a, 0x0a,
+	0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x47, 0x52, 0x4f, 0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xce, 0x05, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x47, 0x52, 0x4f,
+	0x57, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x49, 0x4e, 0x53, 0x45,
+	0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0xcf, 0x05, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd0, 0x05, 0x12, 0x29, 0x0a, 0x24, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x10, 0xd1, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x10, 0xd2, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x10, 0xd3, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0xd4, 0x05, 0x12,
+	0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x51,
+	0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xd5, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f,
+	0x42, 0x49, 0x54, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd6, 0x05, 0x12, 0x2a,
+	0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52,
+	0x41, 0x4c, 0x4c, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44,
+	0x45, 0x58, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0xd7, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4c, 0x4c, 0x45,
+	0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0xd8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x41, 0x52,
+	0x52, 0x41, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x10, 0xd9, 0x05, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0xda, 0x05, 0x12, 0x27, 0x0a, 0x22,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x10, 0xdb, 0x05, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xdc, 0x05, 0x12, 0x1d, 0x0a, 0x18,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x41, 0x46, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0xdd, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52,
+	0x45, 0x50, 0x10, 0xde, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x44,
+	0x4f, 0x4e, 0x45, 0x10, 0xdf, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xe0, 0x05, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b,
+	0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12, 0x18,
+	0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x47, 0x5f,
+	0x53, 0x4c, 0x45, 0x45, 0x50, 0x10, 0xa1, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10, 0xa2, 0x06, 0x12, 0x1c,
+	0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x84, 0x07, 0x12, 0x1d, 0x0a, 0x18,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x85, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
+	0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x86, 0x07, 0x12, 0x21,
+	0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x87,
+	0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x88, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
+	0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x89, 0x07, 0x12,
+	0x29, 0x0a, 0x24, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x8a, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x8b, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x8c, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0x8d, 0x07, 0x12, 0x1f, 0x0a,
+	0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0x8e, 0x07, 0x12, 0x28,
+	0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x8f, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x90, 0x07, 0x12, 0x1e, 0x0a, 0x19,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x91, 0x07, 0x12, 0x1e, 0x0a, 0x19,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x92, 0x07, 0x12, 0x22, 0x0a, 0x1d,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x93, 0x07,
+	0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x94,
+	0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x44, 0x53, 0x4d, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x10, 0x95, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41,
+	0x44, 0x44, 0x54, 0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0x96, 0x07, 0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54,
+	0x4f, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x97, 0x07,
+	0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x54, 0x4f, 0x44, 0x41,
+	0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x98, 0x07, 0x12, 0x25,
+	0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0x99, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x9a, 0x07, 0x12, 0x26, 0x0a, 0x21,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0x9b, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x44, 0x41, 0x54, 0x41, 0x44, 0x49, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0x9c, 0x07, 0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0x9d, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0x9e, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x9f,
+	0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0xa0, 0x07, 0x12, 0x28, 0x0a, 0x23, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0xa1,
+	0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa2, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x41, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa3, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xa4, 0x07, 0x12, 0x22,
+	0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0xa5, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0xa6, 0x07, 0x12, 0x24, 0x0a, 0x1f, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x55,
+	0x46, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xa7, 0x07, 0x12, 0x2c, 0x0a,
+	0x27, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x50, 0x50,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xa8, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
+	0xa9, 0x07, 0x12, 0x2d, 0x0a, 0x28, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f,
+	0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xaa,
+	0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xab, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xac, 0x07,
+	0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4c, 0x52, 0x55, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xad,
+	0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x4c, 0x52, 0x55, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xae, 0x07, 0x12, 0x19, 0x0a, 0x14,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0xaf, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x52, 0x55, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x10, 0xb0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0xb1, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43,
+	0x10, 0xb2, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0xb3, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb4, 0x07,
+	0x12, 0x2b, 0x0a, 0x26, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xb5, 0x07, 0x12, 0x25, 0x0a,
+	0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x10, 0xb6, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xb7, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x10, 0xb8, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0xb9, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xba, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x50, 0x48, 0x41,
+	0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbb, 0x07,
+	0x12, 0x2f, 0x0a, 0x2a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57,
+	0x41, 0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbc,
+	0x07, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0xbd, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41,
+	0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xbe, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50,
+	0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xbf, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc0, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc1, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0xc2, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0xc3, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xc4,
+	0x07, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc5, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57,
+	0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
+	0x10, 0xc6, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x57, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xc7, 0x07, 0x12, 0x23,
+	0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x52, 0x52, 0x49, 0x45, 0x52,
+	0x10, 0xc8, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x90,
+	0x4e, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41,
+	0x49, 0x4e, 0x10, 0x91, 0x4e, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x92, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x41,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x49, 0x54, 0x55, 0x53, 0x5f, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x10, 0x93, 0x4e, 0x22, 0x04, 0x08,
+	0x64, 0x10, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52,
+	0x59, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x51, 0x4c,
+	0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x10, 0x03, 0x22, 0xfc, 0x02, 0x0a, 0x19, 0x56,
+	0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75,
+	0x75, 0x6d, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x61, 0x73,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6e, 0x74, 0x69, 0x57, 0x72, 0x61,
+	0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x8e, 0x06, 0x0a, 0x17, 0x56, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e,
+	0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x56, 0x61, 0x63, 0x75,
+	0x75, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b,
+	0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62,
+	0x6c, 0x6b, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64,
+	0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x61,
+	0x64, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x74,
+	0x75, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x48, 0x45, 0x41,
+	0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x49, 0x4e,
+	0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f,
+	0x48, 0x45, 0x41, 0x50, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f,
+	0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55,
+	0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4e, 0x41, 0x4c,
+	0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10, 0x06, 0x22, 0xae, 0x04, 0x0a, 0x1e, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x61, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x6b, 0x0a, 0x10, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70,
+	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e,
+	0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52,
+	0x0e, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x1a,
+	0x57, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x61, 0x76, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x1a, 0x71, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x61, 0x76, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x22, 0xf0, 0x02, 0x0a, 0x1e,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x75,
+	0x70, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0x95,
+	0x02, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x3f, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xee, 0x06, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x41, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x63, 0x6b,
+	0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x49, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x10, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73,
+	0x41, 0x74, 0x12, 0x62, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x11, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x1a, 0x8f, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x75,
+	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x1a, 0xd1, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
+	0x61, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0x04, 0x0a, 0x1a, 0x4c, 0x6f, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x39, 0x0a, 0x0a,
+	0x78, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x78, 0x61,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x69,
+	0x64, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61,
+	0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x78, 0x6d, 0x69, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x58, 0x6d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
+	0x78, 0x6d, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x58, 0x6d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x78, 0x6d, 0x69, 0x6e, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x78, 0x6d, 0x69, 0x6e, 0x41,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x69, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_compact_activity_snapshot_proto_rawDescOnce sync.Once
+	file_compact_activity_snapshot_proto_rawDescData = file_compact_activity_snapshot_proto_rawDesc
+)
+
+func file_compact_activity_snapshot_proto_rawDescGZIP() []byte {
+	file_compact_activity_snapshot_proto_rawDescOnce.Do(func() {
+		file_compact_activity_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(file_compact_activity_snapshot_proto_rawDescData)
+	})
+	return file_compact_activity_snapshot_proto_rawDescData
+}
+
+var file_compact_activity_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_compact_activity_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_compact_activity_snapshot_proto_goTypes = []interface{}{
+	(Backend_WaitEventType)(0),                           // 0: pganalyze.collector.Backend.WaitEventType
+	(Backend_WaitEvent)(0),                               // 1: pganalyze.collector.Backend.WaitEvent
+	(Backend_QueryProtocol)(0),                           // 2: pganalyze.collector.Backend.QueryProtocol
+	(VacuumProgressStatistic_VacuumPhase)(0),             // 3: pganalyze.collector.VacuumProgressStatistic.VacuumPhase
+	(*CompactActivitySnapshot)(nil),                      // 4: pganalyze.collector.CompactActivitySnapshot
+	(*Backend)(nil),                                      // 5: pganalyze.collector.Backend
+	(*VacuumProgressInformation)(nil),                    // 6: pganalyze.collector.VacuumProgressInformation
+	(*VacuumProgressStatistic)(nil),                      // 7: pganalyze.collector.VacuumProgressStatistic
+	(*PerformanceInsightsInformation)(nil),               // 8: pganalyze.collector.PerformanceInsightsInformation
+	(*MaintenanceProgressInformation)(nil),               // 9: pganalyze.collector.MaintenanceProgressInformation
+	(*BlockingLockInformation)(nil),                      // 10: pganalyze.collector.BlockingLockInformation
+	(*ActivitySampleInformation)(nil),                    // 11: pganalyze.collector.ActivitySampleInformation
+	(*LongTransactionInformation)(nil),                   // 12: pganalyze.collector.LongTransactionInformation
+	(*PerformanceInsightsInformation_LoadSample)(nil),    // 13: pganalyze.collector.PerformanceInsightsInformation.LoadSample
+	(*PerformanceInsightsInformation_WaitEventLoad)(nil), // 14: pganalyze.collector.PerformanceInsightsInformation.WaitEventLoad
+	(*ActivitySampleInformation_BackendCount)(nil),       // 15: pganalyze.collector.ActivitySampleInformation.BackendCount
+	(*ActivitySampleInformation_WaitEventCount)(nil),     // 16: pganalyze.collector.ActivitySampleInformation.WaitEventCount
+	(*PostgresVersion)(nil),                              // 17: pganalyze.collector.PostgresVersion
+	(*timestamp.Timestamp)(nil),                          // 18: google.protobuf.Timestamp
+}
+var file_compact_activity_snapshot_proto_depIdxs = []int32{
+	17, // 0: pganalyze.collector.CompactActivitySnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
+	5,  // 1: pganalyze.collector.CompactActivitySnapshot.backends:type_name -> pganalyze.collector.Backend
+	18, // 2: pganalyze.collector.CompactActivitySnapshot.prev_activity_snapshot_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_informations:type_name -> pganalyze.collector.VacuumProgressInformation
+	7,  // 4: pganalyze.collector.CompactActivitySnapshot.vacuum_progress_statistics:type_name -> pganalyze.collector.VacuumProgressStatistic
+	8,  // 5: pganalyze.collector.CompactActivitySnapshot.performance_insights:type_name -> pganalyze.collector.PerformanceInsightsInformation
+	9,  // 6: pganalyze.collector.CompactActivitySnapshot.maintenance_progress:type_name -> pganalyze.collector.MaintenanceProgressInformation
+	10, // 7: pganalyze.collector.CompactActivitySnapshot.blocking_locks:type_name -> pganalyze.collector.BlockingLockInformation
+	11, // 8: pganalyze.collector.CompactActivitySnapshot.activity_samples:type_name -> pganalyze.collector.ActivitySampleInformation
+	12, // 9: pganalyze.collector.CompactActivitySnapshot.long_transactions:type_name -> pganalyze.collector.LongTransactionInformation
+	18, // 10: pganalyze.collector.Backend.backend_start:type_name -> google.protobuf.Timestamp
+	18, // 11: pganalyze.collector.Backend.xact_start:type_name -> google.protobuf.Timestamp
+	18, // 12: pganalyze.collector.Backend.query_start:type_name -> google.protobuf.Timestamp
+	18, // 13: pganalyze.collector.Backend.state_change:type_name -> google.protobuf.Timestamp
+	2,  // 14: pganalyze.collector.Backend.query_protocol:type_name -> pganalyze.collector.Backend.QueryProtocol
+	18, // 15: pganalyze.collector.VacuumProgressInformation.started_at:type_name -> google.protobuf.Timestamp
+	3,  // 16: pganalyze.collector.VacuumProgressStatistic.phase:type_name -> pganalyze.collector.VacuumProgressStatistic.VacuumPhase
+	18, // 17: pganalyze.collector.PerformanceInsightsInformation.start_time:type_name -> google.protobuf.Timestamp
+	18, // 18: pganalyze.collector.PerformanceInsightsInformation.end_time:type_name -> google.protobuf.Timestamp
+	13, // 19: pganalyze.collector.PerformanceInsightsInformation.load_samples:type_name -> pganalyze.collector.PerformanceInsightsInformation.LoadSample
+	14, // 20: pganalyze.collector.PerformanceInsightsInformation.wait_event_loads:type_name -> pganalyze.collector.PerformanceInsightsInformation.WaitEventLoad
+	18, // 21: pganalyze.collector.BlockingLockInformation.waiting_since:type_name -> google.protobuf.Timestamp
+	18, // 22: pganalyze.collector.ActivitySampleInformation.first_sample_at:type_name -> google.protobuf.Timestamp
+	18, // 23: pganalyze.collector.ActivitySampleInformation.last_sample_at:type_name -> google.protobuf.Timestamp
+	18, // 24: pganalyze.collector.ActivitySampleInformation.max_lock_waiters_at:type_name -> google.protobuf.Timestamp
+	15, // 25: pganalyze.collector.ActivitySampleInformation.backend_counts:type_name -> pganalyze.collector.ActivitySampleInformation.BackendCount
+	16, // 26: pganalyze.collector.ActivitySampleInformation.wait_event_counts:type_name -> pganalyze.collector.ActivitySampleInformation.WaitEventCount
+	18, // 27: pganalyze.collector.LongTransactionInformation.xact_start:type_name -> google.protobuf.Timestamp
+	18, // 28: pganalyze.collector.PerformanceInsightsInformation.LoadSample.time:type_name -> google.protobuf.Timestamp
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_compact_activity_snapshot_proto_init() }
+func file_compact_activity_snapshot_proto_init() {
+	if File_compact_activity_snapshot_proto != nil {
+		return
+	}
+	file_shared_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_compact_activity_snapshot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactActivitySnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_compact_activity_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VacuumProgressInformation); i {
@@ -2163,14 +3455,122 @@ func file_compact_activity_snapshot_proto_init() {
 				return nil
 			}
 		}
+		file_compact_activity_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerformanceInsightsInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceProgressInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockingLockInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivitySampleInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LongTransactionInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerformanceInsightsInformation_LoadSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerformanceInsightsInformation_WaitEventLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivitySampleInformation_BackendCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compact_activity_snapshot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivitySampleInformation_WaitEventCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_compact_activity_snapshot_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
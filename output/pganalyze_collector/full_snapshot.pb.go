@@ -422,22 +422,39 @@ type FullSnapshot struct {
 	TablespaceReferences   []*TablespaceReference   `protobuf:"bytes,130,rep,name=tablespace_references,json=tablespaceReferences,proto3" json:"tablespace_references,omitempty"`
 	TablespaceInformations []*TablespaceInformation `protobuf:"bytes,131,rep,name=tablespace_informations,json=tablespaceInformations,proto3" json:"tablespace_informations,omitempty"`
 	// Per database
-	QueryReferences         []*QueryReference          `protobuf:"bytes,200,rep,name=query_references,json=queryReferences,proto3" json:"query_references,omitempty"`
-	RelationReferences      []*RelationReference       `protobuf:"bytes,201,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
-	IndexReferences         []*IndexReference          `protobuf:"bytes,202,rep,name=index_references,json=indexReferences,proto3" json:"index_references,omitempty"`
-	FunctionReferences      []*FunctionReference       `protobuf:"bytes,203,rep,name=function_references,json=functionReferences,proto3" json:"function_references,omitempty"`
-	QueryInformations       []*QueryInformation        `protobuf:"bytes,210,rep,name=query_informations,json=queryInformations,proto3" json:"query_informations,omitempty"`
-	QueryStatistics         []*QueryStatistic          `protobuf:"bytes,211,rep,name=query_statistics,json=queryStatistics,proto3" json:"query_statistics,omitempty"`
-	HistoricQueryStatistics []*HistoricQueryStatistics `protobuf:"bytes,213,rep,name=historic_query_statistics,json=historicQueryStatistics,proto3" json:"historic_query_statistics,omitempty"`
-	QueryExplains           []*QueryExplainInformation `protobuf:"bytes,214,rep,name=query_explains,json=queryExplains,proto3" json:"query_explains,omitempty"`
-	RelationInformations    []*RelationInformation     `protobuf:"bytes,220,rep,name=relation_informations,json=relationInformations,proto3" json:"relation_informations,omitempty"`
-	RelationStatistics      []*RelationStatistic       `protobuf:"bytes,221,rep,name=relation_statistics,json=relationStatistics,proto3" json:"relation_statistics,omitempty"`
-	RelationEvents          []*RelationEvent           `protobuf:"bytes,223,rep,name=relation_events,json=relationEvents,proto3" json:"relation_events,omitempty"`
-	IndexInformations       []*IndexInformation        `protobuf:"bytes,224,rep,name=index_informations,json=indexInformations,proto3" json:"index_informations,omitempty"`
-	IndexStatistics         []*IndexStatistic          `protobuf:"bytes,225,rep,name=index_statistics,json=indexStatistics,proto3" json:"index_statistics,omitempty"`
-	FunctionInformations    []*FunctionInformation     `protobuf:"bytes,227,rep,name=function_informations,json=functionInformations,proto3" json:"function_informations,omitempty"`
-	FunctionStatistics      []*FunctionStatistic       `protobuf:"bytes,228,rep,name=function_statistics,json=functionStatistics,proto3" json:"function_statistics,omitempty"`
-	CustomTypeInformations  []*CustomTypeInformation   `protobuf:"bytes,229,rep,name=custom_type_informations,json=customTypeInformations,proto3" json:"custom_type_informations,omitempty"`
+	QueryReferences               []*QueryReference                          `protobuf:"bytes,200,rep,name=query_references,json=queryReferences,proto3" json:"query_references,omitempty"`
+	RelationReferences            []*RelationReference                       `protobuf:"bytes,201,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
+	IndexReferences               []*IndexReference                          `protobuf:"bytes,202,rep,name=index_references,json=indexReferences,proto3" json:"index_references,omitempty"`
+	FunctionReferences            []*FunctionReference                       `protobuf:"bytes,203,rep,name=function_references,json=functionReferences,proto3" json:"function_references,omitempty"`
+	QueryInformations             []*QueryInformation                        `protobuf:"bytes,210,rep,name=query_informations,json=queryInformations,proto3" json:"query_informations,omitempty"`
+	QueryStatistics               []*QueryStatistic                          `protobuf:"bytes,211,rep,name=query_statistics,json=queryStatistics,proto3" json:"query_statistics,omitempty"`
+	HistoricQueryStatistics       []*HistoricQueryStatistics                 `protobuf:"bytes,213,rep,name=historic_query_statistics,json=historicQueryStatistics,proto3" json:"historic_query_statistics,omitempty"`
+	QueryExplains                 []*QueryExplainInformation                 `protobuf:"bytes,214,rep,name=query_explains,json=queryExplains,proto3" json:"query_explains,omitempty"`
+	RelationInformations          []*RelationInformation                     `protobuf:"bytes,220,rep,name=relation_informations,json=relationInformations,proto3" json:"relation_informations,omitempty"`
+	RelationStatistics            []*RelationStatistic                       `protobuf:"bytes,221,rep,name=relation_statistics,json=relationStatistics,proto3" json:"relation_statistics,omitempty"`
+	RelationEvents                []*RelationEvent                           `protobuf:"bytes,223,rep,name=relation_events,json=relationEvents,proto3" json:"relation_events,omitempty"`
+	IndexInformations             []*IndexInformation                        `protobuf:"bytes,224,rep,name=index_informations,json=indexInformations,proto3" json:"index_informations,omitempty"`
+	IndexStatistics               []*IndexStatistic                          `protobuf:"bytes,225,rep,name=index_statistics,json=indexStatistics,proto3" json:"index_statistics,omitempty"`
+	FunctionInformations          []*FunctionInformation                     `protobuf:"bytes,227,rep,name=function_informations,json=functionInformations,proto3" json:"function_informations,omitempty"`
+	FunctionStatistics            []*FunctionStatistic                       `protobuf:"bytes,228,rep,name=function_statistics,json=functionStatistics,proto3" json:"function_statistics,omitempty"`
+	CustomTypeInformations        []*CustomTypeInformation                   `protobuf:"bytes,229,rep,name=custom_type_informations,json=customTypeInformations,proto3" json:"custom_type_informations,omitempty"`
+	AlloydbInformation            *AlloyDBInformation                        `protobuf:"bytes,140,opt,name=alloydb_information,json=alloydbInformation,proto3" json:"alloydb_information,omitempty"` // Only set for AlloyDB instances
+	CitusInformation              *CitusInformation                          `protobuf:"bytes,141,opt,name=citus_information,json=citusInformation,proto3" json:"citus_information,omitempty"`
+	TimescaleHypertables          []*TimescaleHypertableInformation          `protobuf:"bytes,230,rep,name=timescale_hypertables,json=timescaleHypertables,proto3" json:"timescale_hypertables,omitempty"`
+	TimescaleContinuousAggregates []*TimescaleContinuousAggregateInformation `protobuf:"bytes,231,rep,name=timescale_continuous_aggregates,json=timescaleContinuousAggregates,proto3" json:"timescale_continuous_aggregates,omitempty"`
+	Patroni                       *PatroniInformation                        `protobuf:"bytes,142,opt,name=patroni,proto3" json:"patroni,omitempty"`
+	PgAutoFailover                *PgAutoFailoverInformation                 `protobuf:"bytes,143,opt,name=pg_auto_failover,json=pgAutoFailover,proto3" json:"pg_auto_failover,omitempty"`
+	Pgbouncer                     []*PgBouncerInformation                    `protobuf:"bytes,144,rep,name=pgbouncer,proto3" json:"pgbouncer,omitempty"`
+	Pgpool                        *PgpoolInformation                         `protobuf:"bytes,145,opt,name=pgpool,proto3" json:"pgpool,omitempty"`
+	QueryPlanReferences           []*QueryPlanReference                      `protobuf:"bytes,215,rep,name=query_plan_references,json=queryPlanReferences,proto3" json:"query_plan_references,omitempty"`
+	QueryPlanInformations         []*QueryPlanInformation                    `protobuf:"bytes,216,rep,name=query_plan_informations,json=queryPlanInformations,proto3" json:"query_plan_informations,omitempty"`
+	QueryPlanStatistics           []*QueryPlanStatistic                      `protobuf:"bytes,217,rep,name=query_plan_statistics,json=queryPlanStatistics,proto3" json:"query_plan_statistics,omitempty"`
+	IoStatistics                  []*IOStatistic                             `protobuf:"bytes,125,rep,name=io_statistics,json=ioStatistics,proto3" json:"io_statistics,omitempty"`
+	QueryWaitEventStatistics      []*QueryWaitEventStatistic                 `protobuf:"bytes,218,rep,name=query_wait_event_statistics,json=queryWaitEventStatistics,proto3" json:"query_wait_event_statistics,omitempty"`
+	SlruStatistics                []*SLRUStatistic                           `protobuf:"bytes,126,rep,name=slru_statistics,json=slruStatistics,proto3" json:"slru_statistics,omitempty"`
+	WalStatistic                  *WALStatistic                              `protobuf:"bytes,127,opt,name=wal_statistic,json=walStatistic,proto3" json:"wal_statistic,omitempty"`
+	QueryTempFileStatistics       []*QueryTempFileStatistic                  `protobuf:"bytes,219,rep,name=query_temp_file_statistics,json=queryTempFileStatistics,proto3" json:"query_temp_file_statistics,omitempty"`
+	LogMetricsStatistic           *LogMetricsStatistic                       `protobuf:"bytes,128,opt,name=log_metrics_statistic,json=logMetricsStatistic,proto3" json:"log_metrics_statistic,omitempty"`
 }
 
 func (x *FullSnapshot) Reset() {
@@ -808,6 +825,125 @@ func (x *FullSnapshot) GetCustomTypeInformations() []*CustomTypeInformation {
 	return nil
 }
 
+func (x *FullSnapshot) GetAlloydbInformation() *AlloyDBInformation {
+	if x != nil {
+		return x.AlloydbInformation
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetCitusInformation() *CitusInformation {
+	if x != nil {
+		return x.CitusInformation
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetTimescaleHypertables() []*TimescaleHypertableInformation {
+	if x != nil {
+		return x.TimescaleHypertables
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetTimescaleContinuousAggregates() []*TimescaleContinuousAggregateInformation {
+	if x != nil {
+		return x.TimescaleContinuousAggregates
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetPatroni() *PatroniInformation {
+	if x != nil {
+		return x.Patroni
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetPgAutoFailover() *PgAutoFailoverInformation {
+	if x != nil {
+		return x.PgAutoFailover
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetPgbouncer() []*PgBouncerInformation {
+	if x != nil {
+		return x.Pgbouncer
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetPgpool() *PgpoolInformation {
+	if x != nil {
+		return x.Pgpool
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetQueryPlanReferences() []*QueryPlanReference {
+	if x != nil {
+		return x.QueryPlanReferences
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetQueryPlanInformations() []*QueryPlanInformation {
+	if x != nil {
+		return x.QueryPlanInformations
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetQueryPlanStatistics() []*QueryPlanStatistic {
+	if x != nil {
+		return x.QueryPlanStatistics
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetIoStatistics() []*IOStatistic {
+	if x != nil {
+		return x.IoStatistics
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetQueryWaitEventStatistics() []*QueryWaitEventStatistic {
+	if x != nil {
+		return x.QueryWaitEventStatistics
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetSlruStatistics() []*SLRUStatistic {
+	if x != nil {
+		return x.SlruStatistics
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetWalStatistic() *WALStatistic {
+	if x != nil {
+		return x.WalStatistic
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetQueryTempFileStatistics() []*QueryTempFileStatistic {
+	if x != nil {
+		return x.QueryTempFileStatistics
+	}
+	return nil
+}
+
+func (x *FullSnapshot) GetLogMetricsStatistic() *LogMetricsStatistic {
+	if x != nil {
+		return x.LogMetricsStatistic
+	}
+	return nil
+}
+
 type CollectorStatistic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -821,7 +957,8 @@ type CollectorStatistic struct {
 	MemoryRssBytes           uint64 `protobuf:"varint,16,opt,name=memory_rss_bytes,json=memoryRssBytes,proto3" json:"memory_rss_bytes,omitempty"`                                 // Memory allocated in bytes as seen by the OS
 	ActiveGoroutines         int32  `protobuf:"varint,20,opt,name=active_goroutines,json=activeGoroutines,proto3" json:"active_goroutines,omitempty"`                             // Number of active Go routines
 	// Diff-ed statistics between two runs
-	CgoCalls int64 `protobuf:"varint,30,opt,name=cgo_calls,json=cgoCalls,proto3" json:"cgo_calls,omitempty"`
+	CgoCalls            int64                 `protobuf:"varint,30,opt,name=cgo_calls,json=cgoCalls,proto3" json:"cgo_calls,omitempty"`
+	GcpPubsubStatistics []*GcpPubSubStatistic `protobuf:"bytes,40,rep,name=gcp_pubsub_statistics,json=gcpPubsubStatistics,proto3" json:"gcp_pubsub_statistics,omitempty"`
 }
 
 func (x *CollectorStatistic) Reset() {
@@ -905,6 +1042,13 @@ func (x *CollectorStatistic) GetCgoCalls() int64 {
 	return 0
 }
 
+func (x *CollectorStatistic) GetGcpPubsubStatistics() []*GcpPubSubStatistic {
+	if x != nil {
+		return x.GcpPubsubStatistics
+	}
+	return nil
+}
+
 type RoleInformation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1179,14 +1323,17 @@ type Setting struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CurrentValue string      `protobuf:"bytes,2,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
-	Unit         *NullString `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
-	BootValue    *NullString `protobuf:"bytes,4,opt,name=boot_value,json=bootValue,proto3" json:"boot_value,omitempty"`
-	ResetValue   *NullString `protobuf:"bytes,5,opt,name=reset_value,json=resetValue,proto3" json:"reset_value,omitempty"`
-	Source       *NullString `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
-	SourceFile   *NullString `protobuf:"bytes,7,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"`
-	SourceLine   *NullString `protobuf:"bytes,8,opt,name=source_line,json=sourceLine,proto3" json:"source_line,omitempty"`
+	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentValue         string      `protobuf:"bytes,2,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	Unit                 *NullString `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	BootValue            *NullString `protobuf:"bytes,4,opt,name=boot_value,json=bootValue,proto3" json:"boot_value,omitempty"`
+	ResetValue           *NullString `protobuf:"bytes,5,opt,name=reset_value,json=resetValue,proto3" json:"reset_value,omitempty"`
+	Source               *NullString `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	SourceFile           *NullString `protobuf:"bytes,7,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"`
+	SourceLine           *NullString `protobuf:"bytes,8,opt,name=source_line,json=sourceLine,proto3" json:"source_line,omitempty"`
+	PendingRestart       bool        `protobuf:"varint,9,opt,name=pending_restart,json=pendingRestart,proto3" json:"pending_restart,omitempty"`                     // Whether a changed value was saved that only takes effect once the server is restarted (from the provider's API, currently Azure)
+	PendingValue         *NullString `protobuf:"bytes,10,opt,name=pending_value,json=pendingValue,proto3" json:"pending_value,omitempty"`                           // Value that takes effect after the restart
+	ProviderDefaultValue *NullString `protobuf:"bytes,11,opt,name=provider_default_value,json=providerDefaultValue,proto3" json:"provider_default_value,omitempty"` // Default value according to the provider's API (currently Azure)
 }
 
 func (x *Setting) Reset() {
@@ -1277,6 +1424,27 @@ func (x *Setting) GetSourceLine() *NullString {
 	return nil
 }
 
+func (x *Setting) GetPendingRestart() bool {
+	if x != nil {
+		return x.PendingRestart
+	}
+	return false
+}
+
+func (x *Setting) GetPendingValue() *NullString {
+	if x != nil {
+		return x.PendingValue
+	}
+	return nil
+}
+
+func (x *Setting) GetProviderDefaultValue() *NullString {
+	if x != nil {
+		return x.ProviderDefaultValue
+	}
+	return nil
+}
+
 type Replication struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2325,22 +2493,29 @@ type QueryStatistic struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QueryIdx          int32   `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
-	Calls             int64   `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
-	TotalTime         float64 `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
-	Rows              int64   `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
-	SharedBlksHit     int64   `protobuf:"varint,5,opt,name=shared_blks_hit,json=sharedBlksHit,proto3" json:"shared_blks_hit,omitempty"`
-	SharedBlksRead    int64   `protobuf:"varint,6,opt,name=shared_blks_read,json=sharedBlksRead,proto3" json:"shared_blks_read,omitempty"`
-	SharedBlksDirtied int64   `protobuf:"varint,7,opt,name=shared_blks_dirtied,json=sharedBlksDirtied,proto3" json:"shared_blks_dirtied,omitempty"`
-	SharedBlksWritten int64   `protobuf:"varint,8,opt,name=shared_blks_written,json=sharedBlksWritten,proto3" json:"shared_blks_written,omitempty"`
-	LocalBlksHit      int64   `protobuf:"varint,9,opt,name=local_blks_hit,json=localBlksHit,proto3" json:"local_blks_hit,omitempty"`
-	LocalBlksRead     int64   `protobuf:"varint,10,opt,name=local_blks_read,json=localBlksRead,proto3" json:"local_blks_read,omitempty"`
-	LocalBlksDirtied  int64   `protobuf:"varint,11,opt,name=local_blks_dirtied,json=localBlksDirtied,proto3" json:"local_blks_dirtied,omitempty"`
-	LocalBlksWritten  int64   `protobuf:"varint,12,opt,name=local_blks_written,json=localBlksWritten,proto3" json:"local_blks_written,omitempty"`
-	TempBlksRead      int64   `protobuf:"varint,13,opt,name=temp_blks_read,json=tempBlksRead,proto3" json:"temp_blks_read,omitempty"`
-	TempBlksWritten   int64   `protobuf:"varint,14,opt,name=temp_blks_written,json=tempBlksWritten,proto3" json:"temp_blks_written,omitempty"`
-	BlkReadTime       float64 `protobuf:"fixed64,15,opt,name=blk_read_time,json=blkReadTime,proto3" json:"blk_read_time,omitempty"`
-	BlkWriteTime      float64 `protobuf:"fixed64,16,opt,name=blk_write_time,json=blkWriteTime,proto3" json:"blk_write_time,omitempty"`
+	QueryIdx          int32       `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	Calls             int64       `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	TotalTime         float64     `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	Rows              int64       `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	SharedBlksHit     int64       `protobuf:"varint,5,opt,name=shared_blks_hit,json=sharedBlksHit,proto3" json:"shared_blks_hit,omitempty"`
+	SharedBlksRead    int64       `protobuf:"varint,6,opt,name=shared_blks_read,json=sharedBlksRead,proto3" json:"shared_blks_read,omitempty"`
+	SharedBlksDirtied int64       `protobuf:"varint,7,opt,name=shared_blks_dirtied,json=sharedBlksDirtied,proto3" json:"shared_blks_dirtied,omitempty"`
+	SharedBlksWritten int64       `protobuf:"varint,8,opt,name=shared_blks_written,json=sharedBlksWritten,proto3" json:"shared_blks_written,omitempty"`
+	LocalBlksHit      int64       `protobuf:"varint,9,opt,name=local_blks_hit,json=localBlksHit,proto3" json:"local_blks_hit,omitempty"`
+	LocalBlksRead     int64       `protobuf:"varint,10,opt,name=local_blks_read,json=localBlksRead,proto3" json:"local_blks_read,omitempty"`
+	LocalBlksDirtied  int64       `protobuf:"varint,11,opt,name=local_blks_dirtied,json=localBlksDirtied,proto3" json:"local_blks_dirtied,omitempty"`
+	LocalBlksWritten  int64       `protobuf:"varint,12,opt,name=local_blks_written,json=localBlksWritten,proto3" json:"local_blks_written,omitempty"`
+	TempBlksRead      int64       `protobuf:"varint,13,opt,name=temp_blks_read,json=tempBlksRead,proto3" json:"temp_blks_read,omitempty"`
+	TempBlksWritten   int64       `protobuf:"varint,14,opt,name=temp_blks_written,json=tempBlksWritten,proto3" json:"temp_blks_written,omitempty"`
+	BlkReadTime       float64     `protobuf:"fixed64,15,opt,name=blk_read_time,json=blkReadTime,proto3" json:"blk_read_time,omitempty"`
+	BlkWriteTime      float64     `protobuf:"fixed64,16,opt,name=blk_write_time,json=blkWriteTime,proto3" json:"blk_write_time,omitempty"`
+	ExecUserTime      float64     `protobuf:"fixed64,17,opt,name=exec_user_time,json=execUserTime,proto3" json:"exec_user_time,omitempty"`       // pg_stat_kcache 2.1+: CPU time spent in user mode executing the statement, in milliseconds
+	ExecSystemTime    float64     `protobuf:"fixed64,18,opt,name=exec_system_time,json=execSystemTime,proto3" json:"exec_system_time,omitempty"` // pg_stat_kcache 2.1+: CPU time spent in kernel mode executing the statement, in milliseconds
+	ExecReads         int64       `protobuf:"varint,19,opt,name=exec_reads,json=execReads,proto3" json:"exec_reads,omitempty"`                   // pg_stat_kcache 2.1+: Bytes read from the filesystem (not the OS page cache) executing the statement
+	ExecWrites        int64       `protobuf:"varint,20,opt,name=exec_writes,json=execWrites,proto3" json:"exec_writes,omitempty"`                // pg_stat_kcache 2.1+: Bytes written to the filesystem executing the statement
+	Tags              []*QueryTag `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`                                               // Tags in the query text of the first execution (as kept by pg_stat_statements)
+	Plans             int64       `protobuf:"varint,22,opt,name=plans,proto3" json:"plans,omitempty"`                                            // Times the statement was planned (requires pg_stat_statements.track_planning), calls beyond this reused a cached plan
+	NestedCalls       int64       `protobuf:"varint,23,opt,name=nested_calls,json=nestedCalls,proto3" json:"nested_calls,omitempty"`             // Calls executed within functions rather than directly by the client (requires pg_stat_statements.track = all)
 }
 
 func (x *QueryStatistic) Reset() {
@@ -2487,6 +2662,55 @@ func (x *QueryStatistic) GetBlkWriteTime() float64 {
 	return 0
 }
 
+func (x *QueryStatistic) GetExecUserTime() float64 {
+	if x != nil {
+		return x.ExecUserTime
+	}
+	return 0
+}
+
+func (x *QueryStatistic) GetExecSystemTime() float64 {
+	if x != nil {
+		return x.ExecSystemTime
+	}
+	return 0
+}
+
+func (x *QueryStatistic) GetExecReads() int64 {
+	if x != nil {
+		return x.ExecReads
+	}
+	return 0
+}
+
+func (x *QueryStatistic) GetExecWrites() int64 {
+	if x != nil {
+		return x.ExecWrites
+	}
+	return 0
+}
+
+func (x *QueryStatistic) GetTags() []*QueryTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QueryStatistic) GetPlans() int64 {
+	if x != nil {
+		return x.Plans
+	}
+	return 0
+}
+
+func (x *QueryStatistic) GetNestedCalls() int64 {
+	if x != nil {
+		return x.NestedCalls
+	}
+	return 0
+}
+
 type HistoricQueryStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2569,14 +2793,15 @@ type RelationInformation struct {
 	MinimumMultixactXid    uint32                            `protobuf:"varint,12,opt,name=minimum_multixact_xid,json=minimumMultixactXid,proto3" json:"minimum_multixact_xid,omitempty"`
 	// True if another process is currently holding an AccessExclusiveLock on this
 	// relation, this also means we won't have columns/index/constraints information
-	ExclusivelyLocked bool                                  `protobuf:"varint,13,opt,name=exclusively_locked,json=exclusivelyLocked,proto3" json:"exclusively_locked,omitempty"`
-	Options           map[string]string                     `protobuf:"bytes,14,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ParentRelationIdx int32                                 `protobuf:"varint,15,opt,name=parent_relation_idx,json=parentRelationIdx,proto3" json:"parent_relation_idx,omitempty"`
-	HasParentRelation bool                                  `protobuf:"varint,16,opt,name=has_parent_relation,json=hasParentRelation,proto3" json:"has_parent_relation,omitempty"`
-	PartitionBoundary string                                `protobuf:"bytes,17,opt,name=partition_boundary,json=partitionBoundary,proto3" json:"partition_boundary,omitempty"`
-	PartitionStrategy RelationInformation_PartitionStrategy `protobuf:"varint,18,opt,name=partition_strategy,json=partitionStrategy,proto3,enum=pganalyze.collector.RelationInformation_PartitionStrategy" json:"partition_strategy,omitempty"`
-	PartitionColumns  []int32                               `protobuf:"varint,19,rep,packed,name=partition_columns,json=partitionColumns,proto3" json:"partition_columns,omitempty"` // list of either column index (when corresponding partition field is a column) or 0 (when expression)
-	PartitionedBy     string                                `protobuf:"bytes,20,opt,name=partitioned_by,json=partitionedBy,proto3" json:"partitioned_by,omitempty"`
+	ExclusivelyLocked  bool                                     `protobuf:"varint,13,opt,name=exclusively_locked,json=exclusivelyLocked,proto3" json:"exclusively_locked,omitempty"`
+	Options            map[string]string                        `protobuf:"bytes,14,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ParentRelationIdx  int32                                    `protobuf:"varint,15,opt,name=parent_relation_idx,json=parentRelationIdx,proto3" json:"parent_relation_idx,omitempty"`
+	HasParentRelation  bool                                     `protobuf:"varint,16,opt,name=has_parent_relation,json=hasParentRelation,proto3" json:"has_parent_relation,omitempty"`
+	PartitionBoundary  string                                   `protobuf:"bytes,17,opt,name=partition_boundary,json=partitionBoundary,proto3" json:"partition_boundary,omitempty"`
+	PartitionStrategy  RelationInformation_PartitionStrategy    `protobuf:"varint,18,opt,name=partition_strategy,json=partitionStrategy,proto3,enum=pganalyze.collector.RelationInformation_PartitionStrategy" json:"partition_strategy,omitempty"`
+	PartitionColumns   []int32                                  `protobuf:"varint,19,rep,packed,name=partition_columns,json=partitionColumns,proto3" json:"partition_columns,omitempty"` // list of either column index (when corresponding partition field is a column) or 0 (when expression)
+	PartitionedBy      string                                   `protobuf:"bytes,20,opt,name=partitioned_by,json=partitionedBy,proto3" json:"partitioned_by,omitempty"`
+	ExtendedStatistics []*RelationInformation_ExtendedStatistic `protobuf:"bytes,21,rep,name=extended_statistics,json=extendedStatistics,proto3" json:"extended_statistics,omitempty"`
 }
 
 func (x *RelationInformation) Reset() {
@@ -2751,6 +2976,13 @@ func (x *RelationInformation) GetPartitionedBy() string {
 	return ""
 }
 
+func (x *RelationInformation) GetExtendedStatistics() []*RelationInformation_ExtendedStatistic {
+	if x != nil {
+		return x.ExtendedStatistics
+	}
+	return nil
+}
+
 type RelationStatistic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3608,37 +3840,34 @@ func (x *CustomTypeInformation) GetDomainConstraints() []string {
 	return nil
 }
 
-type RelationInformation_Column struct {
+type AlloyDBInformation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name              string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DataType          string                                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DefaultValue      *NullString                            `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	NotNull           bool                                   `protobuf:"varint,5,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
-	Position          int32                                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
-	Statistics        []*RelationInformation_ColumnStatistic `protobuf:"bytes,7,rep,name=statistics,proto3" json:"statistics,omitempty"`
-	DataTypeCustomIdx *NullInt32                             `protobuf:"bytes,8,opt,name=data_type_custom_idx,json=dataTypeCustomIdx,proto3" json:"data_type_custom_idx,omitempty"`
+	InstanceRole               string                                 `protobuf:"bytes,1,opt,name=instance_role,json=instanceRole,proto3" json:"instance_role,omitempty"` // "primary" or "read_pool"
+	ColumnarEngineEnabled      bool                                   `protobuf:"varint,2,opt,name=columnar_engine_enabled,json=columnarEngineEnabled,proto3" json:"columnar_engine_enabled,omitempty"`
+	ColumnarRelations          []*AlloyDBInformation_ColumnarRelation `protobuf:"bytes,3,rep,name=columnar_relations,json=columnarRelations,proto3" json:"columnar_relations,omitempty"`                              // Relations (or parts of relations) currently held in the columnar engine
+	ColumnarRecommendedColumns []*AlloyDBInformation_ColumnarColumn   `protobuf:"bytes,4,rep,name=columnar_recommended_columns,json=columnarRecommendedColumns,proto3" json:"columnar_recommended_columns,omitempty"` // Columns recommended by the columnar engine recommendation feature
 }
 
-func (x *RelationInformation_Column) Reset() {
-	*x = RelationInformation_Column{}
+func (x *AlloyDBInformation) Reset() {
+	*x = AlloyDBInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_full_snapshot_proto_msgTypes[24]
+		mi := &file_full_snapshot_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelationInformation_Column) String() string {
+func (x *AlloyDBInformation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationInformation_Column) ProtoMessage() {}
+func (*AlloyDBInformation) ProtoMessage() {}
 
-func (x *RelationInformation_Column) ProtoReflect() protoreflect.Message {
-	mi := &file_full_snapshot_proto_msgTypes[24]
+func (x *AlloyDBInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3649,89 +3878,71 @@ func (x *RelationInformation_Column) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationInformation_Column.ProtoReflect.Descriptor instead.
-func (*RelationInformation_Column) Descriptor() ([]byte, []int) {
-	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 1}
-}
-
-func (x *RelationInformation_Column) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use AlloyDBInformation.ProtoReflect.Descriptor instead.
+func (*AlloyDBInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RelationInformation_Column) GetDataType() string {
+func (x *AlloyDBInformation) GetInstanceRole() string {
 	if x != nil {
-		return x.DataType
+		return x.InstanceRole
 	}
 	return ""
 }
 
-func (x *RelationInformation_Column) GetDefaultValue() *NullString {
-	if x != nil {
-		return x.DefaultValue
-	}
-	return nil
-}
-
-func (x *RelationInformation_Column) GetNotNull() bool {
+func (x *AlloyDBInformation) GetColumnarEngineEnabled() bool {
 	if x != nil {
-		return x.NotNull
+		return x.ColumnarEngineEnabled
 	}
 	return false
 }
 
-func (x *RelationInformation_Column) GetPosition() int32 {
-	if x != nil {
-		return x.Position
-	}
-	return 0
-}
-
-func (x *RelationInformation_Column) GetStatistics() []*RelationInformation_ColumnStatistic {
+func (x *AlloyDBInformation) GetColumnarRelations() []*AlloyDBInformation_ColumnarRelation {
 	if x != nil {
-		return x.Statistics
+		return x.ColumnarRelations
 	}
 	return nil
 }
 
-func (x *RelationInformation_Column) GetDataTypeCustomIdx() *NullInt32 {
+func (x *AlloyDBInformation) GetColumnarRecommendedColumns() []*AlloyDBInformation_ColumnarColumn {
 	if x != nil {
-		return x.DataTypeCustomIdx
+		return x.ColumnarRecommendedColumns
 	}
 	return nil
 }
 
-type RelationInformation_ColumnStatistic struct {
+// Counters for a Google Cloud Pub/Sub subscription that log data is received from, diff-ed between two runs
+type GcpPubSubStatistic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inherited   bool        `protobuf:"varint,1,opt,name=inherited,proto3" json:"inherited,omitempty"`
-	NullFrac    float64     `protobuf:"fixed64,2,opt,name=null_frac,json=nullFrac,proto3" json:"null_frac,omitempty"`
-	AvgWidth    int32       `protobuf:"varint,3,opt,name=avg_width,json=avgWidth,proto3" json:"avg_width,omitempty"`
-	NDistinct   float64     `protobuf:"fixed64,4,opt,name=n_distinct,json=nDistinct,proto3" json:"n_distinct,omitempty"`
-	Correlation *NullDouble `protobuf:"bytes,5,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	Subscription        string  `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	MessagesReceived    int64   `protobuf:"varint,2,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
+	MessagesFiltered    int64   `protobuf:"varint,3,opt,name=messages_filtered,json=messagesFiltered,proto3" json:"messages_filtered,omitempty"` // Messages that did not contain Postgres logs
+	MessagesUnparseable int64   `protobuf:"varint,4,opt,name=messages_unparseable,json=messagesUnparseable,proto3" json:"messages_unparseable,omitempty"`
+	MessagesDuplicate   int64   `protobuf:"varint,5,opt,name=messages_duplicate,json=messagesDuplicate,proto3" json:"messages_duplicate,omitempty"`    // Redeliveries of messages that were already processed
+	LinesDelivered      int64   `protobuf:"varint,6,opt,name=lines_delivered,json=linesDelivered,proto3" json:"lines_delivered,omitempty"`             // Log lines that were matched to a configured server
+	BacklogAgeSeconds   float64 `protobuf:"fixed64,7,opt,name=backlog_age_seconds,json=backlogAgeSeconds,proto3" json:"backlog_age_seconds,omitempty"` // Age of the most recently received message (not diff-ed)
 }
 
-func (x *RelationInformation_ColumnStatistic) Reset() {
-	*x = RelationInformation_ColumnStatistic{}
+func (x *GcpPubSubStatistic) Reset() {
+	*x = GcpPubSubStatistic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_full_snapshot_proto_msgTypes[25]
+		mi := &file_full_snapshot_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelationInformation_ColumnStatistic) String() string {
+func (x *GcpPubSubStatistic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationInformation_ColumnStatistic) ProtoMessage() {}
+func (*GcpPubSubStatistic) ProtoMessage() {}
 
-func (x *RelationInformation_ColumnStatistic) ProtoReflect() protoreflect.Message {
-	mi := &file_full_snapshot_proto_msgTypes[25]
+func (x *GcpPubSubStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3742,79 +3953,91 @@ func (x *RelationInformation_ColumnStatistic) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationInformation_ColumnStatistic.ProtoReflect.Descriptor instead.
-func (*RelationInformation_ColumnStatistic) Descriptor() ([]byte, []int) {
-	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 2}
+// Deprecated: Use GcpPubSubStatistic.ProtoReflect.Descriptor instead.
+func (*GcpPubSubStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RelationInformation_ColumnStatistic) GetInherited() bool {
+func (x *GcpPubSubStatistic) GetSubscription() string {
 	if x != nil {
-		return x.Inherited
+		return x.Subscription
 	}
-	return false
+	return ""
 }
 
-func (x *RelationInformation_ColumnStatistic) GetNullFrac() float64 {
+func (x *GcpPubSubStatistic) GetMessagesReceived() int64 {
 	if x != nil {
-		return x.NullFrac
+		return x.MessagesReceived
 	}
 	return 0
 }
 
-func (x *RelationInformation_ColumnStatistic) GetAvgWidth() int32 {
+func (x *GcpPubSubStatistic) GetMessagesFiltered() int64 {
 	if x != nil {
-		return x.AvgWidth
+		return x.MessagesFiltered
 	}
 	return 0
 }
 
-func (x *RelationInformation_ColumnStatistic) GetNDistinct() float64 {
+func (x *GcpPubSubStatistic) GetMessagesUnparseable() int64 {
 	if x != nil {
-		return x.NDistinct
+		return x.MessagesUnparseable
 	}
 	return 0
 }
 
-func (x *RelationInformation_ColumnStatistic) GetCorrelation() *NullDouble {
+func (x *GcpPubSubStatistic) GetMessagesDuplicate() int64 {
 	if x != nil {
-		return x.Correlation
+		return x.MessagesDuplicate
 	}
-	return nil
+	return 0
 }
 
-type RelationInformation_Constraint struct {
+func (x *GcpPubSubStatistic) GetLinesDelivered() int64 {
+	if x != nil {
+		return x.LinesDelivered
+	}
+	return 0
+}
+
+func (x *GcpPubSubStatistic) GetBacklogAgeSeconds() float64 {
+	if x != nil {
+		return x.BacklogAgeSeconds
+	}
+	return 0
+}
+
+// Topology and distributed activity of a Citus cluster, only collected on the coordinator
+type CitusInformation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ForeignRelationIdx int32   `protobuf:"varint,1,opt,name=foreign_relation_idx,json=foreignRelationIdx,proto3" json:"foreign_relation_idx,omitempty"` // If a foreign key, the referenced table
-	Name               string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                          // Constraint name (not necessarily unique!)
-	Type               string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                          // c = check constraint, f = foreign key constraint, p = primary key constraint, u = unique constraint, t = constraint trigger, x = exclusion constraint
-	ConstraintDef      string  `protobuf:"bytes,4,opt,name=constraint_def,json=constraintDef,proto3" json:"constraint_def,omitempty"`                   // Human-readable representation of the expression
-	Columns            []int32 `protobuf:"varint,5,rep,packed,name=columns,proto3" json:"columns,omitempty"`                                            // If a table constraint (including foreign keys, but not constraint triggers), list of the constrained columns
-	ForeignColumns     []int32 `protobuf:"varint,6,rep,packed,name=foreign_columns,json=foreignColumns,proto3" json:"foreign_columns,omitempty"`        // If a foreign key, list of the referenced columns
-	ForeignUpdateType  string  `protobuf:"bytes,7,opt,name=foreign_update_type,json=foreignUpdateType,proto3" json:"foreign_update_type,omitempty"`     // Foreign key update action code: a = no action, r = restrict, c = cascade, n = set null, d = set default
-	ForeignDeleteType  string  `protobuf:"bytes,8,opt,name=foreign_delete_type,json=foreignDeleteType,proto3" json:"foreign_delete_type,omitempty"`     // Foreign key deletion action code: a = no action, r = restrict, c = cascade, n = set null, d = set default
-	ForeignMatchType   string  `protobuf:"bytes,9,opt,name=foreign_match_type,json=foreignMatchType,proto3" json:"foreign_match_type,omitempty"`        // Foreign key match type: f = full, p = partial, s = simple
+	Nodes                 []*CitusInformation_Node                 `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	DistributedTables     []*CitusInformation_DistributedTable     `protobuf:"bytes,2,rep,name=distributed_tables,json=distributedTables,proto3" json:"distributed_tables,omitempty"`
+	DistributedBackends   []*CitusInformation_DistributedBackend   `protobuf:"bytes,3,rep,name=distributed_backends,json=distributedBackends,proto3" json:"distributed_backends,omitempty"`
+	DistributedStatements []*CitusInformation_DistributedStatement `protobuf:"bytes,4,rep,name=distributed_statements,json=distributedStatements,proto3" json:"distributed_statements,omitempty"`
+	ShardPlacements       []*CitusInformation_ShardPlacement       `protobuf:"bytes,5,rep,name=shard_placements,json=shardPlacements,proto3" json:"shard_placements,omitempty"`
+	RebalanceProgress     []*CitusInformation_RebalanceMove        `protobuf:"bytes,6,rep,name=rebalance_progress,json=rebalanceProgress,proto3" json:"rebalance_progress,omitempty"`
 }
 
-func (x *RelationInformation_Constraint) Reset() {
-	*x = RelationInformation_Constraint{}
+func (x *CitusInformation) Reset() {
+	*x = CitusInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_full_snapshot_proto_msgTypes[26]
+		mi := &file_full_snapshot_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelationInformation_Constraint) String() string {
+func (x *CitusInformation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationInformation_Constraint) ProtoMessage() {}
+func (*CitusInformation) ProtoMessage() {}
 
-func (x *RelationInformation_Constraint) ProtoReflect() protoreflect.Message {
-	mi := &file_full_snapshot_proto_msgTypes[26]
+func (x *CitusInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3825,100 +4048,411 @@ func (x *RelationInformation_Constraint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationInformation_Constraint.ProtoReflect.Descriptor instead.
-func (*RelationInformation_Constraint) Descriptor() ([]byte, []int) {
-	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 3}
+// Deprecated: Use CitusInformation.ProtoReflect.Descriptor instead.
+func (*CitusInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RelationInformation_Constraint) GetForeignRelationIdx() int32 {
+func (x *CitusInformation) GetNodes() []*CitusInformation_Node {
 	if x != nil {
-		return x.ForeignRelationIdx
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CitusInformation) GetDistributedTables() []*CitusInformation_DistributedTable {
+	if x != nil {
+		return x.DistributedTables
+	}
+	return nil
+}
+
+func (x *CitusInformation) GetDistributedBackends() []*CitusInformation_DistributedBackend {
+	if x != nil {
+		return x.DistributedBackends
+	}
+	return nil
+}
+
+func (x *CitusInformation) GetDistributedStatements() []*CitusInformation_DistributedStatement {
+	if x != nil {
+		return x.DistributedStatements
+	}
+	return nil
+}
+
+func (x *CitusInformation) GetShardPlacements() []*CitusInformation_ShardPlacement {
+	if x != nil {
+		return x.ShardPlacements
+	}
+	return nil
+}
+
+func (x *CitusInformation) GetRebalanceProgress() []*CitusInformation_RebalanceMove {
+	if x != nil {
+		return x.RebalanceProgress
+	}
+	return nil
+}
+
+// TimescaleDB hypertable, whose chunks are included in the statistics of the hypertable's relation
+type TimescaleHypertableInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationIdx                int32 `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	NumDimensions              int32 `protobuf:"varint,2,opt,name=num_dimensions,json=numDimensions,proto3" json:"num_dimensions,omitempty"`
+	NumChunks                  int64 `protobuf:"varint,3,opt,name=num_chunks,json=numChunks,proto3" json:"num_chunks,omitempty"`
+	CompressionEnabled         bool  `protobuf:"varint,4,opt,name=compression_enabled,json=compressionEnabled,proto3" json:"compression_enabled,omitempty"`
+	CompressedChunks           int64 `protobuf:"varint,5,opt,name=compressed_chunks,json=compressedChunks,proto3" json:"compressed_chunks,omitempty"`
+	BeforeCompressionSizeBytes int64 `protobuf:"varint,6,opt,name=before_compression_size_bytes,json=beforeCompressionSizeBytes,proto3" json:"before_compression_size_bytes,omitempty"`
+	AfterCompressionSizeBytes  int64 `protobuf:"varint,7,opt,name=after_compression_size_bytes,json=afterCompressionSizeBytes,proto3" json:"after_compression_size_bytes,omitempty"`
+}
+
+func (x *TimescaleHypertableInformation) Reset() {
+	*x = TimescaleHypertableInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimescaleHypertableInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimescaleHypertableInformation) ProtoMessage() {}
+
+func (x *TimescaleHypertableInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimescaleHypertableInformation.ProtoReflect.Descriptor instead.
+func (*TimescaleHypertableInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TimescaleHypertableInformation) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
 	}
 	return 0
 }
 
-func (x *RelationInformation_Constraint) GetName() string {
+func (x *TimescaleHypertableInformation) GetNumDimensions() int32 {
 	if x != nil {
-		return x.Name
+		return x.NumDimensions
+	}
+	return 0
+}
+
+func (x *TimescaleHypertableInformation) GetNumChunks() int64 {
+	if x != nil {
+		return x.NumChunks
+	}
+	return 0
+}
+
+func (x *TimescaleHypertableInformation) GetCompressionEnabled() bool {
+	if x != nil {
+		return x.CompressionEnabled
+	}
+	return false
+}
+
+func (x *TimescaleHypertableInformation) GetCompressedChunks() int64 {
+	if x != nil {
+		return x.CompressedChunks
+	}
+	return 0
+}
+
+func (x *TimescaleHypertableInformation) GetBeforeCompressionSizeBytes() int64 {
+	if x != nil {
+		return x.BeforeCompressionSizeBytes
+	}
+	return 0
+}
+
+func (x *TimescaleHypertableInformation) GetAfterCompressionSizeBytes() int64 {
+	if x != nil {
+		return x.AfterCompressionSizeBytes
+	}
+	return 0
+}
+
+// TimescaleDB continuous aggregate, with the statistics of its refresh policy job (if any)
+type TimescaleContinuousAggregateInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseIdx            int32                `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	SchemaName             string               `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	ViewName               string               `protobuf:"bytes,3,opt,name=view_name,json=viewName,proto3" json:"view_name,omitempty"`
+	HypertableSchemaName   string               `protobuf:"bytes,4,opt,name=hypertable_schema_name,json=hypertableSchemaName,proto3" json:"hypertable_schema_name,omitempty"`
+	HypertableName         string               `protobuf:"bytes,5,opt,name=hypertable_name,json=hypertableName,proto3" json:"hypertable_name,omitempty"`
+	MaterializedOnly       bool                 `protobuf:"varint,6,opt,name=materialized_only,json=materializedOnly,proto3" json:"materialized_only,omitempty"`
+	HasJob                 bool                 `protobuf:"varint,7,opt,name=has_job,json=hasJob,proto3" json:"has_job,omitempty"`
+	JobId                  int64                `protobuf:"varint,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	LastRunStartedAt       *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_run_started_at,json=lastRunStartedAt,proto3" json:"last_run_started_at,omitempty"`
+	LastSuccessfulFinish   *timestamp.Timestamp `protobuf:"bytes,10,opt,name=last_successful_finish,json=lastSuccessfulFinish,proto3" json:"last_successful_finish,omitempty"`
+	LastRunStatus          string               `protobuf:"bytes,11,opt,name=last_run_status,json=lastRunStatus,proto3" json:"last_run_status,omitempty"`                                // "Success" or "Failed" (empty if the job never ran)
+	LastRunDurationSeconds float64              `protobuf:"fixed64,12,opt,name=last_run_duration_seconds,json=lastRunDurationSeconds,proto3" json:"last_run_duration_seconds,omitempty"` // -1 if unknown
+	NextStart              *timestamp.Timestamp `protobuf:"bytes,13,opt,name=next_start,json=nextStart,proto3" json:"next_start,omitempty"`
+	TotalRuns              int64                `protobuf:"varint,14,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	TotalFailures          int64                `protobuf:"varint,15,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty"`
+}
+
+func (x *TimescaleContinuousAggregateInformation) Reset() {
+	*x = TimescaleContinuousAggregateInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimescaleContinuousAggregateInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimescaleContinuousAggregateInformation) ProtoMessage() {}
+
+func (x *TimescaleContinuousAggregateInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimescaleContinuousAggregateInformation.ProtoReflect.Descriptor instead.
+func (*TimescaleContinuousAggregateInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
 	}
 	return ""
 }
 
-func (x *RelationInformation_Constraint) GetType() string {
+func (x *TimescaleContinuousAggregateInformation) GetViewName() string {
 	if x != nil {
-		return x.Type
+		return x.ViewName
 	}
 	return ""
 }
 
-func (x *RelationInformation_Constraint) GetConstraintDef() string {
+func (x *TimescaleContinuousAggregateInformation) GetHypertableSchemaName() string {
 	if x != nil {
-		return x.ConstraintDef
+		return x.HypertableSchemaName
 	}
 	return ""
 }
 
-func (x *RelationInformation_Constraint) GetColumns() []int32 {
+func (x *TimescaleContinuousAggregateInformation) GetHypertableName() string {
 	if x != nil {
-		return x.Columns
+		return x.HypertableName
+	}
+	return ""
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetMaterializedOnly() bool {
+	if x != nil {
+		return x.MaterializedOnly
+	}
+	return false
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetHasJob() bool {
+	if x != nil {
+		return x.HasJob
+	}
+	return false
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetLastRunStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastRunStartedAt
 	}
 	return nil
 }
 
-func (x *RelationInformation_Constraint) GetForeignColumns() []int32 {
+func (x *TimescaleContinuousAggregateInformation) GetLastSuccessfulFinish() *timestamp.Timestamp {
 	if x != nil {
-		return x.ForeignColumns
+		return x.LastSuccessfulFinish
 	}
 	return nil
 }
 
-func (x *RelationInformation_Constraint) GetForeignUpdateType() string {
+func (x *TimescaleContinuousAggregateInformation) GetLastRunStatus() string {
 	if x != nil {
-		return x.ForeignUpdateType
+		return x.LastRunStatus
 	}
 	return ""
 }
 
-func (x *RelationInformation_Constraint) GetForeignDeleteType() string {
+func (x *TimescaleContinuousAggregateInformation) GetLastRunDurationSeconds() float64 {
 	if x != nil {
-		return x.ForeignDeleteType
+		return x.LastRunDurationSeconds
+	}
+	return 0
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetNextStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextStart
+	}
+	return nil
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetTotalRuns() int64 {
+	if x != nil {
+		return x.TotalRuns
+	}
+	return 0
+}
+
+func (x *TimescaleContinuousAggregateInformation) GetTotalFailures() int64 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+// Topology of the Patroni cluster the server is a member of, as reported by the Patroni REST API
+type PatroniInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope         string                               `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	CurrentMember string                               `protobuf:"bytes,2,opt,name=current_member,json=currentMember,proto3" json:"current_member,omitempty"` // Member this server connects to (empty if unknown)
+	Members       []*PatroniInformation_Member         `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	History       []*PatroniInformation_TimelineChange `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"` // Most recent changes, oldest first
+}
+
+func (x *PatroniInformation) Reset() {
+	*x = PatroniInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatroniInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatroniInformation) ProtoMessage() {}
+
+func (x *PatroniInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatroniInformation.ProtoReflect.Descriptor instead.
+func (*PatroniInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PatroniInformation) GetScope() string {
+	if x != nil {
+		return x.Scope
 	}
 	return ""
 }
 
-func (x *RelationInformation_Constraint) GetForeignMatchType() string {
+func (x *PatroniInformation) GetCurrentMember() string {
 	if x != nil {
-		return x.ForeignMatchType
+		return x.CurrentMember
 	}
 	return ""
 }
 
-type CustomTypeInformation_CompositeAttr struct {
+func (x *PatroniInformation) GetMembers() []*PatroniInformation_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PatroniInformation) GetHistory() []*PatroniInformation_TimelineChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Nodes of the pg_auto_failover formation the server belongs to, as tracked by the monitor
+type PgAutoFailoverInformation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	FormationId      string                             `protobuf:"bytes,1,opt,name=formation_id,json=formationId,proto3" json:"formation_id,omitempty"`
+	HasCurrentNodeId bool                               `protobuf:"varint,2,opt,name=has_current_node_id,json=hasCurrentNodeId,proto3" json:"has_current_node_id,omitempty"`
+	CurrentNodeId    int64                              `protobuf:"varint,3,opt,name=current_node_id,json=currentNodeId,proto3" json:"current_node_id,omitempty"` // Node of the server itself (if it could be matched by host and port)
+	Nodes            []*PgAutoFailoverInformation_Node  `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Events           []*PgAutoFailoverInformation_Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"` // Transitions within the last hour, oldest first
 }
 
-func (x *CustomTypeInformation_CompositeAttr) Reset() {
-	*x = CustomTypeInformation_CompositeAttr{}
+func (x *PgAutoFailoverInformation) Reset() {
+	*x = PgAutoFailoverInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_full_snapshot_proto_msgTypes[27]
+		mi := &file_full_snapshot_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CustomTypeInformation_CompositeAttr) String() string {
+func (x *PgAutoFailoverInformation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomTypeInformation_CompositeAttr) ProtoMessage() {}
+func (*PgAutoFailoverInformation) ProtoMessage() {}
 
-func (x *CustomTypeInformation_CompositeAttr) ProtoReflect() protoreflect.Message {
-	mi := &file_full_snapshot_proto_msgTypes[27]
+func (x *PgAutoFailoverInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3929,38 +4463,3490 @@ func (x *CustomTypeInformation_CompositeAttr) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomTypeInformation_CompositeAttr.ProtoReflect.Descriptor instead.
-func (*CustomTypeInformation_CompositeAttr) Descriptor() ([]byte, []int) {
-	return file_full_snapshot_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use PgAutoFailoverInformation.ProtoReflect.Descriptor instead.
+func (*PgAutoFailoverInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CustomTypeInformation_CompositeAttr) GetName() string {
+func (x *PgAutoFailoverInformation) GetFormationId() string {
 	if x != nil {
-		return x.Name
+		return x.FormationId
 	}
 	return ""
 }
 
-func (x *CustomTypeInformation_CompositeAttr) GetType() string {
+func (x *PgAutoFailoverInformation) GetHasCurrentNodeId() bool {
 	if x != nil {
-		return x.Type
+		return x.HasCurrentNodeId
 	}
-	return ""
+	return false
 }
 
-var File_full_snapshot_proto protoreflect.FileDescriptor
+func (x *PgAutoFailoverInformation) GetCurrentNodeId() int64 {
+	if x != nil {
+		return x.CurrentNodeId
+	}
+	return 0
+}
 
-var file_full_snapshot_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x1c, 0x0a, 0x0c, 0x46, 0x75,
-	0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
-	0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x73, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6a, 0x6f, 0x72,
+func (x *PgAutoFailoverInformation) GetNodes() []*PgAutoFailoverInformation_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PgAutoFailoverInformation) GetEvents() []*PgAutoFailoverInformation_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// PgBouncer instance in front of the server, as reported by its admin console
+type PgBouncerInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address            string                                    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Host and port of the admin console
+	DatabaseStatistics []*PgBouncerInformation_DatabaseStatistic `protobuf:"bytes,2,rep,name=database_statistics,json=databaseStatistics,proto3" json:"database_statistics,omitempty"`
+	Pools              []*PgBouncerInformation_Pool              `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+	ClientCounts       []*PgBouncerInformation_ClientCount       `protobuf:"bytes,4,rep,name=client_counts,json=clientCounts,proto3" json:"client_counts,omitempty"`
+	Lists              []*PgBouncerInformation_ListItem          `protobuf:"bytes,5,rep,name=lists,proto3" json:"lists,omitempty"`
+}
+
+func (x *PgBouncerInformation) Reset() {
+	*x = PgBouncerInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgBouncerInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgBouncerInformation) ProtoMessage() {}
+
+func (x *PgBouncerInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgBouncerInformation.ProtoReflect.Descriptor instead.
+func (*PgBouncerInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PgBouncerInformation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation) GetDatabaseStatistics() []*PgBouncerInformation_DatabaseStatistic {
+	if x != nil {
+		return x.DatabaseStatistics
+	}
+	return nil
+}
+
+func (x *PgBouncerInformation) GetPools() []*PgBouncerInformation_Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *PgBouncerInformation) GetClientCounts() []*PgBouncerInformation_ClientCount {
+	if x != nil {
+		return x.ClientCounts
+	}
+	return nil
+}
+
+func (x *PgBouncerInformation) GetLists() []*PgBouncerInformation_ListItem {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+// Status of the Pgpool-II instance in front of the server
+type PgpoolInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address            string                            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Host and port of the Pgpool-II instance
+	Nodes              []*PgpoolInformation_Node         `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	ChildProcesses     int64                             `protobuf:"varint,3,opt,name=child_processes,json=childProcesses,proto3" json:"child_processes,omitempty"` // Child processes that accept client connections
+	ConnectedProcesses []*PgpoolInformation_ProcessCount `protobuf:"bytes,4,rep,name=connected_processes,json=connectedProcesses,proto3" json:"connected_processes,omitempty"`
+	QueryCache         *PgpoolInformation_QueryCache     `protobuf:"bytes,5,opt,name=query_cache,json=queryCache,proto3" json:"query_cache,omitempty"` // Only set if the in-memory query cache is enabled
+}
+
+func (x *PgpoolInformation) Reset() {
+	*x = PgpoolInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgpoolInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgpoolInformation) ProtoMessage() {}
+
+func (x *PgpoolInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgpoolInformation.ProtoReflect.Descriptor instead.
+func (*PgpoolInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PgpoolInformation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PgpoolInformation) GetNodes() []*PgpoolInformation_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PgpoolInformation) GetChildProcesses() int64 {
+	if x != nil {
+		return x.ChildProcesses
+	}
+	return 0
+}
+
+func (x *PgpoolInformation) GetConnectedProcesses() []*PgpoolInformation_ProcessCount {
+	if x != nil {
+		return x.ConnectedProcesses
+	}
+	return nil
+}
+
+func (x *PgpoolInformation) GetQueryCache() *PgpoolInformation_QueryCache {
+	if x != nil {
+		return x.QueryCache
+	}
+	return nil
+}
+
+// Plan of a query, from pg_stat_plans or pg_store_plans
+type QueryPlanReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryIdx       int32 `protobuf:"varint,1,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`
+	OriginalPlanId int64 `protobuf:"varint,2,opt,name=original_plan_id,json=originalPlanId,proto3" json:"original_plan_id,omitempty"` // Plan ID as reported by the extension (unique together with the query ID)
+}
+
+func (x *QueryPlanReference) Reset() {
+	*x = QueryPlanReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPlanReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanReference) ProtoMessage() {}
+
+func (x *QueryPlanReference) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanReference.ProtoReflect.Descriptor instead.
+func (*QueryPlanReference) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryPlanReference) GetQueryIdx() int32 {
+	if x != nil {
+		return x.QueryIdx
+	}
+	return 0
+}
+
+func (x *QueryPlanReference) GetOriginalPlanId() int64 {
+	if x != nil {
+		return x.OriginalPlanId
+	}
+	return 0
+}
+
+type QueryPlanInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryPlanIdx int32  `protobuf:"varint,1,opt,name=query_plan_idx,json=queryPlanIdx,proto3" json:"query_plan_idx,omitempty"`
+	ExplainPlan  string `protobuf:"bytes,2,opt,name=explain_plan,json=explainPlan,proto3" json:"explain_plan,omitempty"` // Plan text (in the extension's output format), empty if not available
+}
+
+func (x *QueryPlanInformation) Reset() {
+	*x = QueryPlanInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPlanInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanInformation) ProtoMessage() {}
+
+func (x *QueryPlanInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanInformation.ProtoReflect.Descriptor instead.
+func (*QueryPlanInformation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryPlanInformation) GetQueryPlanIdx() int32 {
+	if x != nil {
+		return x.QueryPlanIdx
+	}
+	return 0
+}
+
+func (x *QueryPlanInformation) GetExplainPlan() string {
+	if x != nil {
+		return x.ExplainPlan
+	}
+	return ""
+}
+
+type QueryPlanStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryPlanIdx int32   `protobuf:"varint,1,opt,name=query_plan_idx,json=queryPlanIdx,proto3" json:"query_plan_idx,omitempty"`
+	Calls        int64   `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`                           // Number of times the query was executed with this plan
+	TotalTime    float64 `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"` // Total time spent executing the query with this plan, in milliseconds
+}
+
+func (x *QueryPlanStatistic) Reset() {
+	*x = QueryPlanStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPlanStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanStatistic) ProtoMessage() {}
+
+func (x *QueryPlanStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanStatistic.ProtoReflect.Descriptor instead.
+func (*QueryPlanStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryPlanStatistic) GetQueryPlanIdx() int32 {
+	if x != nil {
+		return x.QueryPlanIdx
+	}
+	return 0
+}
+
+func (x *QueryPlanStatistic) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *QueryPlanStatistic) GetTotalTime() float64 {
+	if x != nil {
+		return x.TotalTime
+	}
+	return 0
+}
+
+// I/O operations since the last snapshot, from pg_stat_io (Postgres 16+)
+type IOStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackendType   string  `protobuf:"bytes,1,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"` // e.g. "client backend", "checkpointer" or "background writer"
+	Object        string  `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`                              // "relation" or "temp relation"
+	Context       string  `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`                            // "normal", "vacuum", "bulkread" or "bulkwrite"
+	Reads         int64   `protobuf:"varint,4,opt,name=reads,proto3" json:"reads,omitempty"`                               // Counts are in number of operations (of block size each)
+	ReadTime      float64 `protobuf:"fixed64,5,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`        // Times are in milliseconds, and only tracked with track_io_timing enabled
+	Writes        int64   `protobuf:"varint,6,opt,name=writes,proto3" json:"writes,omitempty"`
+	WriteTime     float64 `protobuf:"fixed64,7,opt,name=write_time,json=writeTime,proto3" json:"write_time,omitempty"`
+	Writebacks    int64   `protobuf:"varint,8,opt,name=writebacks,proto3" json:"writebacks,omitempty"`
+	WritebackTime float64 `protobuf:"fixed64,9,opt,name=writeback_time,json=writebackTime,proto3" json:"writeback_time,omitempty"`
+	Extends       int64   `protobuf:"varint,10,opt,name=extends,proto3" json:"extends,omitempty"`
+	ExtendTime    float64 `protobuf:"fixed64,11,opt,name=extend_time,json=extendTime,proto3" json:"extend_time,omitempty"`
+	Hits          int64   `protobuf:"varint,12,opt,name=hits,proto3" json:"hits,omitempty"`
+	Evictions     int64   `protobuf:"varint,13,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	Reuses        int64   `protobuf:"varint,14,opt,name=reuses,proto3" json:"reuses,omitempty"`
+	Fsyncs        int64   `protobuf:"varint,15,opt,name=fsyncs,proto3" json:"fsyncs,omitempty"`
+	FsyncTime     float64 `protobuf:"fixed64,16,opt,name=fsync_time,json=fsyncTime,proto3" json:"fsync_time,omitempty"`
+}
+
+func (x *IOStatistic) Reset() {
+	*x = IOStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IOStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IOStatistic) ProtoMessage() {}
+
+func (x *IOStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IOStatistic.ProtoReflect.Descriptor instead.
+func (*IOStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IOStatistic) GetBackendType() string {
+	if x != nil {
+		return x.BackendType
+	}
+	return ""
+}
+
+func (x *IOStatistic) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *IOStatistic) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *IOStatistic) GetReads() int64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetReadTime() float64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetWriteTime() float64 {
+	if x != nil {
+		return x.WriteTime
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetWritebacks() int64 {
+	if x != nil {
+		return x.Writebacks
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetWritebackTime() float64 {
+	if x != nil {
+		return x.WritebackTime
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetExtends() int64 {
+	if x != nil {
+		return x.Extends
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetExtendTime() float64 {
+	if x != nil {
+		return x.ExtendTime
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetEvictions() int64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetReuses() int64 {
+	if x != nil {
+		return x.Reuses
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetFsyncs() int64 {
+	if x != nil {
+		return x.Fsyncs
+	}
+	return 0
+}
+
+func (x *IOStatistic) GetFsyncTime() float64 {
+	if x != nil {
+		return x.FsyncTime
+	}
+	return 0
+}
+
+// Wait event samples of a query since the last snapshot, summed up across processes, from pg_wait_sampling
+type QueryWaitEventStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId       int64  `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`                    // Matches the query IDs in QueryInformation (zero unless pg_wait_sampling.profile_queries is enabled)
+	WaitEventType string `protobuf:"bytes,2,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"` // Empty if the process was running (on CPU), instead of waiting
+	WaitEvent     string `protobuf:"bytes,3,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	Samples       int64  `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	TimeMs        int64  `protobuf:"varint,5,opt,name=time_ms,json=timeMs,proto3" json:"time_ms,omitempty"` // Approximate time spent, based on the sample count and pg_wait_sampling.sample_period
+}
+
+func (x *QueryWaitEventStatistic) Reset() {
+	*x = QueryWaitEventStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryWaitEventStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWaitEventStatistic) ProtoMessage() {}
+
+func (x *QueryWaitEventStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWaitEventStatistic.ProtoReflect.Descriptor instead.
+func (*QueryWaitEventStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueryWaitEventStatistic) GetQueryId() int64 {
+	if x != nil {
+		return x.QueryId
+	}
+	return 0
+}
+
+func (x *QueryWaitEventStatistic) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *QueryWaitEventStatistic) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+func (x *QueryWaitEventStatistic) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *QueryWaitEventStatistic) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+// Activity of an SLRU (simple least-recently-used) cache since the last snapshot, from pg_stat_slru (Postgres 13+)
+type SLRUStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. "MultiXactMember", "Subtrans" or "Notify"
+	BlksZeroed  int64  `protobuf:"varint,2,opt,name=blks_zeroed,json=blksZeroed,proto3" json:"blks_zeroed,omitempty"`
+	BlksHit     int64  `protobuf:"varint,3,opt,name=blks_hit,json=blksHit,proto3" json:"blks_hit,omitempty"`
+	BlksRead    int64  `protobuf:"varint,4,opt,name=blks_read,json=blksRead,proto3" json:"blks_read,omitempty"`
+	BlksWritten int64  `protobuf:"varint,5,opt,name=blks_written,json=blksWritten,proto3" json:"blks_written,omitempty"`
+	BlksExists  int64  `protobuf:"varint,6,opt,name=blks_exists,json=blksExists,proto3" json:"blks_exists,omitempty"`
+	Flushes     int64  `protobuf:"varint,7,opt,name=flushes,proto3" json:"flushes,omitempty"`
+	Truncates   int64  `protobuf:"varint,8,opt,name=truncates,proto3" json:"truncates,omitempty"`
+}
+
+func (x *SLRUStatistic) Reset() {
+	*x = SLRUStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLRUStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLRUStatistic) ProtoMessage() {}
+
+func (x *SLRUStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLRUStatistic.ProtoReflect.Descriptor instead.
+func (*SLRUStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SLRUStatistic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SLRUStatistic) GetBlksZeroed() int64 {
+	if x != nil {
+		return x.BlksZeroed
+	}
+	return 0
+}
+
+func (x *SLRUStatistic) GetBlksHit() int64 {
+	if x != nil {
+		return x.BlksHit
+	}
+	return 0
+}
+
+func (x *SLRUStatistic) GetBlksRead() int64 {
+	if x != nil {
+		return x.BlksRead
+	}
+	return 0
+}
+
+func (x *SLRUStatistic) GetBlksWritten() int64 {
+	if x != nil {
+		return x.BlksWritten
+	}
+	return 0
+}
+
+func (x *SLRUStatistic) GetBlksExists() int64 {
+	if x != nil {
+		return x.BlksExists
+	}
+	return 0
+}
+
+func (x *SLRUStatistic) GetFlushes() int64 {
+	if x != nil {
+		return x.Flushes
+	}
+	return 0
+}
+
+func (x *SLRUStatistic) GetTruncates() int64 {
+	if x != nil {
+		return x.Truncates
+	}
+	return 0
+}
+
+// WAL activity since the last snapshot, from pg_stat_wal (Postgres 14+)
+type WALStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalRecords          int64   `protobuf:"varint,1,opt,name=wal_records,json=walRecords,proto3" json:"wal_records,omitempty"`
+	WalFpi              int64   `protobuf:"varint,2,opt,name=wal_fpi,json=walFpi,proto3" json:"wal_fpi,omitempty"` // Full page images
+	WalBytes            int64   `protobuf:"varint,3,opt,name=wal_bytes,json=walBytes,proto3" json:"wal_bytes,omitempty"`
+	WalBuffersFull      int64   `protobuf:"varint,4,opt,name=wal_buffers_full,json=walBuffersFull,proto3" json:"wal_buffers_full,omitempty"` // Times WAL data was written to disk because WAL buffers became full
+	WalWrite            int64   `protobuf:"varint,5,opt,name=wal_write,json=walWrite,proto3" json:"wal_write,omitempty"`                     // Zero on Postgres 18+
+	WalSync             int64   `protobuf:"varint,6,opt,name=wal_sync,json=walSync,proto3" json:"wal_sync,omitempty"`                        // Zero on Postgres 18+
+	WalWriteTime        float64 `protobuf:"fixed64,7,opt,name=wal_write_time,json=walWriteTime,proto3" json:"wal_write_time,omitempty"`      // In milliseconds, only tracked with track_wal_io_timing enabled
+	WalSyncTime         float64 `protobuf:"fixed64,8,opt,name=wal_sync_time,json=walSyncTime,proto3" json:"wal_sync_time,omitempty"`
+	WalRecordsPerSecond float64 `protobuf:"fixed64,9,opt,name=wal_records_per_second,json=walRecordsPerSecond,proto3" json:"wal_records_per_second,omitempty"`
+	WalFpiPerSecond     float64 `protobuf:"fixed64,10,opt,name=wal_fpi_per_second,json=walFpiPerSecond,proto3" json:"wal_fpi_per_second,omitempty"`
+	WalBytesPerSecond   float64 `protobuf:"fixed64,11,opt,name=wal_bytes_per_second,json=walBytesPerSecond,proto3" json:"wal_bytes_per_second,omitempty"`
+}
+
+func (x *WALStatistic) Reset() {
+	*x = WALStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WALStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WALStatistic) ProtoMessage() {}
+
+func (x *WALStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WALStatistic.ProtoReflect.Descriptor instead.
+func (*WALStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WALStatistic) GetWalRecords() int64 {
+	if x != nil {
+		return x.WalRecords
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalFpi() int64 {
+	if x != nil {
+		return x.WalFpi
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalBytes() int64 {
+	if x != nil {
+		return x.WalBytes
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalBuffersFull() int64 {
+	if x != nil {
+		return x.WalBuffersFull
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalWrite() int64 {
+	if x != nil {
+		return x.WalWrite
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalSync() int64 {
+	if x != nil {
+		return x.WalSync
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalWriteTime() float64 {
+	if x != nil {
+		return x.WalWriteTime
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalSyncTime() float64 {
+	if x != nil {
+		return x.WalSyncTime
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalRecordsPerSecond() float64 {
+	if x != nil {
+		return x.WalRecordsPerSecond
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalFpiPerSecond() float64 {
+	if x != nil {
+		return x.WalFpiPerSecond
+	}
+	return 0
+}
+
+func (x *WALStatistic) GetWalBytesPerSecond() float64 {
+	if x != nil {
+		return x.WalBytesPerSecond
+	}
+	return 0
+}
+
+// Temporary files logged since the last snapshot (requires log_temp_files), by database and query
+type QueryTempFileStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseIdx int32 `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	HasQueryIdx bool  `protobuf:"varint,2,opt,name=has_query_idx,json=hasQueryIdx,proto3" json:"has_query_idx,omitempty"` // False for temporary files whose query is not known
+	QueryIdx    int32 `protobuf:"varint,3,opt,name=query_idx,json=queryIdx,proto3" json:"query_idx,omitempty"`            // References the query summed up across roles
+	Files       int64 `protobuf:"varint,4,opt,name=files,proto3" json:"files,omitempty"`
+	Bytes       int64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *QueryTempFileStatistic) Reset() {
+	*x = QueryTempFileStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTempFileStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTempFileStatistic) ProtoMessage() {}
+
+func (x *QueryTempFileStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTempFileStatistic.ProtoReflect.Descriptor instead.
+func (*QueryTempFileStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryTempFileStatistic) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *QueryTempFileStatistic) GetHasQueryIdx() bool {
+	if x != nil {
+		return x.HasQueryIdx
+	}
+	return false
+}
+
+func (x *QueryTempFileStatistic) GetQueryIdx() int32 {
+	if x != nil {
+		return x.QueryIdx
+	}
+	return 0
+}
+
+func (x *QueryTempFileStatistic) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *QueryTempFileStatistic) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+// Checkpoint, autovacuum and connection activity derived from the log lines analyzed since the last full snapshot
+type LogMetricsStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountedSince              *timestamp.Timestamp              `protobuf:"bytes,1,opt,name=counted_since,json=countedSince,proto3" json:"counted_since,omitempty"` // When counting started (this can be before the previous snapshot, if that failed to be sent)
+	Checkpoints               int64                             `protobuf:"varint,2,opt,name=checkpoints,proto3" json:"checkpoints,omitempty"`                      // Includes restartpoints on replicas
+	CheckpointBufsWritten     int64                             `protobuf:"varint,3,opt,name=checkpoint_bufs_written,json=checkpointBufsWritten,proto3" json:"checkpoint_bufs_written,omitempty"`
+	CheckpointWriteSecs       float64                           `protobuf:"fixed64,4,opt,name=checkpoint_write_secs,json=checkpointWriteSecs,proto3" json:"checkpoint_write_secs,omitempty"`
+	CheckpointSyncSecs        float64                           `protobuf:"fixed64,5,opt,name=checkpoint_sync_secs,json=checkpointSyncSecs,proto3" json:"checkpoint_sync_secs,omitempty"`
+	CheckpointTotalSecs       float64                           `protobuf:"fixed64,6,opt,name=checkpoint_total_secs,json=checkpointTotalSecs,proto3" json:"checkpoint_total_secs,omitempty"`
+	Autovacuums               int64                             `protobuf:"varint,10,opt,name=autovacuums,proto3" json:"autovacuums,omitempty"`
+	AutovacuumsAntiWraparound int64                             `protobuf:"varint,11,opt,name=autovacuums_anti_wraparound,json=autovacuumsAntiWraparound,proto3" json:"autovacuums_anti_wraparound,omitempty"`
+	AutovacuumPagesRemoved    int64                             `protobuf:"varint,12,opt,name=autovacuum_pages_removed,json=autovacuumPagesRemoved,proto3" json:"autovacuum_pages_removed,omitempty"`
+	AutovacuumTuplesDeleted   int64                             `protobuf:"varint,13,opt,name=autovacuum_tuples_deleted,json=autovacuumTuplesDeleted,proto3" json:"autovacuum_tuples_deleted,omitempty"`
+	AutovacuumFrozenPages     int64                             `protobuf:"varint,14,opt,name=autovacuum_frozen_pages,json=autovacuumFrozenPages,proto3" json:"autovacuum_frozen_pages,omitempty"`    // Postgres 16+
+	AutovacuumTuplesFrozen    int64                             `protobuf:"varint,15,opt,name=autovacuum_tuples_frozen,json=autovacuumTuplesFrozen,proto3" json:"autovacuum_tuples_frozen,omitempty"` // Postgres 16+
+	AutovacuumWalBytes        int64                             `protobuf:"varint,16,opt,name=autovacuum_wal_bytes,json=autovacuumWalBytes,proto3" json:"autovacuum_wal_bytes,omitempty"`             // Postgres 13+
+	AutovacuumElapsedSecs     float64                           `protobuf:"fixed64,17,opt,name=autovacuum_elapsed_secs,json=autovacuumElapsedSecs,proto3" json:"autovacuum_elapsed_secs,omitempty"`
+	ConnectionsReceived       int64                             `protobuf:"varint,20,opt,name=connections_received,json=connectionsReceived,proto3" json:"connections_received,omitempty"` // Connection activity requires log_connections and log_disconnections
+	ConnectionsAuthorized     int64                             `protobuf:"varint,21,opt,name=connections_authorized,json=connectionsAuthorized,proto3" json:"connections_authorized,omitempty"`
+	ConnectionsRejected       int64                             `protobuf:"varint,22,opt,name=connections_rejected,json=connectionsRejected,proto3" json:"connections_rejected,omitempty"` // Authentication failures, pg_hba.conf rejections and similar
+	Disconnections            int64                             `protobuf:"varint,23,opt,name=disconnections,proto3" json:"disconnections,omitempty"`
+	SessionSecs               float64                           `protobuf:"fixed64,24,opt,name=session_secs,json=sessionSecs,proto3" json:"session_secs,omitempty"` // Combined duration of the sessions that disconnected
+	Logins                    []*LogMetricsStatistic_LoginCount `protobuf:"bytes,25,rep,name=logins,proto3" json:"logins,omitempty"`                                // Only for databases and roles that still exist
+}
+
+func (x *LogMetricsStatistic) Reset() {
+	*x = LogMetricsStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMetricsStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMetricsStatistic) ProtoMessage() {}
+
+func (x *LogMetricsStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMetricsStatistic.ProtoReflect.Descriptor instead.
+func (*LogMetricsStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LogMetricsStatistic) GetCountedSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.CountedSince
+	}
+	return nil
+}
+
+func (x *LogMetricsStatistic) GetCheckpoints() int64 {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetCheckpointBufsWritten() int64 {
+	if x != nil {
+		return x.CheckpointBufsWritten
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetCheckpointWriteSecs() float64 {
+	if x != nil {
+		return x.CheckpointWriteSecs
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetCheckpointSyncSecs() float64 {
+	if x != nil {
+		return x.CheckpointSyncSecs
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetCheckpointTotalSecs() float64 {
+	if x != nil {
+		return x.CheckpointTotalSecs
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuums() int64 {
+	if x != nil {
+		return x.Autovacuums
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumsAntiWraparound() int64 {
+	if x != nil {
+		return x.AutovacuumsAntiWraparound
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumPagesRemoved() int64 {
+	if x != nil {
+		return x.AutovacuumPagesRemoved
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumTuplesDeleted() int64 {
+	if x != nil {
+		return x.AutovacuumTuplesDeleted
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumFrozenPages() int64 {
+	if x != nil {
+		return x.AutovacuumFrozenPages
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumTuplesFrozen() int64 {
+	if x != nil {
+		return x.AutovacuumTuplesFrozen
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumWalBytes() int64 {
+	if x != nil {
+		return x.AutovacuumWalBytes
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetAutovacuumElapsedSecs() float64 {
+	if x != nil {
+		return x.AutovacuumElapsedSecs
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetConnectionsReceived() int64 {
+	if x != nil {
+		return x.ConnectionsReceived
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetConnectionsAuthorized() int64 {
+	if x != nil {
+		return x.ConnectionsAuthorized
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetConnectionsRejected() int64 {
+	if x != nil {
+		return x.ConnectionsRejected
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetDisconnections() int64 {
+	if x != nil {
+		return x.Disconnections
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetSessionSecs() float64 {
+	if x != nil {
+		return x.SessionSecs
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic) GetLogins() []*LogMetricsStatistic_LoginCount {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+type RelationInformation_Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DataType          string                                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DefaultValue      *NullString                            `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	NotNull           bool                                   `protobuf:"varint,5,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
+	Position          int32                                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	Statistics        []*RelationInformation_ColumnStatistic `protobuf:"bytes,7,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	DataTypeCustomIdx *NullInt32                             `protobuf:"bytes,8,opt,name=data_type_custom_idx,json=dataTypeCustomIdx,proto3" json:"data_type_custom_idx,omitempty"`
+}
+
+func (x *RelationInformation_Column) Reset() {
+	*x = RelationInformation_Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationInformation_Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationInformation_Column) ProtoMessage() {}
+
+func (x *RelationInformation_Column) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationInformation_Column.ProtoReflect.Descriptor instead.
+func (*RelationInformation_Column) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *RelationInformation_Column) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelationInformation_Column) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *RelationInformation_Column) GetDefaultValue() *NullString {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *RelationInformation_Column) GetNotNull() bool {
+	if x != nil {
+		return x.NotNull
+	}
+	return false
+}
+
+func (x *RelationInformation_Column) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RelationInformation_Column) GetStatistics() []*RelationInformation_ColumnStatistic {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *RelationInformation_Column) GetDataTypeCustomIdx() *NullInt32 {
+	if x != nil {
+		return x.DataTypeCustomIdx
+	}
+	return nil
+}
+
+type RelationInformation_ColumnStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inherited   bool        `protobuf:"varint,1,opt,name=inherited,proto3" json:"inherited,omitempty"`
+	NullFrac    float64     `protobuf:"fixed64,2,opt,name=null_frac,json=nullFrac,proto3" json:"null_frac,omitempty"`
+	AvgWidth    int32       `protobuf:"varint,3,opt,name=avg_width,json=avgWidth,proto3" json:"avg_width,omitempty"`
+	NDistinct   float64     `protobuf:"fixed64,4,opt,name=n_distinct,json=nDistinct,proto3" json:"n_distinct,omitempty"`
+	Correlation *NullDouble `protobuf:"bytes,5,opt,name=correlation,proto3" json:"correlation,omitempty"`
+}
+
+func (x *RelationInformation_ColumnStatistic) Reset() {
+	*x = RelationInformation_ColumnStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationInformation_ColumnStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationInformation_ColumnStatistic) ProtoMessage() {}
+
+func (x *RelationInformation_ColumnStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationInformation_ColumnStatistic.ProtoReflect.Descriptor instead.
+func (*RelationInformation_ColumnStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 2}
+}
+
+func (x *RelationInformation_ColumnStatistic) GetInherited() bool {
+	if x != nil {
+		return x.Inherited
+	}
+	return false
+}
+
+func (x *RelationInformation_ColumnStatistic) GetNullFrac() float64 {
+	if x != nil {
+		return x.NullFrac
+	}
+	return 0
+}
+
+func (x *RelationInformation_ColumnStatistic) GetAvgWidth() int32 {
+	if x != nil {
+		return x.AvgWidth
+	}
+	return 0
+}
+
+func (x *RelationInformation_ColumnStatistic) GetNDistinct() float64 {
+	if x != nil {
+		return x.NDistinct
+	}
+	return 0
+}
+
+func (x *RelationInformation_ColumnStatistic) GetCorrelation() *NullDouble {
+	if x != nil {
+		return x.Correlation
+	}
+	return nil
+}
+
+type RelationInformation_Constraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForeignRelationIdx int32   `protobuf:"varint,1,opt,name=foreign_relation_idx,json=foreignRelationIdx,proto3" json:"foreign_relation_idx,omitempty"` // If a foreign key, the referenced table
+	Name               string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                          // Constraint name (not necessarily unique!)
+	Type               string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                          // c = check constraint, f = foreign key constraint, p = primary key constraint, u = unique constraint, t = constraint trigger, x = exclusion constraint
+	ConstraintDef      string  `protobuf:"bytes,4,opt,name=constraint_def,json=constraintDef,proto3" json:"constraint_def,omitempty"`                   // Human-readable representation of the expression
+	Columns            []int32 `protobuf:"varint,5,rep,packed,name=columns,proto3" json:"columns,omitempty"`                                            // If a table constraint (including foreign keys, but not constraint triggers), list of the constrained columns
+	ForeignColumns     []int32 `protobuf:"varint,6,rep,packed,name=foreign_columns,json=foreignColumns,proto3" json:"foreign_columns,omitempty"`        // If a foreign key, list of the referenced columns
+	ForeignUpdateType  string  `protobuf:"bytes,7,opt,name=foreign_update_type,json=foreignUpdateType,proto3" json:"foreign_update_type,omitempty"`     // Foreign key update action code: a = no action, r = restrict, c = cascade, n = set null, d = set default
+	ForeignDeleteType  string  `protobuf:"bytes,8,opt,name=foreign_delete_type,json=foreignDeleteType,proto3" json:"foreign_delete_type,omitempty"`     // Foreign key deletion action code: a = no action, r = restrict, c = cascade, n = set null, d = set default
+	ForeignMatchType   string  `protobuf:"bytes,9,opt,name=foreign_match_type,json=foreignMatchType,proto3" json:"foreign_match_type,omitempty"`        // Foreign key match type: f = full, p = partial, s = simple
+}
+
+func (x *RelationInformation_Constraint) Reset() {
+	*x = RelationInformation_Constraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationInformation_Constraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationInformation_Constraint) ProtoMessage() {}
+
+func (x *RelationInformation_Constraint) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationInformation_Constraint.ProtoReflect.Descriptor instead.
+func (*RelationInformation_Constraint) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 3}
+}
+
+func (x *RelationInformation_Constraint) GetForeignRelationIdx() int32 {
+	if x != nil {
+		return x.ForeignRelationIdx
+	}
+	return 0
+}
+
+func (x *RelationInformation_Constraint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelationInformation_Constraint) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RelationInformation_Constraint) GetConstraintDef() string {
+	if x != nil {
+		return x.ConstraintDef
+	}
+	return ""
+}
+
+func (x *RelationInformation_Constraint) GetColumns() []int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *RelationInformation_Constraint) GetForeignColumns() []int32 {
+	if x != nil {
+		return x.ForeignColumns
+	}
+	return nil
+}
+
+func (x *RelationInformation_Constraint) GetForeignUpdateType() string {
+	if x != nil {
+		return x.ForeignUpdateType
+	}
+	return ""
+}
+
+func (x *RelationInformation_Constraint) GetForeignDeleteType() string {
+	if x != nil {
+		return x.ForeignDeleteType
+	}
+	return ""
+}
+
+func (x *RelationInformation_Constraint) GetForeignMatchType() string {
+	if x != nil {
+		return x.ForeignMatchType
+	}
+	return ""
+}
+
+// Extended statistics object on the table (CREATE STATISTICS), Postgres 10+
+type RelationInformation_ExtendedStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaName      string   `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"` // Schema of the statistics object (which may differ from the table's)
+	Name            string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Columns         []int32  `protobuf:"varint,3,rep,packed,name=columns,proto3" json:"columns,omitempty"`                                 // Columns covered by the statistics object (not including expressions)
+	Kinds           []string `protobuf:"bytes,4,rep,name=kinds,proto3" json:"kinds,omitempty"`                                             // d = n-distinct, f = functional dependencies, m = most common values (12+), e = expressions (14+)
+	Definition      string   `protobuf:"bytes,5,opt,name=definition,proto3" json:"definition,omitempty"`                                   // CREATE STATISTICS statement that recreates the object
+	HasNdistinct    bool     `protobuf:"varint,6,opt,name=has_ndistinct,json=hasNdistinct,proto3" json:"has_ndistinct,omitempty"`          // Whether ANALYZE has computed the n-distinct statistics yet
+	HasDependencies bool     `protobuf:"varint,7,opt,name=has_dependencies,json=hasDependencies,proto3" json:"has_dependencies,omitempty"` // Whether ANALYZE has computed the functional dependencies yet
+	HasMcv          bool     `protobuf:"varint,8,opt,name=has_mcv,json=hasMcv,proto3" json:"has_mcv,omitempty"`                            // Whether ANALYZE has computed the most common values yet
+}
+
+func (x *RelationInformation_ExtendedStatistic) Reset() {
+	*x = RelationInformation_ExtendedStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationInformation_ExtendedStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationInformation_ExtendedStatistic) ProtoMessage() {}
+
+func (x *RelationInformation_ExtendedStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationInformation_ExtendedStatistic.ProtoReflect.Descriptor instead.
+func (*RelationInformation_ExtendedStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{15, 4}
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetColumns() []int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetHasNdistinct() bool {
+	if x != nil {
+		return x.HasNdistinct
+	}
+	return false
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetHasDependencies() bool {
+	if x != nil {
+		return x.HasDependencies
+	}
+	return false
+}
+
+func (x *RelationInformation_ExtendedStatistic) GetHasMcv() bool {
+	if x != nil {
+		return x.HasMcv
+	}
+	return false
+}
+
+type CustomTypeInformation_CompositeAttr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *CustomTypeInformation_CompositeAttr) Reset() {
+	*x = CustomTypeInformation_CompositeAttr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomTypeInformation_CompositeAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomTypeInformation_CompositeAttr) ProtoMessage() {}
+
+func (x *CustomTypeInformation_CompositeAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomTypeInformation_CompositeAttr.ProtoReflect.Descriptor instead.
+func (*CustomTypeInformation_CompositeAttr) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *CustomTypeInformation_CompositeAttr) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomTypeInformation_CompositeAttr) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type AlloyDBInformation_ColumnarRelation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationIdx       int32  `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	Status            string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SizeBytes         int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	InvalidBlockCount int64  `protobuf:"varint,4,opt,name=invalid_block_count,json=invalidBlockCount,proto3" json:"invalid_block_count,omitempty"` // -1 if unknown
+	TotalBlockCount   int64  `protobuf:"varint,5,opt,name=total_block_count,json=totalBlockCount,proto3" json:"total_block_count,omitempty"`       // -1 if unknown
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) Reset() {
+	*x = AlloyDBInformation_ColumnarRelation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlloyDBInformation_ColumnarRelation) ProtoMessage() {}
+
+func (x *AlloyDBInformation_ColumnarRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlloyDBInformation_ColumnarRelation.ProtoReflect.Descriptor instead.
+func (*AlloyDBInformation_ColumnarRelation) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) GetInvalidBlockCount() int64 {
+	if x != nil {
+		return x.InvalidBlockCount
+	}
+	return 0
+}
+
+func (x *AlloyDBInformation_ColumnarRelation) GetTotalBlockCount() int64 {
+	if x != nil {
+		return x.TotalBlockCount
+	}
+	return 0
+}
+
+type AlloyDBInformation_ColumnarColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationIdx int32  `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	ColumnName  string `protobuf:"bytes,2,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+}
+
+func (x *AlloyDBInformation_ColumnarColumn) Reset() {
+	*x = AlloyDBInformation_ColumnarColumn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlloyDBInformation_ColumnarColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlloyDBInformation_ColumnarColumn) ProtoMessage() {}
+
+func (x *AlloyDBInformation_ColumnarColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlloyDBInformation_ColumnarColumn.ProtoReflect.Descriptor instead.
+func (*AlloyDBInformation_ColumnarColumn) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *AlloyDBInformation_ColumnarColumn) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *AlloyDBInformation_ColumnarColumn) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+// Coordinator or worker node of the cluster, with the shards placed on it
+type CitusInformation_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId           int32  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	GroupId          int32  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 0 for the coordinator
+	NodeName         string `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	NodePort         int32  `protobuf:"varint,4,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	NodeRole         string `protobuf:"bytes,5,opt,name=node_role,json=nodeRole,proto3" json:"node_role,omitempty"` // "primary" or "secondary"
+	IsActive         bool   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ShouldHaveShards bool   `protobuf:"varint,7,opt,name=should_have_shards,json=shouldHaveShards,proto3" json:"should_have_shards,omitempty"`
+	ShardCount       int64  `protobuf:"varint,8,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	ShardSizeBytes   int64  `protobuf:"varint,9,opt,name=shard_size_bytes,json=shardSizeBytes,proto3" json:"shard_size_bytes,omitempty"` // Only available on Citus 10 and newer (0 otherwise)
+}
+
+func (x *CitusInformation_Node) Reset() {
+	*x = CitusInformation_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitusInformation_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitusInformation_Node) ProtoMessage() {}
+
+func (x *CitusInformation_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitusInformation_Node.ProtoReflect.Descriptor instead.
+func (*CitusInformation_Node) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *CitusInformation_Node) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *CitusInformation_Node) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CitusInformation_Node) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CitusInformation_Node) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (x *CitusInformation_Node) GetNodeRole() string {
+	if x != nil {
+		return x.NodeRole
+	}
+	return ""
+}
+
+func (x *CitusInformation_Node) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CitusInformation_Node) GetShouldHaveShards() bool {
+	if x != nil {
+		return x.ShouldHaveShards
+	}
+	return false
+}
+
+func (x *CitusInformation_Node) GetShardCount() int64 {
+	if x != nil {
+		return x.ShardCount
+	}
+	return 0
+}
+
+func (x *CitusInformation_Node) GetShardSizeBytes() int64 {
+	if x != nil {
+		return x.ShardSizeBytes
+	}
+	return 0
+}
+
+// Distributed or reference table (only available on Citus 10 and newer)
+type CitusInformation_DistributedTable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationIdx           int32  `protobuf:"varint,1,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	TableType             string `protobuf:"bytes,2,opt,name=table_type,json=tableType,proto3" json:"table_type,omitempty"` // "distributed", "reference" or "local"
+	HasDistributionColumn bool   `protobuf:"varint,3,opt,name=has_distribution_column,json=hasDistributionColumn,proto3" json:"has_distribution_column,omitempty"`
+	DistributionColumn    string `protobuf:"bytes,4,opt,name=distribution_column,json=distributionColumn,proto3" json:"distribution_column,omitempty"`
+	ColocationId          int32  `protobuf:"varint,5,opt,name=colocation_id,json=colocationId,proto3" json:"colocation_id,omitempty"`
+	ShardCount            int64  `protobuf:"varint,6,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	TableSizeBytes        int64  `protobuf:"varint,7,opt,name=table_size_bytes,json=tableSizeBytes,proto3" json:"table_size_bytes,omitempty"`
+}
+
+func (x *CitusInformation_DistributedTable) Reset() {
+	*x = CitusInformation_DistributedTable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitusInformation_DistributedTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitusInformation_DistributedTable) ProtoMessage() {}
+
+func (x *CitusInformation_DistributedTable) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitusInformation_DistributedTable.ProtoReflect.Descriptor instead.
+func (*CitusInformation_DistributedTable) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *CitusInformation_DistributedTable) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedTable) GetTableType() string {
+	if x != nil {
+		return x.TableType
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedTable) GetHasDistributionColumn() bool {
+	if x != nil {
+		return x.HasDistributionColumn
+	}
+	return false
+}
+
+func (x *CitusInformation_DistributedTable) GetDistributionColumn() string {
+	if x != nil {
+		return x.DistributionColumn
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedTable) GetColocationId() int32 {
+	if x != nil {
+		return x.ColocationId
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedTable) GetShardCount() int64 {
+	if x != nil {
+		return x.ShardCount
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedTable) GetTableSizeBytes() int64 {
+	if x != nil {
+		return x.TableSizeBytes
+	}
+	return 0
+}
+
+// Backend running a distributed query, on any node of the cluster
+type CitusInformation_DistributedBackend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeName        string               `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	NodePort        int32                `protobuf:"varint,2,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	Pid             int32                `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	GlobalPid       int64                `protobuf:"varint,4,opt,name=global_pid,json=globalPid,proto3" json:"global_pid,omitempty"` // Only available on Citus 11.1 and newer (0 otherwise)
+	HasRoleIdx      bool                 `protobuf:"varint,5,opt,name=has_role_idx,json=hasRoleIdx,proto3" json:"has_role_idx,omitempty"`
+	RoleIdx         int32                `protobuf:"varint,6,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	HasDatabaseIdx  bool                 `protobuf:"varint,7,opt,name=has_database_idx,json=hasDatabaseIdx,proto3" json:"has_database_idx,omitempty"`
+	DatabaseIdx     int32                `protobuf:"varint,8,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	ApplicationName string               `protobuf:"bytes,9,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	State           string               `protobuf:"bytes,10,opt,name=state,proto3" json:"state,omitempty"`
+	QueryStart      *timestamp.Timestamp `protobuf:"bytes,11,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
+	WaitEventType   string               `protobuf:"bytes,12,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
+	WaitEvent       string               `protobuf:"bytes,13,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+}
+
+func (x *CitusInformation_DistributedBackend) Reset() {
+	*x = CitusInformation_DistributedBackend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitusInformation_DistributedBackend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitusInformation_DistributedBackend) ProtoMessage() {}
+
+func (x *CitusInformation_DistributedBackend) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitusInformation_DistributedBackend.ProtoReflect.Descriptor instead.
+func (*CitusInformation_DistributedBackend) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25, 2}
+}
+
+func (x *CitusInformation_DistributedBackend) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedBackend) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedBackend) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedBackend) GetGlobalPid() int64 {
+	if x != nil {
+		return x.GlobalPid
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedBackend) GetHasRoleIdx() bool {
+	if x != nil {
+		return x.HasRoleIdx
+	}
+	return false
+}
+
+func (x *CitusInformation_DistributedBackend) GetRoleIdx() int32 {
+	if x != nil {
+		return x.RoleIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedBackend) GetHasDatabaseIdx() bool {
+	if x != nil {
+		return x.HasDatabaseIdx
+	}
+	return false
+}
+
+func (x *CitusInformation_DistributedBackend) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedBackend) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedBackend) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedBackend) GetQueryStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.QueryStart
+	}
+	return nil
+}
+
+func (x *CitusInformation_DistributedBackend) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedBackend) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+// Execution statistics of a query planned by Citus (requires citus.stat_statements_track = 'all')
+type CitusInformation_DistributedStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId         int64  `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"` // Matches the query ID of the pg_stat_statements entry on the coordinator
+	RoleIdx         int32  `protobuf:"varint,2,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	DatabaseIdx     int32  `protobuf:"varint,3,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	Executor        string `protobuf:"bytes,4,opt,name=executor,proto3" json:"executor,omitempty"` // e.g. "adaptive" or "insert-select"
+	HasPartitionKey bool   `protobuf:"varint,5,opt,name=has_partition_key,json=hasPartitionKey,proto3" json:"has_partition_key,omitempty"`
+	PartitionKey    string `protobuf:"bytes,6,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"` // Distribution column value, for queries routed to a single shard
+	Calls           int64  `protobuf:"varint,7,opt,name=calls,proto3" json:"calls,omitempty"`                                  // Cumulative since citus_stat_statements was last reset
+}
+
+func (x *CitusInformation_DistributedStatement) Reset() {
+	*x = CitusInformation_DistributedStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitusInformation_DistributedStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitusInformation_DistributedStatement) ProtoMessage() {}
+
+func (x *CitusInformation_DistributedStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitusInformation_DistributedStatement.ProtoReflect.Descriptor instead.
+func (*CitusInformation_DistributedStatement) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25, 3}
+}
+
+func (x *CitusInformation_DistributedStatement) GetQueryId() int64 {
+	if x != nil {
+		return x.QueryId
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedStatement) GetRoleIdx() int32 {
+	if x != nil {
+		return x.RoleIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedStatement) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_DistributedStatement) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedStatement) GetHasPartitionKey() bool {
+	if x != nil {
+		return x.HasPartitionKey
+	}
+	return false
+}
+
+func (x *CitusInformation_DistributedStatement) GetPartitionKey() string {
+	if x != nil {
+		return x.PartitionKey
+	}
+	return ""
+}
+
+func (x *CitusInformation_DistributedStatement) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+// Placement of a shard on a node (only the largest shards are collected)
+type CitusInformation_ShardPlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardId     int64  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	RelationIdx int32  `protobuf:"varint,2,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	NodeName    string `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	NodePort    int32  `protobuf:"varint,4,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	SizeBytes   int64  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"` // Only available on Citus 10 and newer (0 otherwise)
+}
+
+func (x *CitusInformation_ShardPlacement) Reset() {
+	*x = CitusInformation_ShardPlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitusInformation_ShardPlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitusInformation_ShardPlacement) ProtoMessage() {}
+
+func (x *CitusInformation_ShardPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitusInformation_ShardPlacement.ProtoReflect.Descriptor instead.
+func (*CitusInformation_ShardPlacement) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25, 4}
+}
+
+func (x *CitusInformation_ShardPlacement) GetShardId() int64 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *CitusInformation_ShardPlacement) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_ShardPlacement) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CitusInformation_ShardPlacement) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (x *CitusInformation_ShardPlacement) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// Shard move of a running rebalance
+type CitusInformation_RebalanceMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId   int32  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RelationIdx int32  `protobuf:"varint,2,opt,name=relation_idx,json=relationIdx,proto3" json:"relation_idx,omitempty"`
+	ShardId     int64  `protobuf:"varint,3,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SizeBytes   int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	SourceName  string `protobuf:"bytes,5,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourcePort  int32  `protobuf:"varint,6,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	TargetName  string `protobuf:"bytes,7,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	TargetPort  int32  `protobuf:"varint,8,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	Progress    int32  `protobuf:"varint,9,opt,name=progress,proto3" json:"progress,omitempty"` // 0 = waiting, 1 = moving, 2 = moved
+}
+
+func (x *CitusInformation_RebalanceMove) Reset() {
+	*x = CitusInformation_RebalanceMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitusInformation_RebalanceMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitusInformation_RebalanceMove) ProtoMessage() {}
+
+func (x *CitusInformation_RebalanceMove) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitusInformation_RebalanceMove.ProtoReflect.Descriptor instead.
+func (*CitusInformation_RebalanceMove) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{25, 5}
+}
+
+func (x *CitusInformation_RebalanceMove) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *CitusInformation_RebalanceMove) GetRelationIdx() int32 {
+	if x != nil {
+		return x.RelationIdx
+	}
+	return 0
+}
+
+func (x *CitusInformation_RebalanceMove) GetShardId() int64 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *CitusInformation_RebalanceMove) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CitusInformation_RebalanceMove) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *CitusInformation_RebalanceMove) GetSourcePort() int32 {
+	if x != nil {
+		return x.SourcePort
+	}
+	return 0
+}
+
+func (x *CitusInformation_RebalanceMove) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *CitusInformation_RebalanceMove) GetTargetPort() int32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
+func (x *CitusInformation_RebalanceMove) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type PatroniInformation_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role     string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`   // "leader", "standby_leader", "sync_standby" or "replica"
+	State    string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // e.g. "running", "streaming" or "starting"
+	Host     string `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Timeline int64  `protobuf:"varint,6,opt,name=timeline,proto3" json:"timeline,omitempty"`                 // 0 if unknown
+	LagBytes int64  `protobuf:"varint,7,opt,name=lag_bytes,json=lagBytes,proto3" json:"lag_bytes,omitempty"` // Replication lag behind the leader, -1 if unknown or not a replica
+}
+
+func (x *PatroniInformation_Member) Reset() {
+	*x = PatroniInformation_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatroniInformation_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatroniInformation_Member) ProtoMessage() {}
+
+func (x *PatroniInformation_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatroniInformation_Member.ProtoReflect.Descriptor instead.
+func (*PatroniInformation_Member) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *PatroniInformation_Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PatroniInformation_Member) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PatroniInformation_Member) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PatroniInformation_Member) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PatroniInformation_Member) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PatroniInformation_Member) GetTimeline() int64 {
+	if x != nil {
+		return x.Timeline
+	}
+	return 0
+}
+
+func (x *PatroniInformation_Member) GetLagBytes() int64 {
+	if x != nil {
+		return x.LagBytes
+	}
+	return 0
+}
+
+// Promotion of a new leader, which started a new timeline
+type PatroniInformation_TimelineChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timeline  int64                `protobuf:"varint,1,opt,name=timeline,proto3" json:"timeline,omitempty"` // Timeline that ended with the change
+	Lsn       int64                `protobuf:"varint,2,opt,name=lsn,proto3" json:"lsn,omitempty"`           // Position at which the timeline ended
+	Reason    string               `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	NewLeader string               `protobuf:"bytes,5,opt,name=new_leader,json=newLeader,proto3" json:"new_leader,omitempty"` // Only reported by Patroni 2.0 and newer
+}
+
+func (x *PatroniInformation_TimelineChange) Reset() {
+	*x = PatroniInformation_TimelineChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatroniInformation_TimelineChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatroniInformation_TimelineChange) ProtoMessage() {}
+
+func (x *PatroniInformation_TimelineChange) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatroniInformation_TimelineChange.ProtoReflect.Descriptor instead.
+func (*PatroniInformation_TimelineChange) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *PatroniInformation_TimelineChange) GetTimeline() int64 {
+	if x != nil {
+		return x.Timeline
+	}
+	return 0
+}
+
+func (x *PatroniInformation_TimelineChange) GetLsn() int64 {
+	if x != nil {
+		return x.Lsn
+	}
+	return 0
+}
+
+func (x *PatroniInformation_TimelineChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PatroniInformation_TimelineChange) GetChangedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *PatroniInformation_TimelineChange) GetNewLeader() string {
+	if x != nil {
+		return x.NewLeader
+	}
+	return ""
+}
+
+// Node registered with the monitor
+type PgAutoFailoverInformation_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId            int64                `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	GroupId           int32                `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	NodeName          string               `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	NodeHost          string               `protobuf:"bytes,4,opt,name=node_host,json=nodeHost,proto3" json:"node_host,omitempty"`
+	NodePort          int32                `protobuf:"varint,5,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	ReportedState     string               `protobuf:"bytes,6,opt,name=reported_state,json=reportedState,proto3" json:"reported_state,omitempty"` // e.g. "primary", "secondary", "wait_primary" or "catchingup"
+	GoalState         string               `protobuf:"bytes,7,opt,name=goal_state,json=goalState,proto3" json:"goal_state,omitempty"`             // Differs from the reported state during transitions
+	ReportedRepState  string               `protobuf:"bytes,8,opt,name=reported_rep_state,json=reportedRepState,proto3" json:"reported_rep_state,omitempty"`
+	ReportTime        *timestamp.Timestamp `protobuf:"bytes,9,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
+	StateChangeTime   *timestamp.Timestamp `protobuf:"bytes,10,opt,name=state_change_time,json=stateChangeTime,proto3" json:"state_change_time,omitempty"`
+	Health            int32                `protobuf:"varint,11,opt,name=health,proto3" json:"health,omitempty"` // -1 = unknown, 0 = bad, 1 = good
+	CandidatePriority int32                `protobuf:"varint,12,opt,name=candidate_priority,json=candidatePriority,proto3" json:"candidate_priority,omitempty"`
+	ReplicationQuorum bool                 `protobuf:"varint,13,opt,name=replication_quorum,json=replicationQuorum,proto3" json:"replication_quorum,omitempty"`
+}
+
+func (x *PgAutoFailoverInformation_Node) Reset() {
+	*x = PgAutoFailoverInformation_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgAutoFailoverInformation_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgAutoFailoverInformation_Node) ProtoMessage() {}
+
+func (x *PgAutoFailoverInformation_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgAutoFailoverInformation_Node.ProtoReflect.Descriptor instead.
+func (*PgAutoFailoverInformation_Node) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *PgAutoFailoverInformation_Node) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *PgAutoFailoverInformation_Node) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *PgAutoFailoverInformation_Node) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Node) GetNodeHost() string {
+	if x != nil {
+		return x.NodeHost
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Node) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (x *PgAutoFailoverInformation_Node) GetReportedState() string {
+	if x != nil {
+		return x.ReportedState
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Node) GetGoalState() string {
+	if x != nil {
+		return x.GoalState
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Node) GetReportedRepState() string {
+	if x != nil {
+		return x.ReportedRepState
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Node) GetReportTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReportTime
+	}
+	return nil
+}
+
+func (x *PgAutoFailoverInformation_Node) GetStateChangeTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StateChangeTime
+	}
+	return nil
+}
+
+func (x *PgAutoFailoverInformation_Node) GetHealth() int32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *PgAutoFailoverInformation_Node) GetCandidatePriority() int32 {
+	if x != nil {
+		return x.CandidatePriority
+	}
+	return 0
+}
+
+func (x *PgAutoFailoverInformation_Node) GetReplicationQuorum() bool {
+	if x != nil {
+		return x.ReplicationQuorum
+	}
+	return false
+}
+
+// State change of the server's node, as recorded in the monitor's event table
+type PgAutoFailoverInformation_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId       int64                `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventTime     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	ReportedState string               `protobuf:"bytes,3,opt,name=reported_state,json=reportedState,proto3" json:"reported_state,omitempty"`
+	GoalState     string               `protobuf:"bytes,4,opt,name=goal_state,json=goalState,proto3" json:"goal_state,omitempty"`
+	Description   string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *PgAutoFailoverInformation_Event) Reset() {
+	*x = PgAutoFailoverInformation_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgAutoFailoverInformation_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgAutoFailoverInformation_Event) ProtoMessage() {}
+
+func (x *PgAutoFailoverInformation_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgAutoFailoverInformation_Event.ProtoReflect.Descriptor instead.
+func (*PgAutoFailoverInformation_Event) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *PgAutoFailoverInformation_Event) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *PgAutoFailoverInformation_Event) GetEventTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *PgAutoFailoverInformation_Event) GetReportedState() string {
+	if x != nil {
+		return x.ReportedState
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Event) GetGoalState() string {
+	if x != nil {
+		return x.GoalState
+	}
+	return ""
+}
+
+func (x *PgAutoFailoverInformation_Event) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Activity of a database since the last snapshot (only sent on follow-up runs)
+type PgBouncerInformation_DatabaseStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Database      string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	XactCount     int64  `protobuf:"varint,2,opt,name=xact_count,json=xactCount,proto3" json:"xact_count,omitempty"`
+	QueryCount    int64  `protobuf:"varint,3,opt,name=query_count,json=queryCount,proto3" json:"query_count,omitempty"`
+	ReceivedBytes int64  `protobuf:"varint,4,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+	SentBytes     int64  `protobuf:"varint,5,opt,name=sent_bytes,json=sentBytes,proto3" json:"sent_bytes,omitempty"`
+	XactTimeUs    int64  `protobuf:"varint,6,opt,name=xact_time_us,json=xactTimeUs,proto3" json:"xact_time_us,omitempty"`
+	QueryTimeUs   int64  `protobuf:"varint,7,opt,name=query_time_us,json=queryTimeUs,proto3" json:"query_time_us,omitempty"`
+	WaitTimeUs    int64  `protobuf:"varint,8,opt,name=wait_time_us,json=waitTimeUs,proto3" json:"wait_time_us,omitempty"` // Time clients waited for a server connection
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) Reset() {
+	*x = PgBouncerInformation_DatabaseStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgBouncerInformation_DatabaseStatistic) ProtoMessage() {}
+
+func (x *PgBouncerInformation_DatabaseStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgBouncerInformation_DatabaseStatistic.ProtoReflect.Descriptor instead.
+func (*PgBouncerInformation_DatabaseStatistic) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetXactCount() int64 {
+	if x != nil {
+		return x.XactCount
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetQueryCount() int64 {
+	if x != nil {
+		return x.QueryCount
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetReceivedBytes() int64 {
+	if x != nil {
+		return x.ReceivedBytes
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetSentBytes() int64 {
+	if x != nil {
+		return x.SentBytes
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetXactTimeUs() int64 {
+	if x != nil {
+		return x.XactTimeUs
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetQueryTimeUs() int64 {
+	if x != nil {
+		return x.QueryTimeUs
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_DatabaseStatistic) GetWaitTimeUs() int64 {
+	if x != nil {
+		return x.WaitTimeUs
+	}
+	return 0
+}
+
+// Current client and server connections of a pool (database and user pair)
+type PgBouncerInformation_Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Database  string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	User      string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	PoolMode  string `protobuf:"bytes,3,opt,name=pool_mode,json=poolMode,proto3" json:"pool_mode,omitempty"` // "session", "transaction" or "statement"
+	ClActive  int64  `protobuf:"varint,4,opt,name=cl_active,json=clActive,proto3" json:"cl_active,omitempty"`
+	ClWaiting int64  `protobuf:"varint,5,opt,name=cl_waiting,json=clWaiting,proto3" json:"cl_waiting,omitempty"`
+	SvActive  int64  `protobuf:"varint,6,opt,name=sv_active,json=svActive,proto3" json:"sv_active,omitempty"`
+	SvIdle    int64  `protobuf:"varint,7,opt,name=sv_idle,json=svIdle,proto3" json:"sv_idle,omitempty"`
+	SvUsed    int64  `protobuf:"varint,8,opt,name=sv_used,json=svUsed,proto3" json:"sv_used,omitempty"`
+	SvTested  int64  `protobuf:"varint,9,opt,name=sv_tested,json=svTested,proto3" json:"sv_tested,omitempty"`
+	SvLogin   int64  `protobuf:"varint,10,opt,name=sv_login,json=svLogin,proto3" json:"sv_login,omitempty"`
+	MaxWaitUs int64  `protobuf:"varint,11,opt,name=max_wait_us,json=maxWaitUs,proto3" json:"max_wait_us,omitempty"` // How long the oldest waiting client has been waiting
+}
+
+func (x *PgBouncerInformation_Pool) Reset() {
+	*x = PgBouncerInformation_Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgBouncerInformation_Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgBouncerInformation_Pool) ProtoMessage() {}
+
+func (x *PgBouncerInformation_Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgBouncerInformation_Pool.ProtoReflect.Descriptor instead.
+func (*PgBouncerInformation_Pool) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *PgBouncerInformation_Pool) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_Pool) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_Pool) GetPoolMode() string {
+	if x != nil {
+		return x.PoolMode
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_Pool) GetClActive() int64 {
+	if x != nil {
+		return x.ClActive
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetClWaiting() int64 {
+	if x != nil {
+		return x.ClWaiting
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetSvActive() int64 {
+	if x != nil {
+		return x.SvActive
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetSvIdle() int64 {
+	if x != nil {
+		return x.SvIdle
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetSvUsed() int64 {
+	if x != nil {
+		return x.SvUsed
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetSvTested() int64 {
+	if x != nil {
+		return x.SvTested
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetSvLogin() int64 {
+	if x != nil {
+		return x.SvLogin
+	}
+	return 0
+}
+
+func (x *PgBouncerInformation_Pool) GetMaxWaitUs() int64 {
+	if x != nil {
+		return x.MaxWaitUs
+	}
+	return 0
+}
+
+// Number of client connections in a state, from SHOW CLIENTS
+type PgBouncerInformation_ClientCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	User     string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	State    string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // e.g. "active" or "waiting"
+	Count    int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PgBouncerInformation_ClientCount) Reset() {
+	*x = PgBouncerInformation_ClientCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgBouncerInformation_ClientCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgBouncerInformation_ClientCount) ProtoMessage() {}
+
+func (x *PgBouncerInformation_ClientCount) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgBouncerInformation_ClientCount.ProtoReflect.Descriptor instead.
+func (*PgBouncerInformation_ClientCount) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{30, 2}
+}
+
+func (x *PgBouncerInformation_ClientCount) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_ClientCount) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_ClientCount) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_ClientCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Item of SHOW LISTS
+type PgBouncerInformation_ListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"` // e.g. "used_clients" or "free_servers"
+	Items int64  `protobuf:"varint,2,opt,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *PgBouncerInformation_ListItem) Reset() {
+	*x = PgBouncerInformation_ListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgBouncerInformation_ListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgBouncerInformation_ListItem) ProtoMessage() {}
+
+func (x *PgBouncerInformation_ListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgBouncerInformation_ListItem.ProtoReflect.Descriptor instead.
+func (*PgBouncerInformation_ListItem) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{30, 3}
+}
+
+func (x *PgBouncerInformation_ListItem) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *PgBouncerInformation_ListItem) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+// Backend node, as reported by SHOW POOL_NODES
+type PgpoolInformation_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId           int32   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Hostname         string  `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Port             int32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Status           string  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "up", "down", "waiting" or "unused"
+	LbWeight         float64 `protobuf:"fixed64,5,opt,name=lb_weight,json=lbWeight,proto3" json:"lb_weight,omitempty"`
+	Role             string  `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`                                   // "primary" or "standby"
+	SelectCount      int64   `protobuf:"varint,7,opt,name=select_count,json=selectCount,proto3" json:"select_count,omitempty"` // SELECT queries load balanced to the node since Pgpool-II started
+	LoadBalanceNode  bool    `protobuf:"varint,8,opt,name=load_balance_node,json=loadBalanceNode,proto3" json:"load_balance_node,omitempty"`
+	ReplicationDelay int64   `protobuf:"varint,9,opt,name=replication_delay,json=replicationDelay,proto3" json:"replication_delay,omitempty"` // Bytes (or seconds, with delay_threshold_by_time)
+	ReplicationState string  `protobuf:"bytes,10,opt,name=replication_state,json=replicationState,proto3" json:"replication_state,omitempty"` // Only reported by Pgpool-II 4.1 and newer
+	LastStatusChange string  `protobuf:"bytes,11,opt,name=last_status_change,json=lastStatusChange,proto3" json:"last_status_change,omitempty"`
+}
+
+func (x *PgpoolInformation_Node) Reset() {
+	*x = PgpoolInformation_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgpoolInformation_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgpoolInformation_Node) ProtoMessage() {}
+
+func (x *PgpoolInformation_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgpoolInformation_Node.ProtoReflect.Descriptor instead.
+func (*PgpoolInformation_Node) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *PgpoolInformation_Node) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_Node) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PgpoolInformation_Node) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_Node) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PgpoolInformation_Node) GetLbWeight() float64 {
+	if x != nil {
+		return x.LbWeight
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_Node) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PgpoolInformation_Node) GetSelectCount() int64 {
+	if x != nil {
+		return x.SelectCount
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_Node) GetLoadBalanceNode() bool {
+	if x != nil {
+		return x.LoadBalanceNode
+	}
+	return false
+}
+
+func (x *PgpoolInformation_Node) GetReplicationDelay() int64 {
+	if x != nil {
+		return x.ReplicationDelay
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_Node) GetReplicationState() string {
+	if x != nil {
+		return x.ReplicationState
+	}
+	return ""
+}
+
+func (x *PgpoolInformation_Node) GetLastStatusChange() string {
+	if x != nil {
+		return x.LastStatusChange
+	}
+	return ""
+}
+
+// Number of child processes connected to a database as a user
+type PgpoolInformation_ProcessCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Count    int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PgpoolInformation_ProcessCount) Reset() {
+	*x = PgpoolInformation_ProcessCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgpoolInformation_ProcessCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgpoolInformation_ProcessCount) ProtoMessage() {}
+
+func (x *PgpoolInformation_ProcessCount) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgpoolInformation_ProcessCount.ProtoReflect.Descriptor instead.
+func (*PgpoolInformation_ProcessCount) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *PgpoolInformation_ProcessCount) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *PgpoolInformation_ProcessCount) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PgpoolInformation_ProcessCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Statistics of the in-memory query cache, from SHOW POOL_CACHE
+type PgpoolInformation_QueryCache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumCacheHits         int64   `protobuf:"varint,1,opt,name=num_cache_hits,json=numCacheHits,proto3" json:"num_cache_hits,omitempty"`
+	NumSelects           int64   `protobuf:"varint,2,opt,name=num_selects,json=numSelects,proto3" json:"num_selects,omitempty"`
+	CacheHitRatio        float64 `protobuf:"fixed64,3,opt,name=cache_hit_ratio,json=cacheHitRatio,proto3" json:"cache_hit_ratio,omitempty"`
+	NumHashEntries       int64   `protobuf:"varint,4,opt,name=num_hash_entries,json=numHashEntries,proto3" json:"num_hash_entries,omitempty"`
+	UsedHashEntries      int64   `protobuf:"varint,5,opt,name=used_hash_entries,json=usedHashEntries,proto3" json:"used_hash_entries,omitempty"`
+	NumCacheEntries      int64   `protobuf:"varint,6,opt,name=num_cache_entries,json=numCacheEntries,proto3" json:"num_cache_entries,omitempty"`
+	UsedCacheEntriesSize int64   `protobuf:"varint,7,opt,name=used_cache_entries_size,json=usedCacheEntriesSize,proto3" json:"used_cache_entries_size,omitempty"`
+	FreeCacheEntriesSize int64   `protobuf:"varint,8,opt,name=free_cache_entries_size,json=freeCacheEntriesSize,proto3" json:"free_cache_entries_size,omitempty"`
+}
+
+func (x *PgpoolInformation_QueryCache) Reset() {
+	*x = PgpoolInformation_QueryCache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PgpoolInformation_QueryCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PgpoolInformation_QueryCache) ProtoMessage() {}
+
+func (x *PgpoolInformation_QueryCache) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PgpoolInformation_QueryCache.ProtoReflect.Descriptor instead.
+func (*PgpoolInformation_QueryCache) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{31, 2}
+}
+
+func (x *PgpoolInformation_QueryCache) GetNumCacheHits() int64 {
+	if x != nil {
+		return x.NumCacheHits
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetNumSelects() int64 {
+	if x != nil {
+		return x.NumSelects
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetCacheHitRatio() float64 {
+	if x != nil {
+		return x.CacheHitRatio
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetNumHashEntries() int64 {
+	if x != nil {
+		return x.NumHashEntries
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetUsedHashEntries() int64 {
+	if x != nil {
+		return x.UsedHashEntries
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetNumCacheEntries() int64 {
+	if x != nil {
+		return x.NumCacheEntries
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetUsedCacheEntriesSize() int64 {
+	if x != nil {
+		return x.UsedCacheEntriesSize
+	}
+	return 0
+}
+
+func (x *PgpoolInformation_QueryCache) GetFreeCacheEntriesSize() int64 {
+	if x != nil {
+		return x.FreeCacheEntriesSize
+	}
+	return 0
+}
+
+// Connections authorized for a database and role
+type LogMetricsStatistic_LoginCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseIdx int32 `protobuf:"varint,1,opt,name=database_idx,json=databaseIdx,proto3" json:"database_idx,omitempty"`
+	RoleIdx     int32 `protobuf:"varint,2,opt,name=role_idx,json=roleIdx,proto3" json:"role_idx,omitempty"`
+	Count       int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *LogMetricsStatistic_LoginCount) Reset() {
+	*x = LogMetricsStatistic_LoginCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_full_snapshot_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMetricsStatistic_LoginCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMetricsStatistic_LoginCount) ProtoMessage() {}
+
+func (x *LogMetricsStatistic_LoginCount) ProtoReflect() protoreflect.Message {
+	mi := &file_full_snapshot_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMetricsStatistic_LoginCount.ProtoReflect.Descriptor instead.
+func (*LogMetricsStatistic_LoginCount) Descriptor() ([]byte, []int) {
+	return file_full_snapshot_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *LogMetricsStatistic_LoginCount) GetDatabaseIdx() int32 {
+	if x != nil {
+		return x.DatabaseIdx
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic_LoginCount) GetRoleIdx() int32 {
+	if x != nil {
+		return x.RoleIdx
+	}
+	return 0
+}
+
+func (x *LogMetricsStatistic_LoginCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_full_snapshot_proto protoreflect.FileDescriptor
+
+var file_full_snapshot_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x28, 0x0a, 0x0c, 0x46, 0x75,
+	0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6a, 0x6f, 0x72,
 	0x12, 0x34, 0x0a, 0x16, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x14, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
@@ -4180,431 +8166,561 @@ var file_full_snapshot_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
 	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x63, 0x75, 0x73, 0x74, 0x6f,
 	0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x4a, 0x04, 0x08, 0x78, 0x10, 0x79, 0x4a, 0x04, 0x08, 0x79, 0x10, 0x7a, 0x4a, 0x06, 0x08,
-	0xde, 0x01, 0x10, 0xdf, 0x01, 0x4a, 0x06, 0x08, 0xe2, 0x01, 0x10, 0xe3, 0x01, 0x22, 0xc6, 0x02,
-	0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x65,
-	0x61, 0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x48, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x61,
-	0x70, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x48, 0x65, 0x61, 0x70, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x73, 0x73,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
-	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
-	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x67, 0x6f,
-	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x67,
-	0x6f, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xb0, 0x03, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f,
-	0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x64, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x72,
-	0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73,
-	0x52, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x54,
-	0x0a, 0x14, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
+	0x73, 0x12, 0x59, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x79, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x79, 0x44, 0x42, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x79, 0x64,
+	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x11,
+	0x63, 0x69, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43,
+	0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x10, 0x63, 0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x69, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0xe6, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x48, 0x79, 0x70, 0x65, 0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x48, 0x79, 0x70, 0x65, 0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a,
+	0x1f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0xe7, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x6f, 0x75,
+	0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x6f, 0x75, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x69, 0x18,
+	0x8e, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x74,
+	0x72, 0x6f, 0x6e, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x69, 0x12, 0x59, 0x0a, 0x10, 0x70, 0x67, 0x5f, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x8f, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x41, 0x75, 0x74, 0x6f,
+	0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x67, 0x41, 0x75, 0x74, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x09, 0x70, 0x67, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72,
+	0x18, 0x90, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67,
+	0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x70, 0x67, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x06, 0x70, 0x67, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x91, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x67, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x5c,
+	0x0a, 0x15, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0xd7, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x17,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd8, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x5c, 0x0a, 0x15, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0xd9, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x45,
+	0x0a, 0x0d, 0x69, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x7d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x4f, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x6c, 0x0a, 0x1b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x18, 0xda, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x18, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x73, 0x6c, 0x72, 0x75, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x7e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
 	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x12, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55,
-	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x22, 0xb8, 0x03, 0x0a, 0x13, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x49, 0x64, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x6f,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x12, 0x15, 0x0a, 0x06, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x74, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x78, 0x69, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x58, 0x69, 0x64, 0x12,
-	0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x78, 0x61, 0x63, 0x74, 0x5f, 0x78, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
-	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74,
-	0x58, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x22, 0xb6, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x75, 0x6e, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75,
-	0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x3e,
-	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40,
-	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x37, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x6f, 0x72, 0x2e, 0x53, 0x4c, 0x52, 0x55, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x0e, 0x73, 0x6c, 0x72, 0x75, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x46, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x41,
+	0x4c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0c, 0x77, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x69, 0x0a, 0x1a, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0xdb, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x17, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x6c, 0x6f, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x80, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x13, 0x6c,
+	0x6f, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x4a, 0x04, 0x08, 0x78, 0x10, 0x79, 0x4a, 0x04, 0x08, 0x79, 0x10, 0x7a, 0x4a, 0x06,
+	0x08, 0xde, 0x01, 0x10, 0xdf, 0x01, 0x4a, 0x06, 0x08, 0xe2, 0x01, 0x10, 0xe3, 0x01, 0x22, 0xa3,
+	0x03, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x68,
+	0x65, 0x61, 0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x48, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x65,
+	0x61, 0x70, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x48, 0x65, 0x61, 0x70, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x73,
+	0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x67,
+	0x6f, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
+	0x67, 0x6f, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x5b, 0x0a, 0x15, 0x67, 0x63, 0x70, 0x5f, 0x70,
+	0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x63, 0x70,
+	0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x13, 0x67, 0x63, 0x70, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x22, 0xb0, 0x03, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x62,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x62,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x72, 0x6c, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x52, 0x6c,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x14,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x22, 0xb8, 0x03, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x78, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x58, 0x69, 0x64, 0x12, 0x32, 0x0a,
+	0x15, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61,
+	0x63, 0x74, 0x5f, 0x78, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74, 0x58, 0x69,
+	0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xfc, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x0a,
+	0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
 	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x67, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x80, 0x05,
-	0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x32,
-	0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x78, 0x6c, 0x6f, 0x67, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x58, 0x6c, 0x6f, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6e,
-	0x64, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61,
-	0x6e, 0x64, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x13, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
-	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a,
-	0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c,
-	0x61, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f,
-	0x6c, 0x61, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79,
-	0x42, 0x79, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x72,
-	0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30,
-	0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65,
-	0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x41, 0x67, 0x65,
-	0x22, 0x33, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
-	0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
-	0x70, 0x6c, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x74,
-	0x65, 0x4c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x22, 0x8b, 0x06, 0x0a, 0x15, 0x42,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
-	0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52,
-	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64,
-	0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x4d,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e,
-	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x59, 0x0a,
-	0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x61, 0x69, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x4c, 0x6f,
-	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x4c, 0x45,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x49,
-	0x44, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
-	0x46, 0x41, 0x53, 0x54, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41,
-	0x42, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f,
-	0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f,
-	0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x41, 0x43, 0x4b,
-	0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x10, 0x03, 0x12,
-	0x15, 0x0a, 0x11, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54,
-	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x41, 0x4c,
-	0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x41,
-	0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x41, 0x4c,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x0a, 0x22, 0x29, 0x0a, 0x13, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
-	0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x49, 0x64, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x10, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xe4, 0x10, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12,
-	0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f,
-	0x67, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x62, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
-	0x62, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x62,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x73, 0x73, 0x6c, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x62, 0x53, 0x73, 0x6c, 0x6d,
-	0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x62, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x73,
-	0x6c, 0x72, 0x6f, 0x6f, 0x74, 0x63, 0x65, 0x72, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x64, 0x62, 0x48, 0x61, 0x73, 0x53, 0x73, 0x6c, 0x72, 0x6f, 0x6f, 0x74, 0x63, 0x65, 0x72,
-	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x73, 0x6c, 0x63,
-	0x65, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x62, 0x48, 0x61, 0x73,
-	0x53, 0x73, 0x6c, 0x63, 0x65, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x62, 0x5f, 0x68, 0x61,
-	0x73, 0x5f, 0x73, 0x73, 0x6c, 0x6b, 0x65, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x64, 0x62, 0x48, 0x61, 0x73, 0x53, 0x73, 0x6c, 0x6b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x64,
-	0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x62, 0x55,
-	0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x62, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x62, 0x5f, 0x61,
-	0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x62, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x77,
-	0x73, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x77, 0x73,
-	0x5f, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x77, 0x73, 0x44, 0x62, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x15, 0x61, 0x77, 0x73, 0x5f, 0x68, 0x61,
-	0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x2c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x77, 0x73, 0x5f,
-	0x68, 0x61, 0x73, 0x5f, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18,
-	0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x41, 0x73, 0x73,
-	0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x77, 0x73, 0x5f, 0x68,
-	0x61, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x2e, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x1f, 0x61, 0x77, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x5f,
-	0x77, 0x65, 0x62, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x61,
-	0x77, 0x73, 0x48, 0x61, 0x73, 0x57, 0x65, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x61, 0x77, 0x73,
-	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x30, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x41,
-	0x72, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x44, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x68, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2e, 0x0a,
-	0x13, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x68, 0x75, 0x62, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x7a, 0x75, 0x72,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x68, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x39, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x7a, 0x75, 0x72, 0x65,
-	0x41, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x7a,
-	0x75, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x41, 0x64, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x7a, 0x75, 0x72, 0x65,
-	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x64, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x7a, 0x75, 0x72, 0x65,
-	0x48, 0x61, 0x73, 0x41, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x12, 0x37, 0x0a, 0x18, 0x67, 0x63, 0x70, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x71, 0x6c,
-	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x45, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x15, 0x67, 0x63, 0x70, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x71, 0x6c, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x67, 0x63, 0x70,
-	0x5f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x67, 0x63, 0x70, 0x50,
-	0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x63, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x47, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x15, 0x67, 0x63, 0x70, 0x48, 0x61, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x63,
-	0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x48, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x67, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x39, 0x0a, 0x19, 0x63, 0x72, 0x75, 0x6e, 0x63, 0x68, 0x79, 0x5f, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x4b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x75, 0x6e, 0x63, 0x68, 0x79, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61,
-	0x70, 0x69, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x52, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
-	0x26, 0x0a, 0x0f, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x53, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x70, 0x69, 0x5f, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x54, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x55,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0f,
-	0x64, 0x62, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x5e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x62, 0x4c, 0x6f, 0x67, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x64, 0x62, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x64,
-	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x5f, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x64, 0x62, 0x4c, 0x6f, 0x67, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x54, 0x61, 0x69,
-	0x6c, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x6a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x74, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x12, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x75, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x69, 0x66, 0x5f, 0x72,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x76, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b,
-	0x69, 0x70, 0x49, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x18, 0x7f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x6f,
-	0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x80,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x5f,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61,
-	0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x12,
-	0x2b, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74, 0x22, 0xee, 0x04, 0x0a,
-	0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12,
-	0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c,
-	0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f,
-	0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x28, 0x0a,
-	0x10, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42,
-	0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73,
-	0x44, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73,
-	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x26, 0x0a,
-	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b,
-	0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62,
-	0x6c, 0x6b, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x44, 0x69, 0x72, 0x74,
-	0x69, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b,
-	0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65,
-	0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x42,
-	0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x5f,
-	0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x57, 0x72, 0x69, 0x74,
-	0x74, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6b, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x6c, 0x6b, 0x52,
-	0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0c, 0x62, 0x6c, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd5, 0x01,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x55, 0x0a, 0x16, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x80, 0x05, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x78, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x58, 0x6c, 0x6f, 0x67, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62,
+	0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x62, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x14,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x13, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x62, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x11, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x12, 0x45, 0x0a, 0x10, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x41, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x12, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x64,
+	0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x79, 0x6e,
+	0x63, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x49, 0x64, 0x78, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6e,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f,
+	0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x22, 0x8b,
+	0x06, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x68, 0x61, 0x73, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x78, 0x12, 0x4d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x59, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46,
+	0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a,
+	0x0c, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a,
+	0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x49, 0x44, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49,
+	0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12,
+	0x1f, 0x0a, 0x1b, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1a, 0x0a, 0x16, 0x46, 0x41, 0x53, 0x54, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x46, 0x55, 0x4e,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x5f, 0x4c, 0x41, 0x55, 0x4e, 0x43,
+	0x48, 0x45, 0x52, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x55, 0x54, 0x4f, 0x56, 0x41, 0x43,
+	0x55, 0x55, 0x4d, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45,
+	0x52, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x06,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0x07, 0x12, 0x0f, 0x0a,
+	0x0b, 0x57, 0x41, 0x4c, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x10, 0x08, 0x12, 0x0d,
+	0x0a, 0x09, 0x57, 0x41, 0x4c, 0x53, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x09, 0x12, 0x0d, 0x0a,
+	0x09, 0x57, 0x41, 0x4c, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x0a, 0x22, 0x29, 0x0a, 0x13,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xe4, 0x10, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2c,
+	0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x62, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x64, 0x62, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x73,
+	0x73, 0x6c, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x62,
+	0x53, 0x73, 0x6c, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x62, 0x5f, 0x68, 0x61,
+	0x73, 0x5f, 0x73, 0x73, 0x6c, 0x72, 0x6f, 0x6f, 0x74, 0x63, 0x65, 0x72, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x62, 0x48, 0x61, 0x73, 0x53, 0x73, 0x6c, 0x72, 0x6f, 0x6f,
+	0x74, 0x63, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x68, 0x61, 0x73, 0x5f,
+	0x73, 0x73, 0x6c, 0x63, 0x65, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64,
+	0x62, 0x48, 0x61, 0x73, 0x53, 0x73, 0x6c, 0x63, 0x65, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x64,
+	0x62, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x73, 0x6c, 0x6b, 0x65, 0x79, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x64, 0x62, 0x48, 0x61, 0x73, 0x53, 0x73, 0x6c, 0x6b, 0x65, 0x79, 0x12,
+	0x15, 0x0a, 0x06, 0x64, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x62, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x62, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c,
+	0x64, 0x62, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x62, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x2a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a,
+	0x12, 0x61, 0x77, 0x73, 0x5f, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x77, 0x73, 0x44, 0x62,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x15, 0x61, 0x77,
+	0x73, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x77, 0x73, 0x48, 0x61,
+	0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13,
+	0x61, 0x77, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x77, 0x73, 0x48, 0x61,
+	0x73, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x61,
+	0x77, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x1f, 0x61, 0x77, 0x73, 0x5f,
+	0x68, 0x61, 0x73, 0x5f, 0x77, 0x65, 0x62, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1a, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x57, 0x65, 0x62, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a,
+	0x10, 0x61, 0x77, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x72,
+	0x6e, 0x18, 0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x77, 0x73, 0x48, 0x61, 0x73, 0x52,
+	0x6f, 0x6c, 0x65, 0x41, 0x72, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f,
+	0x64, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x36,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x44, 0x62, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x7a, 0x75, 0x72, 0x65,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x7a, 0x75, 0x72, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x68, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x68, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x7a, 0x75, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x68, 0x75, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x5f, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x39, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x7a, 0x75, 0x72, 0x65, 0x41, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x7a, 0x75, 0x72,
+	0x65, 0x41, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x61,
+	0x7a, 0x75, 0x72, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x64, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61,
+	0x7a, 0x75, 0x72, 0x65, 0x48, 0x61, 0x73, 0x41, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x63, 0x70, 0x5f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x73, 0x71, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x45, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x67, 0x63, 0x70, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x73, 0x71, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x17, 0x67, 0x63, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x67, 0x63, 0x70, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x63, 0x70, 0x5f, 0x68, 0x61, 0x73,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x47, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x67, 0x63, 0x70, 0x48, 0x61, 0x73, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x67, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x48, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x72, 0x75, 0x6e, 0x63, 0x68, 0x79, 0x5f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x75, 0x6e, 0x63, 0x68, 0x79,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x52, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x53, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70,
+	0x69, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x70, 0x69, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x54, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x18, 0x55, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x70, 0x69, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x26, 0x0a, 0x0f, 0x64, 0x62, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x62, 0x4c, 0x6f, 0x67,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x64, 0x62, 0x5f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x5f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x62, 0x4c, 0x6f, 0x67, 0x44, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x54, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x69, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18,
+	0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x74, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x75, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x69, 0x66, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x76, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x49, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12,
+	0x2a, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x82, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x72, 0x6f, 0x6d,
+	0x45, 0x6e, 0x76, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x78, 0x74,
+	0x22, 0xea, 0x06, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x74, 0x69,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x42, 0x6c, 0x6b, 0x73, 0x44, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x42, 0x6c, 0x6b, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73,
+	0x44, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x57, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x62, 0x6c,
+	0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74,
+	0x65, 0x6d, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x65, 0x6d, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x42, 0x6c, 0x6b, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6b, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x62, 0x6c, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62,
+	0x6c, 0x6b, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x52, 0x65, 0x61, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x67, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xd5, 0x01,
 	0x0a, 0x17, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
 	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6c,
 	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
@@ -4618,7 +8734,7 @@ var file_full_snapshot_proto_rawDesc = []byte{
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
 	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xb7, 0x10, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xa8, 0x13, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
 	0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78,
@@ -4684,270 +8800,1002 @@ var file_full_snapshot_proto_rawDesc = []byte{
 	0x18, 0x13, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
 	0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74,
 	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x1a,
-	0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe1, 0x02, 0x0a, 0x06,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x6b, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70,
+	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe1, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f,
+	0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x58, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x67, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x4e, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x78, 0x1a, 0xcb, 0x01, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x76, 0x67, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x61, 0x76, 0x67, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x44,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xde, 0x02, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x81, 0x02, 0x0a, 0x11,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x64,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68,
+	0x61, 0x73, 0x4e, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x68,
+	0x61, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x63,
+	0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4d, 0x63, 0x76, 0x22,
+	0x3f, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x53, 0x48, 0x10, 0x03,
+	0x22, 0xca, 0x06, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x5f,
+	0x73, 0x63, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x71, 0x53,
+	0x63, 0x61, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x71, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x54, 0x75,
+	0x70, 0x52, 0x65, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x78, 0x5f, 0x73, 0x63, 0x61,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x78, 0x53, 0x63, 0x61, 0x6e,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x78, 0x54, 0x75, 0x70, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x09, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x69, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x54, 0x75, 0x70, 0x49, 0x6e, 0x73,
+	0x12, 0x1a, 0x0a, 0x09, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x54, 0x75, 0x70, 0x55, 0x70, 0x64, 0x12, 0x1a, 0x0a, 0x09,
+	0x6e, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6e, 0x54, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0d, 0x6e, 0x5f, 0x74, 0x75,
+	0x70, 0x5f, 0x68, 0x6f, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6e, 0x54, 0x75, 0x70, 0x48, 0x6f, 0x74, 0x55, 0x70, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6e,
+	0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x54, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x0a, 0x6e, 0x5f, 0x64,
+	0x65, 0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e,
+	0x44, 0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x12, 0x2d, 0x0a, 0x13, 0x6e, 0x5f, 0x6d, 0x6f, 0x64,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x4d, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62,
+	0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73,
+	0x5f, 0x68, 0x69, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x78, 0x42,
+	0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x5f,
+	0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6b,
+	0x73, 0x48, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b,
+	0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x69,
+	0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69,
+	0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc0, 0x02,
+	0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x78, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c,
+	0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41,
+	0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x45, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x45, 0x10, 0x03,
+	0x22, 0xe7, 0x02, 0x0a, 0x10, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x49,
+	0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12, 0x46, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x44, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6c, 0x6c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x0e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x78,
+	0x5f, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x78,
+	0x53, 0x63, 0x61, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x64, 0x78, 0x5f, 0x74, 0x75, 0x70, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x78, 0x54,
+	0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78, 0x5f, 0x74, 0x75,
+	0x70, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69,
+	0x64, 0x78, 0x54, 0x75, 0x70, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x64,
+	0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x20,
+	0x0a, 0x0c, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74,
+	0x22, 0xaf, 0x04, 0x0a, 0x13, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x6b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x6b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6c, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x53, 0x0a,
+	0x0c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x55,
+	0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x44, 0x55, 0x52, 0x45,
+	0x10, 0x04, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9b, 0x05,
+	0x0a, 0x15, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x73, 0x1a, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43,
+	0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x41,
+	0x53, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x53, 0x45, 0x55, 0x44, 0x4f, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x4d,
+	0x55, 0x4c, 0x54, 0x49, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x22, 0xf5, 0x04, 0x0a, 0x12,
+	0x41, 0x6c, 0x6c, 0x6f, 0x79, 0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x61, 0x72, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x61, 0x72, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x67, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x79, 0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x78, 0x0a, 0x1c, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
 	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4f,
-	0x0a, 0x14, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
-	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x11, 0x64, 0x61,
-	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x49, 0x64, 0x78, 0x1a,
-	0xcb, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x63, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x61, 0x76, 0x67, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
-	0x5f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xde, 0x02,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14,
-	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2c, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3f,
-	0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c,
-	0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x53, 0x48, 0x10, 0x03, 0x22,
-	0xca, 0x06, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x79, 0x44, 0x42, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x1a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x1a, 0xc8, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x54, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x47, 0x63, 0x70, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x75, 0x6e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x55, 0x6e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x5f, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xd8, 0x12, 0x0a, 0x10, 0x43, 0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x69,
+	0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x12, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x69, 0x74,
+	0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x11,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x6b, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x71,
+	0x0a, 0x16, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x15, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x5f, 0x0a, 0x10, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x43, 0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x62, 0x0a, 0x12, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x69, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x11, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0xa7, 0x02, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x68, 0x6f, 0x75, 0x6c,
+	0x64, 0x5f, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x48, 0x61, 0x76, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x1a, 0xad, 0x02, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x5f, 0x73,
-	0x63, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x71, 0x53, 0x63,
-	0x61, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x71, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x54, 0x75, 0x70,
-	0x52, 0x65, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x78, 0x53, 0x63, 0x61, 0x6e, 0x12,
-	0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x78, 0x54, 0x75, 0x70, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x09, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x54, 0x75, 0x70, 0x49, 0x6e, 0x73, 0x12,
-	0x1a, 0x0a, 0x09, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x6e, 0x54, 0x75, 0x70, 0x55, 0x70, 0x64, 0x12, 0x1a, 0x0a, 0x09, 0x6e,
-	0x5f, 0x74, 0x75, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x6e, 0x54, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0d, 0x6e, 0x5f, 0x74, 0x75, 0x70,
-	0x5f, 0x68, 0x6f, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x6e, 0x54, 0x75, 0x70, 0x48, 0x6f, 0x74, 0x55, 0x70, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6e, 0x5f,
-	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x6e, 0x4c, 0x69, 0x76, 0x65, 0x54, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x0a, 0x6e, 0x5f, 0x64, 0x65,
-	0x61, 0x64, 0x5f, 0x74, 0x75, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x44,
-	0x65, 0x61, 0x64, 0x54, 0x75, 0x70, 0x12, 0x2d, 0x0a, 0x13, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x5f,
-	0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x4d, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x41, 0x6e,
-	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c,
-	0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68,
-	0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x68,
-	0x65, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12,
-	0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f,
-	0x68, 0x69, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x78, 0x42, 0x6c,
-	0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x5f, 0x62,
-	0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x74, 0x6f, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x24, 0x0a,
-	0x0e, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6b, 0x73,
-	0x48, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73,
-	0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x69, 0x64,
-	0x78, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x64,
-	0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x74, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x28, 0x0a,
-	0x10, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x61, 0x73, 0x74, 0x53, 0x69,
-	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x7a, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
-	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc0, 0x02, 0x0a,
-	0x0d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x78, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x2c, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x36, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f,
-	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x15, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4f, 0x63,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f,
-	0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x4f,
-	0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x4e,
-	0x55, 0x41, 0x4c, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a,
-	0x0c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x45, 0x10, 0x03, 0x22,
-	0xe7, 0x02, 0x0a, 0x10, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x69, 0x64,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x64,
-	0x78, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x68, 0x61, 0x73, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12,
+	0x2f, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x1a, 0xce, 0x03, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x70, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x50,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12,
+	0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x29, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0xf2, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12,
+	0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x1a, 0xa7, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x1a, 0xab, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12, 0x46, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
-	0x44, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69,
-	0x6c, 0x6c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x66, 0x69, 0x6c, 0x6c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x0e, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
-	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
-	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x78, 0x5f,
-	0x73, 0x63, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x78, 0x53,
-	0x63, 0x61, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x64, 0x78, 0x5f, 0x74, 0x75, 0x70, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x78, 0x54, 0x75,
-	0x70, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78, 0x5f, 0x74, 0x75, 0x70,
-	0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64,
-	0x78, 0x54, 0x75, 0x70, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x64, 0x78,
-	0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x20, 0x0a,
-	0x0c, 0x69, 0x64, 0x78, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x78, 0x42, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x22,
-	0xaf, 0x04, 0x0a, 0x13, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x69, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x6b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x6b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x73, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
-	0x65, 0x12, 0x49, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x35, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x53, 0x0a, 0x0c,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x55, 0x4e,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x47, 0x47, 0x52, 0x45,
-	0x47, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57,
-	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x44, 0x55, 0x52, 0x45, 0x10,
-	0x04, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61,
-	0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9b, 0x05, 0x0a,
-	0x15, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x70,
-	0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e,
-	0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x61,
-	0x74, 0x74, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x67, 0x61,
+	0x6e, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xeb,
+	0x02, 0x0a, 0x1e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x48, 0x79, 0x70, 0x65,
+	0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6d, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x1a, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x19, 0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc7, 0x05, 0x0a,
+	0x27, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e,
+	0x75, 0x6f, 0x75, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x69, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x68, 0x79, 0x70,
+	0x65, 0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x68, 0x79, 0x70, 0x65, 0x72,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x6a, 0x6f, 0x62,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x50, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0xca, 0x04, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x72, 0x6f,
+	0x6e, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x67,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x50, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x74, 0x72,
+	0x6f, 0x6e, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0xa7, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x1a, 0xb0, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x73,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x22, 0xf9, 0x07, 0x0a, 0x19, 0x50, 0x67, 0x41, 0x75, 0x74, 0x6f, 0x46, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x67, 0x61,
 	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65,
-	0x41, 0x74, 0x74, 0x72, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x41,
-	0x74, 0x74, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x73, 0x1a, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65,
-	0x41, 0x74, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f,
-	0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x41, 0x53,
-	0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x53, 0x45, 0x55, 0x44, 0x4f, 0x10, 0x04, 0x12,
-	0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x55,
-	0x4c, 0x54, 0x49, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x50, 0x67, 0x41, 0x75, 0x74, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x41,
+	0x75, 0x74, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x80, 0x04, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0xc5, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x84, 0x09, 0x0a, 0x14, 0x50, 0x67, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x6c, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x12, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x44, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x5a, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x48, 0x0a, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x1a, 0x9d, 0x02, 0x0a,
+	0x11, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x78, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x78, 0x61, 0x63, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x1a, 0xb6, 0x02, 0x0a,
+	0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x76, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x76, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x76, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x76,
+	0x49, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x76, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x76, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x76, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x76, 0x54, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76,
+	0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x76,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57,
+	0x61, 0x69, 0x74, 0x55, 0x73, 0x1a, 0x69, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0x34, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x91, 0x09, 0x0a, 0x11, 0x50, 0x67, 0x70, 0x6f, 0x6f,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x64, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x67, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x1a, 0xef, 0x02, 0x0a,
+	0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x62, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x62, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x5c,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xeb, 0x02, 0x0a,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6e,
+	0x75, 0x6d, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x48, 0x69, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68, 0x69, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x48, 0x69, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75,
+	0x6d, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x75, 0x73, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6e, 0x75, 0x6d,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x17,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x75,
+	0x73, 0x65, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x66, 0x72, 0x65, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x5b, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x28, 0x0a,
+	0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c,
+	0x61, 0x6e, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x6f, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x24,
+	0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61,
+	0x6e, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xcf, 0x03, 0x0a, 0x0b, 0x49, 0x4f,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x75, 0x73, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x77, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0xf8, 0x01, 0x0a,
+	0x0d, 0x53, 0x4c, 0x52, 0x55, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6b, 0x73, 0x5a, 0x65, 0x72,
+	0x6f, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x73, 0x48, 0x69, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x62, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6b, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6b, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa4, 0x03, 0x0a, 0x0c, 0x57, 0x41, 0x4c, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x61, 0x6c,
+	0x5f, 0x66, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x46,
+	0x70, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x66,
+	0x75, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x77, 0x61, 0x6c, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x61,
+	0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x77, 0x61, 0x6c, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x77, 0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x77,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x77, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x2b, 0x0a, 0x12, 0x77, 0x61, 0x6c, 0x5f, 0x66, 0x70, 0x69, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x77, 0x61,
+	0x6c, 0x46, 0x70, 0x69, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2f, 0x0a,
+	0x14, 0x77, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x77, 0x61, 0x6c,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0xa8,
+	0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78, 0x12, 0x22, 0x0a, 0x0d,
+	0x68, 0x61, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x95, 0x09, 0x0a, 0x13, 0x4c, 0x6f,
+	0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x62, 0x75, 0x66, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x42, 0x75, 0x66, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x63, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65,
+	0x63, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x73, 0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x77, 0x72, 0x61,
+	0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x61,
+	0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x73, 0x41, 0x6e, 0x74, 0x69, 0x57, 0x72,
+	0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f,
+	0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75,
+	0x6d, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x15, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x46, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x12, 0x30, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x77,
+	0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x57, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x45,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x53, 0x65, 0x63, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x35, 0x0a,
+	0x16, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x63, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x19, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x67, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x1a,
+	0x60, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x78,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4963,127 +9811,218 @@ func file_full_snapshot_proto_rawDescGZIP() []byte {
 }
 
 var file_full_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_full_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_full_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_full_snapshot_proto_goTypes = []interface{}{
-	(BackendCountStatistic_BackendState)(0),     // 0: pganalyze.collector.BackendCountStatistic.BackendState
-	(BackendCountStatistic_BackendType)(0),      // 1: pganalyze.collector.BackendCountStatistic.BackendType
-	(RelationInformation_PartitionStrategy)(0),  // 2: pganalyze.collector.RelationInformation.PartitionStrategy
-	(RelationEvent_EventType)(0),                // 3: pganalyze.collector.RelationEvent.EventType
-	(FunctionInformation_FunctionKind)(0),       // 4: pganalyze.collector.FunctionInformation.FunctionKind
-	(CustomTypeInformation_Type)(0),             // 5: pganalyze.collector.CustomTypeInformation.Type
-	(*FullSnapshot)(nil),                        // 6: pganalyze.collector.FullSnapshot
-	(*CollectorStatistic)(nil),                  // 7: pganalyze.collector.CollectorStatistic
-	(*RoleInformation)(nil),                     // 8: pganalyze.collector.RoleInformation
-	(*DatabaseInformation)(nil),                 // 9: pganalyze.collector.DatabaseInformation
-	(*Setting)(nil),                             // 10: pganalyze.collector.Setting
-	(*Replication)(nil),                         // 11: pganalyze.collector.Replication
-	(*StandbyReference)(nil),                    // 12: pganalyze.collector.StandbyReference
-	(*StandbyInformation)(nil),                  // 13: pganalyze.collector.StandbyInformation
-	(*StandbyStatistic)(nil),                    // 14: pganalyze.collector.StandbyStatistic
-	(*BackendCountStatistic)(nil),               // 15: pganalyze.collector.BackendCountStatistic
-	(*TablespaceReference)(nil),                 // 16: pganalyze.collector.TablespaceReference
-	(*TablespaceInformation)(nil),               // 17: pganalyze.collector.TablespaceInformation
-	(*CollectorConfig)(nil),                     // 18: pganalyze.collector.CollectorConfig
-	(*QueryStatistic)(nil),                      // 19: pganalyze.collector.QueryStatistic
-	(*HistoricQueryStatistics)(nil),             // 20: pganalyze.collector.HistoricQueryStatistics
-	(*RelationInformation)(nil),                 // 21: pganalyze.collector.RelationInformation
-	(*RelationStatistic)(nil),                   // 22: pganalyze.collector.RelationStatistic
-	(*RelationEvent)(nil),                       // 23: pganalyze.collector.RelationEvent
-	(*IndexInformation)(nil),                    // 24: pganalyze.collector.IndexInformation
-	(*IndexStatistic)(nil),                      // 25: pganalyze.collector.IndexStatistic
-	(*FunctionInformation)(nil),                 // 26: pganalyze.collector.FunctionInformation
-	(*FunctionStatistic)(nil),                   // 27: pganalyze.collector.FunctionStatistic
-	(*CustomTypeInformation)(nil),               // 28: pganalyze.collector.CustomTypeInformation
-	nil,                                         // 29: pganalyze.collector.RelationInformation.OptionsEntry
-	(*RelationInformation_Column)(nil),          // 30: pganalyze.collector.RelationInformation.Column
-	(*RelationInformation_ColumnStatistic)(nil), // 31: pganalyze.collector.RelationInformation.ColumnStatistic
-	(*RelationInformation_Constraint)(nil),      // 32: pganalyze.collector.RelationInformation.Constraint
-	(*CustomTypeInformation_CompositeAttr)(nil), // 33: pganalyze.collector.CustomTypeInformation.CompositeAttr
-	(*timestamp.Timestamp)(nil),                 // 34: google.protobuf.Timestamp
-	(*System)(nil),                              // 35: pganalyze.collector.System
-	(*PostgresVersion)(nil),                     // 36: pganalyze.collector.PostgresVersion
-	(*RoleReference)(nil),                       // 37: pganalyze.collector.RoleReference
-	(*DatabaseReference)(nil),                   // 38: pganalyze.collector.DatabaseReference
-	(*QueryReference)(nil),                      // 39: pganalyze.collector.QueryReference
-	(*RelationReference)(nil),                   // 40: pganalyze.collector.RelationReference
-	(*IndexReference)(nil),                      // 41: pganalyze.collector.IndexReference
-	(*FunctionReference)(nil),                   // 42: pganalyze.collector.FunctionReference
-	(*QueryInformation)(nil),                    // 43: pganalyze.collector.QueryInformation
-	(*QueryExplainInformation)(nil),             // 44: pganalyze.collector.QueryExplainInformation
-	(*NullTimestamp)(nil),                       // 45: pganalyze.collector.NullTimestamp
-	(*NullString)(nil),                          // 46: pganalyze.collector.NullString
-	(*NullInt32)(nil),                           // 47: pganalyze.collector.NullInt32
-	(*NullDouble)(nil),                          // 48: pganalyze.collector.NullDouble
+	(BackendCountStatistic_BackendState)(0),         // 0: pganalyze.collector.BackendCountStatistic.BackendState
+	(BackendCountStatistic_BackendType)(0),          // 1: pganalyze.collector.BackendCountStatistic.BackendType
+	(RelationInformation_PartitionStrategy)(0),      // 2: pganalyze.collector.RelationInformation.PartitionStrategy
+	(RelationEvent_EventType)(0),                    // 3: pganalyze.collector.RelationEvent.EventType
+	(FunctionInformation_FunctionKind)(0),           // 4: pganalyze.collector.FunctionInformation.FunctionKind
+	(CustomTypeInformation_Type)(0),                 // 5: pganalyze.collector.CustomTypeInformation.Type
+	(*FullSnapshot)(nil),                            // 6: pganalyze.collector.FullSnapshot
+	(*CollectorStatistic)(nil),                      // 7: pganalyze.collector.CollectorStatistic
+	(*RoleInformation)(nil),                         // 8: pganalyze.collector.RoleInformation
+	(*DatabaseInformation)(nil),                     // 9: pganalyze.collector.DatabaseInformation
+	(*Setting)(nil),                                 // 10: pganalyze.collector.Setting
+	(*Replication)(nil),                             // 11: pganalyze.collector.Replication
+	(*StandbyReference)(nil),                        // 12: pganalyze.collector.StandbyReference
+	(*StandbyInformation)(nil),                      // 13: pganalyze.collector.StandbyInformation
+	(*StandbyStatistic)(nil),                        // 14: pganalyze.collector.StandbyStatistic
+	(*BackendCountStatistic)(nil),                   // 15: pganalyze.collector.BackendCountStatistic
+	(*TablespaceReference)(nil),                     // 16: pganalyze.collector.TablespaceReference
+	(*TablespaceInformation)(nil),                   // 17: pganalyze.collector.TablespaceInformation
+	(*CollectorConfig)(nil),                         // 18: pganalyze.collector.CollectorConfig
+	(*QueryStatistic)(nil),                          // 19: pganalyze.collector.QueryStatistic
+	(*HistoricQueryStatistics)(nil),                 // 20: pganalyze.collector.HistoricQueryStatistics
+	(*RelationInformation)(nil),                     // 21: pganalyze.collector.RelationInformation
+	(*RelationStatistic)(nil),                       // 22: pganalyze.collector.RelationStatistic
+	(*RelationEvent)(nil),                           // 23: pganalyze.collector.RelationEvent
+	(*IndexInformation)(nil),                        // 24: pganalyze.collector.IndexInformation
+	(*IndexStatistic)(nil),                          // 25: pganalyze.collector.IndexStatistic
+	(*FunctionInformation)(nil),                     // 26: pganalyze.collector.FunctionInformation
+	(*FunctionStatistic)(nil),                       // 27: pganalyze.collector.FunctionStatistic
+	(*CustomTypeInformation)(nil),                   // 28: pganalyze.collector.CustomTypeInformation
+	(*AlloyDBInformation)(nil),                      // 29: pganalyze.collector.AlloyDBInformation
+	(*GcpPubSubStatistic)(nil),                      // 30: pganalyze.collector.GcpPubSubStatistic
+	(*CitusInformation)(nil),                        // 31: pganalyze.collector.CitusInformation
+	(*TimescaleHypertableInformation)(nil),          // 32: pganalyze.collector.TimescaleHypertableInformation
+	(*TimescaleContinuousAggregateInformation)(nil), // 33: pganalyze.collector.TimescaleContinuousAggregateInformation
+	(*PatroniInformation)(nil),                      // 34: pganalyze.collector.PatroniInformation
+	(*PgAutoFailoverInformation)(nil),               // 35: pganalyze.collector.PgAutoFailoverInformation
+	(*PgBouncerInformation)(nil),                    // 36: pganalyze.collector.PgBouncerInformation
+	(*PgpoolInformation)(nil),                       // 37: pganalyze.collector.PgpoolInformation
+	(*QueryPlanReference)(nil),                      // 38: pganalyze.collector.QueryPlanReference
+	(*QueryPlanInformation)(nil),                    // 39: pganalyze.collector.QueryPlanInformation
+	(*QueryPlanStatistic)(nil),                      // 40: pganalyze.collector.QueryPlanStatistic
+	(*IOStatistic)(nil),                             // 41: pganalyze.collector.IOStatistic
+	(*QueryWaitEventStatistic)(nil),                 // 42: pganalyze.collector.QueryWaitEventStatistic
+	(*SLRUStatistic)(nil),                           // 43: pganalyze.collector.SLRUStatistic
+	(*WALStatistic)(nil),                            // 44: pganalyze.collector.WALStatistic
+	(*QueryTempFileStatistic)(nil),                  // 45: pganalyze.collector.QueryTempFileStatistic
+	(*LogMetricsStatistic)(nil),                     // 46: pganalyze.collector.LogMetricsStatistic
+	nil,                                             // 47: pganalyze.collector.RelationInformation.OptionsEntry
+	(*RelationInformation_Column)(nil),              // 48: pganalyze.collector.RelationInformation.Column
+	(*RelationInformation_ColumnStatistic)(nil),     // 49: pganalyze.collector.RelationInformation.ColumnStatistic
+	(*RelationInformation_Constraint)(nil),          // 50: pganalyze.collector.RelationInformation.Constraint
+	(*RelationInformation_ExtendedStatistic)(nil),   // 51: pganalyze.collector.RelationInformation.ExtendedStatistic
+	(*CustomTypeInformation_CompositeAttr)(nil),     // 52: pganalyze.collector.CustomTypeInformation.CompositeAttr
+	(*AlloyDBInformation_ColumnarRelation)(nil),     // 53: pganalyze.collector.AlloyDBInformation.ColumnarRelation
+	(*AlloyDBInformation_ColumnarColumn)(nil),       // 54: pganalyze.collector.AlloyDBInformation.ColumnarColumn
+	(*CitusInformation_Node)(nil),                   // 55: pganalyze.collector.CitusInformation.Node
+	(*CitusInformation_DistributedTable)(nil),       // 56: pganalyze.collector.CitusInformation.DistributedTable
+	(*CitusInformation_DistributedBackend)(nil),     // 57: pganalyze.collector.CitusInformation.DistributedBackend
+	(*CitusInformation_DistributedStatement)(nil),   // 58: pganalyze.collector.CitusInformation.DistributedStatement
+	(*CitusInformation_ShardPlacement)(nil),         // 59: pganalyze.collector.CitusInformation.ShardPlacement
+	(*CitusInformation_RebalanceMove)(nil),          // 60: pganalyze.collector.CitusInformation.RebalanceMove
+	(*PatroniInformation_Member)(nil),               // 61: pganalyze.collector.PatroniInformation.Member
+	(*PatroniInformation_TimelineChange)(nil),       // 62: pganalyze.collector.PatroniInformation.TimelineChange
+	(*PgAutoFailoverInformation_Node)(nil),          // 63: pganalyze.collector.PgAutoFailoverInformation.Node
+	(*PgAutoFailoverInformation_Event)(nil),         // 64: pganalyze.collector.PgAutoFailoverInformation.Event
+	(*PgBouncerInformation_DatabaseStatistic)(nil),  // 65: pganalyze.collector.PgBouncerInformation.DatabaseStatistic
+	(*PgBouncerInformation_Pool)(nil),               // 66: pganalyze.collector.PgBouncerInformation.Pool
+	(*PgBouncerInformation_ClientCount)(nil),        // 67: pganalyze.collector.PgBouncerInformation.ClientCount
+	(*PgBouncerInformation_ListItem)(nil),           // 68: pganalyze.collector.PgBouncerInformation.ListItem
+	(*PgpoolInformation_Node)(nil),                  // 69: pganalyze.collector.PgpoolInformation.Node
+	(*PgpoolInformation_ProcessCount)(nil),          // 70: pganalyze.collector.PgpoolInformation.ProcessCount
+	(*PgpoolInformation_QueryCache)(nil),            // 71: pganalyze.collector.PgpoolInformation.QueryCache
+	(*LogMetricsStatistic_LoginCount)(nil),          // 72: pganalyze.collector.LogMetricsStatistic.LoginCount
+	(*timestamp.Timestamp)(nil),                     // 73: google.protobuf.Timestamp
+	(*System)(nil),                                  // 74: pganalyze.collector.System
+	(*PostgresVersion)(nil),                         // 75: pganalyze.collector.PostgresVersion
+	(*RoleReference)(nil),                           // 76: pganalyze.collector.RoleReference
+	(*DatabaseReference)(nil),                       // 77: pganalyze.collector.DatabaseReference
+	(*QueryReference)(nil),                          // 78: pganalyze.collector.QueryReference
+	(*RelationReference)(nil),                       // 79: pganalyze.collector.RelationReference
+	(*IndexReference)(nil),                          // 80: pganalyze.collector.IndexReference
+	(*FunctionReference)(nil),                       // 81: pganalyze.collector.FunctionReference
+	(*QueryInformation)(nil),                        // 82: pganalyze.collector.QueryInformation
+	(*QueryExplainInformation)(nil),                 // 83: pganalyze.collector.QueryExplainInformation
+	(*NullTimestamp)(nil),                           // 84: pganalyze.collector.NullTimestamp
+	(*NullString)(nil),                              // 85: pganalyze.collector.NullString
+	(*QueryTag)(nil),                                // 86: pganalyze.collector.QueryTag
+	(*NullInt32)(nil),                               // 87: pganalyze.collector.NullInt32
+	(*NullDouble)(nil),                              // 88: pganalyze.collector.NullDouble
 }
 var file_full_snapshot_proto_depIdxs = []int32{
-	34, // 0: pganalyze.collector.FullSnapshot.collected_at:type_name -> google.protobuf.Timestamp
-	18, // 1: pganalyze.collector.FullSnapshot.config:type_name -> pganalyze.collector.CollectorConfig
-	7,  // 2: pganalyze.collector.FullSnapshot.collector_statistic:type_name -> pganalyze.collector.CollectorStatistic
-	34, // 3: pganalyze.collector.FullSnapshot.collector_started_at:type_name -> google.protobuf.Timestamp
-	35, // 4: pganalyze.collector.FullSnapshot.system:type_name -> pganalyze.collector.System
-	36, // 5: pganalyze.collector.FullSnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
-	37, // 6: pganalyze.collector.FullSnapshot.role_references:type_name -> pganalyze.collector.RoleReference
-	38, // 7: pganalyze.collector.FullSnapshot.database_references:type_name -> pganalyze.collector.DatabaseReference
-	8,  // 8: pganalyze.collector.FullSnapshot.role_informations:type_name -> pganalyze.collector.RoleInformation
-	9,  // 9: pganalyze.collector.FullSnapshot.database_informations:type_name -> pganalyze.collector.DatabaseInformation
-	10, // 10: pganalyze.collector.FullSnapshot.settings:type_name -> pganalyze.collector.Setting
-	11, // 11: pganalyze.collector.FullSnapshot.replication:type_name -> pganalyze.collector.Replication
-	15, // 12: pganalyze.collector.FullSnapshot.backend_count_statistics:type_name -> pganalyze.collector.BackendCountStatistic
-	16, // 13: pganalyze.collector.FullSnapshot.tablespace_references:type_name -> pganalyze.collector.TablespaceReference
-	17, // 14: pganalyze.collector.FullSnapshot.tablespace_informations:type_name -> pganalyze.collector.TablespaceInformation
-	39, // 15: pganalyze.collector.FullSnapshot.query_references:type_name -> pganalyze.collector.QueryReference
-	40, // 16: pganalyze.collector.FullSnapshot.relation_references:type_name -> pganalyze.collector.RelationReference
-	41, // 17: pganalyze.collector.FullSnapshot.index_references:type_name -> pganalyze.collector.IndexReference
-	42, // 18: pganalyze.collector.FullSnapshot.function_references:type_name -> pganalyze.collector.FunctionReference
-	43, // 19: pganalyze.collector.FullSnapshot.query_informations:type_name -> pganalyze.collector.QueryInformation
-	19, // 20: pganalyze.collector.FullSnapshot.query_statistics:type_name -> pganalyze.collector.QueryStatistic
-	20, // 21: pganalyze.collector.FullSnapshot.historic_query_statistics:type_name -> pganalyze.collector.HistoricQueryStatistics
-	44, // 22: pganalyze.collector.FullSnapshot.query_explains:type_name -> pganalyze.collector.QueryExplainInformation
-	21, // 23: pganalyze.collector.FullSnapshot.relation_informations:type_name -> pganalyze.collector.RelationInformation
-	22, // 24: pganalyze.collector.FullSnapshot.relation_statistics:type_name -> pganalyze.collector.RelationStatistic
-	23, // 25: pganalyze.collector.FullSnapshot.relation_events:type_name -> pganalyze.collector.RelationEvent
-	24, // 26: pganalyze.collector.FullSnapshot.index_informations:type_name -> pganalyze.collector.IndexInformation
-	25, // 27: pganalyze.collector.FullSnapshot.index_statistics:type_name -> pganalyze.collector.IndexStatistic
-	26, // 28: pganalyze.collector.FullSnapshot.function_informations:type_name -> pganalyze.collector.FunctionInformation
-	27, // 29: pganalyze.collector.FullSnapshot.function_statistics:type_name -> pganalyze.collector.FunctionStatistic
-	28, // 30: pganalyze.collector.FullSnapshot.custom_type_informations:type_name -> pganalyze.collector.CustomTypeInformation
-	45, // 31: pganalyze.collector.RoleInformation.password_valid_until:type_name -> pganalyze.collector.NullTimestamp
-	46, // 32: pganalyze.collector.Setting.unit:type_name -> pganalyze.collector.NullString
-	46, // 33: pganalyze.collector.Setting.boot_value:type_name -> pganalyze.collector.NullString
-	46, // 34: pganalyze.collector.Setting.reset_value:type_name -> pganalyze.collector.NullString
-	46, // 35: pganalyze.collector.Setting.source:type_name -> pganalyze.collector.NullString
-	46, // 36: pganalyze.collector.Setting.source_file:type_name -> pganalyze.collector.NullString
-	46, // 37: pganalyze.collector.Setting.source_line:type_name -> pganalyze.collector.NullString
-	12, // 38: pganalyze.collector.Replication.standby_references:type_name -> pganalyze.collector.StandbyReference
-	13, // 39: pganalyze.collector.Replication.standby_informations:type_name -> pganalyze.collector.StandbyInformation
-	14, // 40: pganalyze.collector.Replication.standby_statistics:type_name -> pganalyze.collector.StandbyStatistic
-	34, // 41: pganalyze.collector.Replication.replay_timestamp:type_name -> google.protobuf.Timestamp
-	34, // 42: pganalyze.collector.StandbyInformation.backend_start:type_name -> google.protobuf.Timestamp
-	0,  // 43: pganalyze.collector.BackendCountStatistic.state:type_name -> pganalyze.collector.BackendCountStatistic.BackendState
-	1,  // 44: pganalyze.collector.BackendCountStatistic.backend_type:type_name -> pganalyze.collector.BackendCountStatistic.BackendType
-	34, // 45: pganalyze.collector.HistoricQueryStatistics.collected_at:type_name -> google.protobuf.Timestamp
-	19, // 46: pganalyze.collector.HistoricQueryStatistics.statistics:type_name -> pganalyze.collector.QueryStatistic
-	46, // 47: pganalyze.collector.RelationInformation.view_definition:type_name -> pganalyze.collector.NullString
-	30, // 48: pganalyze.collector.RelationInformation.columns:type_name -> pganalyze.collector.RelationInformation.Column
-	32, // 49: pganalyze.collector.RelationInformation.constraints:type_name -> pganalyze.collector.RelationInformation.Constraint
-	29, // 50: pganalyze.collector.RelationInformation.options:type_name -> pganalyze.collector.RelationInformation.OptionsEntry
-	2,  // 51: pganalyze.collector.RelationInformation.partition_strategy:type_name -> pganalyze.collector.RelationInformation.PartitionStrategy
-	45, // 52: pganalyze.collector.RelationStatistic.analyzed_at:type_name -> pganalyze.collector.NullTimestamp
-	3,  // 53: pganalyze.collector.RelationEvent.type:type_name -> pganalyze.collector.RelationEvent.EventType
-	34, // 54: pganalyze.collector.RelationEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	46, // 55: pganalyze.collector.IndexInformation.constraint_def:type_name -> pganalyze.collector.NullString
-	4,  // 56: pganalyze.collector.FunctionInformation.kind:type_name -> pganalyze.collector.FunctionInformation.FunctionKind
-	5,  // 57: pganalyze.collector.CustomTypeInformation.type:type_name -> pganalyze.collector.CustomTypeInformation.Type
-	33, // 58: pganalyze.collector.CustomTypeInformation.composite_attrs:type_name -> pganalyze.collector.CustomTypeInformation.CompositeAttr
-	46, // 59: pganalyze.collector.RelationInformation.Column.default_value:type_name -> pganalyze.collector.NullString
-	31, // 60: pganalyze.collector.RelationInformation.Column.statistics:type_name -> pganalyze.collector.RelationInformation.ColumnStatistic
-	47, // 61: pganalyze.collector.RelationInformation.Column.data_type_custom_idx:type_name -> pganalyze.collector.NullInt32
-	48, // 62: pganalyze.collector.RelationInformation.ColumnStatistic.correlation:type_name -> pganalyze.collector.NullDouble
-	63, // [63:63] is the sub-list for method output_type
-	63, // [63:63] is the sub-list for method input_type
-	63, // [63:63] is the sub-list for extension type_name
-	63, // [63:63] is the sub-list for extension extendee
-	0,  // [0:63] is the sub-list for field type_name
+	73,  // 0: pganalyze.collector.FullSnapshot.collected_at:type_name -> google.protobuf.Timestamp
+	18,  // 1: pganalyze.collector.FullSnapshot.config:type_name -> pganalyze.collector.CollectorConfig
+	7,   // 2: pganalyze.collector.FullSnapshot.collector_statistic:type_name -> pganalyze.collector.CollectorStatistic
+	73,  // 3: pganalyze.collector.FullSnapshot.collector_started_at:type_name -> google.protobuf.Timestamp
+	74,  // 4: pganalyze.collector.FullSnapshot.system:type_name -> pganalyze.collector.System
+	75,  // 5: pganalyze.collector.FullSnapshot.postgres_version:type_name -> pganalyze.collector.PostgresVersion
+	76,  // 6: pganalyze.collector.FullSnapshot.role_references:type_name -> pganalyze.collector.RoleReference
+	77,  // 7: pganalyze.collector.FullSnapshot.database_references:type_name -> pganalyze.collector.DatabaseReference
+	8,   // 8: pganalyze.collector.FullSnapshot.role_informations:type_name -> pganalyze.collector.RoleInformation
+	9,   // 9: pganalyze.collector.FullSnapshot.database_informations:type_name -> pganalyze.collector.DatabaseInformation
+	10,  // 10: pganalyze.collector.FullSnapshot.settings:type_name -> pganalyze.collector.Setting
+	11,  // 11: pganalyze.collector.FullSnapshot.replication:type_name -> pganalyze.collector.Replication
+	15,  // 12: pganalyze.collector.FullSnapshot.backend_count_statistics:type_name -> pganalyze.collector.BackendCountStatistic
+	16,  // 13: pganalyze.collector.FullSnapshot.tablespace_references:type_name -> pganalyze.collector.TablespaceReference
+	17,  // 14: pganalyze.collector.FullSnapshot.tablespace_informations:type_name -> pganalyze.collector.TablespaceInformation
+	78,  // 15: pganalyze.collector.FullSnapshot.query_references:type_name -> pganalyze.collector.QueryReference
+	79,  // 16: pganalyze.collector.FullSnapshot.relation_references:type_name -> pganalyze.collector.RelationReference
+	80,  // 17: pganalyze.collector.FullSnapshot.index_references:type_name -> pganalyze.collector.IndexReference
+	81,  // 18: pganalyze.collector.FullSnapshot.function_references:type_name -> pganalyze.collector.FunctionReference
+	82,  // 19: pganalyze.collector.FullSnapshot.query_informations:type_name -> pganalyze.collector.QueryInformation
+	19,  // 20: pganalyze.collector.FullSnapshot.query_statistics:type_name -> pganalyze.collector.QueryStatistic
+	20,  // 21: pganalyze.collector.FullSnapshot.historic_query_statistics:type_name -> pganalyze.collector.HistoricQueryStatistics
+	83,  // 22: pganalyze.collector.FullSnapshot.query_explains:type_name -> pganalyze.collector.QueryExplainInformation
+	21,  // 23: pganalyze.collector.FullSnapshot.relation_informations:type_name -> pganalyze.collector.RelationInformation
+	22,  // 24: pganalyze.collector.FullSnapshot.relation_statistics:type_name -> pganalyze.collector.RelationStatistic
+	23,  // 25: pganalyze.collector.FullSnapshot.relation_events:type_name -> pganalyze.collector.RelationEvent
+	24,  // 26: pganalyze.collector.FullSnapshot.index_informations:type_name -> pganalyze.collector.IndexInformation
+	25,  // 27: pganalyze.collector.FullSnapshot.index_statistics:type_name -> pganalyze.collector.IndexStatistic
+	26,  // 28: pganalyze.collector.FullSnapshot.function_informations:type_name -> pganalyze.collector.FunctionInformation
+	27,  // 29: pganalyze.collector.FullSnapshot.function_statistics:type_name -> pganalyze.collector.FunctionStatistic
+	28,  // 30: pganalyze.collector.FullSnapshot.custom_type_informations:type_name -> pganalyze.collector.CustomTypeInformation
+	29,  // 31: pganalyze.collector.FullSnapshot.alloydb_information:type_name -> pganalyze.collector.AlloyDBInformation
+	31,  // 32: pganalyze.collector.FullSnapshot.citus_information:type_name -> pganalyze.collector.CitusInformation
+	32,  // 33: pganalyze.collector.FullSnapshot.timescale_hypertables:type_name -> pganalyze.collector.TimescaleHypertableInformation
+	33,  // 34: pganalyze.collector.FullSnapshot.timescale_continuous_aggregates:type_name -> pganalyze.collector.TimescaleContinuousAggregateInformation
+	34,  // 35: pganalyze.collector.FullSnapshot.patroni:type_name -> pganalyze.collector.PatroniInformation
+	35,  // 36: pganalyze.collector.FullSnapshot.pg_auto_failover:type_name -> pganalyze.collector.PgAutoFailoverInformation
+	36,  // 37: pganalyze.collector.FullSnapshot.pgbouncer:type_name -> pganalyze.collector.PgBouncerInformation
+	37,  // 38: pganalyze.collector.FullSnapshot.pgpool:type_name -> pganalyze.collector.PgpoolInformation
+	38,  // 39: pganalyze.collector.FullSnapshot.query_plan_references:type_name -> pganalyze.collector.QueryPlanReference
+	39,  // 40: pganalyze.collector.FullSnapshot.query_plan_informations:type_name -> pganalyze.collector.QueryPlanInformation
+	40,  // 41: pganalyze.collector.FullSnapshot.query_plan_statistics:type_name -> pganalyze.collector.QueryPlanStatistic
+	41,  // 42: pganalyze.collector.FullSnapshot.io_statistics:type_name -> pganalyze.collector.IOStatistic
+	42,  // 43: pganalyze.collector.FullSnapshot.query_wait_event_statistics:type_name -> pganalyze.collector.QueryWaitEventStatistic
+	43,  // 44: pganalyze.collector.FullSnapshot.slru_statistics:type_name -> pganalyze.collector.SLRUStatistic
+	44,  // 45: pganalyze.collector.FullSnapshot.wal_statistic:type_name -> pganalyze.collector.WALStatistic
+	45,  // 46: pganalyze.collector.FullSnapshot.query_temp_file_statistics:type_name -> pganalyze.collector.QueryTempFileStatistic
+	46,  // 47: pganalyze.collector.FullSnapshot.log_metrics_statistic:type_name -> pganalyze.collector.LogMetricsStatistic
+	30,  // 48: pganalyze.collector.CollectorStatistic.gcp_pubsub_statistics:type_name -> pganalyze.collector.GcpPubSubStatistic
+	84,  // 49: pganalyze.collector.RoleInformation.password_valid_until:type_name -> pganalyze.collector.NullTimestamp
+	85,  // 50: pganalyze.collector.Setting.unit:type_name -> pganalyze.collector.NullString
+	85,  // 51: pganalyze.collector.Setting.boot_value:type_name -> pganalyze.collector.NullString
+	85,  // 52: pganalyze.collector.Setting.reset_value:type_name -> pganalyze.collector.NullString
+	85,  // 53: pganalyze.collector.Setting.source:type_name -> pganalyze.collector.NullString
+	85,  // 54: pganalyze.collector.Setting.source_file:type_name -> pganalyze.collector.NullString
+	85,  // 55: pganalyze.collector.Setting.source_line:type_name -> pganalyze.collector.NullString
+	85,  // 56: pganalyze.collector.Setting.pending_value:type_name -> pganalyze.collector.NullString
+	85,  // 57: pganalyze.collector.Setting.provider_default_value:type_name -> pganalyze.collector.NullString
+	12,  // 58: pganalyze.collector.Replication.standby_references:type_name -> pganalyze.collector.StandbyReference
+	13,  // 59: pganalyze.collector.Replication.standby_informations:type_name -> pganalyze.collector.StandbyInformation
+	14,  // 60: pganalyze.collector.Replication.standby_statistics:type_name -> pganalyze.collector.StandbyStatistic
+	73,  // 61: pganalyze.collector.Replication.replay_timestamp:type_name -> google.protobuf.Timestamp
+	73,  // 62: pganalyze.collector.StandbyInformation.backend_start:type_name -> google.protobuf.Timestamp
+	0,   // 63: pganalyze.collector.BackendCountStatistic.state:type_name -> pganalyze.collector.BackendCountStatistic.BackendState
+	1,   // 64: pganalyze.collector.BackendCountStatistic.backend_type:type_name -> pganalyze.collector.BackendCountStatistic.BackendType
+	86,  // 65: pganalyze.collector.QueryStatistic.tags:type_name -> pganalyze.collector.QueryTag
+	73,  // 66: pganalyze.collector.HistoricQueryStatistics.collected_at:type_name -> google.protobuf.Timestamp
+	19,  // 67: pganalyze.collector.HistoricQueryStatistics.statistics:type_name -> pganalyze.collector.QueryStatistic
+	85,  // 68: pganalyze.collector.RelationInformation.view_definition:type_name -> pganalyze.collector.NullString
+	48,  // 69: pganalyze.collector.RelationInformation.columns:type_name -> pganalyze.collector.RelationInformation.Column
+	50,  // 70: pganalyze.collector.RelationInformation.constraints:type_name -> pganalyze.collector.RelationInformation.Constraint
+	47,  // 71: pganalyze.collector.RelationInformation.options:type_name -> pganalyze.collector.RelationInformation.OptionsEntry
+	2,   // 72: pganalyze.collector.RelationInformation.partition_strategy:type_name -> pganalyze.collector.RelationInformation.PartitionStrategy
+	51,  // 73: pganalyze.collector.RelationInformation.extended_statistics:type_name -> pganalyze.collector.RelationInformation.ExtendedStatistic
+	84,  // 74: pganalyze.collector.RelationStatistic.analyzed_at:type_name -> pganalyze.collector.NullTimestamp
+	3,   // 75: pganalyze.collector.RelationEvent.type:type_name -> pganalyze.collector.RelationEvent.EventType
+	73,  // 76: pganalyze.collector.RelationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	85,  // 77: pganalyze.collector.IndexInformation.constraint_def:type_name -> pganalyze.collector.NullString
+	4,   // 78: pganalyze.collector.FunctionInformation.kind:type_name -> pganalyze.collector.FunctionInformation.FunctionKind
+	5,   // 79: pganalyze.collector.CustomTypeInformation.type:type_name -> pganalyze.collector.CustomTypeInformation.Type
+	52,  // 80: pganalyze.collector.CustomTypeInformation.composite_attrs:type_name -> pganalyze.collector.CustomTypeInformation.CompositeAttr
+	53,  // 81: pganalyze.collector.AlloyDBInformation.columnar_relations:type_name -> pganalyze.collector.AlloyDBInformation.ColumnarRelation
+	54,  // 82: pganalyze.collector.AlloyDBInformation.columnar_recommended_columns:type_name -> pganalyze.collector.AlloyDBInformation.ColumnarColumn
+	55,  // 83: pganalyze.collector.CitusInformation.nodes:type_name -> pganalyze.collector.CitusInformation.Node
+	56,  // 84: pganalyze.collector.CitusInformation.distributed_tables:type_name -> pganalyze.collector.CitusInformation.DistributedTable
+	57,  // 85: pganalyze.collector.CitusInformation.distributed_backends:type_name -> pganalyze.collector.CitusInformation.DistributedBackend
+	58,  // 86: pganalyze.collector.CitusInformation.distributed_statements:type_name -> pganalyze.collector.CitusInformation.DistributedStatement
+	59,  // 87: pganalyze.collector.CitusInformation.shard_placements:type_name -> pganalyze.collector.CitusInformation.ShardPlacement
+	60,  // 88: pganalyze.collector.CitusInformation.rebalance_progress:type_name -> pganalyze.collector.CitusInformation.RebalanceMove
+	73,  // 89: pganalyze.collector.TimescaleContinuousAggregateInformation.last_run_started_at:type_name -> google.protobuf.Timestamp
+	73,  // 90: pganalyze.collector.TimescaleContinuousAggregateInformation.last_successful_finish:type_name -> google.protobuf.Timestamp
+	73,  // 91: pganalyze.collector.TimescaleContinuousAggregateInformation.next_start:type_name -> google.protobuf.Timestamp
+	61,  // 92: pganalyze.collector.PatroniInformation.members:type_name -> pganalyze.collector.PatroniInformation.Member
+	62,  // 93: pganalyze.collector.PatroniInformation.history:type_name -> pganalyze.collector.PatroniInformation.TimelineChange
+	63,  // 94: pganalyze.collector.PgAutoFailoverInformation.nodes:type_name -> pganalyze.collector.PgAutoFailoverInformation.Node
+	64,  // 95: pganalyze.collector.PgAutoFailoverInformation.events:type_name -> pganalyze.collector.PgAutoFailoverInformation.Event
+	65,  // 96: pganalyze.collector.PgBouncerInformation.database_statistics:type_name -> pganalyze.collector.PgBouncerInformation.DatabaseStatistic
+	66,  // 97: pganalyze.collector.PgBouncerInformation.pools:type_name -> pganalyze.collector.PgBouncerInformation.Pool
+	67,  // 98: pganalyze.collector.PgBouncerInformation.client_counts:type_name -> pganalyze.collector.PgBouncerInformation.ClientCount
+	68,  // 99: pganalyze.collector.PgBouncerInformation.lists:type_name -> pganalyze.collector.PgBouncerInformation.ListItem
+	69,  // 100: pganalyze.collector.PgpoolInformation.nodes:type_name -> pganalyze.collector.PgpoolInformation.Node
+	70,  // 101: pganalyze.collector.PgpoolInformation.connected_processes:type_name -> pganalyze.collector.PgpoolInformation.ProcessCount
+	71,  // 102: pganalyze.collector.PgpoolInformation.query_cache:type_name -> pganalyze.collector.PgpoolInformation.QueryCache
+	73,  // 103: pganalyze.collector.LogMetricsStatistic.counted_since:type_name -> google.protobuf.Timestamp
+	72,  // 104: pganalyze.collector.LogMetricsStatistic.logins:type_name -> pganalyze.collector.LogMetricsStatistic.LoginCount
+	85,  // 105: pganalyze.collector.RelationInformation.Column.default_value:type_name -> pganalyze.collector.NullString
+	49,  // 106: pganalyze.collector.RelationInformation.Column.statistics:type_name -> pganalyze.collector.RelationInformation.ColumnStatistic
+	87,  // 107: pganalyze.collector.RelationInformation.Column.data_type_custom_idx:type_name -> pganalyze.collector.NullInt32
+	88,  // 108: pganalyze.collector.RelationInformation.ColumnStatistic.correlation:type_name -> pganalyze.collector.NullDouble
+	73,  // 109: pganalyze.collector.CitusInformation.DistributedBackend.query_start:type_name -> google.protobuf.Timestamp
+	73,  // 110: pganalyze.collector.PatroniInformation.TimelineChange.changed_at:type_name -> google.protobuf.Timestamp
+	73,  // 111: pganalyze.collector.PgAutoFailoverInformation.Node.report_time:type_name -> google.protobuf.Timestamp
+	73,  // 112: pganalyze.collector.PgAutoFailoverInformation.Node.state_change_time:type_name -> google.protobuf.Timestamp
+	73,  // 113: pganalyze.collector.PgAutoFailoverInformation.Event.event_time:type_name -> google.protobuf.Timestamp
+	114, // [114:114] is the sub-list for method output_type
+	114, // [114:114] is the sub-list for method input_type
+	114, // [114:114] is the sub-list for extension type_name
+	114, // [114:114] is the sub-list for extension extendee
+	0,   // [0:114] is the sub-list for field type_name
 }
 
 func init() { file_full_snapshot_proto_init() }
@@ -5105,8 +10044,248 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectorStatistic); i {
+		file_full_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Replication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandbyReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandbyInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandbyStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendCountStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TablespaceReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TablespaceInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricQueryStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5117,8 +10296,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleInformation); i {
+		file_full_snapshot_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomTypeInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5129,8 +10308,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DatabaseInformation); i {
+		file_full_snapshot_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlloyDBInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5141,8 +10320,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Setting); i {
+		file_full_snapshot_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcpPubSubStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5153,8 +10332,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Replication); i {
+		file_full_snapshot_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5165,8 +10344,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StandbyReference); i {
+		file_full_snapshot_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimescaleHypertableInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5177,8 +10356,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StandbyInformation); i {
+		file_full_snapshot_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimescaleContinuousAggregateInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5189,8 +10368,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StandbyStatistic); i {
+		file_full_snapshot_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatroniInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5201,8 +10380,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendCountStatistic); i {
+		file_full_snapshot_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgAutoFailoverInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5213,8 +10392,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TablespaceReference); i {
+		file_full_snapshot_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgBouncerInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5225,8 +10404,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TablespaceInformation); i {
+		file_full_snapshot_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgpoolInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5237,8 +10416,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectorConfig); i {
+		file_full_snapshot_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPlanReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5249,8 +10428,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryStatistic); i {
+		file_full_snapshot_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPlanInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5261,8 +10440,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricQueryStatistics); i {
+		file_full_snapshot_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPlanStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5273,8 +10452,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationInformation); i {
+		file_full_snapshot_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IOStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5285,8 +10464,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationStatistic); i {
+		file_full_snapshot_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryWaitEventStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5297,8 +10476,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationEvent); i {
+		file_full_snapshot_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLRUStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5309,8 +10488,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexInformation); i {
+		file_full_snapshot_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WALStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5321,8 +10500,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexStatistic); i {
+		file_full_snapshot_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTempFileStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5333,8 +10512,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FunctionInformation); i {
+		file_full_snapshot_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogMetricsStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5345,8 +10524,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FunctionStatistic); i {
+		file_full_snapshot_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationInformation_Column); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5357,8 +10536,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomTypeInformation); i {
+		file_full_snapshot_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationInformation_ColumnStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5369,8 +10548,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationInformation_Column); i {
+		file_full_snapshot_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationInformation_Constraint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5381,8 +10560,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationInformation_ColumnStatistic); i {
+		file_full_snapshot_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationInformation_ExtendedStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5393,8 +10572,8 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationInformation_Constraint); i {
+		file_full_snapshot_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomTypeInformation_CompositeAttr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5405,8 +10584,236 @@ func file_full_snapshot_proto_init() {
 				return nil
 			}
 		}
-		file_full_snapshot_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomTypeInformation_CompositeAttr); i {
+		file_full_snapshot_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlloyDBInformation_ColumnarRelation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlloyDBInformation_ColumnarColumn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation_DistributedTable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation_DistributedBackend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation_DistributedStatement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation_ShardPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitusInformation_RebalanceMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatroniInformation_Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatroniInformation_TimelineChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgAutoFailoverInformation_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgAutoFailoverInformation_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgBouncerInformation_DatabaseStatistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgBouncerInformation_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgBouncerInformation_ClientCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgBouncerInformation_ListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgpoolInformation_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgpoolInformation_ProcessCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PgpoolInformation_QueryCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_full_snapshot_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogMetricsStatistic_LoginCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5424,7 +10831,7 @@ func file_full_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_full_snapshot_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   28,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
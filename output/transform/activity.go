@@ -14,9 +14,19 @@ func ActivityStateToCompactActivitySnapshot(server *state.Server, activityState
 		s.PrevActivitySnapshotAt, _ = ptypes.TimestampProto(server.ActivityPrevState.ActivitySnapshotAt)
 	}
 
+	backendMemory := make(map[uint64]state.PostgresBackendMemory)
+	for _, m := range activityState.BackendMemory {
+		backendMemory[m.Identity] = m
+	}
+
 	for _, backend := range activityState.Backends {
 		b := transformBackendWithoutRefs(backend)
 
+		if m, ok := backendMemory[backend.Identity]; ok {
+			b.PrivateMemoryBytes = m.PrivateBytes
+			b.MemoryContextsLogged = m.ContextsLogged
+		}
+
 		if backend.RoleName.Valid {
 			b.RoleIdx, r.RoleReferences = upsertRoleReference(r.RoleReferences, backend.RoleName.String)
 			b.HasRoleIdx = true
@@ -50,6 +60,8 @@ func ActivityStateToCompactActivitySnapshot(server *state.Server, activityState
 			BackendIdentity: vacuum.BackendIdentity,
 			Autovacuum:      vacuum.Autovacuum,
 			Toast:           vacuum.Toast,
+			AntiWraparound:  vacuum.AntiWraparound,
+			Pid:             vacuum.Pid,
 		}
 
 		if vacuum.RoleName != "" {
@@ -78,6 +90,13 @@ func ActivityStateToCompactActivitySnapshot(server *state.Server, activityState
 				IndexVacuumCount: vacuum.IndexVacuumCount,
 				MaxDeadTuples:    vacuum.MaxDeadTuples,
 				NumDeadTuples:    vacuum.NumDeadTuples,
+
+				WaitEventType:     vacuum.WaitEventType,
+				WaitEvent:         vacuum.WaitEvent,
+				MaxDeadTupleBytes: vacuum.MaxDeadTupleBytes,
+				DeadTupleBytes:    vacuum.DeadTupleBytes,
+				IndexesTotal:      vacuum.IndexesTotal,
+				IndexesProcessed:  vacuum.IndexesProcessed,
 			}
 
 			switch vacuum.Phase {
@@ -101,5 +120,118 @@ func ActivityStateToCompactActivitySnapshot(server *state.Server, activityState
 		}
 	}
 
+	for _, progress := range activityState.Progress {
+		progressInfo := snapshot.MaintenanceProgressInformation{
+			Pid:         progress.Pid,
+			Command:     progress.Command,
+			DatabaseIdx: -1,
+			RelationIdx: -1,
+			Phase:       progress.Phase,
+			BlocksTotal: progress.BlocksTotal,
+			BlocksDone:  progress.BlocksDone,
+			TuplesTotal: progress.TuplesTotal,
+			TuplesDone:  progress.TuplesDone,
+			BytesTotal:  progress.BytesTotal,
+			BytesDone:   progress.BytesDone,
+		}
+		if progress.DatabaseName != "" {
+			progressInfo.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, progress.DatabaseName)
+			if progress.RelationName != "" {
+				progressInfo.RelationIdx, r.RelationReferences = upsertRelationReference(r.RelationReferences, progressInfo.DatabaseIdx, progress.SchemaName, progress.RelationName)
+			}
+		}
+		s.MaintenanceProgress = append(s.MaintenanceProgress, &progressInfo)
+	}
+
+	for _, lock := range activityState.BlockingLocks {
+		lockInfo := snapshot.BlockingLockInformation{
+			BlockedPid:  lock.BlockedPid,
+			BlockingPid: lock.BlockingPid,
+			LockType:    lock.LockType,
+			Mode:        lock.Mode,
+			DatabaseIdx: -1,
+			RelationIdx: -1,
+		}
+		if lock.DatabaseName != "" {
+			lockInfo.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, lock.DatabaseName)
+			if lock.RelationName != "" {
+				lockInfo.RelationIdx, r.RelationReferences = upsertRelationReference(r.RelationReferences, lockInfo.DatabaseIdx, lock.SchemaName, lock.RelationName)
+			}
+		}
+		if lock.WaitingSince.Valid {
+			lockInfo.WaitingSince, _ = ptypes.TimestampProto(lock.WaitingSince.Time)
+		}
+		s.BlockingLocks = append(s.BlockingLocks, &lockInfo)
+	}
+
+	for _, transaction := range activityState.LongTransactions {
+		transactionInfo := snapshot.LongTransactionInformation{
+			Pid:               transaction.Pid,
+			DatabaseIdx:       -1,
+			RoleIdx:           -1,
+			ApplicationName:   transaction.ApplicationName,
+			ClientAddr:        transaction.ClientAddr,
+			BackendType:       transaction.BackendType,
+			State:             transaction.State,
+			IdleSeconds:       transaction.IdleTime.Seconds(),
+			XminAge:           transaction.XminAge,
+			IdleInTransaction: transaction.IdleInTransaction,
+		}
+		if transaction.DatabaseName != "" {
+			transactionInfo.DatabaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, transaction.DatabaseName)
+		}
+		if transaction.RoleName != "" {
+			transactionInfo.RoleIdx, r.RoleReferences = upsertRoleReference(r.RoleReferences, transaction.RoleName)
+		}
+		if transaction.Query != "" && transactionInfo.DatabaseIdx != -1 && transactionInfo.RoleIdx != -1 {
+			transactionInfo.QueryIdx, r.QueryReferences, r.QueryInformations = upsertQueryReferenceAndInformationSimple(
+				server,
+				r.QueryReferences,
+				r.QueryInformations,
+				transactionInfo.RoleIdx,
+				transactionInfo.DatabaseIdx,
+				transaction.Query,
+				activityState.TrackActivityQuerySize,
+			)
+			transactionInfo.HasQueryIdx = true
+		}
+		transactionInfo.XactStart, _ = ptypes.TimestampProto(transaction.XactStart)
+		if transaction.BackendXmin.Valid {
+			transactionInfo.BackendXmin = transaction.BackendXmin.Int64
+			transactionInfo.HasBackendXmin = true
+		}
+		s.LongTransactions = append(s.LongTransactions, &transactionInfo)
+	}
+
+	if activityState.Samples.SampleCount > 0 {
+		s.ActivitySamples = transformActivitySamples(server, activityState.Samples, &r)
+	}
+
+	if activityState.PerformanceInsights != nil {
+		s.PerformanceInsights = transformPerformanceInsights(*activityState.PerformanceInsights)
+	}
+
 	return s, r
 }
+
+func transformPerformanceInsights(pi state.AmazonRdsPerformanceInsights) *snapshot.PerformanceInsightsInformation {
+	info := snapshot.PerformanceInsightsInformation{}
+	info.StartTime, _ = ptypes.TimestampProto(pi.StartTime)
+	info.EndTime, _ = ptypes.TimestampProto(pi.EndTime)
+
+	for _, sample := range pi.LoadSamples {
+		loadSample := snapshot.PerformanceInsightsInformation_LoadSample{LoadAvg: sample.LoadAvg}
+		loadSample.Time, _ = ptypes.TimestampProto(sample.Time)
+		info.LoadSamples = append(info.LoadSamples, &loadSample)
+	}
+
+	for _, waitEvent := range pi.WaitEvents {
+		info.WaitEventLoads = append(info.WaitEventLoads, &snapshot.PerformanceInsightsInformation_WaitEventLoad{
+			WaitEventType: waitEvent.WaitEventType,
+			WaitEvent:     waitEvent.WaitEvent,
+			LoadAvg:       waitEvent.LoadAvg,
+		})
+	}
+
+	return &info
+}
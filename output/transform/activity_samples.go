@@ -0,0 +1,105 @@
+package transform
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformActivitySamples(server *state.Server, samples state.ActivitySamples, r *snapshot.CompactSnapshot_BaseRefs) *snapshot.ActivitySampleInformation {
+	info := snapshot.ActivitySampleInformation{
+		SampleCount:    int32(samples.SampleCount),
+		MaxLockWaiters: samples.MaxLockWaiters,
+	}
+	info.FirstSampleAt, _ = ptypes.TimestampProto(samples.FirstAt)
+	info.LastSampleAt, _ = ptypes.TimestampProto(samples.LastAt)
+	if !samples.MaxLockWaitersAt.IsZero() {
+		info.MaxLockWaitersAt, _ = ptypes.TimestampProto(samples.MaxLockWaitersAt)
+	}
+
+	keys := make([]state.ActivitySampleKey, 0, len(samples.Counts))
+	for key := range samples.Counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].State != keys[j].State {
+			return keys[i].State < keys[j].State
+		}
+		if keys[i].WaitEventType != keys[j].WaitEventType {
+			return keys[i].WaitEventType < keys[j].WaitEventType
+		}
+		return keys[i].WaitEvent < keys[j].WaitEvent
+	})
+	for _, key := range keys {
+		info.BackendCounts = append(info.BackendCounts, &snapshot.ActivitySampleInformation_BackendCount{
+			State:         key.State,
+			WaitEventType: key.WaitEventType,
+			WaitEvent:     key.WaitEvent,
+			Sum:           samples.Counts[key].Sum,
+			Max:           samples.Counts[key].Max,
+		})
+	}
+
+	waitKeys := make([]state.ActivityWaitEventKey, 0, len(samples.WaitEvents))
+	for key := range samples.WaitEvents {
+		waitKeys = append(waitKeys, key)
+	}
+	sort.Slice(waitKeys, func(i, j int) bool {
+		if samples.WaitEvents[waitKeys[i]] != samples.WaitEvents[waitKeys[j]] {
+			return samples.WaitEvents[waitKeys[i]] > samples.WaitEvents[waitKeys[j]]
+		}
+		a, b := waitKeys[i], waitKeys[j]
+		if a.Fingerprint != b.Fingerprint {
+			return a.Fingerprint < b.Fingerprint
+		}
+		if a.DatabaseName != b.DatabaseName {
+			return a.DatabaseName < b.DatabaseName
+		}
+		if a.RoleName != b.RoleName {
+			return a.RoleName < b.RoleName
+		}
+		if a.BackendType != b.BackendType {
+			return a.BackendType < b.BackendType
+		}
+		if a.WaitEventType != b.WaitEventType {
+			return a.WaitEventType < b.WaitEventType
+		}
+		return a.WaitEvent < b.WaitEvent
+	})
+	for _, key := range waitKeys {
+		waitEventCount := snapshot.ActivitySampleInformation_WaitEventCount{
+			BackendType:   key.BackendType,
+			WaitEventType: key.WaitEventType,
+			WaitEvent:     key.WaitEvent,
+			Count:         samples.WaitEvents[key],
+		}
+		if key.Fingerprint != 0 {
+			roleName := key.RoleName
+			if roleName == "" {
+				roleName = server.Config.GetDbUsername()
+			}
+			databaseName := key.DatabaseName
+			if databaseName == "" {
+				databaseName = server.Config.GetDbName()
+			}
+			var roleIdx, databaseIdx int32
+			roleIdx, r.RoleReferences = upsertRoleReference(r.RoleReferences, roleName)
+			databaseIdx, r.DatabaseReferences = upsertDatabaseReference(r.DatabaseReferences, databaseName)
+			fingerprint := key.Fingerprint
+			waitEventCount.QueryIdx, r.QueryReferences, r.QueryInformations = upsertQueryReferenceAndInformationFingerprint(
+				r.QueryReferences,
+				r.QueryInformations,
+				roleIdx,
+				databaseIdx,
+				fingerprint,
+				func() string { return samples.QueryTexts[fingerprint] },
+			)
+			waitEventCount.HasQueryIdx = true
+		}
+		info.WaitEventCounts = append(info.WaitEventCounts, &waitEventCount)
+	}
+
+	return &info
+}
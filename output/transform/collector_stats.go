@@ -15,5 +15,16 @@ func transformCollectorStats(s snapshot.FullSnapshot, newState state.PersistedSt
 		ActiveGoroutines:         diffState.CollectorStats.ActiveGoroutines,
 		CgoCalls:                 diffState.CollectorStats.CgoCalls,
 	}
+	for _, stats := range diffState.CollectorStats.GcpPubSub {
+		s.CollectorStatistic.GcpPubsubStatistics = append(s.CollectorStatistic.GcpPubsubStatistics, &snapshot.GcpPubSubStatistic{
+			Subscription:        stats.Subscription,
+			MessagesReceived:    stats.MessagesReceived,
+			MessagesFiltered:    stats.MessagesFiltered,
+			MessagesUnparseable: stats.MessagesUnparseable,
+			MessagesDuplicate:   stats.MessagesDuplicate,
+			LinesDelivered:      stats.LinesDelivered,
+			BacklogAgeSeconds:   stats.BacklogAge.Seconds(),
+		})
+	}
 	return s
 }
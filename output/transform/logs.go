@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/pganalyze/collector/config"
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
 	uuid "github.com/satori/go.uuid"
@@ -68,6 +69,7 @@ func transformPostgresQuerySamples(server *state.Server, s snapshot.CompactLogSn
 			QueryText:        sampleIn.Query,
 			Parameters:       parameters,
 			ParametersLegacy: parametersLegacy,
+			Tags:             transformQueryTags(sampleIn.Tags),
 
 			HasExplain:    sampleIn.HasExplain,
 			ExplainSource: sampleIn.ExplainSource,
@@ -82,6 +84,10 @@ func transformPostgresQuerySamples(server *state.Server, s snapshot.CompactLogSn
 }
 
 func transformSystemLogs(server *state.Server, s snapshot.CompactLogSnapshot, r snapshot.CompactSnapshot_BaseRefs, logState state.TransientLogState) (snapshot.CompactLogSnapshot, snapshot.CompactSnapshot_BaseRefs) {
+	server.CollectionStatusMutex.Lock()
+	queries := queryIDLookup{fingerprints: server.CollectionStatus.QueryFingerprints, texts: server.CollectionStatus.QueryTexts}
+	server.CollectionStatusMutex.Unlock()
+
 	for _, logFileIn := range logState.LogFiles {
 		fileIdx := int32(len(s.LogFileReferences))
 		logFileReference := &snapshot.LogFileReference{
@@ -112,7 +118,7 @@ func transformSystemLogs(server *state.Server, s snapshot.CompactLogSnapshot, r
 		}
 		s.LogFileReferences = append(s.LogFileReferences, logFileReference)
 		for _, logLineIn := range logFileIn.LogLines {
-			logLine := transformSystemLogLine(server, &r, fileIdx, logLineIn)
+			logLine := transformSystemLogLine(server, &r, fileIdx, logLineIn, queries)
 			s.LogLineInformations = append(s.LogLineInformations, &logLine)
 		}
 	}
@@ -120,7 +126,13 @@ func transformSystemLogs(server *state.Server, s snapshot.CompactLogSnapshot, r
 	return s, r
 }
 
-func transformSystemLogLine(server *state.Server, r *snapshot.CompactSnapshot_BaseRefs, logFileIdx int32, logLineIn state.LogLine) snapshot.LogLineInformation {
+// queryIDLookup - Fingerprints and normalized query texts of the pg_stat_statements entries by query ID
+type queryIDLookup struct {
+	fingerprints map[int64]uint64
+	texts        state.PostgresStatementTextMap
+}
+
+func transformSystemLogLine(server *state.Server, r *snapshot.CompactSnapshot_BaseRefs, logFileIdx int32, logLineIn state.LogLine, queries queryIDLookup) snapshot.LogLineInformation {
 	occurredAt, _ := ptypes.TimestampProto(logLineIn.OccurredAt)
 
 	logLine := snapshot.LogLineInformation{
@@ -141,7 +153,7 @@ func transformSystemLogLine(server *state.Server, r *snapshot.CompactSnapshot_Ba
 	}
 
 	if logLineIn.Details != nil {
-		detailsJson, err := json.Marshal(logLineIn.Details)
+		detailsJson, err := json.Marshal(redactLogLineDetails(logLineIn.Details, server.Config.FilterLogRedactRules))
 		if err == nil {
 			logLine.DetailsJson = string(detailsJson)
 		}
@@ -161,7 +173,20 @@ func transformSystemLogLine(server *state.Server, r *snapshot.CompactSnapshot_Ba
 		}
 	}
 
-	if logLine.HasRoleIdx && logLine.HasDatabaseIdx && logLineIn.Query != "" {
+	// Prefer the query ID (when known) over fingerprinting the query text, since it matches the
+	// query statistics exactly, and is also present on log lines without the query text
+	fingerprint, hasFingerprint := queries.fingerprints[logLineIn.QueryID]
+	if logLine.HasRoleIdx && logLine.HasDatabaseIdx && logLineIn.QueryID != 0 && hasFingerprint {
+		logLine.QueryIdx, r.QueryReferences, r.QueryInformations = upsertQueryReferenceAndInformationFingerprint(
+			r.QueryReferences,
+			r.QueryInformations,
+			logLine.RoleIdx,
+			logLine.DatabaseIdx,
+			fingerprint,
+			func() string { return queries.texts[fingerprint] },
+		)
+		logLine.HasQueryIdx = true
+	} else if logLine.HasRoleIdx && logLine.HasDatabaseIdx && logLineIn.Query != "" {
 		logLine.QueryIdx, r.QueryReferences, r.QueryInformations = upsertQueryReferenceAndInformationSimple(
 			server,
 			r.QueryReferences,
@@ -176,3 +201,29 @@ func transformSystemLogLine(server *state.Server, r *snapshot.CompactSnapshot_Ba
 
 	return logLine
 }
+
+// redactLogLineDetails - Applies the redaction rules to the text values of the log line details,
+// since these are extracted from the (redacted) log line contents
+func redactLogLineDetails(details map[string]interface{}, rules []config.LogRedactRule) map[string]interface{} {
+	if len(rules) == 0 {
+		return details
+	}
+	redacted := make(map[string]interface{}, len(details))
+	for key, value := range details {
+		switch v := value.(type) {
+		case string:
+			for _, rule := range rules {
+				v = rule.Regexp.ReplaceAllString(v, rule.Replacement)
+			}
+			value = v
+		case []map[string]interface{}: // e.g. deadlock participants
+			values := make([]map[string]interface{}, len(v))
+			for idx, nested := range v {
+				values[idx] = redactLogLineDetails(nested, rules)
+			}
+			value = values
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
@@ -16,9 +16,22 @@ func transformPostgres(s snapshot.FullSnapshot, newState state.PersistedState, d
 	s = transformPostgresConfig(s, transientState)
 	s = transformPostgresReplication(s, transientState, roleOidToIdx)
 	s = transformPostgresStatements(s, newState, diffState, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresWaitEvents(s, diffState)
 	s = transformPostgresRelations(s, newState, diffState, databaseOidToIdx, typeOidToIdx)
 	s = transformPostgresFunctions(s, newState, diffState, roleOidToIdx, databaseOidToIdx)
 	s = transformPostgresBackendCounts(s, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresStatIO(s, diffState)
+	s = transformPostgresStatSLRU(s, diffState)
+	s = transformPostgresStatWAL(s, diffState)
+	s = transformPostgresTempFiles(s, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresLogMetrics(s, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresAlloyDB(s, transientState)
+	s = transformPostgresCitus(s, transientState, roleOidToIdx, databaseOidToIdx)
+	s = transformPostgresPatroni(s, transientState)
+	s = transformPostgresPgAutoFailover(s, transientState)
+	s = transformPostgresPgBouncer(s, diffState, transientState)
+	s = transformPostgresPgpool(s, transientState)
+	s = transformPostgresTimescale(s, transientState, databaseOidToIdx)
 
 	return s
 }
@@ -122,6 +135,13 @@ func transformPostgresConfig(s snapshot.FullSnapshot, transientState state.Trans
 		if setting.SourceLine.Valid {
 			info.SourceLine = &snapshot.NullString{Valid: true, Value: setting.SourceLine.String}
 		}
+		info.PendingRestart = setting.PendingRestart
+		if setting.PendingValue.Valid {
+			info.PendingValue = &snapshot.NullString{Valid: true, Value: setting.PendingValue.String}
+		}
+		if setting.ProviderDefaultValue.Valid {
+			info.ProviderDefaultValue = &snapshot.NullString{Valid: true, Value: setting.ProviderDefaultValue.String}
+		}
 
 		s.Settings = append(s.Settings, &info)
 	}
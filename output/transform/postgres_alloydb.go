@@ -0,0 +1,48 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresAlloyDB(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if transientState.AlloyDB == nil {
+		return s
+	}
+
+	info := snapshot.AlloyDBInformation{
+		InstanceRole:          transientState.AlloyDB.InstanceRole,
+		ColumnarEngineEnabled: transientState.AlloyDB.ColumnarEngineEnabled,
+	}
+
+	for _, relation := range transientState.AlloyDB.ColumnarRelations {
+		r := snapshot.AlloyDBInformation_ColumnarRelation{
+			Status:            relation.Status,
+			SizeBytes:         relation.SizeBytes,
+			InvalidBlockCount: -1,
+			TotalBlockCount:   -1,
+		}
+		var databaseIdx int32
+		databaseIdx, s.DatabaseReferences = upsertDatabaseReference(s.DatabaseReferences, relation.DatabaseName)
+		r.RelationIdx, s.RelationReferences = upsertRelationReference(s.RelationReferences, databaseIdx, relation.SchemaName, relation.RelationName)
+		if relation.InvalidBlockCount.Valid {
+			r.InvalidBlockCount = relation.InvalidBlockCount.Int64
+		}
+		if relation.TotalBlockCount.Valid {
+			r.TotalBlockCount = relation.TotalBlockCount.Int64
+		}
+		info.ColumnarRelations = append(info.ColumnarRelations, &r)
+	}
+
+	for _, column := range transientState.AlloyDB.ColumnarRecommendedColumns {
+		c := snapshot.AlloyDBInformation_ColumnarColumn{ColumnName: column.ColumnName}
+		var databaseIdx int32
+		databaseIdx, s.DatabaseReferences = upsertDatabaseReference(s.DatabaseReferences, column.DatabaseName)
+		c.RelationIdx, s.RelationReferences = upsertRelationReference(s.RelationReferences, databaseIdx, column.SchemaName, column.RelationName)
+		info.ColumnarRecommendedColumns = append(info.ColumnarRecommendedColumns, &c)
+	}
+
+	s.AlloydbInformation = &info
+
+	return s
+}
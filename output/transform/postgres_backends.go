@@ -1,6 +1,9 @@
 package transform
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/golang/protobuf/ptypes"
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
@@ -56,5 +59,30 @@ func transformBackendWithoutRefs(backend state.PostgresBackend) snapshot.Backend
 		b.BackendType = backend.BackendType.String
 	}
 
+	b.QueryProtocol = backendQueryProtocol(backend)
+	b.QueryFullText = backend.QueryFullText
+
 	return b
 }
+
+var queryParamRegexp = regexp.MustCompile(`\$\d+`)
+
+// backendQueryProtocol - Determines whether a client backend last ran a query with bind parameters
+// (extended protocol), as a SQL-level PREPARE/EXECUTE, or as a plain query (simple protocol)
+//
+// pg_prepared_statements only shows the prepared statements of the current session, so session-level
+// prepares of other connections can only be spotted through the EXECUTE/PREPARE calls seen here.
+func backendQueryProtocol(backend state.PostgresBackend) snapshot.Backend_QueryProtocol {
+	if !backend.Query.Valid || (backend.BackendType.Valid && backend.BackendType.String != "client backend") {
+		return snapshot.Backend_UNKNOWN_QUERY_PROTOCOL
+	}
+	query := strings.ToUpper(strings.TrimSpace(backend.Query.String))
+	if strings.HasPrefix(query, "EXECUTE ") || strings.HasPrefix(query, "PREPARE ") {
+		return snapshot.Backend_SQL_PREPARED_QUERY_PROTOCOL
+	} else if queryParamRegexp.MatchString(query) {
+		return snapshot.Backend_EXTENDED_QUERY_PROTOCOL
+	} else if query != "" && query != "<INSUFFICIENT PRIVILEGE>" {
+		return snapshot.Backend_SIMPLE_QUERY_PROTOCOL
+	}
+	return snapshot.Backend_UNKNOWN_QUERY_PROTOCOL
+}
@@ -0,0 +1,120 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresCitus(s snapshot.FullSnapshot, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	if transientState.Citus == nil {
+		return s
+	}
+
+	info := snapshot.CitusInformation{}
+
+	for _, node := range transientState.Citus.Nodes {
+		info.Nodes = append(info.Nodes, &snapshot.CitusInformation_Node{
+			NodeId:           node.NodeID,
+			GroupId:          node.GroupID,
+			NodeName:         node.NodeName,
+			NodePort:         node.NodePort,
+			NodeRole:         node.NodeRole,
+			IsActive:         node.IsActive,
+			ShouldHaveShards: node.ShouldHaveShards,
+			ShardCount:       node.ShardCount,
+			ShardSizeBytes:   node.ShardSizeBytes,
+		})
+	}
+
+	for _, table := range transientState.Citus.DistributedTables {
+		t := snapshot.CitusInformation_DistributedTable{
+			TableType:      table.TableType,
+			ColocationId:   table.ColocationID,
+			ShardCount:     table.ShardCount,
+			TableSizeBytes: table.TableSizeBytes,
+		}
+		var databaseIdx int32
+		databaseIdx, s.DatabaseReferences = upsertDatabaseReference(s.DatabaseReferences, transientState.Citus.DatabaseName)
+		t.RelationIdx, s.RelationReferences = upsertRelationReference(s.RelationReferences, databaseIdx, table.SchemaName, table.RelationName)
+		if table.DistributionColumn.Valid {
+			t.HasDistributionColumn = true
+			t.DistributionColumn = table.DistributionColumn.String
+		}
+		info.DistributedTables = append(info.DistributedTables, &t)
+	}
+
+	for _, backend := range transientState.Citus.DistributedActivity {
+		b := snapshot.CitusInformation_DistributedBackend{
+			NodeName:        backend.NodeName,
+			NodePort:        backend.NodePort,
+			Pid:             backend.Pid,
+			GlobalPid:       backend.GlobalPid.Int64,
+			ApplicationName: backend.ApplicationName.String,
+			State:           backend.State.String,
+			WaitEventType:   backend.WaitEventType.String,
+			WaitEvent:       backend.WaitEvent.String,
+		}
+		if backend.RoleName.Valid {
+			b.RoleIdx, s.RoleReferences = upsertRoleReference(s.RoleReferences, backend.RoleName.String)
+			b.HasRoleIdx = true
+		}
+		if backend.DatabaseName.Valid {
+			b.DatabaseIdx, s.DatabaseReferences = upsertDatabaseReference(s.DatabaseReferences, backend.DatabaseName.String)
+			b.HasDatabaseIdx = true
+		}
+		if backend.QueryStart.Valid {
+			b.QueryStart, _ = ptypes.TimestampProto(backend.QueryStart.Time)
+		}
+		info.DistributedBackends = append(info.DistributedBackends, &b)
+	}
+
+	for _, statement := range transientState.Citus.DistributedStatements {
+		st := snapshot.CitusInformation_DistributedStatement{
+			QueryId:     statement.QueryID,
+			RoleIdx:     roleOidToIdx[statement.UserOid],
+			DatabaseIdx: databaseOidToIdx[statement.DatabaseOid],
+			Executor:    statement.Executor,
+			Calls:       statement.Calls,
+		}
+		if statement.PartitionKey.Valid {
+			st.HasPartitionKey = true
+			st.PartitionKey = statement.PartitionKey.String
+		}
+		info.DistributedStatements = append(info.DistributedStatements, &st)
+	}
+
+	for _, placement := range transientState.Citus.ShardPlacements {
+		p := snapshot.CitusInformation_ShardPlacement{
+			ShardId:   placement.ShardID,
+			NodeName:  placement.NodeName,
+			NodePort:  placement.NodePort,
+			SizeBytes: placement.SizeBytes,
+		}
+		var databaseIdx int32
+		databaseIdx, s.DatabaseReferences = upsertDatabaseReference(s.DatabaseReferences, transientState.Citus.DatabaseName)
+		p.RelationIdx, s.RelationReferences = upsertRelationReference(s.RelationReferences, databaseIdx, placement.SchemaName, placement.RelationName)
+		info.ShardPlacements = append(info.ShardPlacements, &p)
+	}
+
+	for _, move := range transientState.Citus.RebalanceProgress {
+		m := snapshot.CitusInformation_RebalanceMove{
+			SessionId:  move.SessionID,
+			ShardId:    move.ShardID,
+			SizeBytes:  move.SizeBytes,
+			SourceName: move.SourceName,
+			SourcePort: move.SourcePort,
+			TargetName: move.TargetName,
+			TargetPort: move.TargetPort,
+			Progress:   move.Progress,
+		}
+		var databaseIdx int32
+		databaseIdx, s.DatabaseReferences = upsertDatabaseReference(s.DatabaseReferences, transientState.Citus.DatabaseName)
+		m.RelationIdx, s.RelationReferences = upsertRelationReference(s.RelationReferences, databaseIdx, move.SchemaName, move.RelationName)
+		info.RebalanceProgress = append(info.RebalanceProgress, &m)
+	}
+
+	s.CitusInformation = &info
+
+	return s
+}
@@ -0,0 +1,76 @@
+package transform
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// transformPostgresLogMetrics - Adds the activity derived from the log lines analyzed since the
+// last snapshot
+//
+// Logins are matched to the databases and roles by name, and skipped for ones that no longer exist.
+func transformPostgresLogMetrics(s snapshot.FullSnapshot, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	metrics := transientState.LogMetrics
+	if metrics.Since.IsZero() {
+		return s
+	}
+
+	countedSince, _ := ptypes.TimestampProto(metrics.Since)
+	s.LogMetricsStatistic = &snapshot.LogMetricsStatistic{
+		CountedSince:              countedSince,
+		Checkpoints:               metrics.Checkpoints,
+		CheckpointBufsWritten:     metrics.CheckpointBufsWritten,
+		CheckpointWriteSecs:       metrics.CheckpointWriteSecs,
+		CheckpointSyncSecs:        metrics.CheckpointSyncSecs,
+		CheckpointTotalSecs:       metrics.CheckpointTotalSecs,
+		Autovacuums:               metrics.Autovacuums,
+		AutovacuumsAntiWraparound: metrics.AutovacuumsAntiWraparound,
+		AutovacuumPagesRemoved:    metrics.AutovacuumPagesRemoved,
+		AutovacuumTuplesDeleted:   metrics.AutovacuumTuplesDeleted,
+		AutovacuumFrozenPages:     metrics.AutovacuumFrozenPages,
+		AutovacuumTuplesFrozen:    metrics.AutovacuumTuplesFrozen,
+		AutovacuumWalBytes:        metrics.AutovacuumWalBytes,
+		AutovacuumElapsedSecs:     metrics.AutovacuumElapsedSecs,
+		ConnectionsReceived:       metrics.ConnectionsReceived,
+		ConnectionsAuthorized:     metrics.ConnectionsAuthorized,
+		ConnectionsRejected:       metrics.ConnectionsRejected,
+		Disconnections:            metrics.Disconnections,
+		SessionSecs:               metrics.SessionSecs,
+	}
+
+	databaseOids := make(map[string]state.Oid)
+	for _, database := range transientState.Databases {
+		databaseOids[database.Name] = database.Oid
+	}
+	roleOids := make(map[string]state.Oid)
+	for _, role := range transientState.Roles {
+		roleOids[role.Name] = role.Oid
+	}
+	for key, count := range metrics.LoginsByDatabaseRole {
+		databaseOid, ok := databaseOids[key.Database]
+		if !ok {
+			continue
+		}
+		roleOid, ok := roleOids[key.Username]
+		if !ok {
+			continue
+		}
+		s.LogMetricsStatistic.Logins = append(s.LogMetricsStatistic.Logins, &snapshot.LogMetricsStatistic_LoginCount{
+			DatabaseIdx: databaseOidToIdx[databaseOid],
+			RoleIdx:     roleOidToIdx[roleOid],
+			Count:       count,
+		})
+	}
+	logins := s.LogMetricsStatistic.Logins
+	sort.Slice(logins, func(i, j int) bool {
+		if logins[i].DatabaseIdx != logins[j].DatabaseIdx {
+			return logins[i].DatabaseIdx < logins[j].DatabaseIdx
+		}
+		return logins[i].RoleIdx < logins[j].RoleIdx
+	})
+
+	return s
+}
@@ -0,0 +1,51 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresPatroni(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if transientState.Patroni == nil {
+		return s
+	}
+
+	info := snapshot.PatroniInformation{
+		Scope:         transientState.Patroni.Scope,
+		CurrentMember: transientState.Patroni.CurrentMember,
+	}
+
+	for _, member := range transientState.Patroni.Members {
+		m := snapshot.PatroniInformation_Member{
+			Name:     member.Name,
+			Role:     member.Role,
+			State:    member.State,
+			Host:     member.Host,
+			Port:     member.Port,
+			Timeline: member.Timeline.Int64,
+			LagBytes: -1,
+		}
+		if member.LagBytes.Valid {
+			m.LagBytes = member.LagBytes.Int64
+		}
+		info.Members = append(info.Members, &m)
+	}
+
+	for _, change := range transientState.Patroni.History {
+		c := snapshot.PatroniInformation_TimelineChange{
+			Timeline:  change.Timeline,
+			Lsn:       change.LSN,
+			Reason:    change.Reason,
+			NewLeader: change.NewLeader,
+		}
+		if change.ChangedAt.Valid {
+			c.ChangedAt, _ = ptypes.TimestampProto(change.ChangedAt.Time)
+		}
+		info.History = append(info.History, &c)
+	}
+
+	s.Patroni = &info
+
+	return s
+}
@@ -0,0 +1,57 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresPgAutoFailover(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if transientState.PgAutoFailover == nil {
+		return s
+	}
+
+	info := snapshot.PgAutoFailoverInformation{
+		FormationId:      transientState.PgAutoFailover.FormationID,
+		HasCurrentNodeId: transientState.PgAutoFailover.CurrentNodeID.Valid,
+		CurrentNodeId:    transientState.PgAutoFailover.CurrentNodeID.Int64,
+	}
+
+	for _, node := range transientState.PgAutoFailover.Nodes {
+		n := snapshot.PgAutoFailoverInformation_Node{
+			NodeId:            node.NodeID,
+			GroupId:           node.GroupID,
+			NodeName:          node.NodeName,
+			NodeHost:          node.NodeHost,
+			NodePort:          node.NodePort,
+			ReportedState:     node.ReportedState,
+			GoalState:         node.GoalState,
+			ReportedRepState:  node.ReportedRepState.String,
+			Health:            node.Health,
+			CandidatePriority: node.CandidatePriority,
+			ReplicationQuorum: node.ReplicationQuorum,
+		}
+		if node.ReportTime.Valid {
+			n.ReportTime, _ = ptypes.TimestampProto(node.ReportTime.Time)
+		}
+		if node.StateChangeTime.Valid {
+			n.StateChangeTime, _ = ptypes.TimestampProto(node.StateChangeTime.Time)
+		}
+		info.Nodes = append(info.Nodes, &n)
+	}
+
+	for _, event := range transientState.PgAutoFailover.Events {
+		e := snapshot.PgAutoFailoverInformation_Event{
+			EventId:       event.EventID,
+			ReportedState: event.ReportedState,
+			GoalState:     event.GoalState,
+			Description:   event.Description,
+		}
+		e.EventTime, _ = ptypes.TimestampProto(event.EventTime)
+		info.Events = append(info.Events, &e)
+	}
+
+	s.PgAutoFailover = &info
+
+	return s
+}
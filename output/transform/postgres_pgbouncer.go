@@ -0,0 +1,69 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresPgBouncer(s snapshot.FullSnapshot, diffState state.DiffState, transientState state.TransientState) snapshot.FullSnapshot {
+	for _, instance := range transientState.PgBouncer {
+		info := snapshot.PgBouncerInformation{Address: instance.Address}
+
+		for key, stats := range diffState.PgBouncerStats {
+			if key.Address != instance.Address {
+				continue
+			}
+			info.DatabaseStatistics = append(info.DatabaseStatistics, &snapshot.PgBouncerInformation_DatabaseStatistic{
+				Database:      key.Database,
+				XactCount:     stats.XactCount,
+				QueryCount:    stats.QueryCount,
+				ReceivedBytes: stats.Received,
+				SentBytes:     stats.Sent,
+				XactTimeUs:    stats.XactTimeUs,
+				QueryTimeUs:   stats.QueryTimeUs,
+				WaitTimeUs:    stats.WaitTimeUs,
+			})
+		}
+		sort.Slice(info.DatabaseStatistics, func(i, j int) bool {
+			return info.DatabaseStatistics[i].Database < info.DatabaseStatistics[j].Database
+		})
+
+		for _, pool := range instance.Pools {
+			info.Pools = append(info.Pools, &snapshot.PgBouncerInformation_Pool{
+				Database:  pool.Database,
+				User:      pool.User,
+				PoolMode:  pool.PoolMode,
+				ClActive:  pool.ClActive,
+				ClWaiting: pool.ClWaiting,
+				SvActive:  pool.SvActive,
+				SvIdle:    pool.SvIdle,
+				SvUsed:    pool.SvUsed,
+				SvTested:  pool.SvTested,
+				SvLogin:   pool.SvLogin,
+				MaxWaitUs: pool.MaxWaitUs,
+			})
+		}
+
+		for _, client := range instance.Clients {
+			info.ClientCounts = append(info.ClientCounts, &snapshot.PgBouncerInformation_ClientCount{
+				Database: client.Database,
+				User:     client.User,
+				State:    client.State,
+				Count:    client.Count,
+			})
+		}
+
+		for list, items := range instance.Lists {
+			info.Lists = append(info.Lists, &snapshot.PgBouncerInformation_ListItem{List: list, Items: items})
+		}
+		sort.Slice(info.Lists, func(i, j int) bool {
+			return info.Lists[i].List < info.Lists[j].List
+		})
+
+		s.Pgbouncer = append(s.Pgbouncer, &info)
+	}
+
+	return s
+}
@@ -0,0 +1,58 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresPgpool(s snapshot.FullSnapshot, transientState state.TransientState) snapshot.FullSnapshot {
+	if transientState.Pgpool == nil {
+		return s
+	}
+
+	info := snapshot.PgpoolInformation{
+		Address:        transientState.Pgpool.Address,
+		ChildProcesses: transientState.Pgpool.ChildProcesses,
+	}
+
+	for _, node := range transientState.Pgpool.Nodes {
+		info.Nodes = append(info.Nodes, &snapshot.PgpoolInformation_Node{
+			NodeId:           node.NodeID,
+			Hostname:         node.Hostname,
+			Port:             node.Port,
+			Status:           node.Status,
+			LbWeight:         node.LbWeight,
+			Role:             node.Role,
+			SelectCount:      node.SelectCount,
+			LoadBalanceNode:  node.LoadBalanceNode,
+			ReplicationDelay: node.ReplicationDelay,
+			ReplicationState: node.ReplicationState.String,
+			LastStatusChange: node.LastStatusChange.String,
+		})
+	}
+
+	for _, process := range transientState.Pgpool.ConnectedProcesses {
+		info.ConnectedProcesses = append(info.ConnectedProcesses, &snapshot.PgpoolInformation_ProcessCount{
+			Database: process.Database,
+			Username: process.Username,
+			Count:    process.Count,
+		})
+	}
+
+	if cache := transientState.Pgpool.QueryCache; cache != nil {
+		info.QueryCache = &snapshot.PgpoolInformation_QueryCache{
+			NumCacheHits:         cache.NumCacheHits,
+			NumSelects:           cache.NumSelects,
+			CacheHitRatio:        cache.CacheHitRatio,
+			NumHashEntries:       cache.NumHashEntries,
+			UsedHashEntries:      cache.UsedHashEntries,
+			NumCacheEntries:      cache.NumCacheEntries,
+			UsedCacheEntriesSize: cache.UsedCacheEntriesSize,
+			FreeCacheEntriesSize: cache.FreeCacheEntriesSize,
+		}
+	}
+
+	s.Pgpool = &info
+
+	return s
+}
@@ -0,0 +1,68 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+type queryPlanKey struct {
+	queryIdx int32
+	planID   int64
+}
+
+// transformPostgresPlans - Adds the plan statistics of the queries in the snapshot, identified by
+// the query and the plan ID
+//
+// Plans of statements that are not part of the query statistics (e.g. because they were summed
+// up into the remainder entry) are skipped. Plans of statements that were grouped into the same
+// query (e.g. for different roles) are summed up.
+func transformPostgresPlans(s snapshot.FullSnapshot, diffState state.DiffState, transientState state.TransientState, queryIdxByStatementKey map[state.PostgresStatementKey]int32) snapshot.FullSnapshot {
+	plans := make(map[queryPlanKey]state.DiffedPostgresPlanStats)
+	planTexts := make(map[queryPlanKey]string)
+	for planKey, stats := range diffState.PlanStats {
+		queryIdx, ok := queryIdxByStatementKey[planKey.StatementKey()]
+		if !ok {
+			continue
+		}
+		key := queryPlanKey{queryIdx: queryIdx, planID: planKey.PlanID}
+		plan := plans[key]
+		plan.Calls += stats.Calls
+		plan.TotalTime += stats.TotalTime
+		plans[key] = plan
+		if text := transientState.PlanTexts[planKey]; text != "" {
+			planTexts[key] = text
+		}
+	}
+
+	keys := make([]queryPlanKey, 0, len(plans))
+	for key := range plans {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].queryIdx != keys[j].queryIdx {
+			return keys[i].queryIdx < keys[j].queryIdx
+		}
+		return keys[i].planID < keys[j].planID
+	})
+
+	for _, key := range keys {
+		idx := int32(len(s.QueryPlanReferences))
+		s.QueryPlanReferences = append(s.QueryPlanReferences, &snapshot.QueryPlanReference{
+			QueryIdx:       key.queryIdx,
+			OriginalPlanId: key.planID,
+		})
+		s.QueryPlanInformations = append(s.QueryPlanInformations, &snapshot.QueryPlanInformation{
+			QueryPlanIdx: idx,
+			ExplainPlan:  planTexts[key],
+		})
+		s.QueryPlanStatistics = append(s.QueryPlanStatistics, &snapshot.QueryPlanStatistic{
+			QueryPlanIdx: idx,
+			Calls:        plans[key].Calls,
+			TotalTime:    plans[key].TotalTime,
+		})
+	}
+
+	return s
+}
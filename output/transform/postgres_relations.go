@@ -128,6 +128,18 @@ func transformPostgresRelations(s snapshot.FullSnapshot, newState state.Persiste
 			}
 			info.Constraints = append(info.Constraints, &sConstraint)
 		}
+		for _, statistic := range relation.ExtendedStatistics {
+			info.ExtendedStatistics = append(info.ExtendedStatistics, &snapshot.RelationInformation_ExtendedStatistic{
+				SchemaName:      statistic.SchemaName,
+				Name:            statistic.Name,
+				Columns:         statistic.Columns,
+				Kinds:           statistic.Kinds,
+				Definition:      statistic.Definition,
+				HasNdistinct:    statistic.HasNDistinct,
+				HasDependencies: statistic.HasDependencies,
+				HasMcv:          statistic.HasMCV,
+			})
+		}
 		s.RelationInformations = append(s.RelationInformations, &info)
 
 		// Statistic
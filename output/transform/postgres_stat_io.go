@@ -0,0 +1,52 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresStatIO(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	keys := make([]state.PostgresStatIOKey, 0, len(diffState.StatIO))
+	for key, stats := range diffState.StatIO {
+		// Most combinations of backend type, object and context see no activity between snapshots
+		if stats == (state.DiffedPostgresStatIO{StatsReset: stats.StatsReset}) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].BackendType != keys[j].BackendType {
+			return keys[i].BackendType < keys[j].BackendType
+		}
+		if keys[i].Object != keys[j].Object {
+			return keys[i].Object < keys[j].Object
+		}
+		return keys[i].Context < keys[j].Context
+	})
+
+	for _, key := range keys {
+		stats := diffState.StatIO[key]
+		s.IoStatistics = append(s.IoStatistics, &snapshot.IOStatistic{
+			BackendType:   key.BackendType,
+			Object:        key.Object,
+			Context:       key.Context,
+			Reads:         stats.Reads,
+			ReadTime:      stats.ReadTime,
+			Writes:        stats.Writes,
+			WriteTime:     stats.WriteTime,
+			Writebacks:    stats.Writebacks,
+			WritebackTime: stats.WritebackTime,
+			Extends:       stats.Extends,
+			ExtendTime:    stats.ExtendTime,
+			Hits:          stats.Hits,
+			Evictions:     stats.Evictions,
+			Reuses:        stats.Reuses,
+			Fsyncs:        stats.Fsyncs,
+			FsyncTime:     stats.FsyncTime,
+		})
+	}
+
+	return s
+}
@@ -0,0 +1,32 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresStatSLRU(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	names := make([]string, 0, len(diffState.StatSLRU))
+	for name := range diffState.StatSLRU {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		stats := diffState.StatSLRU[name]
+		s.SlruStatistics = append(s.SlruStatistics, &snapshot.SLRUStatistic{
+			Name:        name,
+			BlksZeroed:  stats.BlksZeroed,
+			BlksHit:     stats.BlksHit,
+			BlksRead:    stats.BlksRead,
+			BlksWritten: stats.BlksWritten,
+			BlksExists:  stats.BlksExists,
+			Flushes:     stats.Flushes,
+			Truncates:   stats.Truncates,
+		})
+	}
+
+	return s
+}
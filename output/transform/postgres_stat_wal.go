@@ -0,0 +1,29 @@
+package transform
+
+import (
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresStatWAL(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	if diffState.StatWAL == nil {
+		return s
+	}
+
+	stats := diffState.StatWAL
+	s.WalStatistic = &snapshot.WALStatistic{
+		WalRecords:          stats.WalRecords,
+		WalFpi:              stats.WalFpi,
+		WalBytes:            stats.WalBytes,
+		WalBuffersFull:      stats.WalBuffersFull,
+		WalWrite:            stats.WalWrite,
+		WalSync:             stats.WalSync,
+		WalWriteTime:        stats.WalWriteTime,
+		WalSyncTime:         stats.WalSyncTime,
+		WalRecordsPerSecond: stats.WalRecordsPerSecond,
+		WalFpiPerSecond:     stats.WalFpiPerSecond,
+		WalBytesPerSecond:   stats.WalBytesPerSecond,
+	}
+
+	return s
+}
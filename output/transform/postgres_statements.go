@@ -1,6 +1,7 @@
 package transform
 
 import (
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -9,8 +10,49 @@ import (
 	"github.com/pganalyze/collector/util"
 )
 
-func groupStatements(statements state.PostgresStatementMap, statementTexts state.PostgresStatementTextMap, statsMap state.DiffedPostgresStatementStatsMap) map[statementKey]statementValue {
+// keptRoles - Determines the roles whose statement statistics are reported separately, based on
+// the query_stats_user_dimension setting (nil if all roles are kept)
+//
+// With "rollup", the roles are kept that have the most total time in the statistics, up to
+// rollupRoleLimit of them, and with "collapse" none are kept.
+func keptRoles(statsMap state.DiffedPostgresStatementStatsMap, userDimension string) map[state.Oid]bool {
+	switch userDimension {
+	case "collapse":
+		return map[state.Oid]bool{}
+	case "rollup":
+		totalTimes := make(map[state.Oid]float64)
+		for sKey, stats := range statsMap {
+			totalTimes[sKey.UserOid] += stats.TotalTime
+		}
+		roles := make([]state.Oid, 0, len(totalTimes))
+		for role := range totalTimes {
+			roles = append(roles, role)
+		}
+		sort.Slice(roles, func(i, j int) bool {
+			if totalTimes[roles[i]] != totalTimes[roles[j]] {
+				return totalTimes[roles[i]] > totalTimes[roles[j]]
+			}
+			return roles[i] < roles[j]
+		})
+		if len(roles) > rollupRoleLimit {
+			roles = roles[:rollupRoleLimit]
+		}
+		kept := make(map[state.Oid]bool)
+		for _, role := range roles {
+			kept[role] = true
+		}
+		return kept
+	default:
+		return nil
+	}
+}
+
+// rollupRoleLimit - Number of roles whose statistics are kept separately with the "rollup" user dimension
+const rollupRoleLimit = 10
+
+func groupStatements(statements state.PostgresStatementMap, statementTexts state.PostgresStatementTextMap, statsMap state.DiffedPostgresStatementStatsMap, userDimension string) map[statementKey]statementValue {
 	groupedStatements := make(map[statementKey]statementValue)
+	roles := keptRoles(statsMap, userDimension)
 
 	for sKey, stats := range statsMap {
 		statement, exist := statements[sKey]
@@ -23,6 +65,10 @@ func groupStatements(statements state.PostgresStatementMap, statementTexts state
 			userOid:     sKey.UserOid,
 			fingerprint: statement.Fingerprint,
 		}
+		if roles != nil && !roles[sKey.UserOid] {
+			key.userOid = 0
+			key.allRoles = true
+		}
 
 		value, exist := groupedStatements[key]
 		if exist {
@@ -30,12 +76,14 @@ func groupStatements(statements state.PostgresStatementMap, statementTexts state
 				statement:      value.statement,
 				statementStats: value.statementStats.Add(stats),
 				queryIDs:       append(value.queryIDs, sKey.QueryID),
+				keys:           append(value.keys, sKey),
 			}
 		} else {
 			groupedStatements[key] = statementValue{
 				statement:      statement,
 				statementStats: stats,
 				queryIDs:       []int64{sKey.QueryID},
+				keys:           []state.PostgresStatementKey{sKey},
 			}
 		}
 	}
@@ -43,6 +91,83 @@ func groupStatements(statements state.PostgresStatementMap, statementTexts state
 	return groupedStatements
 }
 
+// limitStatements - Keeps the top statements (up to the limit) by total time, mean time and calls,
+// taking turns between these rankings, and sums up the statistics of all other statements into
+// one remainder entry per database
+func limitStatements(groupedStatements map[statementKey]statementValue, limit int) map[statementKey]statementValue {
+	if limit <= 0 || len(groupedStatements) <= limit {
+		return groupedStatements
+	}
+
+	keys := make([]statementKey, 0, len(groupedStatements))
+	for key := range groupedStatements {
+		keys = append(keys, key)
+	}
+	rankBy := func(value func(stats state.DiffedPostgresStatementStats) float64) []statementKey {
+		ranked := make([]statementKey, len(keys))
+		copy(ranked, keys)
+		sort.Slice(ranked, func(i, j int) bool {
+			a := value(groupedStatements[ranked[i]].statementStats)
+			b := value(groupedStatements[ranked[j]].statementStats)
+			if a != b {
+				return a > b
+			}
+			if ranked[i].fingerprint != ranked[j].fingerprint {
+				return ranked[i].fingerprint < ranked[j].fingerprint
+			}
+			if ranked[i].databaseOid != ranked[j].databaseOid {
+				return ranked[i].databaseOid < ranked[j].databaseOid
+			}
+			return ranked[i].userOid < ranked[j].userOid
+		})
+		return ranked
+	}
+	rankings := [][]statementKey{
+		rankBy(func(stats state.DiffedPostgresStatementStats) float64 { return stats.TotalTime }),
+		rankBy(func(stats state.DiffedPostgresStatementStats) float64 {
+			if stats.Calls == 0 {
+				return 0
+			}
+			return stats.TotalTime / float64(stats.Calls)
+		}),
+		rankBy(func(stats state.DiffedPostgresStatementStats) float64 { return float64(stats.Calls) }),
+	}
+
+	limitedStatements := make(map[statementKey]statementValue)
+	for i := 0; len(limitedStatements) < limit; i++ {
+		for _, ranking := range rankings {
+			if len(limitedStatements) < limit {
+				key := ranking[i]
+				limitedStatements[key] = groupedStatements[key]
+			}
+		}
+	}
+
+	remainderFingerprint := util.FingerprintText(util.QueryTextRemainder)
+	for key, value := range groupedStatements {
+		if _, ok := limitedStatements[key]; ok {
+			continue
+		}
+		remainderKey := statementKey{databaseOid: key.databaseOid, fingerprint: remainderFingerprint, allRoles: true}
+		// Query IDs (and the plans of the statements) are omitted, since they would make the snapshot
+		// grow with the number of statements again
+		remainder, exist := limitedStatements[remainderKey]
+		if exist {
+			limitedStatements[remainderKey] = statementValue{
+				statement:      remainder.statement,
+				statementStats: remainder.statementStats.Add(value.statementStats),
+			}
+		} else {
+			limitedStatements[remainderKey] = statementValue{
+				statement:      state.PostgresStatement{Remainder: true, Fingerprint: remainderFingerprint},
+				statementStats: value.statementStats,
+			}
+		}
+	}
+
+	return limitedStatements
+}
+
 func transformQueryStatistic(stats state.DiffedPostgresStatementStats, idx int32) snapshot.QueryStatistic {
 	return snapshot.QueryStatistic{
 		QueryIdx: idx,
@@ -62,18 +187,42 @@ func transformQueryStatistic(stats state.DiffedPostgresStatementStats, idx int32
 		TempBlksWritten:   stats.TempBlksWritten,
 		BlkReadTime:       stats.BlkReadTime,
 		BlkWriteTime:      stats.BlkWriteTime,
+		Plans:             stats.Plans,
+		NestedCalls:       stats.NestedCalls,
+
+		ExecUserTime:   stats.ExecUserTime,
+		ExecSystemTime: stats.ExecSystemTime,
+		ExecReads:      stats.ExecReads,
+		ExecWrites:     stats.ExecWrites,
 	}
 }
 
+// statementTags - Returns the query tags of the statements whose statistics were summed up into the value
+func statementTags(statements state.PostgresStatementMap, value statementValue) []*snapshot.QueryTag {
+	tagMaps := make([]map[string]string, 0, len(value.keys))
+	for _, sKey := range value.keys {
+		tagMaps = append(tagMaps, statements[sKey].Tags)
+	}
+	return transformQueryTags(tagMaps...)
+}
+
 func transformPostgresStatements(s snapshot.FullSnapshot, newState state.PersistedState, diffState state.DiffState, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
 	// Statement stats from this snapshot
-	groupedStatements := groupStatements(transientState.Statements, transientState.StatementTexts, diffState.StatementStats)
+	groupedStatements := groupStatements(transientState.Statements, transientState.StatementTexts, diffState.StatementStats, transientState.StatementUserDimension)
+	groupedStatements = limitStatements(groupedStatements, transientState.StatementLimit)
+	queryIdxByStatementKey := make(map[state.PostgresStatementKey]int32)
 	for key, value := range groupedStatements {
 		idx := upsertQueryReferenceAndInformation(&s, transientState.StatementTexts, roleOidToIdx, databaseOidToIdx, key, value)
 
 		statistic := transformQueryStatistic(value.statementStats, idx)
+		statistic.Tags = statementTags(transientState.Statements, value)
 		s.QueryStatistics = append(s.QueryStatistics, &statistic)
+
+		for _, sKey := range value.keys {
+			queryIdxByStatementKey[sKey] = idx
+		}
 	}
+	s = transformPostgresPlans(s, diffState, transientState, queryIdxByStatementKey)
 
 	// Historic statement stats which are sent now since we got the query text only now
 	for timeKey, diffedStats := range transientState.HistoricStatementStats {
@@ -87,10 +236,12 @@ func transformPostgresStatements(s snapshot.FullSnapshot, newState state.Persist
 		h.CollectedAt, _ = ptypes.TimestampProto(timeKey.CollectedAt)
 		h.CollectedIntervalSecs = timeKey.CollectedIntervalSecs
 
-		groupedStatements = groupStatements(transientState.Statements, transientState.StatementTexts, diffedStats)
+		groupedStatements = groupStatements(transientState.Statements, transientState.StatementTexts, diffedStats, transientState.StatementUserDimension)
+		groupedStatements = limitStatements(groupedStatements, transientState.StatementLimit)
 		for key, value := range groupedStatements {
 			idx := upsertQueryReferenceAndInformation(&s, transientState.StatementTexts, roleOidToIdx, databaseOidToIdx, key, value)
 			statistic := transformQueryStatistic(value.statementStats, idx)
+			statistic.Tags = statementTags(transientState.Statements, value)
 			h.Statistics = append(h.Statistics, &statistic)
 		}
 		s.HistoricQueryStatistics = append(s.HistoricQueryStatistics, &h)
@@ -0,0 +1,50 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+// transformPostgresTempFiles - Adds the temporary files logged since the last snapshot, referencing
+// their queries summed up across roles (since the log lines aren't matched to a role here)
+//
+// Temporary files of databases that no longer exist are skipped.
+func transformPostgresTempFiles(s snapshot.FullSnapshot, transientState state.TransientState, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	databaseOids := make(map[string]state.Oid)
+	for _, database := range transientState.Databases {
+		databaseOids[database.Name] = database.Oid
+	}
+
+	keys := make([]state.LogMetricsTempFileQuery, 0, len(transientState.LogMetrics.TempFilesByQuery))
+	for key := range transientState.LogMetrics.TempFilesByQuery {
+		if _, ok := databaseOids[key.Database]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Database != keys[j].Database {
+			return keys[i].Database < keys[j].Database
+		}
+		return keys[i].Fingerprint < keys[j].Fingerprint
+	})
+
+	for _, key := range keys {
+		databaseOid := databaseOids[key.Database]
+		files := transientState.LogMetrics.TempFilesByQuery[key]
+		statistic := snapshot.QueryTempFileStatistic{
+			DatabaseIdx: databaseOidToIdx[databaseOid],
+			Files:       files.Files,
+			Bytes:       files.Bytes,
+		}
+		if key.Fingerprint != 0 {
+			queryKey := statementKey{databaseOid: databaseOid, fingerprint: key.Fingerprint, allRoles: true}
+			statistic.QueryIdx = upsertQueryReferenceAndInformation(&s, transientState.StatementTexts, roleOidToIdx, databaseOidToIdx, queryKey, statementValue{})
+			statistic.HasQueryIdx = true
+		}
+		s.QueryTempFileStatistics = append(s.QueryTempFileStatistics, &statistic)
+	}
+
+	return s
+}
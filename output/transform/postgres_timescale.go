@@ -0,0 +1,54 @@
+package transform
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresTimescale(s snapshot.FullSnapshot, transientState state.TransientState, databaseOidToIdx OidToIdx) snapshot.FullSnapshot {
+	for _, hypertable := range transientState.TimescaleHypertables {
+		h := snapshot.TimescaleHypertableInformation{
+			NumDimensions:              hypertable.NumDimensions,
+			NumChunks:                  hypertable.NumChunks,
+			CompressionEnabled:         hypertable.CompressionEnabled,
+			CompressedChunks:           hypertable.CompressedChunks,
+			BeforeCompressionSizeBytes: hypertable.BeforeCompressionSizeBytes,
+			AfterCompressionSizeBytes:  hypertable.AfterCompressionSizeBytes,
+		}
+		h.RelationIdx, s.RelationReferences = upsertRelationReference(s.RelationReferences, databaseOidToIdx[hypertable.DatabaseOid], hypertable.SchemaName, hypertable.RelationName)
+		s.TimescaleHypertables = append(s.TimescaleHypertables, &h)
+	}
+
+	for _, aggregate := range transientState.TimescaleContinuousAggregates {
+		a := snapshot.TimescaleContinuousAggregateInformation{
+			DatabaseIdx:            databaseOidToIdx[aggregate.DatabaseOid],
+			SchemaName:             aggregate.SchemaName,
+			ViewName:               aggregate.ViewName,
+			HypertableSchemaName:   aggregate.HypertableSchemaName,
+			HypertableName:         aggregate.HypertableName,
+			MaterializedOnly:       aggregate.MaterializedOnly,
+			HasJob:                 aggregate.JobID.Valid,
+			JobId:                  aggregate.JobID.Int64,
+			LastRunStatus:          aggregate.LastRunStatus.String,
+			LastRunDurationSeconds: -1,
+			TotalRuns:              aggregate.TotalRuns,
+			TotalFailures:          aggregate.TotalFailures,
+		}
+		if aggregate.LastRunStartedAt.Valid {
+			a.LastRunStartedAt, _ = ptypes.TimestampProto(aggregate.LastRunStartedAt.Time)
+		}
+		if aggregate.LastSuccessfulFinish.Valid {
+			a.LastSuccessfulFinish, _ = ptypes.TimestampProto(aggregate.LastSuccessfulFinish.Time)
+		}
+		if aggregate.LastRunDurationSeconds.Valid {
+			a.LastRunDurationSeconds = aggregate.LastRunDurationSeconds.Float64
+		}
+		if aggregate.NextStart.Valid {
+			a.NextStart, _ = ptypes.TimestampProto(aggregate.NextStart.Time)
+		}
+		s.TimescaleContinuousAggregates = append(s.TimescaleContinuousAggregates, &a)
+	}
+
+	return s
+}
@@ -0,0 +1,37 @@
+package transform
+
+import (
+	"sort"
+
+	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
+	"github.com/pganalyze/collector/state"
+)
+
+func transformPostgresWaitEvents(s snapshot.FullSnapshot, diffState state.DiffState) snapshot.FullSnapshot {
+	keys := make([]state.PostgresWaitEventKey, 0, len(diffState.WaitEvents))
+	for key := range diffState.WaitEvents {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].QueryID != keys[j].QueryID {
+			return keys[i].QueryID < keys[j].QueryID
+		}
+		if keys[i].EventType != keys[j].EventType {
+			return keys[i].EventType < keys[j].EventType
+		}
+		return keys[i].Event < keys[j].Event
+	})
+
+	for _, key := range keys {
+		event := diffState.WaitEvents[key]
+		s.QueryWaitEventStatistics = append(s.QueryWaitEventStatistics, &snapshot.QueryWaitEventStatistic{
+			QueryId:       key.QueryID,
+			WaitEventType: key.EventType,
+			WaitEvent:     key.Event,
+			Samples:       event.Samples,
+			TimeMs:        event.TimeMs,
+		})
+	}
+
+	return s
+}
@@ -85,6 +85,7 @@ func transformSystem(systemState state.SystemState, diffState state.DiffState) *
 					ParameterPgssEnabled:        systemState.Info.AmazonRds.ParameterPgssEnabled,
 					ParameterAutoExplainEnabled: systemState.Info.AmazonRds.ParameterAutoExplainEnabled,
 					IsAuroraPostgres:            systemState.Info.AmazonRds.IsAuroraPostgres,
+					IsAuroraServerless:          systemState.Info.AmazonRds.IsAuroraServerless,
 				},
 			}
 		}
@@ -96,11 +97,28 @@ func transformSystem(systemState state.SystemState, diffState state.DiffState) *
 		system.SystemInformation.Type = snapshot.SystemInformation_AZURE_DATABASE_SYSTEM
 	} else if systemState.Info.Type == state.CrunchyBridgeSystem {
 		system.SystemInformation.Type = snapshot.SystemInformation_CRUNCHY_BRIDGE_SYSTEM
+		if systemState.Info.CrunchyBridge != nil {
+			createdAt, _ := ptypes.TimestampProto(systemState.Info.CrunchyBridge.CreatedAt)
+
+			system.SystemInformation.Info = &snapshot.SystemInformation_CrunchyBridge{
+				CrunchyBridge: &snapshot.SystemInformationCrunchyBridge{
+					ClusterName:  systemState.Info.CrunchyBridge.ClusterName,
+					PlanId:       systemState.Info.CrunchyBridge.PlanID,
+					ProviderId:   systemState.Info.CrunchyBridge.ProviderID,
+					RegionId:     systemState.Info.CrunchyBridge.RegionID,
+					MajorVersion: int32(systemState.Info.CrunchyBridge.MajorVersion),
+					IsHa:         systemState.Info.CrunchyBridge.IsHA,
+					State:        systemState.Info.CrunchyBridge.State,
+					CreatedAt:    createdAt,
+				},
+			}
+		}
 	}
 
 	system.SystemId = systemState.Info.SystemID
 	system.SystemScope = systemState.Info.SystemScope
 	system.XlogUsedBytes = systemState.XlogUsedBytes
+	system.ReplicaLagSeconds = systemState.ReplicaLagSeconds
 
 	system.SchedulerStatistic = &snapshot.SchedulerStatistic{
 		LoadAverage_1Min:  systemState.Scheduler.Loadavg1min,
@@ -129,6 +147,46 @@ func transformSystem(systemState state.SystemState, diffState state.DiffState) *
 		HugePagesTotal:     systemState.Memory.HugePagesTotal,
 		HugePagesReserved:  systemState.Memory.HugePagesReserved,
 		HugePagesSurplus:   systemState.Memory.HugePagesSurplus,
+		UsedPercent:        systemState.MemoryUsedPercent,
+	}
+
+	for _, process := range systemState.Processes {
+		system.ProcessStatistics = append(system.ProcessStatistics, &snapshot.ProcessStatistic{
+			Pid:           process.Pid,
+			ParentPid:     process.ParentPid,
+			Name:          process.Name,
+			CpuPercent:    process.CPUPercent,
+			MemoryPercent: process.MemoryPercent,
+			RssBytes:      process.RssBytes,
+			VssBytes:      process.VssBytes,
+		})
+	}
+
+	if systemState.Tasks != (state.SystemTasks{}) {
+		system.TaskStatistic = &snapshot.TaskStatistic{
+			Total:    systemState.Tasks.Total,
+			Running:  systemState.Tasks.Running,
+			Sleeping: systemState.Tasks.Sleeping,
+			Blocked:  systemState.Tasks.Blocked,
+			Stopped:  systemState.Tasks.Stopped,
+			Zombie:   systemState.Tasks.Zombie,
+		}
+	}
+
+	if systemState.ServerlessCapacity != nil {
+		system.ServerlessCapacityStatistic = &snapshot.ServerlessCapacityStatistic{
+			CapacityUnits:      systemState.ServerlessCapacity.CapacityUnits,
+			UtilizationPercent: systemState.ServerlessCapacity.UtilizationPercent,
+		}
+	}
+
+	if systemState.ProxyStats != nil {
+		system.RdsProxyStatistic = &snapshot.RdsProxyStatistic{
+			ProxyName:              systemState.ProxyStats.ProxyName,
+			ClientConnections:      systemState.ProxyStats.ClientConnections,
+			DatabaseConnections:    systemState.ProxyStats.DatabaseConnections,
+			QueryRequestsPerSecond: systemState.ProxyStats.QueryRequestsPerSecond,
+		}
 	}
 
 	system.CpuInformation = &snapshot.CPUInformation{
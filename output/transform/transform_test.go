@@ -1,10 +1,15 @@
 package transform_test
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/guregu/null"
+	"github.com/kylelemons/godebug/pretty"
 	"github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/output/transform"
 	"github.com/pganalyze/collector/state"
@@ -147,3 +152,1249 @@ func TestStatements(t *testing.T) {
 		t.Errorf("\nExpected:%+v\n\tActual: %+v\n\n", string(expectedJSON), string(actualJSON))
 	}
 }
+
+func TestStatementsLimit(t *testing.T) {
+	transientState := state.TransientState{Statements: make(state.PostgresStatementMap), StatementTexts: make(state.PostgresStatementTextMap), StatementLimit: 3}
+	diffState := state.DiffState{StatementStats: make(state.DiffedPostgresStatementStatsMap)}
+
+	addStatement := func(queryID int64, query string, stats state.DiffedPostgresStatementStats) {
+		key := state.PostgresStatementKey{DatabaseOid: 1, QueryID: queryID}
+		fp := util.FingerprintQuery(query, "none", -1)
+		transientState.Statements[key] = state.PostgresStatement{Fingerprint: fp}
+		transientState.StatementTexts[fp] = query
+		diffState.StatementStats[key] = stats
+	}
+	addStatement(1, "SELECT * FROM t1", state.DiffedPostgresStatementStats{Calls: 1, TotalTime: 100})   // Top total and mean time
+	addStatement(2, "SELECT * FROM t2", state.DiffedPostgresStatementStats{Calls: 1000, TotalTime: 10}) // Top calls
+	addStatement(3, "SELECT * FROM t3", state.DiffedPostgresStatementStats{Calls: 1, TotalTime: 50})    // Second by total time
+	addStatement(4, "SELECT * FROM t4", state.DiffedPostgresStatementStats{Calls: 2, TotalTime: 1})
+	addStatement(5, "SELECT * FROM t5", state.DiffedPostgresStatementStats{Calls: 3, TotalTime: 2})
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+	actualCalls := make(map[string]int64)
+	for _, statistic := range actual.QueryStatistics {
+		actualCalls[actual.QueryInformations[statistic.QueryIdx].NormalizedQuery] = statistic.Calls
+	}
+	expectedCalls := map[string]int64{
+		"SELECT * FROM t1":    1,
+		"SELECT * FROM t2":    1000,
+		"SELECT * FROM t3":    1,
+		"<remaining queries>": 5,
+	}
+	if diff := pretty.Compare(expectedCalls, actualCalls); diff != "" {
+		t.Errorf("query statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestStatementsUserDimension(t *testing.T) {
+	q := "SELECT * FROM test"
+	fp := util.FingerprintQuery(q, "none", -1)
+
+	for _, test := range []struct {
+		userDimension string
+		expectedCalls map[string]int64
+	}{
+		{"full", map[string]int64{"app": 1, "admin": 2}},
+		{"collapse", map[string]int64{"<all roles>": 3}},
+	} {
+		transientState := state.TransientState{
+			Roles:                  []state.PostgresRole{{Oid: 10, Name: "admin"}, {Oid: 20, Name: "app"}},
+			Statements:             make(state.PostgresStatementMap),
+			StatementTexts:         state.PostgresStatementTextMap{fp: q},
+			StatementUserDimension: test.userDimension,
+		}
+		diffState := state.DiffState{StatementStats: make(state.DiffedPostgresStatementStatsMap)}
+		for roleOid, calls := range map[state.Oid]int64{10: 2, 20: 1} {
+			key := state.PostgresStatementKey{UserOid: roleOid, QueryID: int64(roleOid)}
+			transientState.Statements[key] = state.PostgresStatement{Fingerprint: fp}
+			diffState.StatementStats[key] = state.DiffedPostgresStatementStats{Calls: calls}
+		}
+
+		actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+		actualCalls := make(map[string]int64)
+		for _, statistic := range actual.QueryStatistics {
+			ref := actual.QueryReferences[statistic.QueryIdx]
+			actualCalls[actual.RoleReferences[ref.RoleIdx].Name] = statistic.Calls
+		}
+		if diff := pretty.Compare(test.expectedCalls, actualCalls); diff != "" {
+			t.Errorf("%s: query statistics diff: (-want +got)\n%s", test.userDimension, diff)
+		}
+	}
+}
+
+func TestStatementsKcache(t *testing.T) {
+	q := "SELECT * FROM test"
+	fp := util.FingerprintQuery(q, "none", -1)
+	key1 := state.PostgresStatementKey{QueryID: 1}
+	key2 := state.PostgresStatementKey{QueryID: 2}
+	transientState := state.TransientState{
+		Statements:     state.PostgresStatementMap{key1: {Fingerprint: fp}, key2: {Fingerprint: fp}},
+		StatementTexts: state.PostgresStatementTextMap{fp: q},
+	}
+	diffState := state.DiffState{StatementStats: state.DiffedPostgresStatementStatsMap{
+		key1: {Calls: 1, TotalTime: 10, ExecUserTime: 6, ExecSystemTime: 1, ExecReads: 8192, ExecWrites: 0},
+		key2: {Calls: 2, TotalTime: 20, ExecUserTime: 2, ExecSystemTime: 0.5, ExecReads: 16384, ExecWrites: 4096},
+	}}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+	expected := []*pganalyze_collector.QueryStatistic{
+		{Calls: 3, TotalTime: 30, ExecUserTime: 8, ExecSystemTime: 1.5, ExecReads: 24576, ExecWrites: 4096},
+	}
+	if diff := pretty.Compare(expected, actual.QueryStatistics); diff != "" {
+		t.Errorf("query statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestStatementsPlanReuse(t *testing.T) {
+	q := "SELECT * FROM test WHERE id = $1"
+	fp := util.FingerprintQuery(q, "none", -1)
+	key := state.PostgresStatementKey{QueryID: 1}
+	transientState := state.TransientState{
+		Statements:     state.PostgresStatementMap{key: {Fingerprint: fp}},
+		StatementTexts: state.PostgresStatementTextMap{fp: q},
+	}
+	diffState := state.DiffState{StatementStats: state.DiffedPostgresStatementStatsMap{
+		key: {Calls: 100, TotalTime: 10, Plans: 5, NestedCalls: 40},
+	}}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+	expected := []*pganalyze_collector.QueryStatistic{{Calls: 100, TotalTime: 10, Plans: 5, NestedCalls: 40}}
+	if diff := pretty.Compare(expected, actual.QueryStatistics); diff != "" {
+		t.Errorf("query statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestStatementPlans(t *testing.T) {
+	q1 := "SELECT * FROM test WHERE id = $1"
+	q2 := "SELECT * FROM other"
+	fp1 := util.FingerprintQuery(q1, "none", -1)
+	fp2 := util.FingerprintQuery(q2, "none", -1)
+	key1 := state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: 1}
+	key2 := state.PostgresStatementKey{DatabaseOid: 1, UserOid: 20, QueryID: 1}
+	key3 := state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: 2}
+	planKey := func(key state.PostgresStatementKey, planID int64) state.PostgresPlanKey {
+		return state.PostgresPlanKey{DatabaseOid: key.DatabaseOid, UserOid: key.UserOid, QueryID: key.QueryID, PlanID: planID}
+	}
+	transientState := state.TransientState{
+		Roles:                  []state.PostgresRole{{Oid: 10, Name: "app"}, {Oid: 20, Name: "admin"}},
+		Statements:             state.PostgresStatementMap{key1: {Fingerprint: fp1}, key2: {Fingerprint: fp1}, key3: {Fingerprint: fp2}},
+		StatementTexts:         state.PostgresStatementTextMap{fp1: q1, fp2: q2},
+		StatementUserDimension: "collapse",
+		PlanTexts: state.PostgresPlanTextMap{
+			planKey(key1, 100): "Index Scan using test_pkey on test",
+			planKey(key1, 200): "Seq Scan on test",
+		},
+	}
+	diffState := state.DiffState{
+		StatementStats: state.DiffedPostgresStatementStatsMap{
+			key1: {Calls: 10, TotalTime: 20},
+			key2: {Calls: 5, TotalTime: 100},
+		},
+		PlanStats: state.DiffedPostgresPlanStatsMap{
+			planKey(key1, 100): {Calls: 8, TotalTime: 4},
+			planKey(key1, 200): {Calls: 2, TotalTime: 16},
+			planKey(key2, 200): {Calls: 5, TotalTime: 100},
+			// Not part of the query statistics
+			planKey(key3, 300): {Calls: 1, TotalTime: 1},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+	expectedReferences := []*pganalyze_collector.QueryPlanReference{
+		{QueryIdx: 0, OriginalPlanId: 100},
+		{QueryIdx: 0, OriginalPlanId: 200},
+	}
+	if diff := pretty.Compare(expectedReferences, actual.QueryPlanReferences); diff != "" {
+		t.Errorf("query plan references diff: (-want +got)\n%s", diff)
+	}
+	expectedInformations := []*pganalyze_collector.QueryPlanInformation{
+		{QueryPlanIdx: 0, ExplainPlan: "Index Scan using test_pkey on test"},
+		{QueryPlanIdx: 1, ExplainPlan: "Seq Scan on test"},
+	}
+	if diff := pretty.Compare(expectedInformations, actual.QueryPlanInformations); diff != "" {
+		t.Errorf("query plan informations diff: (-want +got)\n%s", diff)
+	}
+	expectedStatistics := []*pganalyze_collector.QueryPlanStatistic{
+		{QueryPlanIdx: 0, Calls: 8, TotalTime: 4},
+		{QueryPlanIdx: 1, Calls: 7, TotalTime: 116},
+	}
+	if diff := pretty.Compare(expectedStatistics, actual.QueryPlanStatistics); diff != "" {
+		t.Errorf("query plan statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestLogLinesQueryID(t *testing.T) {
+	fp := util.FingerprintQuery("SELECT * FROM test WHERE id = $1", "none", -1)
+	fpBuf := make([]byte, 8)
+	binary.BigEndian.PutUint64(fpBuf, fp)
+
+	server := &state.Server{CollectionStatusMutex: &sync.Mutex{}}
+	server.CollectionStatus.QueryFingerprints = map[int64]uint64{42: fp}
+	server.CollectionStatus.QueryTexts = state.PostgresStatementTextMap{fp: "SELECT * FROM test WHERE id = $1"}
+
+	logState := state.TransientLogState{LogFiles: []state.LogFile{{
+		LogLines: []state.LogLine{
+			// Associated through the query ID, without needing the query text
+			{Username: "app", Database: "mydb", QueryID: 42},
+			// Unknown query IDs fall back to the query text
+			{Username: "app", Database: "mydb", QueryID: 43, Query: "SELECT 1"},
+		},
+	}}}
+
+	s, r := transform.LogStateToLogSnapshot(server, logState)
+
+	if len(s.LogLineInformations) != 2 || !s.LogLineInformations[0].HasQueryIdx || !s.LogLineInformations[1].HasQueryIdx {
+		t.Fatalf("Expected both log lines to be associated with a query, got %+v", s.LogLineInformations)
+	}
+	if len(r.QueryReferences) != 2 || !bytes.Equal(r.QueryReferences[s.LogLineInformations[0].QueryIdx].Fingerprint, fpBuf) {
+		t.Errorf("Expected the first log line to reference the fingerprint of query ID 42, got %+v", r.QueryReferences)
+	}
+	if len(r.QueryInformations) != 2 || r.QueryInformations[0].NormalizedQuery != "SELECT * FROM test WHERE id = $1" || r.QueryInformations[1].NormalizedQuery != "SELECT $1" {
+		t.Errorf("Unexpected query informations: %+v", r.QueryInformations)
+	}
+}
+
+func TestQueryTags(t *testing.T) {
+	q := "SELECT * FROM test"
+	fp := util.FingerprintQuery(q, "none", -1)
+	key1 := state.PostgresStatementKey{QueryID: 1}
+	key2 := state.PostgresStatementKey{QueryID: 2}
+	transientState := state.TransientState{
+		Statements: state.PostgresStatementMap{
+			key1: {Fingerprint: fp, Tags: map[string]string{"controller": "users", "action": "index"}},
+			key2: {Fingerprint: fp, Tags: map[string]string{"controller": "users", "action": "show"}},
+		},
+		StatementTexts: state.PostgresStatementTextMap{fp: q},
+	}
+	diffState := state.DiffState{StatementStats: state.DiffedPostgresStatementStatsMap{
+		key1: {Calls: 1, TotalTime: 10},
+		key2: {Calls: 2, TotalTime: 20},
+	}}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+	expected := []*pganalyze_collector.QueryStatistic{{
+		Calls: 3, TotalTime: 30,
+		Tags: []*pganalyze_collector.QueryTag{
+			{Key: "action", Value: "index"},
+			{Key: "action", Value: "show"},
+			{Key: "controller", Value: "users"},
+		},
+	}}
+	if diff := pretty.Compare(expected, actual.QueryStatistics); diff != "" {
+		t.Errorf("query statistics diff: (-want +got)\n%s", diff)
+	}
+
+	server := &state.Server{CollectionStatusMutex: &sync.Mutex{}}
+	logState := state.TransientLogState{QuerySamples: []state.PostgresQuerySample{
+		{Query: "SELECT 1 /*route='%2Fusers'*/", Tags: map[string]string{"route": "/users"}},
+		{Query: "SELECT 1"},
+	}}
+
+	s, _ := transform.LogStateToLogSnapshot(server, logState)
+
+	expectedTags := [][]*pganalyze_collector.QueryTag{{{Key: "route", Value: "/users"}}, nil}
+	var actualTags [][]*pganalyze_collector.QueryTag
+	for _, sample := range s.QuerySamples {
+		actualTags = append(actualTags, sample.Tags)
+	}
+	if diff := pretty.Compare(expectedTags, actualTags); diff != "" {
+		t.Errorf("query sample tags diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestAlloyDB(t *testing.T) {
+	newState := state.PersistedState{
+		Relations: []state.PostgresRelation{{Oid: 1, DatabaseOid: 1, SchemaName: "public", RelationName: "orders"}},
+	}
+	transientState := state.TransientState{
+		Databases: []state.PostgresDatabase{{Oid: 1, Name: "app"}},
+		AlloyDB: &state.PostgresAlloyDB{
+			InstanceRole:          "read_pool",
+			ColumnarEngineEnabled: true,
+			ColumnarRelations: []state.PostgresAlloyDBColumnarRelation{
+				{DatabaseName: "app", SchemaName: "public", RelationName: "orders", Status: "Usable", SizeBytes: 8192, TotalBlockCount: null.IntFrom(10)},
+				{DatabaseName: "app", SchemaName: "public", RelationName: "events", Status: "Usable", SizeBytes: 16384},
+			},
+			ColumnarRecommendedColumns: []state.PostgresAlloyDBColumnarColumn{
+				{DatabaseName: "app", SchemaName: "public", RelationName: "orders", ColumnName: "created_at"},
+			},
+		},
+	}
+
+	actual := transform.StateToSnapshot(newState, state.DiffState{}, transientState)
+
+	info := actual.AlloydbInformation
+	if info == nil {
+		t.Fatal("expected AlloyDB information to be set")
+	}
+	relationName := func(idx int32) string {
+		ref := actual.RelationReferences[idx]
+		return actual.DatabaseReferences[ref.DatabaseIdx].Name + "." + ref.SchemaName + "." + ref.RelationName
+	}
+	type columnarRelation struct {
+		Relation          string
+		TotalBlockCount   int64
+		InvalidBlockCount int64
+	}
+	var actualRelations []columnarRelation
+	for _, r := range info.ColumnarRelations {
+		actualRelations = append(actualRelations, columnarRelation{relationName(r.RelationIdx), r.TotalBlockCount, r.InvalidBlockCount})
+	}
+	expectedRelations := []columnarRelation{{"app.public.orders", 10, -1}, {"app.public.events", -1, -1}}
+	if diff := pretty.Compare(expectedRelations, actualRelations); diff != "" {
+		t.Errorf("columnar relations diff: (-want +got)\n%s", diff)
+	}
+	if info.InstanceRole != "read_pool" || len(actual.RelationReferences) != 2 {
+		t.Errorf("expected read pool role and 2 relation references, got %q and %d", info.InstanceRole, len(actual.RelationReferences))
+	}
+	if len(info.ColumnarRecommendedColumns) != 1 || relationName(info.ColumnarRecommendedColumns[0].RelationIdx) != "app.public.orders" {
+		t.Errorf("unexpected recommended columns: %+v", info.ColumnarRecommendedColumns)
+	}
+}
+
+func TestCollectorStatsGcpPubSub(t *testing.T) {
+	diffState := state.DiffState{CollectorStats: state.DiffedCollectorStats{
+		GcpPubSub: []state.GcpPubSubStats{
+			{Subscription: "projects/p/subscriptions/logs", MessagesReceived: 10, MessagesFiltered: 2, LinesDelivered: 8, BacklogAge: 1500 * time.Millisecond},
+		},
+	}}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, state.TransientState{})
+
+	expected := []*pganalyze_collector.GcpPubSubStatistic{
+		{Subscription: "projects/p/subscriptions/logs", MessagesReceived: 10, MessagesFiltered: 2, LinesDelivered: 8, BacklogAgeSeconds: 1.5},
+	}
+	if diff := pretty.Compare(expected, actual.CollectorStatistic.GcpPubsubStatistics); diff != "" {
+		t.Errorf("Pub/Sub statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestSystemProcesses(t *testing.T) {
+	systemState := state.SystemState{
+		Processes: []state.SystemProcess{
+			{Pid: 1, Name: "postgres", CPUPercent: 0.5, MemoryPercent: 1.25, RssBytes: 4096, VssBytes: 8192},
+			{Pid: 42, ParentPid: 1, Name: "postgres: checkpointer", RssBytes: 2048},
+		},
+		Tasks: state.SystemTasks{Total: 120, Running: 2, Sleeping: 117, Zombie: 1},
+	}
+
+	actual := transform.SystemStateToCompactSystemSnapshot(systemState).System
+
+	expectedProcesses := []*pganalyze_collector.ProcessStatistic{
+		{Pid: 1, Name: "postgres", CpuPercent: 0.5, MemoryPercent: 1.25, RssBytes: 4096, VssBytes: 8192},
+		{Pid: 42, ParentPid: 1, Name: "postgres: checkpointer", RssBytes: 2048},
+	}
+	if diff := pretty.Compare(expectedProcesses, actual.ProcessStatistics); diff != "" {
+		t.Errorf("process statistics diff: (-want +got)\n%s", diff)
+	}
+	expectedTasks := &pganalyze_collector.TaskStatistic{Total: 120, Running: 2, Sleeping: 117, Zombie: 1}
+	if diff := pretty.Compare(expectedTasks, actual.TaskStatistic); diff != "" {
+		t.Errorf("task statistic diff: (-want +got)\n%s", diff)
+	}
+
+	// Platforms without process information don't send empty task counts
+	if actual := transform.SystemStateToCompactSystemSnapshot(state.SystemState{}).System; actual.TaskStatistic != nil {
+		t.Errorf("expected no task statistic, got %+v", actual.TaskStatistic)
+	}
+}
+
+func TestActivityPerformanceInsights(t *testing.T) {
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	activityState := state.TransientActivityState{
+		PerformanceInsights: &state.AmazonRdsPerformanceInsights{
+			StartTime: startTime,
+			EndTime:   startTime.Add(2 * time.Second),
+			LoadSamples: []state.AmazonRdsLoadSample{
+				{Time: startTime, LoadAvg: 1.5},
+				{Time: startTime.Add(time.Second), LoadAvg: 0.25},
+			},
+			WaitEvents: []state.AmazonRdsWaitEventLoad{
+				{WaitEventType: "CPU", WaitEvent: "CPU", LoadAvg: 0.5},
+				{WaitEventType: "IO", WaitEvent: "DataFileRead", LoadAvg: 0.375},
+			},
+		},
+	}
+
+	actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	expected := &pganalyze_collector.PerformanceInsightsInformation{
+		StartTime: &timestamppb.Timestamp{Seconds: 1609459200},
+		EndTime:   &timestamppb.Timestamp{Seconds: 1609459202},
+		LoadSamples: []*pganalyze_collector.PerformanceInsightsInformation_LoadSample{
+			{Time: &timestamppb.Timestamp{Seconds: 1609459200}, LoadAvg: 1.5},
+			{Time: &timestamppb.Timestamp{Seconds: 1609459201}, LoadAvg: 0.25},
+		},
+		WaitEventLoads: []*pganalyze_collector.PerformanceInsightsInformation_WaitEventLoad{
+			{WaitEventType: "CPU", WaitEvent: "CPU", LoadAvg: 0.5},
+			{WaitEventType: "IO", WaitEvent: "DataFileRead", LoadAvg: 0.375},
+		},
+	}
+	if diff := pretty.Compare(expected, actual.PerformanceInsights); diff != "" {
+		t.Errorf("Performance Insights diff: (-want +got)\n%s", diff)
+	}
+
+	if actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, state.TransientActivityState{}); actual.PerformanceInsights != nil {
+		t.Errorf("expected no Performance Insights information, got %+v", actual.PerformanceInsights)
+	}
+}
+
+func TestActivityVacuums(t *testing.T) {
+	startedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	activityState := state.TransientActivityState{
+		Vacuums: []state.PostgresVacuumProgress{{
+			VacuumIdentity: 1, BackendIdentity: 2, DatabaseName: "app", SchemaName: "public", RelationName: "orders", StartedAt: startedAt,
+			Autovacuum: true, AntiWraparound: true, Pid: 10, WaitEventType: "Timeout", WaitEvent: "VacuumDelay",
+			Phase: "vacuuming indexes", HeapBlksTotal: 1000, HeapBlksScanned: 1000, HeapBlksVacuumed: 200, IndexVacuumCount: 1, NumDeadTuples: 5000,
+			MaxDeadTupleBytes: 67108864, DeadTupleBytes: 1048576, IndexesTotal: 3, IndexesProcessed: 1,
+		}},
+	}
+
+	actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	expectedInfo := []*pganalyze_collector.VacuumProgressInformation{
+		{VacuumIdentity: 1, BackendIdentity: 2, RoleIdx: -1, DatabaseIdx: 0, RelationIdx: 0, StartedAt: &timestamppb.Timestamp{Seconds: startedAt.Unix()}, Autovacuum: true, AntiWraparound: true, Pid: 10},
+	}
+	if diff := pretty.Compare(expectedInfo, actual.VacuumProgressInformations); diff != "" {
+		t.Errorf("vacuum progress information diff: (-want +got)\n%s", diff)
+	}
+	expectedStats := []*pganalyze_collector.VacuumProgressStatistic{
+		{
+			VacuumIdentity: 1, Phase: pganalyze_collector.VacuumProgressStatistic_VACUUM_INDEX, HeapBlksTotal: 1000, HeapBlksScanned: 1000, HeapBlksVacuumed: 200, IndexVacuumCount: 1, NumDeadTuples: 5000,
+			WaitEventType: "Timeout", WaitEvent: "VacuumDelay", MaxDeadTupleBytes: 67108864, DeadTupleBytes: 1048576, IndexesTotal: 3, IndexesProcessed: 1,
+		},
+	}
+	if diff := pretty.Compare(expectedStats, actual.VacuumProgressStatistics); diff != "" {
+		t.Errorf("vacuum progress statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestActivityProgress(t *testing.T) {
+	activityState := state.TransientActivityState{
+		Progress: []state.PostgresProgress{
+			{Pid: 10, Command: "CREATE INDEX CONCURRENTLY", DatabaseName: "app", SchemaName: "public", RelationName: "orders", Phase: "building index", BlocksTotal: 1000, BlocksDone: 250},
+			{Pid: 11, Command: "COPY FROM", DatabaseName: "app", SchemaName: "public", RelationName: "events", TuplesDone: 5000, BytesTotal: 1048576, BytesDone: 65536},
+			{Pid: 12, Command: "BASE_BACKUP", Phase: "streaming database files", BytesTotal: 1073741824, BytesDone: 536870912},
+		},
+	}
+
+	actual, refs := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	expected := []*pganalyze_collector.MaintenanceProgressInformation{
+		{Pid: 10, Command: "CREATE INDEX CONCURRENTLY", DatabaseIdx: 0, RelationIdx: 0, Phase: "building index", BlocksTotal: 1000, BlocksDone: 250},
+		{Pid: 11, Command: "COPY FROM", DatabaseIdx: 0, RelationIdx: 1, TuplesDone: 5000, BytesTotal: 1048576, BytesDone: 65536},
+		{Pid: 12, Command: "BASE_BACKUP", DatabaseIdx: -1, RelationIdx: -1, Phase: "streaming database files", BytesTotal: 1073741824, BytesDone: 536870912},
+	}
+	if diff := pretty.Compare(expected, actual.MaintenanceProgress); diff != "" {
+		t.Errorf("maintenance progress diff: (-want +got)\n%s", diff)
+	}
+	expectedRelations := []*pganalyze_collector.RelationReference{
+		{DatabaseIdx: 0, SchemaName: "public", RelationName: "orders"},
+		{DatabaseIdx: 0, SchemaName: "public", RelationName: "events"},
+	}
+	if diff := pretty.Compare(expectedRelations, refs.RelationReferences); diff != "" {
+		t.Errorf("relation references diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestActivitySamples(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	var samples state.ActivitySamples
+	samples.Add(map[state.ActivitySampleKey]int32{
+		{State: "active"}: 2,
+		{State: "active", WaitEventType: "Lock", WaitEvent: "relation"}: 1,
+	}, nil, nil, start)
+	samples.Add(map[state.ActivitySampleKey]int32{
+		{State: "active"}: 1,
+		{State: "active", WaitEventType: "Lock", WaitEvent: "relation"}:   5,
+		{State: "idle", WaitEventType: "Client", WaitEvent: "ClientRead"}: 10,
+	}, nil, nil, start.Add(time.Second))
+
+	actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, state.TransientActivityState{Samples: samples})
+
+	expected := &pganalyze_collector.ActivitySampleInformation{
+		SampleCount:      2,
+		FirstSampleAt:    &timestamppb.Timestamp{Seconds: start.Unix()},
+		LastSampleAt:     &timestamppb.Timestamp{Seconds: start.Unix() + 1},
+		MaxLockWaiters:   5,
+		MaxLockWaitersAt: &timestamppb.Timestamp{Seconds: start.Unix() + 1},
+		BackendCounts: []*pganalyze_collector.ActivitySampleInformation_BackendCount{
+			{State: "active", Sum: 3, Max: 2},
+			{State: "active", WaitEventType: "Lock", WaitEvent: "relation", Sum: 6, Max: 5},
+			{State: "idle", WaitEventType: "Client", WaitEvent: "ClientRead", Sum: 10, Max: 10},
+		},
+	}
+	if diff := pretty.Compare(expected, actual.ActivitySamples); diff != "" {
+		t.Errorf("activity samples diff: (-want +got)\n%s", diff)
+	}
+
+	if actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, state.TransientActivityState{}); actual.ActivitySamples != nil {
+		t.Errorf("expected no activity samples without sampling, got %+v", actual.ActivitySamples)
+	}
+}
+
+func TestActivitySampleWaitEvents(t *testing.T) {
+	q := "SELECT * FROM test WHERE id = $1"
+	fp := util.FingerprintQuery(q, "none", -1)
+	fpBuf := make([]byte, 8)
+	binary.BigEndian.PutUint64(fpBuf, fp)
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	lockWait := state.ActivityWaitEventKey{Fingerprint: fp, DatabaseName: "app", RoleName: "web", BackendType: "client backend", WaitEventType: "Lock", WaitEvent: "transactionid"}
+	ioWait := state.ActivityWaitEventKey{Fingerprint: fp, DatabaseName: "app", RoleName: "web", BackendType: "client backend", WaitEventType: "IO", WaitEvent: "DataFileRead"}
+	checkpointer := state.ActivityWaitEventKey{BackendType: "checkpointer", WaitEventType: "IO", WaitEvent: "DataFileSync"}
+	var samples state.ActivitySamples
+	samples.Add(nil, map[state.ActivityWaitEventKey]int32{lockWait: 3, ioWait: 1}, map[uint64]string{fp: q}, start)
+	samples.Add(nil, map[state.ActivityWaitEventKey]int32{lockWait: 2, checkpointer: 1}, nil, start.Add(time.Second))
+
+	actual, refs := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, state.TransientActivityState{Samples: samples})
+
+	expected := []*pganalyze_collector.ActivitySampleInformation_WaitEventCount{
+		{HasQueryIdx: true, QueryIdx: 0, BackendType: "client backend", WaitEventType: "Lock", WaitEvent: "transactionid", Count: 5},
+		{BackendType: "checkpointer", WaitEventType: "IO", WaitEvent: "DataFileSync", Count: 1},
+		{HasQueryIdx: true, QueryIdx: 0, BackendType: "client backend", WaitEventType: "IO", WaitEvent: "DataFileRead", Count: 1},
+	}
+	if diff := pretty.Compare(expected, actual.ActivitySamples.WaitEventCounts); diff != "" {
+		t.Errorf("wait event counts diff: (-want +got)\n%s", diff)
+	}
+	expectedRefs := []*pganalyze_collector.QueryReference{{DatabaseIdx: 0, RoleIdx: 0, Fingerprint: fpBuf}}
+	if diff := pretty.Compare(expectedRefs, refs.QueryReferences); diff != "" {
+		t.Errorf("query references diff: (-want +got)\n%s", diff)
+	}
+	if len(refs.QueryInformations) != 1 || refs.QueryInformations[0].NormalizedQuery != q {
+		t.Errorf("unexpected query informations: %+v", refs.QueryInformations)
+	}
+	if len(refs.RoleReferences) != 1 || refs.RoleReferences[0].Name != "web" || len(refs.DatabaseReferences) != 1 || refs.DatabaseReferences[0].Name != "app" {
+		t.Errorf("unexpected role/database references: %+v %+v", refs.RoleReferences, refs.DatabaseReferences)
+	}
+}
+
+func TestActivityLongTransactions(t *testing.T) {
+	xactStart := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	activityState := state.TransientActivityState{
+		LongTransactions: []state.PostgresLongTransaction{
+			{Pid: 10, DatabaseName: "app", RoleName: "web", ApplicationName: "puma", ClientAddr: "10.0.0.1", BackendType: "client backend", State: "idle in transaction", Query: "SELECT 1", XactStart: xactStart, XactAge: time.Hour, IdleTime: 90 * time.Second, BackendXmin: null.IntFrom(1000), XminAge: 500, IdleInTransaction: true},
+			{Pid: 11, BackendType: "walsender", State: "active", XactStart: xactStart},
+		},
+	}
+
+	actual, refs := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	expected := []*pganalyze_collector.LongTransactionInformation{
+		{Pid: 10, DatabaseIdx: 0, RoleIdx: 0, ApplicationName: "puma", ClientAddr: "10.0.0.1", BackendType: "client backend", State: "idle in transaction", HasQueryIdx: true, QueryIdx: 0, XactStart: &timestamppb.Timestamp{Seconds: xactStart.Unix()}, IdleSeconds: 90, HasBackendXmin: true, BackendXmin: 1000, XminAge: 500, IdleInTransaction: true},
+		{Pid: 11, DatabaseIdx: -1, RoleIdx: -1, BackendType: "walsender", State: "active", XactStart: &timestamppb.Timestamp{Seconds: xactStart.Unix()}},
+	}
+	if diff := pretty.Compare(expected, actual.LongTransactions); diff != "" {
+		t.Errorf("long transactions diff: (-want +got)\n%s", diff)
+	}
+	if len(refs.QueryInformations) != 1 || refs.QueryInformations[0].NormalizedQuery != "SELECT $1" {
+		t.Errorf("unexpected query informations: %+v", refs.QueryInformations)
+	}
+}
+
+func TestActivityBackendMemory(t *testing.T) {
+	activityState := state.TransientActivityState{
+		Backends: []state.PostgresBackend{{Identity: 1, Pid: 10}, {Identity: 2, Pid: 11}},
+		BackendMemory: []state.PostgresBackendMemory{
+			{Identity: 2, Pid: 11, PrivateBytes: 512 * 1024 * 1024, ContextsLogged: true},
+			// Backend that exited after its memory was read
+			{Identity: 3, Pid: 12, PrivateBytes: 1024},
+		},
+	}
+
+	actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	if len(actual.Backends) != 2 {
+		t.Fatalf("expected two backends, got %d", len(actual.Backends))
+	}
+	if actual.Backends[0].PrivateMemoryBytes != 0 || actual.Backends[0].MemoryContextsLogged {
+		t.Errorf("expected no memory information for the first backend, got %+v", actual.Backends[0])
+	}
+	if actual.Backends[1].PrivateMemoryBytes != 512*1024*1024 || !actual.Backends[1].MemoryContextsLogged {
+		t.Errorf("expected memory information for the second backend, got %+v", actual.Backends[1])
+	}
+}
+
+func TestActivityBlockingLocks(t *testing.T) {
+	waitStart := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	activityState := state.TransientActivityState{
+		BlockingLocks: []state.PostgresBlockingLock{
+			{BlockedPid: 20, BlockingPid: 10, LockType: "relation", Mode: "AccessExclusiveLock", DatabaseOid: 1, DatabaseName: "app", RelationOid: 100, SchemaName: "public", RelationName: "orders", WaitingSince: null.TimeFrom(waitStart)},
+			{BlockedPid: 30, BlockingPid: 20, LockType: "relation", Mode: "AccessShareLock", DatabaseOid: 1, DatabaseName: "app", RelationOid: 100, SchemaName: "public", RelationName: "orders"},
+			{BlockedPid: 40, BlockingPid: 10, LockType: "transactionid", Mode: "ShareLock"},
+		},
+	}
+
+	actual, refs := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	expected := []*pganalyze_collector.BlockingLockInformation{
+		{BlockedPid: 20, BlockingPid: 10, LockType: "relation", Mode: "AccessExclusiveLock", DatabaseIdx: 0, RelationIdx: 0, WaitingSince: &timestamppb.Timestamp{Seconds: waitStart.Unix()}},
+		{BlockedPid: 30, BlockingPid: 20, LockType: "relation", Mode: "AccessShareLock", DatabaseIdx: 0, RelationIdx: 0},
+		{BlockedPid: 40, BlockingPid: 10, LockType: "transactionid", Mode: "ShareLock", DatabaseIdx: -1, RelationIdx: -1},
+	}
+	if diff := pretty.Compare(expected, actual.BlockingLocks); diff != "" {
+		t.Errorf("blocking locks diff: (-want +got)\n%s", diff)
+	}
+	if len(refs.RelationReferences) != 1 || refs.RelationReferences[0].RelationName != "orders" {
+		t.Errorf("expected one relation reference, got %+v", refs.RelationReferences)
+	}
+}
+
+func TestActivityQueryProtocol(t *testing.T) {
+	query := func(q string) null.String { return null.StringFrom(q) }
+	activityState := state.TransientActivityState{
+		Backends: []state.PostgresBackend{
+			{Pid: 1, BackendType: null.StringFrom("client backend"), Query: query("SELECT * FROM test WHERE id = $1")},
+			{Pid: 2, BackendType: null.StringFrom("client backend"), Query: query("SELECT * FROM test WHERE id = 1")},
+			{Pid: 3, BackendType: null.StringFrom("client backend"), Query: query("execute get_test(1)")},
+			{Pid: 4, BackendType: null.StringFrom("client backend"), Query: query("<insufficient privilege>")},
+			{Pid: 5, BackendType: null.StringFrom("autovacuum worker"), Query: query("autovacuum: VACUUM public.test")},
+			{Pid: 6, BackendType: null.StringFrom("client backend")},
+		},
+	}
+
+	actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	expected := []pganalyze_collector.Backend_QueryProtocol{
+		pganalyze_collector.Backend_EXTENDED_QUERY_PROTOCOL,
+		pganalyze_collector.Backend_SIMPLE_QUERY_PROTOCOL,
+		pganalyze_collector.Backend_SQL_PREPARED_QUERY_PROTOCOL,
+		pganalyze_collector.Backend_UNKNOWN_QUERY_PROTOCOL,
+		pganalyze_collector.Backend_UNKNOWN_QUERY_PROTOCOL,
+		pganalyze_collector.Backend_UNKNOWN_QUERY_PROTOCOL,
+	}
+	var protocols []pganalyze_collector.Backend_QueryProtocol
+	for _, backend := range actual.Backends {
+		protocols = append(protocols, backend.QueryProtocol)
+	}
+	if diff := pretty.Compare(expected, protocols); diff != "" {
+		t.Errorf("query protocol diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestActivityQueryFullText(t *testing.T) {
+	activityState := state.TransientActivityState{
+		Backends: []state.PostgresBackend{
+			{Pid: 1, Query: null.StringFrom("SELECT * FROM test WHERE id = $1 AND name = $2"), QueryFullText: true},
+			{Pid: 2, Query: null.StringFrom("SELECT 1")},
+		},
+	}
+
+	actual, _ := transform.ActivityStateToCompactActivitySnapshot(&state.Server{}, activityState)
+
+	if len(actual.Backends) != 2 {
+		t.Fatalf("expected two backends, got %d", len(actual.Backends))
+	}
+	if !actual.Backends[0].QueryFullText || actual.Backends[1].QueryFullText {
+		t.Errorf("expected only the first backend to be marked as having the full query text, got %t and %t", actual.Backends[0].QueryFullText, actual.Backends[1].QueryFullText)
+	}
+}
+
+func TestSystemAuroraReader(t *testing.T) {
+	systemState := state.SystemState{
+		Info: state.SystemInfo{
+			Type:      state.AmazonRdsSystem,
+			AmazonRds: &state.SystemInfoAmazonRds{IsAuroraPostgres: true, IsAuroraServerless: true},
+		},
+		ServerlessCapacity: &state.AuroraServerlessCapacity{CapacityUnits: 4.5, UtilizationPercent: 28.125},
+		ReplicaLagSeconds:  0.025,
+		ProxyStats:         &state.RdsProxyStats{ProxyName: "app-proxy", ClientConnections: 120, DatabaseConnections: 8, QueryRequestsPerSecond: 350.5},
+	}
+
+	actual := transform.SystemStateToCompactSystemSnapshot(systemState).System
+
+	if info := actual.SystemInformation.GetAmazonRds(); info == nil || !info.IsAuroraServerless {
+		t.Errorf("expected Aurora Serverless instance, got %+v", actual.SystemInformation)
+	}
+	expected := &pganalyze_collector.ServerlessCapacityStatistic{CapacityUnits: 4.5, UtilizationPercent: 28.125}
+	if diff := pretty.Compare(expected, actual.ServerlessCapacityStatistic); diff != "" {
+		t.Errorf("serverless capacity diff: (-want +got)\n%s", diff)
+	}
+	expectedProxy := &pganalyze_collector.RdsProxyStatistic{ProxyName: "app-proxy", ClientConnections: 120, DatabaseConnections: 8, QueryRequestsPerSecond: 350.5}
+	if diff := pretty.Compare(expectedProxy, actual.RdsProxyStatistic); diff != "" {
+		t.Errorf("RDS Proxy statistic diff: (-want +got)\n%s", diff)
+	}
+	if actual.ReplicaLagSeconds != 0.025 {
+		t.Errorf("expected replica lag of 25ms, got %fs", actual.ReplicaLagSeconds)
+	}
+}
+
+func TestSystemAzureDatabase(t *testing.T) {
+	systemState := state.SystemState{
+		Info:              state.SystemInfo{Type: state.AzureDatabaseSystem},
+		MemoryUsedPercent: 42.5,
+		ReplicaLagSeconds: 3,
+	}
+
+	actual := transform.SystemStateToCompactSystemSnapshot(systemState).System
+
+	if actual.SystemInformation.Type != pganalyze_collector.SystemInformation_AZURE_DATABASE_SYSTEM {
+		t.Errorf("expected Azure Database system, got %s", actual.SystemInformation.Type)
+	}
+	if actual.MemoryStatistic.UsedPercent != 42.5 || actual.MemoryStatistic.TotalBytes != 0 {
+		t.Errorf("expected only memory used percentage, got %+v", actual.MemoryStatistic)
+	}
+	if actual.ReplicaLagSeconds != 3 {
+		t.Errorf("expected replica lag of 3s, got %fs", actual.ReplicaLagSeconds)
+	}
+}
+
+func TestSystemCrunchyBridge(t *testing.T) {
+	systemState := state.SystemState{
+		Info: state.SystemInfo{
+			Type: state.CrunchyBridgeSystem,
+			CrunchyBridge: &state.SystemInfoCrunchyBridge{
+				ClusterName:  "production",
+				PlanID:       "standard-8",
+				ProviderID:   "aws",
+				RegionID:     "us-east-1",
+				MajorVersion: 15,
+				IsHA:         true,
+				State:        "ready",
+				CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	actual := transform.SystemStateToCompactSystemSnapshot(systemState).System
+
+	expected := &pganalyze_collector.SystemInformationCrunchyBridge{
+		ClusterName:  "production",
+		PlanId:       "standard-8",
+		ProviderId:   "aws",
+		RegionId:     "us-east-1",
+		MajorVersion: 15,
+		IsHa:         true,
+		State:        "ready",
+		CreatedAt:    &timestamppb.Timestamp{Seconds: 1609459200},
+	}
+	if diff := pretty.Compare(expected, actual.SystemInformation.GetCrunchyBridge()); diff != "" {
+		t.Errorf("Crunchy Bridge information diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestCitus(t *testing.T) {
+	transientState := state.TransientState{
+		Roles:     []state.PostgresRole{{Oid: 10, Name: "app"}},
+		Databases: []state.PostgresDatabase{{Oid: 16384, Name: "app"}},
+		Citus: &state.PostgresCitus{
+			DatabaseName: "app",
+			Nodes: []state.PostgresCitusNode{
+				{NodeID: 1, GroupID: 0, NodeName: "coordinator", NodePort: 5432, NodeRole: "primary", IsActive: true},
+				{NodeID: 2, GroupID: 1, NodeName: "worker-1", NodePort: 5432, NodeRole: "primary", IsActive: true, ShouldHaveShards: true, ShardCount: 32, ShardSizeBytes: 1 << 30},
+			},
+			DistributedTables: []state.PostgresCitusTable{
+				{SchemaName: "public", RelationName: "events", TableType: "distributed", DistributionColumn: null.StringFrom("tenant_id"), ColocationID: 1, ShardCount: 32, TableSizeBytes: 1 << 30},
+				{SchemaName: "public", RelationName: "countries", TableType: "reference", ColocationID: 2, ShardCount: 1, TableSizeBytes: 8192},
+			},
+			DistributedActivity: []state.PostgresCitusBackend{
+				{NodeName: "worker-1", NodePort: 5432, Pid: 123, GlobalPid: null.IntFrom(10000000123), DatabaseName: null.StringFrom("app"), RoleName: null.StringFrom("app"),
+					State: null.StringFrom("active"), QueryStart: null.TimeFrom(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), WaitEventType: null.StringFrom("IO"), WaitEvent: null.StringFrom("DataFileRead")},
+				{NodeName: "worker-1", NodePort: 5432, Pid: 124},
+			},
+			DistributedStatements: []state.PostgresCitusStatement{
+				{QueryID: 123456789, UserOid: 10, DatabaseOid: 16384, Executor: "adaptive", PartitionKey: null.StringFrom("42"), Calls: 100},
+				{QueryID: 987654321, UserOid: 10, DatabaseOid: 16384, Executor: "insert-select", Calls: 5},
+			},
+			ShardPlacements: []state.PostgresCitusShardPlacement{
+				{ShardID: 102008, SchemaName: "public", RelationName: "events", NodeName: "worker-1", NodePort: 5432, SizeBytes: 1 << 25},
+			},
+			RebalanceProgress: []state.PostgresCitusRebalanceMove{
+				{SessionID: 1000, SchemaName: "public", RelationName: "events", ShardID: 102008, SizeBytes: 1 << 25,
+					SourceName: "worker-1", SourcePort: 5432, TargetName: "worker-2", TargetPort: 5432, Progress: 1},
+			},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	info := actual.CitusInformation
+	if info == nil {
+		t.Fatal("expected Citus information to be set")
+	}
+	if len(info.Nodes) != 2 || info.Nodes[1].NodeName != "worker-1" || info.Nodes[1].ShardCount != 32 || !info.Nodes[1].ShouldHaveShards {
+		t.Errorf("unexpected nodes: %+v", info.Nodes)
+	}
+
+	type distributedTable struct {
+		Relation           string
+		TableType          string
+		DistributionColumn string
+	}
+	var actualTables []distributedTable
+	for _, table := range info.DistributedTables {
+		ref := actual.RelationReferences[table.RelationIdx]
+		column := "(none)"
+		if table.HasDistributionColumn {
+			column = table.DistributionColumn
+		}
+		actualTables = append(actualTables, distributedTable{actual.DatabaseReferences[ref.DatabaseIdx].Name + "." + ref.SchemaName + "." + ref.RelationName, table.TableType, column})
+	}
+	expectedTables := []distributedTable{{"app.public.events", "distributed", "tenant_id"}, {"app.public.countries", "reference", "(none)"}}
+	if diff := pretty.Compare(expectedTables, actualTables); diff != "" {
+		t.Errorf("distributed tables diff: (-want +got)\n%s", diff)
+	}
+
+	expectedBackends := []*pganalyze_collector.CitusInformation_DistributedBackend{
+		{NodeName: "worker-1", NodePort: 5432, Pid: 123, GlobalPid: 10000000123, HasRoleIdx: true, RoleIdx: 0, HasDatabaseIdx: true, DatabaseIdx: 0,
+			State: "active", QueryStart: &timestamppb.Timestamp{Seconds: 1609459200}, WaitEventType: "IO", WaitEvent: "DataFileRead"},
+		{NodeName: "worker-1", NodePort: 5432, Pid: 124},
+	}
+	if diff := pretty.Compare(expectedBackends, info.DistributedBackends); diff != "" {
+		t.Errorf("distributed backends diff: (-want +got)\n%s", diff)
+	}
+	expectedStatements := []*pganalyze_collector.CitusInformation_DistributedStatement{
+		{QueryId: 123456789, RoleIdx: 0, DatabaseIdx: 0, Executor: "adaptive", HasPartitionKey: true, PartitionKey: "42", Calls: 100},
+		{QueryId: 987654321, RoleIdx: 0, DatabaseIdx: 0, Executor: "insert-select", Calls: 5},
+	}
+	if diff := pretty.Compare(expectedStatements, info.DistributedStatements); diff != "" {
+		t.Errorf("distributed statements diff: (-want +got)\n%s", diff)
+	}
+	// Shard placements and shard moves reference the distributed table
+	expectedPlacements := []*pganalyze_collector.CitusInformation_ShardPlacement{
+		{ShardId: 102008, RelationIdx: info.DistributedTables[0].RelationIdx, NodeName: "worker-1", NodePort: 5432, SizeBytes: 1 << 25},
+	}
+	if diff := pretty.Compare(expectedPlacements, info.ShardPlacements); diff != "" {
+		t.Errorf("shard placements diff: (-want +got)\n%s", diff)
+	}
+	expectedMoves := []*pganalyze_collector.CitusInformation_RebalanceMove{
+		{SessionId: 1000, RelationIdx: info.DistributedTables[0].RelationIdx, ShardId: 102008, SizeBytes: 1 << 25,
+			SourceName: "worker-1", SourcePort: 5432, TargetName: "worker-2", TargetPort: 5432, Progress: 1},
+	}
+	if diff := pretty.Compare(expectedMoves, info.RebalanceProgress); diff != "" {
+		t.Errorf("rebalance progress diff: (-want +got)\n%s", diff)
+	}
+	if len(actual.RoleReferences) != 1 || actual.RoleReferences[0].Name != "app" {
+		t.Errorf("unexpected role references: %+v", actual.RoleReferences)
+	}
+}
+
+func TestTimescale(t *testing.T) {
+	newState := state.PersistedState{
+		Relations: []state.PostgresRelation{
+			{Oid: 100, DatabaseOid: 16384, SchemaName: "public", RelationName: "metrics", RelationType: "r"},
+		},
+	}
+	transientState := state.TransientState{
+		Databases: []state.PostgresDatabase{{Oid: 16384, Name: "tsdb"}},
+		TimescaleHypertables: []state.PostgresTimescaleHypertable{
+			{DatabaseOid: 16384, RelationOid: 100, SchemaName: "public", RelationName: "metrics", NumDimensions: 1, NumChunks: 12,
+				CompressionEnabled: true, CompressedChunks: 10, BeforeCompressionSizeBytes: 1 << 30, AfterCompressionSizeBytes: 1 << 27},
+		},
+		TimescaleContinuousAggregates: []state.PostgresTimescaleContinuousAggregate{
+			{DatabaseOid: 16384, SchemaName: "public", ViewName: "metrics_hourly", HypertableSchemaName: "_timescaledb_internal", HypertableName: "_materialized_hypertable_2",
+				JobID: null.IntFrom(1000), LastRunStartedAt: null.TimeFrom(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), LastRunStatus: null.StringFrom("Success"),
+				LastRunDurationSeconds: null.FloatFrom(1.5), TotalRuns: 24, TotalFailures: 1},
+			{DatabaseOid: 16384, SchemaName: "public", ViewName: "metrics_daily", HypertableSchemaName: "_timescaledb_internal", HypertableName: "_materialized_hypertable_3", MaterializedOnly: true},
+		},
+	}
+
+	actual := transform.StateToSnapshot(newState, state.DiffState{}, transientState)
+
+	expectedHypertables := []*pganalyze_collector.TimescaleHypertableInformation{
+		{RelationIdx: 0, NumDimensions: 1, NumChunks: 12, CompressionEnabled: true, CompressedChunks: 10, BeforeCompressionSizeBytes: 1 << 30, AfterCompressionSizeBytes: 1 << 27},
+	}
+	if diff := pretty.Compare(expectedHypertables, actual.TimescaleHypertables); diff != "" {
+		t.Errorf("hypertables diff: (-want +got)\n%s", diff)
+	}
+	if len(actual.RelationReferences) != 1 {
+		t.Errorf("expected hypertable to reference the existing relation, got %+v", actual.RelationReferences)
+	}
+
+	expectedAggregates := []*pganalyze_collector.TimescaleContinuousAggregateInformation{
+		{DatabaseIdx: 0, SchemaName: "public", ViewName: "metrics_hourly", HypertableSchemaName: "_timescaledb_internal", HypertableName: "_materialized_hypertable_2",
+			HasJob: true, JobId: 1000, LastRunStartedAt: &timestamppb.Timestamp{Seconds: 1609459200}, LastRunStatus: "Success", LastRunDurationSeconds: 1.5, TotalRuns: 24, TotalFailures: 1},
+		{DatabaseIdx: 0, SchemaName: "public", ViewName: "metrics_daily", HypertableSchemaName: "_timescaledb_internal", HypertableName: "_materialized_hypertable_3", MaterializedOnly: true,
+			LastRunDurationSeconds: -1},
+	}
+	if diff := pretty.Compare(expectedAggregates, actual.TimescaleContinuousAggregates); diff != "" {
+		t.Errorf("continuous aggregates diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestPatroni(t *testing.T) {
+	transientState := state.TransientState{
+		Patroni: &state.PatroniCluster{
+			Scope:         "main",
+			CurrentMember: "pg-1",
+			Members: []state.PatroniMember{
+				{Name: "pg-1", Role: "leader", State: "running", Host: "10.0.0.1", Port: 5432, Timeline: null.IntFrom(3)},
+				{Name: "pg-2", Role: "replica", State: "streaming", Host: "10.0.0.2", Port: 5432, Timeline: null.IntFrom(3), LagBytes: null.IntFrom(0)},
+				{Name: "pg-3", Role: "replica", State: "starting", Host: "10.0.0.3", Port: 5432},
+			},
+			History: []state.PatroniTimelineChange{
+				{Timeline: 2, LSN: 83886240, Reason: "no recovery target specified", ChangedAt: null.TimeFrom(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), NewLeader: "pg-1"},
+			},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	expected := &pganalyze_collector.PatroniInformation{
+		Scope:         "main",
+		CurrentMember: "pg-1",
+		Members: []*pganalyze_collector.PatroniInformation_Member{
+			{Name: "pg-1", Role: "leader", State: "running", Host: "10.0.0.1", Port: 5432, Timeline: 3, LagBytes: -1},
+			{Name: "pg-2", Role: "replica", State: "streaming", Host: "10.0.0.2", Port: 5432, Timeline: 3, LagBytes: 0},
+			{Name: "pg-3", Role: "replica", State: "starting", Host: "10.0.0.3", Port: 5432, LagBytes: -1},
+		},
+		History: []*pganalyze_collector.PatroniInformation_TimelineChange{
+			{Timeline: 2, Lsn: 83886240, Reason: "no recovery target specified", ChangedAt: &timestamppb.Timestamp{Seconds: 1609459200}, NewLeader: "pg-1"},
+		},
+	}
+	if diff := pretty.Compare(expected, actual.Patroni); diff != "" {
+		t.Errorf("Patroni diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestPgAutoFailover(t *testing.T) {
+	transientState := state.TransientState{
+		PgAutoFailover: &state.PgAutoFailoverFormation{
+			FormationID:   "default",
+			CurrentNodeID: null.IntFrom(2),
+			Nodes: []state.PgAutoFailoverNode{
+				{NodeID: 1, GroupID: 0, NodeName: "node_1", NodeHost: "10.0.0.1", NodePort: 5432, ReportedState: "primary", GoalState: "primary",
+					ReportedRepState: null.StringFrom("quorum"), ReportTime: null.TimeFrom(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), Health: 1, CandidatePriority: 50, ReplicationQuorum: true},
+				{NodeID: 2, GroupID: 0, NodeName: "node_2", NodeHost: "10.0.0.2", NodePort: 5432, ReportedState: "catchingup", GoalState: "secondary", Health: -1, CandidatePriority: 50},
+			},
+			Events: []state.PgAutoFailoverEvent{
+				{EventID: 10, EventTime: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), ReportedState: "catchingup", GoalState: "secondary", Description: "Setting goal state of node 2 to secondary"},
+			},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	expected := &pganalyze_collector.PgAutoFailoverInformation{
+		FormationId:      "default",
+		HasCurrentNodeId: true,
+		CurrentNodeId:    2,
+		Nodes: []*pganalyze_collector.PgAutoFailoverInformation_Node{
+			{NodeId: 1, GroupId: 0, NodeName: "node_1", NodeHost: "10.0.0.1", NodePort: 5432, ReportedState: "primary", GoalState: "primary",
+				ReportedRepState: "quorum", ReportTime: &timestamppb.Timestamp{Seconds: 1609459200}, Health: 1, CandidatePriority: 50, ReplicationQuorum: true},
+			{NodeId: 2, GroupId: 0, NodeName: "node_2", NodeHost: "10.0.0.2", NodePort: 5432, ReportedState: "catchingup", GoalState: "secondary", Health: -1, CandidatePriority: 50},
+		},
+		Events: []*pganalyze_collector.PgAutoFailoverInformation_Event{
+			{EventId: 10, EventTime: &timestamppb.Timestamp{Seconds: 1609459200}, ReportedState: "catchingup", GoalState: "secondary", Description: "Setting goal state of node 2 to secondary"},
+		},
+	}
+	if diff := pretty.Compare(expected, actual.PgAutoFailover); diff != "" {
+		t.Errorf("pg_auto_failover diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestPgBouncer(t *testing.T) {
+	diffState := state.DiffState{
+		PgBouncerStats: state.DiffedPgBouncerStatsMap{
+			{Address: "pgbouncer-1:6432", Database: "app"}:       {XactCount: 50, QueryCount: 100, Received: 500, Sent: 600, XactTimeUs: 2500, QueryTimeUs: 2000, WaitTimeUs: 5},
+			{Address: "pgbouncer-1:6432", Database: "pgbouncer"}: {QueryCount: 3},
+			{Address: "pgbouncer-2:6432", Database: "app"}:       {XactCount: 7},
+		},
+	}
+	transientState := state.TransientState{
+		PgBouncer: []state.PgBouncerInstance{
+			{
+				Address: "pgbouncer-1:6432",
+				Pools:   []state.PgBouncerPool{{Database: "app", User: "app", PoolMode: "transaction", ClActive: 10, ClWaiting: 2, SvActive: 5, SvIdle: 1, MaxWaitUs: 1500000}},
+				Clients: []state.PgBouncerClientCount{{Database: "app", User: "app", State: "active", Count: 10}},
+				Lists:   map[string]int64{"used_clients": 12, "free_servers": 3},
+			},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, transientState)
+
+	expected := []*pganalyze_collector.PgBouncerInformation{
+		{
+			Address: "pgbouncer-1:6432",
+			DatabaseStatistics: []*pganalyze_collector.PgBouncerInformation_DatabaseStatistic{
+				{Database: "app", XactCount: 50, QueryCount: 100, ReceivedBytes: 500, SentBytes: 600, XactTimeUs: 2500, QueryTimeUs: 2000, WaitTimeUs: 5},
+				{Database: "pgbouncer", QueryCount: 3},
+			},
+			Pools:        []*pganalyze_collector.PgBouncerInformation_Pool{{Database: "app", User: "app", PoolMode: "transaction", ClActive: 10, ClWaiting: 2, SvActive: 5, SvIdle: 1, MaxWaitUs: 1500000}},
+			ClientCounts: []*pganalyze_collector.PgBouncerInformation_ClientCount{{Database: "app", User: "app", State: "active", Count: 10}},
+			Lists:        []*pganalyze_collector.PgBouncerInformation_ListItem{{List: "free_servers", Items: 3}, {List: "used_clients", Items: 12}},
+		},
+	}
+	if diff := pretty.Compare(expected, actual.Pgbouncer); diff != "" {
+		t.Errorf("PgBouncer diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestPgpool(t *testing.T) {
+	transientState := state.TransientState{
+		Pgpool: &state.PgpoolInstance{
+			Address: "pgpool:9999",
+			Nodes: []state.PgpoolNode{
+				{NodeID: 0, Hostname: "pg-1", Port: 5432, Status: "up", LbWeight: 0.5, Role: "primary", SelectCount: 100, LoadBalanceNode: true},
+				{NodeID: 1, Hostname: "pg-2", Port: 5432, Status: "up", LbWeight: 0.5, Role: "standby", SelectCount: 80, ReplicationDelay: 1024,
+					ReplicationState: null.StringFrom("streaming"), LastStatusChange: null.StringFrom("2021-01-01 00:00:00")},
+			},
+			ChildProcesses:     32,
+			ConnectedProcesses: []state.PgpoolProcessCount{{Database: "app", Username: "app", Count: 4}},
+			QueryCache:         &state.PgpoolQueryCache{NumCacheHits: 10, NumSelects: 40, CacheHitRatio: 0.25, NumHashEntries: 1024, UsedHashEntries: 3},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	expected := &pganalyze_collector.PgpoolInformation{
+		Address: "pgpool:9999",
+		Nodes: []*pganalyze_collector.PgpoolInformation_Node{
+			{NodeId: 0, Hostname: "pg-1", Port: 5432, Status: "up", LbWeight: 0.5, Role: "primary", SelectCount: 100, LoadBalanceNode: true},
+			{NodeId: 1, Hostname: "pg-2", Port: 5432, Status: "up", LbWeight: 0.5, Role: "standby", SelectCount: 80, ReplicationDelay: 1024,
+				ReplicationState: "streaming", LastStatusChange: "2021-01-01 00:00:00"},
+		},
+		ChildProcesses:     32,
+		ConnectedProcesses: []*pganalyze_collector.PgpoolInformation_ProcessCount{{Database: "app", Username: "app", Count: 4}},
+		QueryCache:         &pganalyze_collector.PgpoolInformation_QueryCache{NumCacheHits: 10, NumSelects: 40, CacheHitRatio: 0.25, NumHashEntries: 1024, UsedHashEntries: 3},
+	}
+	if diff := pretty.Compare(expected, actual.Pgpool); diff != "" {
+		t.Errorf("Pgpool-II diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestStatIO(t *testing.T) {
+	statsReset := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	diffState := state.DiffState{
+		StatIO: state.DiffedPostgresStatIOMap{
+			{BackendType: "client backend", Object: "relation", Context: "normal"}:    {Reads: 10, ReadTime: 1.5, Hits: 1000, Evictions: 2, StatsReset: statsReset},
+			{BackendType: "checkpointer", Object: "relation", Context: "normal"}:      {Writes: 50, WriteTime: 4, Fsyncs: 3, FsyncTime: 20, StatsReset: statsReset},
+			{BackendType: "client backend", Object: "relation", Context: "bulkread"}:  {Reads: 100, Reuses: 90, StatsReset: statsReset},
+			{BackendType: "autovacuum worker", Object: "relation", Context: "vacuum"}: {StatsReset: statsReset},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, state.TransientState{})
+
+	expected := []*pganalyze_collector.IOStatistic{
+		{BackendType: "checkpointer", Object: "relation", Context: "normal", Writes: 50, WriteTime: 4, Fsyncs: 3, FsyncTime: 20},
+		{BackendType: "client backend", Object: "relation", Context: "bulkread", Reads: 100, Reuses: 90},
+		{BackendType: "client backend", Object: "relation", Context: "normal", Reads: 10, ReadTime: 1.5, Hits: 1000, Evictions: 2},
+	}
+	if diff := pretty.Compare(expected, actual.IoStatistics); diff != "" {
+		t.Errorf("I/O statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestWaitEvents(t *testing.T) {
+	diffState := state.DiffState{
+		WaitEvents: state.DiffedPostgresWaitEventsMap{
+			{QueryID: 2, EventType: "LWLock", Event: "WALWrite"}: {Samples: 5, TimeMs: 50},
+			{QueryID: 1, EventType: "IO", Event: "DataFileRead"}: {Samples: 30, TimeMs: 300},
+			{QueryID: 1}: {Samples: 100, TimeMs: 1000},
+			{QueryID: 0, EventType: "Activity", Event: "WalWriterMain"}: {Samples: 500, TimeMs: 5000},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, state.TransientState{})
+
+	expected := []*pganalyze_collector.QueryWaitEventStatistic{
+		{QueryId: 0, WaitEventType: "Activity", WaitEvent: "WalWriterMain", Samples: 500, TimeMs: 5000},
+		{QueryId: 1, Samples: 100, TimeMs: 1000},
+		{QueryId: 1, WaitEventType: "IO", WaitEvent: "DataFileRead", Samples: 30, TimeMs: 300},
+		{QueryId: 2, WaitEventType: "LWLock", WaitEvent: "WALWrite", Samples: 5, TimeMs: 50},
+	}
+	if diff := pretty.Compare(expected, actual.QueryWaitEventStatistics); diff != "" {
+		t.Errorf("wait event statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestStatSLRU(t *testing.T) {
+	statsReset := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	diffState := state.DiffState{
+		StatSLRU: state.DiffedPostgresStatSLRUMap{
+			"Subtrans":        {BlksZeroed: 2, BlksHit: 100, BlksRead: 40, BlksWritten: 3, Flushes: 1, StatsReset: statsReset},
+			"MultiXactMember": {BlksHit: 20, BlksRead: 1, BlksExists: 4, Truncates: 1, StatsReset: statsReset},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, state.TransientState{})
+
+	expected := []*pganalyze_collector.SLRUStatistic{
+		{Name: "MultiXactMember", BlksHit: 20, BlksRead: 1, BlksExists: 4, Truncates: 1},
+		{Name: "Subtrans", BlksZeroed: 2, BlksHit: 100, BlksRead: 40, BlksWritten: 3, Flushes: 1},
+	}
+	if diff := pretty.Compare(expected, actual.SlruStatistics); diff != "" {
+		t.Errorf("SLRU statistics diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestStatWAL(t *testing.T) {
+	diffState := state.DiffState{
+		StatWAL: &state.DiffedPostgresStatWAL{
+			WalRecords: 6000, WalFpi: 60, WalBytes: 1200000, WalBuffersFull: 2, WalWrite: 30, WalSync: 20, WalWriteTime: 1.5, WalSyncTime: 12,
+			WalRecordsPerSecond: 100, WalFpiPerSecond: 1, WalBytesPerSecond: 20000,
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, diffState, state.TransientState{})
+
+	expected := &pganalyze_collector.WALStatistic{
+		WalRecords: 6000, WalFpi: 60, WalBytes: 1200000, WalBuffersFull: 2, WalWrite: 30, WalSync: 20, WalWriteTime: 1.5, WalSyncTime: 12,
+		WalRecordsPerSecond: 100, WalFpiPerSecond: 1, WalBytesPerSecond: 20000,
+	}
+	if diff := pretty.Compare(expected, actual.WalStatistic); diff != "" {
+		t.Errorf("WAL statistics diff: (-want +got)\n%s", diff)
+	}
+
+	if actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, state.TransientState{}); actual.WalStatistic != nil {
+		t.Errorf("expected no WAL statistics on the first run, got %+v", actual.WalStatistic)
+	}
+}
+
+func TestTempFiles(t *testing.T) {
+	q := "SELECT * FROM test ORDER BY name"
+	fp := util.FingerprintQuery(q, "none", -1)
+	fpBuf := make([]byte, 8)
+	binary.BigEndian.PutUint64(fpBuf, fp)
+	transientState := state.TransientState{
+		Databases:      []state.PostgresDatabase{{Oid: 1, Name: "postgres"}, {Oid: 2, Name: "app"}},
+		StatementTexts: state.PostgresStatementTextMap{fp: q},
+		LogMetrics: state.LogMetrics{TempFilesByQuery: map[state.LogMetricsTempFileQuery]state.LogMetricsTempFiles{
+			{Database: "app", Fingerprint: fp}: {Files: 3, Bytes: 30000000},
+			{Database: "app"}:                  {Files: 1, Bytes: 1000000},
+			{Database: "dropped"}:              {Files: 1, Bytes: 1000000},
+		}},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	expected := []*pganalyze_collector.QueryTempFileStatistic{
+		{DatabaseIdx: 1, Files: 1, Bytes: 1000000},
+		{DatabaseIdx: 1, HasQueryIdx: true, QueryIdx: 0, Files: 3, Bytes: 30000000},
+	}
+	if diff := pretty.Compare(expected, actual.QueryTempFileStatistics); diff != "" {
+		t.Errorf("temp file statistics diff: (-want +got)\n%s", diff)
+	}
+	if len(actual.QueryReferences) != 1 || !bytes.Equal(actual.QueryReferences[0].Fingerprint, fpBuf) || actual.QueryReferences[0].DatabaseIdx != 1 {
+		t.Errorf("unexpected query references: %+v", actual.QueryReferences)
+	}
+	if len(actual.QueryInformations) != 1 || actual.QueryInformations[0].NormalizedQuery != q {
+		t.Errorf("unexpected query informations: %+v", actual.QueryInformations)
+	}
+}
+
+func TestSettingsPendingRestart(t *testing.T) {
+	transientState := state.TransientState{
+		Settings: []state.PostgresSetting{
+			{Name: "shared_buffers", CurrentValue: null.StringFrom("16384"), Unit: null.StringFrom("8kB"), PendingRestart: true, PendingValue: null.StringFrom("32768"), ProviderDefaultValue: null.StringFrom("16384")},
+			{Name: "work_mem", CurrentValue: null.StringFrom("4096"), Unit: null.StringFrom("kB")},
+		},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	expected := []*pganalyze_collector.Setting{
+		{
+			Name: "shared_buffers", CurrentValue: "16384", Unit: &pganalyze_collector.NullString{Valid: true, Value: "8kB"},
+			PendingRestart: true, PendingValue: &pganalyze_collector.NullString{Valid: true, Value: "32768"},
+			ProviderDefaultValue: &pganalyze_collector.NullString{Valid: true, Value: "16384"},
+		},
+		{Name: "work_mem", CurrentValue: "4096", Unit: &pganalyze_collector.NullString{Valid: true, Value: "kB"}},
+	}
+	if diff := pretty.Compare(expected, actual.Settings); diff != "" {
+		t.Errorf("settings diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestLogMetrics(t *testing.T) {
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	transientState := state.TransientState{
+		LogMetrics: state.LogMetrics{
+			Since:                     since,
+			Checkpoints:               3,
+			CheckpointBufsWritten:     220,
+			CheckpointWriteSecs:       3.5,
+			CheckpointSyncSecs:        0.75,
+			CheckpointTotalSecs:       5.0,
+			Autovacuums:               2,
+			AutovacuumsAntiWraparound: 1,
+			AutovacuumPagesRemoved:    3,
+			AutovacuumTuplesDeleted:   1010,
+			AutovacuumFrozenPages:     7,
+			AutovacuumTuplesFrozen:    700,
+			AutovacuumWalBytes:        4096,
+			AutovacuumElapsedSecs:     0.75,
+			ConnectionsReceived:       5,
+			ConnectionsAuthorized:     4,
+			ConnectionsRejected:       1,
+			Disconnections:            2,
+			SessionSecs:               12.5,
+			LoginsByDatabaseRole: map[state.LogMetricsDatabaseRole]int64{
+				{Database: "app", Username: "app"}:      2,
+				{Database: "postgres", Username: "app"}: 1,
+				{Database: "app", Username: "dropped"}:  1,
+			},
+		},
+		Roles:     []state.PostgresRole{{Oid: 10, Name: "postgres"}, {Oid: 20, Name: "app"}},
+		Databases: []state.PostgresDatabase{{Oid: 1, Name: "postgres"}, {Oid: 2, Name: "app"}},
+	}
+
+	actual := transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, transientState)
+
+	expected := &pganalyze_collector.LogMetricsStatistic{
+		CountedSince:              &timestamppb.Timestamp{Seconds: 1609459200},
+		Checkpoints:               3,
+		CheckpointBufsWritten:     220,
+		CheckpointWriteSecs:       3.5,
+		CheckpointSyncSecs:        0.75,
+		CheckpointTotalSecs:       5.0,
+		Autovacuums:               2,
+		AutovacuumsAntiWraparound: 1,
+		AutovacuumPagesRemoved:    3,
+		AutovacuumTuplesDeleted:   1010,
+		AutovacuumFrozenPages:     7,
+		AutovacuumTuplesFrozen:    700,
+		AutovacuumWalBytes:        4096,
+		AutovacuumElapsedSecs:     0.75,
+		ConnectionsReceived:       5,
+		ConnectionsAuthorized:     4,
+		ConnectionsRejected:       1,
+		Disconnections:            2,
+		SessionSecs:               12.5,
+		Logins: []*pganalyze_collector.LogMetricsStatistic_LoginCount{
+			{DatabaseIdx: 0, RoleIdx: 1, Count: 1},
+			{DatabaseIdx: 1, RoleIdx: 1, Count: 2},
+		},
+	}
+	if diff := pretty.Compare(expected, actual.LogMetricsStatistic); diff != "" {
+		t.Errorf("log metrics diff: (-want +got)\n%s", diff)
+	}
+
+	// Servers without analyzed log lines don't send log metrics
+	actual = transform.StateToSnapshot(state.PersistedState{}, state.DiffState{}, state.TransientState{})
+	if actual.LogMetricsStatistic != nil {
+		t.Errorf("expected no log metrics, got %+v", actual.LogMetricsStatistic)
+	}
+}
+
+func TestRelationExtendedStatistics(t *testing.T) {
+	newState := state.PersistedState{Relations: []state.PostgresRelation{{
+		Oid: 100, DatabaseOid: 1, SchemaName: "public", RelationName: "addresses", RelationType: "r",
+		ExtendedStatistics: []state.PostgresExtendedStatistic{{
+			RelationOid: 100, SchemaName: "stats", Name: "addresses_city_zip", Columns: []int32{2, 3}, Kinds: []string{"d", "f"},
+			Definition:   "CREATE STATISTICS stats.addresses_city_zip (ndistinct, dependencies) ON city, zip FROM public.addresses",
+			HasNDistinct: true, HasDependencies: true,
+		}},
+	}}}
+	transientState := state.TransientState{Databases: []state.PostgresDatabase{{Oid: 1, Name: "app"}}}
+
+	actual := transform.StateToSnapshot(newState, state.DiffState{}, transientState)
+
+	if len(actual.RelationInformations) != 1 {
+		t.Fatalf("expected one relation, got %d", len(actual.RelationInformations))
+	}
+	expected := []*pganalyze_collector.RelationInformation_ExtendedStatistic{{
+		SchemaName: "stats", Name: "addresses_city_zip", Columns: []int32{2, 3}, Kinds: []string{"d", "f"},
+		Definition:   "CREATE STATISTICS stats.addresses_city_zip (ndistinct, dependencies) ON city, zip FROM public.addresses",
+		HasNdistinct: true, HasDependencies: true,
+	}}
+	if diff := pretty.Compare(expected, actual.RelationInformations[0].ExtendedStatistics); diff != "" {
+		t.Errorf("extended statistics diff: (-want +got)\n%s", diff)
+	}
+}
@@ -3,6 +3,7 @@ package transform
 import (
 	"bytes"
 	"encoding/binary"
+	"sort"
 
 	snapshot "github.com/pganalyze/collector/output/pganalyze_collector"
 	"github.com/pganalyze/collector/state"
@@ -13,12 +14,17 @@ type statementKey struct {
 	databaseOid state.Oid
 	userOid     state.Oid
 	fingerprint uint64
+	allRoles    bool // Statistics summed up across roles (userOid is not set)
 }
 
+// allRolesName - Role referenced by statistics that were summed up across roles
+const allRolesName = "<all roles>"
+
 type statementValue struct {
 	statement      state.PostgresStatement
 	statementStats state.DiffedPostgresStatementStats
 	queryIDs       []int64
+	keys           []state.PostgresStatementKey // Statements whose statistics were summed up into this value
 }
 
 func upsertQueryReferenceAndInformation(s *snapshot.FullSnapshot, statementTexts state.PostgresStatementTextMap, roleOidToIdx OidToIdx, databaseOidToIdx OidToIdx, key statementKey, value statementValue) int32 {
@@ -29,6 +35,9 @@ func upsertQueryReferenceAndInformation(s *snapshot.FullSnapshot, statementTexts
 		RoleIdx:     roleOidToIdx[key.userOid],
 		Fingerprint: fpBuf,
 	}
+	if key.allRoles {
+		newRef.RoleIdx, s.RoleReferences = upsertRoleReference(s.RoleReferences, allRolesName)
+	}
 
 	for idx, ref := range s.QueryReferences {
 		if ref.DatabaseIdx == newRef.DatabaseIdx && ref.RoleIdx == newRef.RoleIdx &&
@@ -48,6 +57,8 @@ func upsertQueryReferenceAndInformation(s *snapshot.FullSnapshot, statementTexts
 		normalizedQuery = "<insufficient privilege>"
 	} else if value.statement.Collector {
 		normalizedQuery = "<pganalyze-collector>"
+	} else if value.statement.Remainder {
+		normalizedQuery = util.QueryTextRemainder
 	} else {
 		normalizedQuery, _ = statementTexts[key.fingerprint]
 	}
@@ -62,8 +73,18 @@ func upsertQueryReferenceAndInformation(s *snapshot.FullSnapshot, statementTexts
 }
 
 func upsertQueryReferenceAndInformationSimple(server *state.Server, refs []*snapshot.QueryReference, infos []*snapshot.QueryInformation, roleIdx int32, databaseIdx int32, originalQuery string, trackActivityQuerySize int) (int32, []*snapshot.QueryReference, []*snapshot.QueryInformation) {
+	if server.Config.QueryCollapseLists {
+		originalQuery = util.CollapseQueryLists(originalQuery)
+	}
 	fingerprint := util.FingerprintQuery(originalQuery, server.Config.FilterQueryText, trackActivityQuerySize)
+	return upsertQueryReferenceAndInformationFingerprint(refs, infos, roleIdx, databaseIdx, fingerprint, func() string {
+		return util.NormalizeQuery(originalQuery, server.Config.FilterQueryText, trackActivityQuerySize)
+	})
+}
 
+// upsertQueryReferenceAndInformationFingerprint - Like upsertQueryReferenceAndInformationSimple, for
+// queries whose fingerprint is already known (the normalized query is only determined for new references)
+func upsertQueryReferenceAndInformationFingerprint(refs []*snapshot.QueryReference, infos []*snapshot.QueryInformation, roleIdx int32, databaseIdx int32, fingerprint uint64, normalizedQuery func() string) (int32, []*snapshot.QueryReference, []*snapshot.QueryInformation) {
 	fpBuf := make([]byte, 8)
 	binary.BigEndian.PutUint64(fpBuf, fingerprint)
 	newRef := snapshot.QueryReference{
@@ -85,7 +106,7 @@ func upsertQueryReferenceAndInformationSimple(server *state.Server, refs []*snap
 	// Information
 	queryInformation := snapshot.QueryInformation{
 		QueryIdx:        idx,
-		NormalizedQuery: util.NormalizeQuery(originalQuery, server.Config.FilterQueryText, trackActivityQuerySize),
+		NormalizedQuery: normalizedQuery(),
 	}
 	infos = append(infos, &queryInformation)
 
@@ -136,3 +157,26 @@ func upsertRelationReference(refs []*snapshot.RelationReference, databaseIdx int
 
 	return idx, refs
 }
+
+// transformQueryTags - Converts the sqlcommenter/marginalia tags of one or more query texts, sorted by
+// key and value (for statements summed up from several texts, each distinct tag is included once)
+func transformQueryTags(tagMaps ...map[string]string) []*snapshot.QueryTag {
+	seen := make(map[[2]string]bool)
+	var tags []*snapshot.QueryTag
+	for _, tagMap := range tagMaps {
+		for key, value := range tagMap {
+			if seen[[2]string{key, value}] {
+				continue
+			}
+			seen[[2]string{key, value}] = true
+			tags = append(tags, &snapshot.QueryTag{Key: key, Value: value})
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Key != tags[j].Key {
+			return tags[i].Key < tags[j].Key
+		}
+		return tags[i].Value < tags[j].Value
+	})
+	return tags
+}
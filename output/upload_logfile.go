@@ -78,6 +78,9 @@ func EncryptAndUploadLogfiles(httpClient *http.Client, s3 state.GrantS3, encrypt
 		if len(logFile.FilterLogSecret) > 0 {
 			content = logs.ReplaceSecrets(content, logFile.LogLines, logFile.FilterLogSecret)
 		}
+		if len(logFile.FilterLogRedact) > 0 {
+			content = logs.RedactContent(content, logFile.LogLines, logFile.FilterLogRedact)
+		}
 
 		dst := &bytesReadWriteSeeker{}
 		md5 := newMD5Reader(bytes.NewReader(content))
@@ -9,6 +9,7 @@ import (
 
 	"github.com/pganalyze/collector/grant"
 	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/input/system/rds"
 	"github.com/pganalyze/collector/output"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
@@ -87,12 +88,54 @@ func processActivityForServer(server *state.Server, globalCollectionOpts state.C
 	if err != nil {
 		return newState, false, errors.Wrap(err, "error collecting pg_stat_activity")
 	}
+	completeTruncatedQueries(server, func(queryIDs []int64) (map[int64]string, error) {
+		return postgres.GetStatementTextsByQueryID(connection, queryIDs)
+	}, &activity, &newState, logger)
+
+	nextXid, err := postgres.GetNextTransactionID(connection)
+	if err != nil {
+		logger.PrintVerbose("Could not determine xmin age of long-running transactions: %s", err)
+		nextXid = 0
+		err = nil
+	}
+	activity.LongTransactions = findLongTransactions(activity.Backends, time.Now(), server.Config.LongTransactionThreshold, server.Config.IdleInTransactionThreshold, nextXid)
+
+	server.ActivitySamplesMutex.Lock()
+	activity.Samples = server.ActivitySamples
+	server.ActivitySamples = state.ActivitySamples{}
+	server.ActivitySamplesMutex.Unlock()
 
 	activity.Vacuums, err = postgres.GetVacuumProgress(logger, connection, activity.Version, server.Config.IgnoreSchemaRegexp)
 	if err != nil {
 		return newState, false, errors.Wrap(err, "error collecting pg_stat_vacuum_progress")
 	}
 
+	activity.Progress, err = postgres.GetProgress(connection, activity.Version, server.Config.IgnoreSchemaRegexp)
+	if err != nil {
+		logger.PrintWarning("Skipping maintenance progress, due to error: %s", err)
+		err = nil
+	}
+
+	if server.Config.LogBackendMemoryContexts > 0 && activity.Version.Numeric >= state.PostgresVersion14 && backendMemoryAvailable(server) {
+		if newState.MemoryContextsLoggedAt == nil {
+			newState.MemoryContextsLoggedAt = make(map[uint64]time.Time)
+		}
+		activity.BackendMemory = logBackendMemoryContexts(server, connection, activity.Backends, newState.MemoryContextsLoggedAt, logger)
+	}
+
+	activity.BlockingLocks, err = postgres.GetBlockingLocks(connection, activity.Version, server.Config.IgnoreSchemaRegexp)
+	if err != nil {
+		logger.PrintWarning("Skipping lock dependency graph, due to error: %s", err)
+		err = nil
+	}
+
+	if server.Config.SystemType == "amazon_rds" && server.Config.AwsPerformanceInsights {
+		activity.PerformanceInsights, err = rds.GetPerformanceInsights(server.Config, logger)
+		if err != nil {
+			logger.PrintWarning("Skipping Performance Insights data: %s", err)
+		}
+	}
+
 	activity.CollectedAt = time.Now()
 
 	err = output.SubmitCompactActivitySnapshot(server, newGrant, globalCollectionOpts, logger, activity)
@@ -114,6 +157,10 @@ func CollectActivityFromAllServers(servers []*state.Server, globalCollectionOpts
 		if servers[idx].Config.DisableActivity || (servers[idx].Grant.Valid && !servers[idx].Grant.Config.EnableActivity) {
 			continue
 		}
+		// Connecting every few seconds would keep serverless endpoints from ever suspending
+		if servers[idx].Config.DbServerless {
+			continue
+		}
 
 		wg.Add(1)
 		go func(server *state.Server) {
@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"database/sql"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/input/system/selfhosted"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const backendMemoryContextsMinBytes = 100 * 1024 * 1024
+const backendMemoryContextsInterval = 10 * time.Minute
+
+// logBackendMemoryContexts - Determines the client backends with the most private memory, and
+// writes the memory contexts of those above the minimum size to the Postgres log, unless they
+// were logged recently (the state of which is kept in loggedAt)
+//
+// Backends are ranked by the memory the operating system reports for them, so this only works
+// when the collector runs on the database server.
+func logBackendMemoryContexts(server *state.Server, connection *sql.DB, backends []state.PostgresBackend, loggedAt map[uint64]time.Time, logger *util.Logger) []state.PostgresBackendMemory {
+	pids := make([]int32, 0, len(backends))
+	identities := make(map[int32]uint64)
+	for _, backend := range backends {
+		if backend.BackendType.Valid && backend.BackendType.String != "client backend" {
+			continue
+		}
+		pids = append(pids, backend.Pid)
+		identities[backend.Pid] = backend.Identity
+	}
+	privateMemory := selfhosted.GetProcessPrivateMemory(pids)
+
+	var memory []state.PostgresBackendMemory
+	for pid, bytes := range privateMemory {
+		if bytes >= backendMemoryContextsMinBytes {
+			memory = append(memory, state.PostgresBackendMemory{Identity: identities[pid], Pid: pid, PrivateBytes: bytes})
+		}
+	}
+	sort.Slice(memory, func(i, j int) bool {
+		return memory[i].PrivateBytes > memory[j].PrivateBytes
+	})
+	if len(memory) > server.Config.LogBackendMemoryContexts {
+		memory = memory[:server.Config.LogBackendMemoryContexts]
+	}
+
+	now := time.Now()
+	for identity, at := range loggedAt {
+		if now.Sub(at) >= backendMemoryContextsInterval {
+			delete(loggedAt, identity)
+		}
+	}
+	for i, m := range memory {
+		if _, ok := loggedAt[m.Identity]; ok {
+			continue
+		}
+		logged, err := postgres.LogBackendMemoryContexts(connection, m.Pid)
+		if err != nil {
+			logger.PrintVerbose("Could not log memory contexts of pid %d: %s", m.Pid, err)
+			break
+		}
+		memory[i].ContextsLogged = logged
+		loggedAt[m.Identity] = now
+	}
+	return memory
+}
+
+// backendMemoryAvailable - Whether the memory of the server's backends can be inspected, i.e.
+// the collector runs on the database server
+func backendMemoryAvailable(server *state.Server) bool {
+	if server.Config.SystemType != "self_hosted" || server.Config.DbServerless {
+		return false
+	}
+	dbHost := server.Config.GetDbHost()
+	return dbHost == "" || dbHost == "localhost" || dbHost == "127.0.0.1" || os.Getenv("PGA_ALWAYS_COLLECT_SYSTEM_DATA") != ""
+}
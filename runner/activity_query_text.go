@@ -0,0 +1,59 @@
+package runner
+
+import (
+	"time"
+
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+const queryTruncationWarningInterval = time.Hour
+
+// Postgres cuts query texts at a character boundary, so a truncated text in a multibyte encoding
+// can be up to 3 bytes (for UTF-8) shorter than track_activity_query_size - 1
+const queryTruncationSlackBytes = 3
+
+// completeTruncatedQueries - Warns (at most once an hour) when query texts in pg_stat_activity were
+// truncated at track_activity_query_size, and replaces them with the full normalized text from
+// pg_stat_statements (looked up by query ID) if activity_full_query_text is enabled
+func completeTruncatedQueries(server *state.Server, lookupTexts func(queryIDs []int64) (map[int64]string, error), activity *state.TransientActivityState, newState *state.PersistedActivityState, logger *util.Logger) {
+	if activity.TrackActivityQuerySize <= 0 {
+		return
+	}
+	var truncated []int
+	var queryIDs []int64
+	for idx, backend := range activity.Backends {
+		if !backend.Query.Valid || len(backend.Query.String) < activity.TrackActivityQuerySize-1-queryTruncationSlackBytes {
+			continue
+		}
+		truncated = append(truncated, idx)
+		if backend.QueryID.Valid && backend.QueryID.Int64 != 0 {
+			queryIDs = append(queryIDs, backend.QueryID.Int64)
+		}
+	}
+	if len(truncated) == 0 {
+		return
+	}
+
+	remaining := len(truncated)
+	if server.Config.ActivityFullQueryText && len(queryIDs) > 0 {
+		texts, err := lookupTexts(queryIDs)
+		if err != nil {
+			logger.PrintVerbose("Could not look up full query texts in pg_stat_statements: %s", err)
+		}
+		for _, idx := range truncated {
+			backend := &activity.Backends[idx]
+			if text, ok := texts[backend.QueryID.Int64]; ok && backend.QueryID.Valid {
+				backend.Query.String = text
+				backend.QueryFullText = true
+				remaining--
+			}
+		}
+		logger.PrintVerbose("Replaced %d of %d truncated query texts with the full text from pg_stat_statements", len(truncated)-remaining, len(truncated))
+	}
+
+	if remaining > 0 && time.Since(newState.QueryTruncationWarnedAt) >= queryTruncationWarningInterval {
+		logger.PrintWarning("%d query texts in pg_stat_activity were truncated at track_activity_query_size (%d bytes), consider increasing it, or enable activity_full_query_text (Postgres 14+)", remaining, activity.TrackActivityQuerySize)
+		newState.QueryTruncationWarnedAt = time.Now()
+	}
+}
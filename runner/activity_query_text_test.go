@@ -0,0 +1,148 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+var completeTruncatedQueriesTests = []struct {
+	name          string
+	fullQueryText bool
+	lookupErr     error
+	backend       state.PostgresBackend
+	warnedAt      time.Time
+	expectedQuery string
+	expectedFull  bool
+	expectWarning bool
+}{
+	{
+		"text below the limit",
+		true,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT 1"), QueryID: null.IntFrom(1)},
+		time.Time{},
+		"SELECT 1",
+		false,
+		false,
+	},
+	{
+		"truncated text replaced",
+		true,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table"), QueryID: null.IntFrom(1)},
+		time.Time{},
+		"SELECT * FROM table_with_a_long_name",
+		true,
+		false,
+	},
+	{
+		"text truncated at a multibyte character",
+		true,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM ää"), QueryID: null.IntFrom(1)},
+		time.Time{},
+		"SELECT * FROM table_with_a_long_name",
+		true,
+		false,
+	},
+	{
+		"full query text disabled",
+		false,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table"), QueryID: null.IntFrom(1)},
+		time.Time{},
+		"SELECT * FROM table",
+		false,
+		true,
+	},
+	{
+		"truncated text without a query ID",
+		true,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table")},
+		time.Time{},
+		"SELECT * FROM table",
+		false,
+		true,
+	},
+	{
+		"unknown query ID",
+		true,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table"), QueryID: null.IntFrom(2)},
+		time.Time{},
+		"SELECT * FROM table",
+		false,
+		true,
+	},
+	{
+		"lookup failed",
+		true,
+		errors.New("relation \"pg_stat_statements\" does not exist"),
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table"), QueryID: null.IntFrom(1)},
+		time.Time{},
+		"SELECT * FROM table",
+		false,
+		true,
+	},
+	{
+		"warned within the last hour",
+		false,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table"), QueryID: null.IntFrom(1)},
+		time.Now().Add(-10 * time.Minute),
+		"SELECT * FROM table",
+		false,
+		false,
+	},
+	{
+		"warned more than an hour ago",
+		false,
+		nil,
+		state.PostgresBackend{Query: null.StringFrom("SELECT * FROM table"), QueryID: null.IntFrom(1)},
+		time.Now().Add(-2 * time.Hour),
+		"SELECT * FROM table",
+		false,
+		true,
+	},
+}
+
+func TestCompleteTruncatedQueries(t *testing.T) {
+	for _, test := range completeTruncatedQueriesTests {
+		var out bytes.Buffer
+		logger := &util.Logger{Destination: log.New(&out, "", 0)}
+		server := &state.Server{Config: config.ServerConfig{ActivityFullQueryText: test.fullQueryText}}
+		lookupTexts := func(queryIDs []int64) (map[int64]string, error) {
+			if test.lookupErr != nil {
+				return nil, test.lookupErr
+			}
+			return map[int64]string{1: "SELECT * FROM table_with_a_long_name"}, nil
+		}
+		// Postgres keeps up to 19 bytes of each query text
+		activity := state.TransientActivityState{TrackActivityQuerySize: 20, Backends: []state.PostgresBackend{test.backend}}
+		newState := state.PersistedActivityState{QueryTruncationWarnedAt: test.warnedAt}
+
+		completeTruncatedQueries(server, lookupTexts, &activity, &newState, logger)
+
+		backend := activity.Backends[0]
+		if backend.Query.String != test.expectedQuery || backend.QueryFullText != test.expectedFull {
+			t.Errorf("%s: expected query %q (full text %t), got %q (full text %t)", test.name, test.expectedQuery, test.expectedFull, backend.Query.String, backend.QueryFullText)
+		}
+		warned := strings.Contains(out.String(), "truncated at track_activity_query_size")
+		if warned != test.expectWarning {
+			t.Errorf("%s: expected warning %t, got %t (output %q)", test.name, test.expectWarning, warned, out.String())
+		}
+		if warned && time.Since(newState.QueryTruncationWarnedAt) > time.Minute {
+			t.Errorf("%s: expected time of the warning to be remembered, got %s", test.name, newState.QueryTruncationWarnedAt)
+		}
+	}
+}
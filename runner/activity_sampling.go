@@ -0,0 +1,132 @@
+package runner
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/pganalyze/collector/input/postgres"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+// SetupActivitySampling - Samples pg_stat_activity in between activity snapshots, for each server
+// that has activity_sample_interval set, and aggregates the samples in memory
+//
+// Each server keeps one connection open for sampling, which is re-established on errors.
+func SetupActivitySampling(ctx context.Context, wg *sync.WaitGroup, servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
+	for _, server := range servers {
+		if server.Config.DisableActivity || server.Config.ActivitySampleInterval <= 0 {
+			continue
+		}
+		prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+		prefixedLogger.PrintVerbose("Sampling activity every %ds in between activity snapshots", server.Config.ActivitySampleInterval)
+
+		wg.Add(1)
+		go func(server *state.Server, logger *util.Logger) {
+			defer wg.Done()
+
+			var connection *sql.DB
+			var version state.PostgresVersion
+			defer func() {
+				if connection != nil {
+					connection.Close()
+				}
+			}()
+
+			ticker := time.NewTicker(time.Duration(server.Config.ActivitySampleInterval) * time.Second)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if server.Grant.Valid && !server.Grant.Config.EnableActivity {
+						continue
+					}
+					if connection == nil {
+						var err error
+						connection, version, err = connectForActivitySampling(server, globalCollectionOpts, logger)
+						if err != nil {
+							logger.PrintVerbose("Skipping activity sample, due to error: %s", err)
+							continue
+						}
+						if connection == nil {
+							return
+						}
+					}
+					counts, err := postgres.GetActivitySample(connection)
+					if err != nil {
+						logger.PrintVerbose("Skipping activity sample, due to error: %s", err)
+						connection.Close()
+						connection = nil
+						continue
+					}
+					waitSamples, err := postgres.GetActivityWaitSample(connection, version)
+					if err != nil {
+						logger.PrintVerbose("Skipping activity sample, due to error: %s", err)
+						connection.Close()
+						connection = nil
+						continue
+					}
+					waitEvents, queryTexts := fingerprintWaitSamples(server, waitSamples)
+					server.ActivitySamplesMutex.Lock()
+					server.ActivitySamples.Add(counts, waitEvents, queryTexts, time.Now())
+					server.ActivitySamplesMutex.Unlock()
+				}
+			}
+		}(server, prefixedLogger)
+	}
+}
+
+// connectForActivitySampling - Connects to the server, or returns no connection (and no error)
+// if the Postgres version doesn't support sampling, which stops sampling for the server
+func connectForActivitySampling(server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) (*sql.DB, state.PostgresVersion, error) {
+	connection, err := postgres.EstablishConnection(server, logger, globalCollectionOpts, "")
+	if err != nil {
+		return nil, state.PostgresVersion{}, err
+	}
+	version, err := postgres.GetPostgresVersion(logger, connection)
+	if err != nil {
+		connection.Close()
+		return nil, version, err
+	}
+	if version.Numeric < state.PostgresVersion96 {
+		logger.PrintWarning("Activity sampling requires Postgres 9.6 or newer, ignoring activity_sample_interval")
+		connection.Close()
+		return nil, version, nil
+	}
+	return connection, version, nil
+}
+
+// fingerprintWaitSamples - Groups the waiting backends of a sample by query fingerprint, which is
+// looked up by query ID (as of the last full snapshot), or else determined from the query text
+//
+// The normalized query texts are returned alongside, so the queries can be referenced in the
+// activity snapshot without keeping the original texts around.
+func fingerprintWaitSamples(server *state.Server, samples []state.ActivityWaitSample) (map[state.ActivityWaitEventKey]int32, map[uint64]string) {
+	waitEvents := make(map[state.ActivityWaitEventKey]int32)
+	queryTexts := make(map[uint64]string)
+	server.CollectionStatusMutex.Lock()
+	queryFingerprints := server.CollectionStatus.QueryFingerprints
+	statementTexts := server.CollectionStatus.QueryTexts
+	server.CollectionStatusMutex.Unlock()
+	for _, sample := range samples {
+		key := state.ActivityWaitEventKey{DatabaseName: sample.DatabaseName, RoleName: sample.RoleName, BackendType: sample.BackendType, WaitEventType: sample.WaitEventType, WaitEvent: sample.WaitEvent}
+		if fp, ok := queryFingerprints[sample.QueryID]; ok && sample.QueryID != 0 {
+			key.Fingerprint = fp
+			if text, ok := statementTexts[fp]; ok {
+				queryTexts[fp] = text
+			}
+		} else if sample.Query != "" {
+			key.Fingerprint = util.FingerprintQuery(sample.Query, server.Config.FilterQueryText, -1)
+			if _, ok := queryTexts[key.Fingerprint]; !ok {
+				queryTexts[key.Fingerprint] = util.NormalizeQuery(sample.Query, server.Config.FilterQueryText, -1)
+			}
+		}
+		waitEvents[key] += sample.Count
+	}
+	return waitEvents, queryTexts
+}
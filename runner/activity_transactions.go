@@ -0,0 +1,54 @@
+package runner
+
+import (
+	"time"
+
+	"github.com/pganalyze/collector/state"
+)
+
+// findLongTransactions - Finds the transactions that exceed the long-running or the idle in
+// transaction threshold (in seconds, zero disables either), as of the given time
+//
+// nextXid is the next transaction ID (with epoch) to determine the xmin age, or zero if unknown.
+func findLongTransactions(backends []state.PostgresBackend, now time.Time, longThreshold int, idleThreshold int, nextXid int64) []state.PostgresLongTransaction {
+	var transactions []state.PostgresLongTransaction
+	for _, backend := range backends {
+		if !backend.XactStart.Valid {
+			continue
+		}
+		xactAge := now.Sub(backend.XactStart.Time)
+		var idleTime time.Duration
+		isIdle := backend.State.Valid && (backend.State.String == "idle in transaction" || backend.State.String == "idle in transaction (aborted)")
+		if isIdle && backend.StateChange.Valid {
+			idleTime = now.Sub(backend.StateChange.Time)
+		}
+
+		idleExceeded := idleThreshold > 0 && isIdle && idleTime >= time.Duration(idleThreshold)*time.Second
+		longExceeded := longThreshold > 0 && xactAge >= time.Duration(longThreshold)*time.Second
+		if !idleExceeded && !longExceeded {
+			continue
+		}
+
+		transaction := state.PostgresLongTransaction{
+			Pid:               backend.Pid,
+			DatabaseName:      backend.DatabaseName.String,
+			RoleName:          backend.RoleName.String,
+			ApplicationName:   backend.ApplicationName.String,
+			ClientAddr:        backend.ClientAddr.String,
+			BackendType:       backend.BackendType.String,
+			State:             backend.State.String,
+			Query:             backend.Query.String,
+			XactStart:         backend.XactStart.Time,
+			XactAge:           xactAge,
+			IdleTime:          idleTime,
+			BackendXmin:       backend.BackendXmin,
+			IdleInTransaction: idleExceeded,
+		}
+		if backend.BackendXmin.Valid && nextXid != 0 {
+			// backend_xmin is a 32-bit transaction ID without epoch, so compare modulo 2^32
+			transaction.XminAge = int64(int32(uint32(nextXid) - uint32(backend.BackendXmin.Int64)))
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions
+}
@@ -1,12 +1,23 @@
 package runner
 
 import (
+	"time"
+
+	"github.com/guregu/null"
 	"github.com/pganalyze/collector/state"
 	"github.com/pganalyze/collector/util"
 )
 
 func diffState(logger *util.Logger, prevState state.PersistedState, newState state.PersistedState, collectedIntervalSecs uint32) (diffState state.DiffState) {
-	diffState.StatementStats = diffStatements(newState.StatementStats, prevState.StatementStats)
+	statsReset := statementStatsReset(logger, newState.StatementStatsInfo, prevState.StatementStatsInfo)
+	diffState.StatementStats = diffStatements(newState.StatementStats, prevState.StatementStats, prevState.StatementStatsInfo, statsReset)
+	diffState.PlanStats = diffPlans(newState.PlanStats, prevState.PlanStats)
+	diffState.DatabaseStats = diffDatabaseStats(newState.DatabaseStats, prevState.DatabaseStats)
+	diffState.PgBouncerStats = diffPgBouncerStats(newState.PgBouncerStats, prevState.PgBouncerStats)
+	diffState.StatSLRU = diffStatSLRU(newState.StatSLRU, prevState.StatSLRU)
+	diffState.StatWAL = diffStatWAL(newState.StatWAL, prevState.StatWAL, collectedIntervalSecs)
+	diffState.StatIO = diffStatIO(newState.StatIO, prevState.StatIO)
+	diffState.WaitEvents = diffWaitSamplingProfile(newState.WaitSamplingProfile, prevState.WaitSamplingProfile, newState.WaitSamplingSamplePeriodMs)
 	diffState.SchemaStats = make(map[state.Oid]*state.DiffedSchemaStats)
 	for dbOid := range newState.SchemaStats {
 		newDbStats := newState.SchemaStats[dbOid]
@@ -24,6 +35,7 @@ func diffState(logger *util.Logger, prevState state.PersistedState, newState sta
 			RelationStats: diffRelationStats(newDbStats.RelationStats, prevRelStats),
 			IndexStats:    diffIndexStats(newDbStats.IndexStats, prevIdxStats),
 		}
+		rollUpTimescaleChunks(diffState.SchemaStats[dbOid], newDbStats)
 	}
 	diffState.SystemCPUStats = diffSystemCPUStats(newState.System.CPUStats, prevState.System.CPUStats)
 	diffState.SystemNetworkStats = diffSystemNetworkStats(newState.System.NetworkStats, prevState.System.NetworkStats, collectedIntervalSecs)
@@ -33,17 +45,37 @@ func diffState(logger *util.Logger, prevState state.PersistedState, newState sta
 	return
 }
 
-func diffStatements(new state.PostgresStatementStatsMap, prev state.PostgresStatementStatsMap) (diff state.DiffedPostgresStatementStatsMap) {
-	followUpRun := len(prev) > 0
+// statementStatsReset - Whether all statement stats were reset since they were last collected,
+// based on pg_stat_statements_info (Postgres 14+)
+func statementStatsReset(logger *util.Logger, newInfo state.PostgresStatementStatsInfo, prevInfo state.PostgresStatementStatsInfo) bool {
+	if prevInfo.StatsReset.IsZero() || newInfo.StatsReset.IsZero() {
+		return false
+	}
+	if newInfo.StatsReset.After(prevInfo.StatsReset) {
+		logger.PrintVerbose("pg_stat_statements was reset at %s, calculating statement stats diffs from zero", newInfo.StatsReset.Format(time.RFC3339))
+		return true
+	}
+	if newInfo.Dealloc > prevInfo.Dealloc {
+		logger.PrintVerbose("pg_stat_statements evicted entries %d times since the last run, consider raising pg_stat_statements.max", newInfo.Dealloc-prevInfo.Dealloc)
+	}
+	return false
+}
+
+func diffStatements(new state.PostgresStatementStatsMap, prev state.PostgresStatementStatsMap, prevInfo state.PostgresStatementStatsInfo, statsReset bool) (diff state.DiffedPostgresStatementStatsMap) {
+	// The previous statistics can be empty on a follow-up run when the collector reset them, and
+	// no statements ran before they were read again (pg_stat_statements_info tells these apart)
+	followUpRun := len(prev) > 0 || !prevInfo.StatsReset.IsZero() || statsReset
 	diff = make(state.DiffedPostgresStatementStatsMap)
 
 	for key, statement := range new {
 		var diffedStatement state.DiffedPostgresStatementStats
 
 		prevStatement, exists := prev[key]
-		if exists {
+		if exists && !statsReset && statement.Calls >= prevStatement.Calls {
 			diffedStatement = statement.DiffSince(prevStatement)
-		} else if followUpRun { // New statement since the last run
+		} else if exists || followUpRun {
+			// New statement since the last run, or one whose counters started over (because all stats
+			// were reset, or the entry was evicted and added again since the last run)
 			diffedStatement = statement.DiffSince(state.PostgresStatementStats{})
 		}
 
@@ -55,6 +87,141 @@ func diffStatements(new state.PostgresStatementStatsMap, prev state.PostgresStat
 	return
 }
 
+func diffPlans(new state.PostgresPlanStatsMap, prev state.PostgresPlanStatsMap) (diff state.DiffedPostgresPlanStatsMap) {
+	followUpRun := len(prev) > 0
+	diff = make(state.DiffedPostgresPlanStatsMap)
+
+	for key, plan := range new {
+		var diffedPlan state.DiffedPostgresPlanStats
+
+		prevPlan, exists := prev[key]
+		if exists && plan.Calls >= prevPlan.Calls {
+			diffedPlan = plan.DiffSince(prevPlan)
+		} else if exists || followUpRun {
+			// New plan since the last run, or one whose counters started over (because the plan
+			// statistics were reset, or the entry was evicted and added again since the last run)
+			diffedPlan = plan.DiffSince(state.PostgresPlanStats{})
+		}
+
+		if diffedPlan.Calls > 0 {
+			diff[key] = diffedPlan
+		}
+	}
+
+	return
+}
+
+func diffDatabaseStats(new state.PostgresDatabaseStatsMap, prev state.PostgresDatabaseStatsMap) (diff state.DiffedPostgresDatabaseStatsMap) {
+	diff = make(state.DiffedPostgresDatabaseStatsMap)
+	for oid, stats := range new {
+		prevStats, exists := prev[oid]
+		if !exists {
+			continue
+		}
+		if !stats.StatsReset.Equal(prevStats.StatsReset) { // Reset since the last run
+			diff[oid] = stats.DiffSince(state.PostgresDatabaseStats{})
+		} else {
+			diff[oid] = stats.DiffSince(prevStats)
+		}
+	}
+
+	return
+}
+
+// diffPgBouncerStats - PgBouncer doesn't track when its statistics were reset, but they only
+// get reset by restarting PgBouncer, which is noticeable by any of the counters going down
+func diffPgBouncerStats(new state.PgBouncerStatsMap, prev state.PgBouncerStatsMap) (diff state.DiffedPgBouncerStatsMap) {
+	diff = make(state.DiffedPgBouncerStatsMap)
+	for key, stats := range new {
+		prevStats, exists := prev[key]
+		if !exists {
+			continue
+		}
+		if stats.TotalXactCount < prevStats.TotalXactCount || stats.TotalQueryCount < prevStats.TotalQueryCount ||
+			stats.TotalReceived < prevStats.TotalReceived || stats.TotalSent < prevStats.TotalSent { // Restarted since the last run
+			diff[key] = stats.DiffSince(state.PgBouncerDatabaseStats{})
+		} else {
+			diff[key] = stats.DiffSince(prevStats)
+		}
+	}
+
+	return
+}
+
+func diffStatSLRU(new state.PostgresStatSLRUMap, prev state.PostgresStatSLRUMap) (diff state.DiffedPostgresStatSLRUMap) {
+	diff = make(state.DiffedPostgresStatSLRUMap)
+	for name, stats := range new {
+		prevStats, exists := prev[name]
+		if !exists {
+			continue
+		}
+		if !stats.StatsReset.Equal(prevStats.StatsReset) { // Reset since the last run
+			diff[name] = stats.DiffSince(state.PostgresStatSLRU{})
+		} else {
+			diff[name] = stats.DiffSince(prevStats)
+		}
+	}
+
+	return
+}
+
+func diffStatWAL(new *state.PostgresStatWAL, prev *state.PostgresStatWAL, collectedIntervalSecs uint32) *state.DiffedPostgresStatWAL {
+	if new == nil || prev == nil {
+		return nil
+	}
+	var diff state.DiffedPostgresStatWAL
+	if !new.StatsReset.Equal(prev.StatsReset) { // Reset since the last run
+		diff = new.DiffSince(state.PostgresStatWAL{}, collectedIntervalSecs)
+	} else {
+		diff = new.DiffSince(*prev, collectedIntervalSecs)
+	}
+	return &diff
+}
+
+func diffStatIO(new state.PostgresStatIOMap, prev state.PostgresStatIOMap) (diff state.DiffedPostgresStatIOMap) {
+	diff = make(state.DiffedPostgresStatIOMap)
+	for key, stats := range new {
+		prevStats, exists := prev[key]
+		if !exists {
+			continue
+		}
+		if !stats.StatsReset.Equal(prevStats.StatsReset) { // Reset since the last run
+			diff[key] = stats.DiffSince(state.PostgresStatIO{})
+		} else {
+			diff[key] = stats.DiffSince(prevStats)
+		}
+	}
+
+	return
+}
+
+// diffWaitSamplingProfile - Determines the wait event samples of each query since the last run,
+// summed up across all processes
+func diffWaitSamplingProfile(new state.PostgresWaitSamplingProfile, prev state.PostgresWaitSamplingProfile, samplePeriodMs int64) (diff state.DiffedPostgresWaitEventsMap) {
+	followUpRun := len(prev) > 0
+
+	diff = make(state.DiffedPostgresWaitEventsMap)
+	for key, count := range new {
+		prevCount, exists := prev[key]
+		if !exists && !followUpRun {
+			continue
+		}
+		if count < prevCount { // Profile was reset (or the pid reused) since the last run
+			prevCount = 0
+		}
+		if count == prevCount {
+			continue
+		}
+		eventKey := state.PostgresWaitEventKey{QueryID: key.QueryID, EventType: key.EventType, Event: key.Event}
+		event := diff[eventKey]
+		event.Samples += count - prevCount
+		event.TimeMs = event.Samples * samplePeriodMs
+		diff[eventKey] = event
+	}
+
+	return
+}
+
 func diffRelationStats(new state.PostgresRelationStatsMap, prev state.PostgresRelationStatsMap) (diff state.DiffedPostgresRelationStatsMap) {
 	followUpRun := len(prev) > 0
 
@@ -103,6 +270,75 @@ func diffIndexStats(new state.PostgresIndexStatsMap, prev state.PostgresIndexSta
 	return
 }
 
+// rollUpTimescaleChunks - Adds the diffed statistics of TimescaleDB chunks to those of their
+// hypertable, so that dropped or newly created chunks don't affect the hypertable's counters
+func rollUpTimescaleChunks(diff *state.DiffedSchemaStats, schemaStats *state.SchemaStats) {
+	for oid, hypertableOid := range schemaStats.TimescaleChunks {
+		if stats, ok := diff.RelationStats[oid]; ok {
+			diff.RelationStats[hypertableOid] = addRelationStats(diff.RelationStats[hypertableOid], stats)
+			delete(diff.RelationStats, oid)
+		}
+	}
+	for oid, hypertableIndexOid := range schemaStats.TimescaleChunkIndices {
+		if stats, ok := diff.IndexStats[oid]; ok {
+			diff.IndexStats[hypertableIndexOid] = addIndexStats(diff.IndexStats[hypertableIndexOid], stats)
+			delete(diff.IndexStats, oid)
+		}
+	}
+}
+
+func addRelationStats(a state.DiffedPostgresRelationStats, b state.DiffedPostgresRelationStats) state.DiffedPostgresRelationStats {
+	a.SizeBytes += b.SizeBytes
+	a.ToastSizeBytes += b.ToastSizeBytes
+	a.SeqScan += b.SeqScan
+	a.SeqTupRead += b.SeqTupRead
+	a.IdxScan += b.IdxScan
+	a.IdxTupFetch += b.IdxTupFetch
+	a.NTupIns += b.NTupIns
+	a.NTupUpd += b.NTupUpd
+	a.NTupDel += b.NTupDel
+	a.NTupHotUpd += b.NTupHotUpd
+	a.NLiveTup += b.NLiveTup
+	a.NDeadTup += b.NDeadTup
+	if b.NModSinceAnalyze.Valid {
+		a.NModSinceAnalyze = null.IntFrom(a.NModSinceAnalyze.Int64 + b.NModSinceAnalyze.Int64)
+	}
+	a.LastVacuum = laterTime(a.LastVacuum, b.LastVacuum)
+	a.LastAutovacuum = laterTime(a.LastAutovacuum, b.LastAutovacuum)
+	a.LastAnalyze = laterTime(a.LastAnalyze, b.LastAnalyze)
+	a.LastAutoanalyze = laterTime(a.LastAutoanalyze, b.LastAutoanalyze)
+	a.VacuumCount += b.VacuumCount
+	a.AutovacuumCount += b.AutovacuumCount
+	a.AnalyzeCount += b.AnalyzeCount
+	a.AutoanalyzeCount += b.AutoanalyzeCount
+	a.HeapBlksRead += b.HeapBlksRead
+	a.HeapBlksHit += b.HeapBlksHit
+	a.IdxBlksRead += b.IdxBlksRead
+	a.IdxBlksHit += b.IdxBlksHit
+	a.ToastBlksRead += b.ToastBlksRead
+	a.ToastBlksHit += b.ToastBlksHit
+	a.TidxBlksRead += b.TidxBlksRead
+	a.TidxBlksHit += b.TidxBlksHit
+	return a
+}
+
+func addIndexStats(a state.DiffedPostgresIndexStats, b state.DiffedPostgresIndexStats) state.DiffedPostgresIndexStats {
+	a.SizeBytes += b.SizeBytes
+	a.IdxScan += b.IdxScan
+	a.IdxTupRead += b.IdxTupRead
+	a.IdxTupFetch += b.IdxTupFetch
+	a.IdxBlksRead += b.IdxBlksRead
+	a.IdxBlksHit += b.IdxBlksHit
+	return a
+}
+
+func laterTime(a null.Time, b null.Time) null.Time {
+	if !a.Valid || (b.Valid && b.Time.After(a.Time)) {
+		return b
+	}
+	return a
+}
+
 func diffSystemCPUStats(new state.CPUStatisticMap, prev state.CPUStatisticMap) (diff state.DiffedSystemCPUStatsMap) {
 	diff = make(state.DiffedSystemCPUStatsMap)
 	for cpuID, stats := range new {
@@ -0,0 +1,231 @@
+package runner
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/state"
+	"github.com/pganalyze/collector/util"
+)
+
+var testLogger = &util.Logger{Destination: log.New(ioutil.Discard, "", 0)}
+
+func TestDiffStateTimescaleChunks(t *testing.T) {
+	// Hypertable 100 with chunks 101 (dropped by a retention policy) and 102 (created since the last run)
+	prevState := state.PersistedState{
+		SchemaStats: map[state.Oid]*state.SchemaStats{
+			1: {
+				RelationStats: state.PostgresRelationStatsMap{
+					100: {SeqScan: 1},
+					101: {SizeBytes: 8192, SeqScan: 50, NTupIns: 1000},
+					103: {SizeBytes: 8192, SeqScan: 10, NTupIns: 500},
+				},
+				IndexStats: state.PostgresIndexStatsMap{
+					201: {IdxScan: 40},
+					203: {IdxScan: 5},
+				},
+				TimescaleChunks:       map[state.Oid]state.Oid{101: 100, 103: 100},
+				TimescaleChunkIndices: map[state.Oid]state.Oid{201: 200, 203: 200},
+			},
+		},
+	}
+	newState := state.PersistedState{
+		SchemaStats: map[state.Oid]*state.SchemaStats{
+			1: {
+				RelationStats: state.PostgresRelationStatsMap{
+					100: {SeqScan: 2},
+					102: {SizeBytes: 16384, SeqScan: 3, NTupIns: 20},
+					103: {SizeBytes: 8192, SeqScan: 15, NTupIns: 600},
+				},
+				IndexStats: state.PostgresIndexStatsMap{
+					202: {IdxScan: 2},
+					203: {IdxScan: 8},
+				},
+				TimescaleChunks:       map[state.Oid]state.Oid{102: 100, 103: 100},
+				TimescaleChunkIndices: map[state.Oid]state.Oid{202: 200, 203: 200},
+			},
+		},
+	}
+
+	diff := diffState(testLogger, prevState, newState, 600)
+
+	expectedRelationStats := state.DiffedPostgresRelationStatsMap{
+		100: {SizeBytes: 24576, SeqScan: 9, NTupIns: 120},
+	}
+	if d := pretty.Compare(expectedRelationStats, diff.SchemaStats[1].RelationStats); d != "" {
+		t.Errorf("relation stats diff: (-want +got)\n%s", d)
+	}
+	expectedIndexStats := state.DiffedPostgresIndexStatsMap{
+		200: {IdxScan: 5},
+	}
+	if d := pretty.Compare(expectedIndexStats, diff.SchemaStats[1].IndexStats); d != "" {
+		t.Errorf("index stats diff: (-want +got)\n%s", d)
+	}
+}
+
+func TestDiffPgBouncerStats(t *testing.T) {
+	key := state.PgBouncerStatsKey{Address: "pgbouncer:6432", Database: "app"}
+	restartedKey := state.PgBouncerStatsKey{Address: "pgbouncer:6432", Database: "other"}
+	newKey := state.PgBouncerStatsKey{Address: "pgbouncer:6432", Database: "new"}
+	prev := state.PgBouncerStatsMap{
+		key:          {TotalXactCount: 100, TotalQueryCount: 200, TotalReceived: 1000, TotalSent: 2000, TotalXactTimeUs: 5000, TotalQueryTimeUs: 4000, TotalWaitTimeUs: 10},
+		restartedKey: {TotalXactCount: 100, TotalQueryCount: 200, TotalReceived: 1000, TotalSent: 2000},
+	}
+	new := state.PgBouncerStatsMap{
+		key:          {TotalXactCount: 150, TotalQueryCount: 300, TotalReceived: 1500, TotalSent: 2600, TotalXactTimeUs: 7500, TotalQueryTimeUs: 6000, TotalWaitTimeUs: 15},
+		restartedKey: {TotalXactCount: 5, TotalQueryCount: 10, TotalReceived: 50, TotalSent: 100},
+		newKey:       {TotalXactCount: 1, TotalQueryCount: 1},
+	}
+
+	expected := state.DiffedPgBouncerStatsMap{
+		key:          {XactCount: 50, QueryCount: 100, Received: 500, Sent: 600, XactTimeUs: 2500, QueryTimeUs: 2000, WaitTimeUs: 5},
+		restartedKey: {XactCount: 5, QueryCount: 10, Received: 50, Sent: 100},
+	}
+	if d := pretty.Compare(expected, diffPgBouncerStats(new, prev)); d != "" {
+		t.Errorf("diff: (-want +got)\n%s", d)
+	}
+}
+
+var statementKey1 = state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: 1}
+var statementKey2 = state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: 2}
+var statementKey3 = state.PostgresStatementKey{DatabaseOid: 1, UserOid: 10, QueryID: 3}
+
+var statementsResetAt = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var diffStatementsTests = []struct {
+	name      string
+	prevStats state.PostgresStatementStatsMap
+	prevInfo  state.PostgresStatementStatsInfo
+	newStats  state.PostgresStatementStatsMap
+	newInfo   state.PostgresStatementStatsInfo
+	expected  state.DiffedPostgresStatementStatsMap
+}{
+	{
+		"first run has no diffs",
+		nil,
+		state.PostgresStatementStatsInfo{},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt},
+		state.DiffedPostgresStatementStatsMap{},
+	},
+	{
+		"follow-up run",
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100, Rows: 10}, statementKey2: {Calls: 5, TotalTime: 5}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 15, TotalTime: 160, Rows: 12}, statementKey2: {Calls: 5, TotalTime: 5}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt},
+		// Statements that didn't run since the last snapshot are omitted
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 5, TotalTime: 60, Rows: 2}},
+	},
+	{
+		"stats_reset advanced",
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}, statementKey2: {Calls: 5, TotalTime: 5}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 12, TotalTime: 30}, statementKey2: {Calls: 2, TotalTime: 1}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt.Add(time.Minute)},
+		// Counters started over, even for statements that ran more often since the reset than before
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 12, TotalTime: 30}, statementKey2: {Calls: 2, TotalTime: 1}},
+	},
+	{
+		"calls dropped for one entry",
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}, statementKey2: {Calls: 50, TotalTime: 500}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt, Dealloc: 1},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 11, TotalTime: 110}, statementKey2: {Calls: 3, TotalTime: 20}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt, Dealloc: 2},
+		// The entry was evicted and added again since the last run
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 1, TotalTime: 10}, statementKey2: {Calls: 3, TotalTime: 20}},
+	},
+	{
+		"new entry on a follow-up run",
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}},
+		state.PostgresStatementStatsInfo{},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 12, TotalTime: 120}, statementKey2: {Calls: 4, TotalTime: 8}},
+		state.PostgresStatementStatsInfo{},
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 2, TotalTime: 20}, statementKey2: {Calls: 4, TotalTime: 8}},
+	},
+	{
+		"entry removed since the last run",
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}, statementKey2: {Calls: 4, TotalTime: 8}},
+		state.PostgresStatementStatsInfo{},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 12, TotalTime: 120}},
+		state.PostgresStatementStatsInfo{},
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 2, TotalTime: 20}},
+	},
+	{
+		// After the collector reset pg_stat_statements (query_stats_reset), the statistics read right
+		// after the reset are the reference point, together with the new stats_reset time
+		"collector's own reset",
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 1, TotalTime: 2}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt.Add(time.Hour)},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 6, TotalTime: 12}, statementKey3: {Calls: 3, TotalTime: 3}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt.Add(time.Hour)},
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 5, TotalTime: 10}, statementKey3: {Calls: 3, TotalTime: 3}},
+	},
+	{
+		"collector's own reset without any statements since",
+		state.PostgresStatementStatsMap{},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt.Add(time.Hour)},
+		state.PostgresStatementStatsMap{statementKey1: {Calls: 6, TotalTime: 12}},
+		state.PostgresStatementStatsInfo{StatsReset: statementsResetAt.Add(time.Hour)},
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 6, TotalTime: 12}},
+	},
+}
+
+func TestDiffStatements(t *testing.T) {
+	for _, test := range diffStatementsTests {
+		prevState := state.PersistedState{StatementStats: test.prevStats, StatementStatsInfo: test.prevInfo}
+		newState := state.PersistedState{StatementStats: test.newStats, StatementStatsInfo: test.newInfo}
+		diff := diffState(testLogger, prevState, newState, 60)
+		if d := pretty.Compare(test.expected, diff.StatementStats); d != "" {
+			t.Errorf("%s: diff: (-want +got)\n%s", test.name, d)
+		}
+	}
+}
+
+var planKey1 = state.PostgresPlanKey{DatabaseOid: 1, UserOid: 10, QueryID: 1, PlanID: 100}
+var planKey2 = state.PostgresPlanKey{DatabaseOid: 1, UserOid: 10, QueryID: 1, PlanID: 200}
+
+var diffPlansTests = []struct {
+	name      string
+	prevStats state.PostgresPlanStatsMap
+	newStats  state.PostgresPlanStatsMap
+	expected  state.DiffedPostgresPlanStatsMap
+}{
+	{
+		"first run has no diffs",
+		nil,
+		state.PostgresPlanStatsMap{planKey1: {Calls: 10, TotalTime: 100}},
+		state.DiffedPostgresPlanStatsMap{},
+	},
+	{
+		"follow-up run",
+		state.PostgresPlanStatsMap{planKey1: {Calls: 10, TotalTime: 100}, planKey2: {Calls: 5, TotalTime: 5}},
+		state.PostgresPlanStatsMap{planKey1: {Calls: 15, TotalTime: 160}, planKey2: {Calls: 5, TotalTime: 5}},
+		// Plans that didn't run since the last snapshot are omitted
+		state.DiffedPostgresPlanStatsMap{planKey1: {Calls: 5, TotalTime: 60}},
+	},
+	{
+		"calls dropped for one entry",
+		state.PostgresPlanStatsMap{planKey1: {Calls: 10, TotalTime: 100}, planKey2: {Calls: 50, TotalTime: 500}},
+		state.PostgresPlanStatsMap{planKey1: {Calls: 11, TotalTime: 110}, planKey2: {Calls: 3, TotalTime: 20}},
+		// The plan statistics were reset, or the entry was evicted and added again since the last run
+		state.DiffedPostgresPlanStatsMap{planKey1: {Calls: 1, TotalTime: 10}, planKey2: {Calls: 3, TotalTime: 20}},
+	},
+	{
+		"new entry on a follow-up run",
+		state.PostgresPlanStatsMap{planKey1: {Calls: 10, TotalTime: 100}},
+		state.PostgresPlanStatsMap{planKey1: {Calls: 12, TotalTime: 120}, planKey2: {Calls: 4, TotalTime: 8}},
+		state.DiffedPostgresPlanStatsMap{planKey1: {Calls: 2, TotalTime: 20}, planKey2: {Calls: 4, TotalTime: 8}},
+	},
+}
+
+func TestDiffPlans(t *testing.T) {
+	for _, test := range diffPlansTests {
+		if d := pretty.Compare(test.expected, diffPlans(test.newStats, test.prevStats)); d != "" {
+			t.Errorf("%s: diff: (-want +got)\n%s", test.name, d)
+		}
+	}
+}
@@ -25,8 +25,21 @@ func collectDiffAndSubmit(server *state.Server, globalCollectionOpts state.Colle
 
 	connection, err = postgres.EstablishConnection(server, logger, globalCollectionOpts, "")
 	if err != nil {
+		if server.Config.DbServerless && !globalCollectionOpts.TestRun {
+			if server.ServerlessUnavailableSince.IsZero() {
+				server.ServerlessUnavailableSince = time.Now()
+			}
+			if time.Since(server.ServerlessUnavailableSince) < server.Config.GetServerlessSuspendTolerance() {
+				logger.PrintVerbose("Could not connect to serverless endpoint: %s", err)
+				return newState, state.CollectionStatus{}, state.ErrServerlessSuspended
+			}
+		}
 		return newState, state.CollectionStatus{}, fmt.Errorf("Failed to connect to database: %s", err)
 	}
+	if !server.ServerlessUnavailableSince.IsZero() {
+		logger.PrintInfo("Serverless endpoint is reachable again, resuming collection")
+		server.ServerlessUnavailableSince = time.Time{}
+	}
 
 	newState, transientState, err := input.CollectFull(server, connection, globalCollectionOpts, logger)
 	if err != nil {
@@ -41,9 +54,25 @@ func collectDiffAndSubmit(server *state.Server, globalCollectionOpts state.Colle
 	connection.Close()
 
 	logsDisabled, logsDisabledReason := logs.ValidateLogCollectionConfig(server, transientState.Settings)
+	logLinePrefix, logLinePrefixUnsupported := logs.CheckLogLinePrefix(server, transientState.Settings)
+	server.CollectionStatusMutex.Lock()
+	logLinePrefixChanged := logLinePrefix != server.CollectionStatus.LogLinePrefix
+	server.CollectionStatusMutex.Unlock()
+	if logLinePrefixChanged && logLinePrefixUnsupported && !logsDisabled && !globalCollectionOpts.TestRun {
+		logger.PrintWarning("WARNING - Unsupported log_line_prefix setting: '%s', log lines will likely fail to parse", logLinePrefix)
+		logger.PrintInfo(logs.UnsupportedLogLinePrefixHint(logLinePrefix))
+	}
 	collectionStatus := state.CollectionStatus{
 		LogSnapshotDisabled:       logsDisabled,
 		LogSnapshotDisabledReason: logsDisabledReason,
+		LogLinePrefix:             logLinePrefix,
+		QueryFingerprints:         make(map[int64]uint64),
+		QueryTexts:                transientState.StatementTexts,
+	}
+	for key, statement := range transientState.Statements {
+		if !statement.QueryTextUnavailable && !statement.InsufficientPrivilege {
+			collectionStatus.QueryFingerprints[key.QueryID] = statement.Fingerprint
+		}
 	}
 
 	collectedIntervalSecs := uint32(newState.CollectedAt.Sub(server.PrevState.CollectedAt) / time.Second)
@@ -51,12 +80,29 @@ func collectDiffAndSubmit(server *state.Server, globalCollectionOpts state.Colle
 		collectedIntervalSecs = 1 // Avoid divide by zero errors for fast consecutive runs
 	}
 
-	diffState := diffState(logger, server.PrevState, newState, collectedIntervalSecs)
+	prevState := server.PrevState
+	if !prevState.PostmasterStartTime.IsZero() && !newState.PostmasterStartTime.Equal(prevState.PostmasterStartTime) {
+		// Statistics were reset when the endpoint resumed, so treat this like the first run
+		logger.PrintVerbose("Serverless endpoint was restarted since the last snapshot, skipping statistics diffs")
+		prevState = state.PersistedState{}
+	}
+	diffState := diffState(logger, prevState, newState, collectedIntervalSecs)
 
 	transientState.HistoricStatementStats = server.PrevState.UnidentifiedStatementStats
 
+	server.LogMetricsMutex.Lock()
+	if !server.LogMetrics.Since.IsZero() {
+		transientState.LogMetrics = server.LogMetrics
+		server.LogMetrics = state.LogMetrics{Since: time.Now()}
+	}
+	server.LogMetricsMutex.Unlock()
+
 	err = output.SendFull(server, globalCollectionOpts, logger, newState, diffState, transientState, collectedIntervalSecs)
 	if err != nil {
+		// Keep the log metrics for the next snapshot
+		server.LogMetricsMutex.Lock()
+		logs.MergeLogMetrics(&server.LogMetrics, transientState.LogMetrics)
+		server.LogMetricsMutex.Unlock()
 		return newState, collectionStatus, err
 	}
 
@@ -64,6 +110,7 @@ func collectDiffAndSubmit(server *state.Server, globalCollectionOpts state.Colle
 	// next snapshot has an empty reference point
 	if transientState.ResetStatementStats != nil {
 		newState.StatementStats = transientState.ResetStatementStats
+		newState.StatementStatsInfo = transientState.ResetStatementStatsInfo
 	}
 
 	return newState, collectionStatus, nil
@@ -210,6 +257,8 @@ func CollectAllServers(servers []*state.Server, globalCollectionOpts state.Colle
 
 				if isIgnoredReplica {
 					prefixedLogger.PrintVerbose("All monitoring suspended while server is replica")
+				} else if err == state.ErrServerlessSuspended {
+					prefixedLogger.PrintVerbose("Skipping snapshot while serverless endpoint is suspended")
 				} else {
 					allSuccessful = false
 					prefixedLogger.PrintError("Could not process server: %s", err)
@@ -14,7 +14,9 @@ import (
 	"github.com/pganalyze/collector/input/system/azure"
 	"github.com/pganalyze/collector/input/system/google_cloudsql"
 	"github.com/pganalyze/collector/input/system/heroku"
+	"github.com/pganalyze/collector/input/system/rds"
 	"github.com/pganalyze/collector/input/system/selfhosted"
+	"github.com/pganalyze/collector/input/system/supabase"
 	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/logs/stream"
 	"github.com/pganalyze/collector/output"
@@ -31,21 +33,36 @@ const LogStreamingInterval time.Duration = 10 * time.Second
 func SetupLogCollection(ctx context.Context, wg *sync.WaitGroup, servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, hasAnyHeroku bool, hasAnyGoogleCloudSQL bool, hasAnyAzureDatabase bool) {
 	var hasAnyLogDownloads bool
 	var hasAnyLogTails bool
+	var hasAnyAwsLogStreams bool
+	var hasAnyAwsEvents bool
+	var hasAnySupabaseLogDrains bool
 
 	for _, server := range servers {
 		if server.Config.DisableLogs {
 			continue
 		}
-		if server.Config.LogLocation != "" || server.Config.LogDockerTail != "" || server.Config.LogSyslogServer != "" {
+		if server.Config.AwsDbEvents {
+			hasAnyAwsEvents = true
+		}
+		if server.Config.LogLocation != "" || server.Config.LogJournaldUnit != "" || server.Config.LogEventLogSource != "" || server.Config.LogDockerTail != "" || server.Config.LogDockerLabel != "" || server.Config.LogKubernetesLabelSelector != "" || server.Config.LogPipe != "" || server.Config.LogSyslogServer != "" || server.Config.LogOtelServer != "" || server.Config.LogFluentServer != "" {
 			hasAnyLogTails = true
+		} else if server.Config.HasAwsLogStream() {
+			hasAnyAwsLogStreams = true
+		} else if server.Config.SupabaseLogDrainListenAddress != "" {
+			hasAnySupabaseLogDrains = true
 		} else if server.Config.SupportsLogDownload() {
 			hasAnyLogDownloads = true
 		}
 	}
 
+	// Inputs that acknowledge log data upstream before it is sent (Google Pub/Sub,
+	// Kinesis checkpoints, Firehose deliveries) register with this wait group, so
+	// the log streamer can flush their remaining log lines on shutdown
+	var drainWg sync.WaitGroup
+
 	var parsedLogStream chan state.ParsedLogStreamItem
-	if hasAnyLogTails || hasAnyHeroku || hasAnyGoogleCloudSQL || hasAnyAzureDatabase {
-		parsedLogStream = setupLogStreamer(ctx, wg, globalCollectionOpts, logger, servers, nil, stream.LogTestNone)
+	if hasAnyLogTails || hasAnyHeroku || hasAnyGoogleCloudSQL || hasAnyAzureDatabase || hasAnyAwsLogStreams || hasAnyAwsEvents || hasAnySupabaseLogDrains {
+		parsedLogStream = setupLogStreamer(ctx, wg, &drainWg, globalCollectionOpts, logger, servers, nil, stream.LogTestNone)
 	}
 	if hasAnyLogTails {
 		selfhosted.SetupLogTails(ctx, wg, globalCollectionOpts, logger, servers, parsedLogStream)
@@ -54,11 +71,20 @@ func SetupLogCollection(ctx context.Context, wg *sync.WaitGroup, servers []*stat
 		heroku.SetupHttpHandlerLogs(ctx, wg, globalCollectionOpts, logger, servers, parsedLogStream)
 	}
 	if hasAnyGoogleCloudSQL {
-		google_cloudsql.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, servers, parsedLogStream)
+		google_cloudsql.SetupLogSubscriber(ctx, &drainWg, globalCollectionOpts, logger, servers, parsedLogStream)
 	}
 	if hasAnyAzureDatabase {
 		azure.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, servers, parsedLogStream)
 	}
+	if hasAnyAwsLogStreams {
+		rds.SetupLogSubscriber(ctx, &drainWg, globalCollectionOpts, logger, servers, parsedLogStream)
+	}
+	if hasAnyAwsEvents {
+		rds.SetupEventPoller(ctx, wg, globalCollectionOpts, logger, servers, parsedLogStream)
+	}
+	if hasAnySupabaseLogDrains {
+		supabase.SetupLogDrain(ctx, &drainWg, globalCollectionOpts, logger, servers, parsedLogStream)
+	}
 
 	if hasAnyLogDownloads {
 		setupLogDownloadForAllServers(ctx, wg, globalCollectionOpts, logger, servers)
@@ -165,7 +191,11 @@ func findServerByIdentifier(servers []*state.Server, identifier config.ServerIde
 	return nil
 }
 
-func setupLogStreamer(ctx context.Context, wg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, logTestSucceeded chan<- bool, logTestFunc func(s *state.Server, lf state.LogFile, lt chan<- bool)) chan state.ParsedLogStreamItem {
+// setupLogStreamer - Starts processing log lines received from log inputs, and sending them in regular intervals
+//
+// On shutdown, if drainWg is set, the streamer keeps receiving log lines until all inputs
+// registered with it have exited, and then sends everything that's left before returning.
+func setupLogStreamer(ctx context.Context, wg *sync.WaitGroup, drainWg *sync.WaitGroup, globalCollectionOpts state.CollectionOpts, logger *util.Logger, servers []*state.Server, logTestSucceeded chan<- bool, logTestFunc func(s *state.Server, lf state.LogFile, lt chan<- bool)) chan state.ParsedLogStreamItem {
 	parsedLogStream := make(chan state.ParsedLogStreamItem, state.LogStreamBufferLen)
 
 	wg.Add(1)
@@ -178,35 +208,62 @@ func setupLogStreamer(ctx context.Context, wg *sync.WaitGroup, globalCollectionO
 			ticker = time.NewTicker(1 * time.Second)
 		}
 
+		processAll := func(now time.Time) {
+			for identifier := range logLinesByServer {
+				if len(logLinesByServer[identifier]) == 0 {
+					continue
+				}
+
+				server := findServerByIdentifier(servers, identifier)
+				if server == nil {
+					// This should never happen, but in case it does, avoid memory leaks for data that can never be sent
+					logger.PrintError("ERROR: Could not locate server entry for identifier \"%s\", discarding log lines", identifier)
+					delete(logLinesByServer, identifier)
+					continue
+				}
+				prefixedLogger := logger.WithPrefix(server.Config.SectionName)
+				logLinesByServer[identifier] = processLogStream(server, logLinesByServer[identifier], now, globalCollectionOpts, prefixedLogger, logTestSucceeded, logTestFunc)
+			}
+		}
+
+		receive := func(in state.ParsedLogStreamItem) {
+			in.LogLine.CollectedAt = time.Now()
+			in.LogLine.UUID = uuid.NewV4()
+			logLinesByServer[in.Identifier] = append(logLinesByServer[in.Identifier], in.LogLine)
+		}
+
+		var drained chan struct{}
+		done := ctx.Done()
+
 		for {
 			select {
-			case <-ctx.Done():
+			case <-done:
+				if drainWg == nil {
+					ticker.Stop()
+					return
+				}
+				drained = make(chan struct{})
+				go func() {
+					drainWg.Wait()
+					close(drained)
+				}()
+				done = nil
+			case <-drained:
 				ticker.Stop()
+				// Pick up what the inputs sent right before exiting
+				for len(parsedLogStream) > 0 {
+					receive(<-parsedLogStream)
+				}
+				// Treat all remaining lines as ready, since no further lines will arrive
+				processAll(time.Now().Add(stream.StreamReadyThreshold + time.Second))
 				return
 			case t := <-ticker.C:
-				for identifier := range logLinesByServer {
-					if len(logLinesByServer[identifier]) == 0 {
-						continue
-					}
-
-					server := findServerByIdentifier(servers, identifier)
-					if server == nil {
-						// This should never happen, but in case it does, avoid memory leaks for data that can never be sent
-						logger.PrintError("ERROR: Could not locate server entry for identifier \"%s\", discarding log lines", identifier)
-						delete(logLinesByServer, identifier)
-						continue
-					}
-					prefixedLogger := logger.WithPrefix(server.Config.SectionName)
-					logLinesByServer[identifier] = processLogStream(server, logLinesByServer[identifier], t, globalCollectionOpts, prefixedLogger, logTestSucceeded, logTestFunc)
-				}
+				processAll(t)
 			case in, ok := <-parsedLogStream:
 				if !ok {
 					return
 				}
-
-				in.LogLine.CollectedAt = time.Now()
-				in.LogLine.UUID = uuid.NewV4()
-				logLinesByServer[in.Identifier] = append(logLinesByServer[in.Identifier], in.LogLine)
+				receive(in)
 			}
 		}
 	}()
@@ -278,6 +335,32 @@ func getLogsGrant(server *state.Server, globalCollectionOpts state.CollectionOpt
 }
 
 func postprocessAndSendLogs(server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger, transientLogState state.TransientLogState, grant state.GrantLogs) (err error) {
+	server.CollectionStatusMutex.Lock()
+	queryFingerprints := server.CollectionStatus.QueryFingerprints
+	server.CollectionStatusMutex.Unlock()
+
+	server.LogMetricsMutex.Lock()
+	if server.LogMetrics.Since.IsZero() {
+		server.LogMetrics.Since = time.Now()
+	}
+	for _, logFile := range transientLogState.LogFiles {
+		logs.AddLogMetrics(&server.LogMetrics, logFile.LogLines, queryFingerprints)
+	}
+	server.LogMetricsMutex.Unlock()
+
+	if server.Config.LogRateLimit > 0 {
+		var droppedCount, count int
+		server.LogRateLimitMutex.Lock()
+		for idx, logFile := range transientLogState.LogFiles {
+			transientLogState.LogFiles[idx].LogLines, transientLogState.QuerySamples, count = logs.RateLimitLogLines(logFile.LogLines, transientLogState.QuerySamples, &server.LogRateLimitState, server.Config.LogRateLimit, server.Config.GetLogRateLimitInterval(), time.Now())
+			droppedCount += count
+		}
+		server.LogRateLimitMutex.Unlock()
+		if droppedCount > 0 {
+			logger.PrintVerbose("Dropped %d log lines that exceeded log_rate_limit (%d per classification every %s)", droppedCount, server.Config.LogRateLimit, server.Config.GetLogRateLimitInterval())
+		}
+	}
+
 	if server.Config.EnableLogExplain && len(transientLogState.QuerySamples) != 0 {
 		transientLogState.QuerySamples = postgres.RunExplain(server, transientLogState.QuerySamples, globalCollectionOpts, logger)
 	}
@@ -327,9 +410,9 @@ func TestLogsForAllServers(servers []*state.Server, globalCollectionOpts state.C
 		} else if server.Config.SystemType == "heroku" && logLinePrefix == logs.HerokuLogLinePrefixFreeTier {
 			prefixedLogger.PrintWarning("WARNING - Detected log_line_prefix indicates Heroku Postgres Free Tier, which has no log output support")
 			continue
-		} else if !logs.IsSupportedPrefix(logLinePrefix) {
+		} else if !logs.IsSupportedPrefix(logLinePrefix) && !usesStructuredLogFormat(server, globalCollectionOpts, prefixedLogger) {
 			prefixedLogger.PrintError("ERROR - Unsupported log_line_prefix setting: '%s'", logLinePrefix)
-			prefixedLogger.PrintInfo("HINT - You can find a list of supported settings in the pganalyze documentation: https://pganalyze.com/docs/log-insights/setup/self-managed/troubleshooting")
+			prefixedLogger.PrintInfo(logs.UnsupportedLogLinePrefixHint(logLinePrefix))
 			hasFailedServers = true
 			continue
 		}
@@ -338,23 +421,43 @@ func TestLogsForAllServers(servers []*state.Server, globalCollectionOpts state.C
 			prefixedLogger.PrintInfo("Skipping test for log collection (syslog server) - verify log snapshots are sent in collector logs")
 			continue
 		}
+		if server.Config.LogPipe != "" {
+			prefixedLogger.PrintInfo("Skipping test for log collection (pipe) - verify log snapshots are sent in collector logs")
+			continue
+		}
+		if server.Config.LogOtelServer != "" {
+			prefixedLogger.PrintInfo("Skipping test for log collection (OTLP receiver) - verify log snapshots are sent in collector logs")
+			continue
+		}
+		if server.Config.LogFluentServer != "" {
+			prefixedLogger.PrintInfo("Skipping test for log collection (Fluent forward receiver) - verify log snapshots are sent in collector logs")
+			continue
+		}
 
 		ctx, cancel := context.WithCancel(context.Background())
 		wg := sync.WaitGroup{}
 		success := false
 
-		if server.Config.LogLocation != "" {
+		if server.Config.LogLocation != "" || server.Config.LogJournaldUnit != "" || server.Config.LogEventLogSource != "" {
 			if testLocalLogTail(ctx, &wg, server, globalCollectionOpts, prefixedLogger) {
 				hasSuccessfulLocalServers = true
 				success = true
 			} else {
 				success = false
 			}
+		} else if server.Config.AwsKinesisLogStream != "" {
+			success = testKinesisLogStream(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
+		} else if server.Config.AwsFirehoseListenAddress != "" {
+			success = testFirehoseLogStream(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
+		} else if server.Config.SupabaseLogDrainListenAddress != "" {
+			success = testSupabaseLogDrain(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
+		} else if server.Config.AwsS3LogBucket != "" {
+			success = testS3LogStream(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
 		} else if server.Config.SupportsLogDownload() {
 			success = testLogDownload(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
 		} else if server.Config.AzureDbServerName != "" && server.Config.AzureEventhubNamespace != "" && server.Config.AzureEventhubName != "" {
 			success = testAzureLogStream(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
-		} else if server.Config.GcpCloudSQLInstanceID != "" && server.Config.GcpPubsubSubscription != "" {
+		} else if server.Config.GcpCloudSQLInstanceID != "" && (server.Config.GcpPubsubSubscription != "" || server.Config.GcpStorageLogBucket != "" || server.Config.GcpLoggingTail) {
 			success = testGoogleCloudsqlLogStream(ctx, &wg, server, globalCollectionOpts, prefixedLogger)
 		}
 
@@ -368,11 +471,20 @@ func TestLogsForAllServers(servers []*state.Server, globalCollectionOpts state.C
 	return
 }
 
+// usesStructuredLogFormat - Whether the server writes csvlog or jsonlog files, treating errors as not doing so
+func usesStructuredLogFormat(server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
+	logDestination, err := postgres.GetPostgresSetting("log_destination", server, globalCollectionOpts, logger)
+	if err != nil {
+		return false
+	}
+	return logs.UsesStructuredLogFormat(logDestination)
+}
+
 func testLocalLogTail(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
 	logger.PrintInfo("Testing log collection (local)...")
 
 	logTestSucceeded := make(chan bool, 1)
-	parsedLogStream := setupLogStreamer(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestCollectorIdentify)
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestCollectorIdentify)
 
 	err := selfhosted.SetupLogTailForServer(ctx, wg, globalCollectionOpts, logger, server, parsedLogStream)
 	if err != nil {
@@ -406,11 +518,87 @@ func testLogDownload(ctx context.Context, wg *sync.WaitGroup, server *state.Serv
 	return true
 }
 
+func testKinesisLogStream(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
+	logger.PrintInfo("Testing log collection (Amazon Kinesis)...")
+
+	logTestSucceeded := make(chan bool, 1)
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestCollectorIdentify)
+
+	err := rds.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, parsedLogStream)
+	if err != nil {
+		logger.PrintError("ERROR - Could not get logs through Amazon Kinesis: %s", err)
+		return false
+	}
+
+	logs.EmitTestLogMsg(server, globalCollectionOpts, logger)
+
+	// CloudWatch Logs takes a bit longer than other log streams to deliver log events
+	select {
+	case <-logTestSucceeded:
+		break
+	case <-time.After(30 * time.Second):
+		logger.PrintError("ERROR - Amazon Kinesis log stream timed out after 30 seconds - did not find expected log event in stream")
+		logger.PrintInfo("HINT - Check that the CloudWatch Logs subscription filter of the instance's postgresql log group writes to the configured Kinesis stream")
+		return false
+	}
+
+	logger.PrintInfo("  Log test successful")
+	return true
+}
+
+func testFirehoseLogStream(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
+	logger.PrintInfo("Testing log collection (Amazon Kinesis Data Firehose)...")
+
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, nil, stream.LogTestNone)
+
+	err := rds.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, parsedLogStream)
+	if err != nil {
+		logger.PrintError("ERROR - Could not set up Firehose HTTP handler: %s", err)
+		return false
+	}
+
+	// Firehose buffers log data before delivering it, so we can't wait for the test message
+	logger.PrintInfo("  Log test successful (verified configuration, log data is delivered by Firehose in batches)")
+	return true
+}
+
+func testSupabaseLogDrain(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
+	logger.PrintInfo("Testing log collection (Supabase log drain)...")
+
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, nil, stream.LogTestNone)
+
+	err := supabase.SetupLogDrain(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, parsedLogStream)
+	if err != nil {
+		logger.PrintError("ERROR - Could not set up Supabase log drain HTTP handler: %s", err)
+		return false
+	}
+
+	// Supabase sends log drain requests in batches, so we can't wait for the test message
+	logger.PrintInfo("  Log test successful (verified configuration, log data is delivered by the log drain in batches)")
+	return true
+}
+
+func testS3LogStream(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
+	logger.PrintInfo("Testing log collection (Amazon S3)...")
+
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, nil, stream.LogTestNone)
+
+	err := rds.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, parsedLogStream)
+	if err != nil {
+		logger.PrintError("ERROR - Could not read logs from Amazon S3: %s", err)
+		return false
+	}
+
+	// Objects are only written once Firehose flushes its buffer, so we can't wait for the test message
+	logger.PrintInfo("  Log test successful (verified bucket access, log data is written to S3 by Firehose in batches)")
+	return true
+}
+
 func testAzureLogStream(ctx context.Context, wg *sync.WaitGroup, server *state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) bool {
 	logger.PrintInfo("Testing log collection (Azure Database)...")
 
 	logTestSucceeded := make(chan bool, 1)
-	parsedLogStream := setupLogStreamer(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestAnyEvent)
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestAnyEvent)
 
 	err := azure.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, parsedLogStream)
 	if err != nil {
@@ -440,7 +628,7 @@ func testGoogleCloudsqlLogStream(ctx context.Context, wg *sync.WaitGroup, server
 	logger.PrintInfo("Testing log collection (Google Cloud SQL)...")
 
 	logTestSucceeded := make(chan bool, 1)
-	parsedLogStream := setupLogStreamer(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestCollectorIdentify)
+	parsedLogStream := setupLogStreamer(ctx, wg, nil, globalCollectionOpts, logger, []*state.Server{server}, logTestSucceeded, stream.LogTestCollectorIdentify)
 
 	err := google_cloudsql.SetupLogSubscriber(ctx, wg, globalCollectionOpts, logger, []*state.Server{server}, parsedLogStream)
 	if err != nil {
@@ -448,6 +636,12 @@ func testGoogleCloudsqlLogStream(ctx context.Context, wg *sync.WaitGroup, server
 		return false
 	}
 
+	// Log sinks only write to Cloud Storage once an hour, so we can't wait for the test message
+	if server.Config.GcpPubsubSubscription == "" && !server.Config.GcpLoggingTail {
+		logger.PrintInfo("  Log test successful (verified access to Cloud Storage bucket, log entries are exported hourly)")
+		return true
+	}
+
 	logs.EmitTestLogMsg(server, globalCollectionOpts, logger)
 
 	select {
@@ -456,9 +650,18 @@ func testGoogleCloudsqlLogStream(ctx context.Context, wg *sync.WaitGroup, server
 	case <-time.After(10 * time.Second):
 		logger.PrintError("ERROR - Google Cloud Pub/Sub log tail timed out after 10 seconds - did not find expected log event in stream")
 		logger.PrintInfo("HINT - This error may be a false positive if the collector is also running in the background and consumes the same Google Cloud Pub/Sub stream")
+		printGoogleCloudsqlPubSubStats(logger)
 		return false
 	}
 
 	logger.PrintInfo("  Log test successful")
+	printGoogleCloudsqlPubSubStats(logger)
 	return true
 }
+
+func printGoogleCloudsqlPubSubStats(logger *util.Logger) {
+	for _, stats := range google_cloudsql.GetPubSubStats() {
+		logger.PrintInfo("  Pub/Sub subscription %s: %d messages received (%d filtered, %d unparseable, %d duplicate), %d log lines delivered to servers, estimated backlog age %s",
+			stats.Subscription, stats.MessagesReceived, stats.MessagesFiltered, stats.MessagesUnparseable, stats.MessagesDuplicate, stats.LinesDelivered, stats.BacklogAge.Round(time.Second))
+	}
+}
@@ -51,30 +51,42 @@ func gatherQueryStatsForServer(server *state.Server, globalCollectionOpts state.
 	if err != nil {
 		return newState, errors.Wrap(err, "error collecting pg_stat_statements")
 	}
+	newState.StatementStatsInfo, err = postgres.GetStatementStatsInfo(connection, postgresVersion)
+	if err != nil {
+		return newState, errors.Wrap(err, "error collecting pg_stat_statements_info")
+	}
 
-	// Don't calculate any diffs on the first run (but still update the state)
-	if len(server.PrevState.StatementStats) == 0 || server.PrevState.LastStatementStatsAt.IsZero() {
-		return newState, nil
+	return diffQueryStats(logger, server.PrevState, newState, collectedAt), nil
+}
+
+// diffQueryStats - Adds the statement stats collected since the previous high frequency (or full
+// snapshot) run to the statement stats of the new state that are waiting for the next full snapshot
+func diffQueryStats(logger *util.Logger, prevState state.PersistedState, newState state.PersistedState, collectedAt time.Time) state.PersistedState {
+	// Don't calculate any diffs on the first run (but still update the state). An empty set of
+	// previous statement stats can also come from a reset, which diffStatements tells apart.
+	if prevState.LastStatementStatsAt.IsZero() {
+		return newState
 	}
 
-	diffedStatementStats := diffStatements(newState.StatementStats, server.PrevState.StatementStats)
-	collectedIntervalSecs := uint32(newState.LastStatementStatsAt.Sub(server.PrevState.LastStatementStatsAt) / time.Second)
+	statsReset := statementStatsReset(logger, newState.StatementStatsInfo, prevState.StatementStatsInfo)
+	diffedStatementStats := diffStatements(newState.StatementStats, prevState.StatementStats, prevState.StatementStatsInfo, statsReset)
+	collectedIntervalSecs := uint32(newState.LastStatementStatsAt.Sub(prevState.LastStatementStatsAt) / time.Second)
 
 	timeKey := state.PostgresStatementStatsTimeKey{CollectedAt: collectedAt, CollectedIntervalSecs: collectedIntervalSecs}
-	newState.UnidentifiedStatementStats = server.PrevState.UnidentifiedStatementStats
+	newState.UnidentifiedStatementStats = prevState.UnidentifiedStatementStats
 	if newState.UnidentifiedStatementStats == nil {
 		newState.UnidentifiedStatementStats = make(state.HistoricStatementStatsMap)
 	}
 	newState.UnidentifiedStatementStats[timeKey] = diffedStatementStats
 
-	return newState, nil
+	return newState
 }
 
 func GatherQueryStatsFromAllServers(servers []*state.Server, globalCollectionOpts state.CollectionOpts, logger *util.Logger) {
 	var wg sync.WaitGroup
 
 	for idx := range servers {
-		if servers[idx].Config.QueryStatsInterval != 60 {
+		if servers[idx].Config.QueryStatsInterval != 60 || servers[idx].Config.DbServerless {
 			continue
 		}
 
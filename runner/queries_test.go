@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/state"
+)
+
+var diffQueryStatsTests = []struct {
+	name     string
+	prev     state.PersistedState
+	new      state.PersistedState
+	expected state.DiffedPostgresStatementStatsMap
+}{
+	{
+		"first run",
+		state.PersistedState{},
+		state.PersistedState{
+			LastStatementStatsAt: statementsResetAt.Add(time.Minute),
+			StatementStats:       state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}},
+		},
+		nil,
+	},
+	{
+		"follow-up run",
+		state.PersistedState{
+			LastStatementStatsAt: statementsResetAt,
+			StatementStats:       state.PostgresStatementStatsMap{statementKey1: {Calls: 10, TotalTime: 100}},
+		},
+		state.PersistedState{
+			LastStatementStatsAt: statementsResetAt.Add(time.Minute),
+			StatementStats:       state.PostgresStatementStatsMap{statementKey1: {Calls: 15, TotalTime: 130}},
+		},
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 5, TotalTime: 30}},
+	},
+	{
+		"collector's own reset without any statements since",
+		state.PersistedState{
+			LastStatementStatsAt: statementsResetAt,
+			StatementStats:       state.PostgresStatementStatsMap{},
+			StatementStatsInfo:   state.PostgresStatementStatsInfo{StatsReset: statementsResetAt},
+		},
+		state.PersistedState{
+			LastStatementStatsAt: statementsResetAt.Add(time.Minute),
+			StatementStats:       state.PostgresStatementStatsMap{statementKey1: {Calls: 6, TotalTime: 12}},
+			StatementStatsInfo:   state.PostgresStatementStatsInfo{StatsReset: statementsResetAt},
+		},
+		state.DiffedPostgresStatementStatsMap{statementKey1: {Calls: 6, TotalTime: 12}},
+	},
+}
+
+func TestDiffQueryStats(t *testing.T) {
+	collectedAt := statementsResetAt.Add(time.Minute)
+	for _, test := range diffQueryStatsTests {
+		newState := diffQueryStats(testLogger, test.prev, test.new, collectedAt)
+		var diff state.DiffedPostgresStatementStatsMap
+		if test.expected != nil {
+			timeKey := state.PostgresStatementStatsTimeKey{CollectedAt: collectedAt, CollectedIntervalSecs: 60}
+			var ok bool
+			if diff, ok = newState.UnidentifiedStatementStats[timeKey]; !ok {
+				t.Errorf("%s: expected statement stats for %+v, got %+v", test.name, timeKey, newState.UnidentifiedStatementStats)
+				continue
+			}
+		} else if len(newState.UnidentifiedStatementStats) != 0 {
+			t.Errorf("%s: expected no statement stats, got %+v", test.name, newState.UnidentifiedStatementStats)
+		}
+		if d := pretty.Compare(test.expected, diff); d != "" {
+			t.Errorf("%s: diff: (-want +got)\n%s", test.name, d)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	survey "github.com/AlecAivazis/survey/v2"
 	"github.com/go-ini/ini"
+	"github.com/pganalyze/collector/logs"
 	"github.com/pganalyze/collector/setup/query"
 	"github.com/pganalyze/collector/setup/state"
 	s "github.com/pganalyze/collector/setup/state"
@@ -159,7 +160,7 @@ func discoverLogLocation(config *ini.Section, runner *query.Runner) (string, err
 
 	if logDestination == "syslog" {
 		return "", errors.New("log_destination detected as syslog - please check our setup guide for rsyslogd or syslog-ng instructions")
-	} else if logDestination != "stderr" {
+	} else if logDestination != "stderr" && !logs.UsesStructuredLogFormat(logDestination) {
 		return "", fmt.Errorf("unsupported log_destination %s", logDestination)
 	}
 
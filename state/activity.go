@@ -11,8 +11,33 @@ type TransientActivityState struct {
 	Backends []PostgresBackend
 
 	Vacuums []PostgresVacuumProgress
+
+	// Progress of other maintenance operations (Postgres 12+)
+	Progress []PostgresProgress
+
+	// Lock dependency graph (Postgres 9.6+)
+	BlockingLocks []PostgresBlockingLock
+
+	// Transactions exceeding long_transaction_threshold or idle_in_transaction_threshold
+	LongTransactions []PostgresLongTransaction
+
+	// Backends with the most private memory (if log_backend_memory_contexts is set)
+	BackendMemory []PostgresBackendMemory
+
+	// Aggregate of the samples taken since the last activity snapshot (if activity_sample_interval
+	// is set)
+	Samples ActivitySamples
+
+	// Only collected for Amazon RDS when enabled (aws_performance_insights)
+	PerformanceInsights *AmazonRdsPerformanceInsights
 }
 
 type PersistedActivityState struct {
 	ActivitySnapshotAt time.Time
+
+	// When the memory contexts of each backend (by PostgresBackend.Identity) were last logged
+	MemoryContextsLoggedAt map[uint64]time.Time
+
+	// When we last warned about query texts truncated at track_activity_query_size
+	QueryTruncationWarnedAt time.Time
 }
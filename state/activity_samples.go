@@ -0,0 +1,99 @@
+package state
+
+import "time"
+
+// ActivitySampleKey - Kind of backends that are counted in each activity sample
+type ActivitySampleKey struct {
+	State         string // Empty for background processes
+	WaitEventType string // Empty if not waiting
+	WaitEvent     string
+}
+
+// ActivitySampleCounts - Number of backends of one kind, aggregated over all samples
+type ActivitySampleCounts struct {
+	Sum int64 // Sum of the counts in all samples (divide by ActivitySamples.SampleCount for an average)
+	Max int32 // Highest count in a single sample
+}
+
+// ActivityWaitEventKey - Query and kind of process the waiting backends are counted for, in each
+// activity sample
+type ActivityWaitEventKey struct {
+	Fingerprint   uint64 // Zero for processes that don't run a query (e.g. the checkpointer)
+	DatabaseName  string // Empty for processes that aren't connected to a database
+	RoleName      string
+	BackendType   string // Postgres 10+, e.g. "client backend" or "autovacuum worker"
+	WaitEventType string
+	WaitEvent     string
+}
+
+// ActivityWaitSample - Number of active backends waiting for the same event, while running the
+// same query (identified by query ID or text)
+type ActivityWaitSample struct {
+	BackendType   string
+	DatabaseName  string
+	RoleName      string
+	WaitEventType string
+	WaitEvent     string
+	QueryID       int64
+	Query         string
+	Count         int32
+}
+
+// ActivitySamples - Aggregate of the pg_stat_activity samples taken in between two activity
+// snapshots (if activity_sample_interval is set), which keeps the memory use independent of
+// the number of samples
+type ActivitySamples struct {
+	SampleCount int
+	FirstAt     time.Time
+	LastAt      time.Time
+
+	Counts map[ActivitySampleKey]ActivitySampleCounts
+
+	// Histogram of the wait events of active backends, counting one per backend and sample - divided
+	// by SampleCount this is the average number of backends waiting (similar to average active
+	// sessions in Performance Insights)
+	WaitEvents map[ActivityWaitEventKey]int64
+
+	// Normalized query texts of the fingerprints in WaitEvents
+	QueryTexts map[uint64]string
+
+	// Highest number of backends waiting for a heavyweight lock in a single sample
+	MaxLockWaiters   int32
+	MaxLockWaitersAt time.Time
+}
+
+// Add - Aggregates the backend counts and wait events (with the normalized texts of their queries)
+// of one sample taken at the given time
+func (s *ActivitySamples) Add(counts map[ActivitySampleKey]int32, waitEvents map[ActivityWaitEventKey]int32, queryTexts map[uint64]string, collectedAt time.Time) {
+	if s.Counts == nil {
+		s.Counts = make(map[ActivitySampleKey]ActivitySampleCounts)
+		s.WaitEvents = make(map[ActivityWaitEventKey]int64)
+		s.QueryTexts = make(map[uint64]string)
+		s.FirstAt = collectedAt
+	}
+	s.SampleCount++
+	s.LastAt = collectedAt
+
+	var lockWaiters int32
+	for key, count := range counts {
+		c := s.Counts[key]
+		c.Sum += int64(count)
+		if count > c.Max {
+			c.Max = count
+		}
+		s.Counts[key] = c
+		if key.WaitEventType == "Lock" {
+			lockWaiters += count
+		}
+	}
+	for key, count := range waitEvents {
+		s.WaitEvents[key] += int64(count)
+	}
+	for fingerprint, text := range queryTexts {
+		s.QueryTexts[fingerprint] = text
+	}
+	if lockWaiters > s.MaxLockWaiters {
+		s.MaxLockWaiters = lockWaiters
+		s.MaxLockWaitersAt = collectedAt
+	}
+}
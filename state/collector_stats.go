@@ -1,5 +1,7 @@
 package state
 
+import "time"
+
 type CollectorStats struct {
 	GoVersion string
 
@@ -11,6 +13,21 @@ type CollectorStats struct {
 	ActiveGoroutines int32
 
 	CgoCalls int64
+
+	GcpPubSub []GcpPubSubStats
+}
+
+// GcpPubSubStats - Counters for a Google Cloud Pub/Sub subscription that log data is received from
+type GcpPubSubStats struct {
+	Subscription string
+
+	MessagesReceived    int64
+	MessagesFiltered    int64 // Messages that did not contain Postgres logs
+	MessagesUnparseable int64
+	MessagesDuplicate   int64 // Redeliveries of messages that were already processed
+	LinesDelivered      int64 // Log lines that were matched to a configured server
+
+	BacklogAge time.Duration // Age of the most recently received message
 }
 
 type DiffedCollectorStats CollectorStats
@@ -24,5 +41,25 @@ func (curr CollectorStats) DiffSince(prev CollectorStats) DiffedCollectorStats {
 		MemoryRssBytes:           curr.MemoryRssBytes,
 		ActiveGoroutines:         curr.ActiveGoroutines,
 		CgoCalls:                 curr.CgoCalls - prev.CgoCalls,
+		GcpPubSub:                diffGcpPubSubStats(curr.GcpPubSub, prev.GcpPubSub),
+	}
+}
+
+func diffGcpPubSubStats(curr []GcpPubSubStats, prev []GcpPubSubStats) []GcpPubSubStats {
+	var diffed []GcpPubSubStats
+	for _, c := range curr {
+		d := c
+		for _, p := range prev {
+			if p.Subscription == c.Subscription {
+				d.MessagesReceived -= p.MessagesReceived
+				d.MessagesFiltered -= p.MessagesFiltered
+				d.MessagesUnparseable -= p.MessagesUnparseable
+				d.MessagesDuplicate -= p.MessagesDuplicate
+				d.LinesDelivered -= p.LinesDelivered
+				break
+			}
+		}
+		diffed = append(diffed, d)
 	}
+	return diffed
 }
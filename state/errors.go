@@ -3,3 +3,5 @@ package state
 import "errors"
 
 var ErrReplicaCollectionDisabled error = errors.New("monitored server is replica and replication collection disabled via config")
+
+var ErrServerlessSuspended error = errors.New("serverless endpoint is not reachable, likely because it is suspended")
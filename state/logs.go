@@ -37,6 +37,68 @@ type TransientLogState struct {
 	QuerySamples []PostgresQuerySample
 }
 
+// LogRateLimitState - Log lines seen per classification in the current interval of log_rate_limit
+type LogRateLimitState struct {
+	IntervalStart time.Time
+	Seen          map[pganalyze_collector.LogLineInformation_LogClassification]int
+
+	// Log lines that were dropped, and not yet reported on a log line that was sent
+	Suppressed map[pganalyze_collector.LogLineInformation_LogClassification]int
+}
+
+// LogMetrics - Checkpoint, autovacuum and connection activity derived from the log lines (see the
+// details of CHECKPOINT_COMPLETE, AUTOVACUUM_COMPLETED and CONNECTION_*), summed up since the last
+// full snapshot
+type LogMetrics struct {
+	Since time.Time // When counting started
+
+	Checkpoints           int64 // Includes restartpoints on replicas
+	CheckpointBufsWritten int64
+	CheckpointWriteSecs   float64
+	CheckpointSyncSecs    float64
+	CheckpointTotalSecs   float64
+
+	Autovacuums               int64
+	AutovacuumsAntiWraparound int64
+	AutovacuumPagesRemoved    int64
+	AutovacuumTuplesDeleted   int64
+	AutovacuumFrozenPages     int64 // Postgres 16+
+	AutovacuumTuplesFrozen    int64 // Postgres 16+
+	AutovacuumWalBytes        int64 // Postgres 13+
+	AutovacuumElapsedSecs     float64
+
+	// Connection activity (requires log_connections and log_disconnections)
+	ConnectionsReceived   int64
+	ConnectionsAuthorized int64
+	ConnectionsRejected   int64 // Authentication failures, pg_hba.conf rejections and similar
+	Disconnections        int64
+	SessionSecs           float64 // Combined duration of the sessions that disconnected
+
+	LoginsByDatabaseRole map[LogMetricsDatabaseRole]int64
+
+	// Temporary files (requires log_temp_files), by the query that created them
+	TempFilesByQuery map[LogMetricsTempFileQuery]LogMetricsTempFiles
+}
+
+// LogMetricsTempFileQuery - Database and query fingerprint of temporary files counted in
+// LogMetrics (the fingerprint is zero if the query is not known)
+type LogMetricsTempFileQuery struct {
+	Database    string
+	Fingerprint uint64
+}
+
+// LogMetricsTempFiles - Number and total size of temporary files counted in LogMetrics
+type LogMetricsTempFiles struct {
+	Files int64
+	Bytes int64
+}
+
+// LogMetricsDatabaseRole - Database and role of connections counted in LogMetrics
+type LogMetricsDatabaseRole struct {
+	Database string
+	Username string
+}
+
 type PersistedLogState struct {
 	// Markers for pagination of RDS log files
 	//
@@ -44,8 +106,24 @@ type PersistedLogState struct {
 	// all other markers are discarded
 	AwsMarkers map[string]string
 
+	// When the RDS log file markers were last updated, which determines how far back
+	// log files are checked for new data when resuming after a restart
+	AwsMarkersUpdatedAt time.Time
+
 	// Markers for pg_read_file-based access
 	ReadFileMarkers map[string]int64
+
+	// Markers for Cloud Storage log sink objects, tracking the last update time of
+	// the objects we've already read, by bucket and prefix
+	GcsMarkers map[string]time.Time
+
+	// Sequence numbers of the last processed record in each Kinesis shard, by
+	// stream name and shard ID
+	KinesisCheckpoints map[string]string
+
+	// Key of the last processed S3 object (objects written by Firehose sort by
+	// their delivery time), by bucket and prefix
+	S3LogMarkers map[string]string
 }
 
 // LogFile - Log file that we are uploading for reference in log line metadata
@@ -63,6 +141,7 @@ type LogFile struct {
 	TmpFile *os.File
 
 	FilterLogSecret []LogSecretKind
+	FilterLogRedact []config.LogRedactRule
 }
 
 // LogSecretKind - Enum to classify the kind of log secret identified by a marker
@@ -161,6 +240,10 @@ type LogLine struct {
 	LogLevel   pganalyze_collector.LogLineInformation_LogLevel
 	BackendPid int32
 
+	// Only known for csvlog and jsonlog output (query ID requires compute_query_id)
+	BackendType string
+	QueryID     int64
+
 	// %l in log_line_prefix (or similar in syslog)
 	LogLineNumber int32
 
@@ -0,0 +1,46 @@
+package state
+
+import "github.com/guregu/null"
+
+// PatroniCluster - Topology of the Patroni cluster the server is a member of, as reported by
+// the Patroni REST API
+type PatroniCluster struct {
+	Scope   string
+	Members []PatroniMember
+
+	// Name of the member this server connects to (empty if it couldn't be determined)
+	CurrentMember string
+
+	// Most recent timeline changes (failovers and switchovers), oldest first
+	History []PatroniTimelineChange
+}
+
+// PatroniMember - Node of the Patroni cluster
+type PatroniMember struct {
+	Name     string
+	Role     string // "leader", "standby_leader", "sync_standby" or "replica"
+	State    string // e.g. "running", "streaming" or "starting"
+	Host     string
+	Port     int32
+	Timeline null.Int
+	LagBytes null.Int // Replication lag behind the leader, only set for replicas
+}
+
+// PatroniTimelineChange - Promotion of a new leader, which started a new timeline
+type PatroniTimelineChange struct {
+	Timeline  int64 // Timeline that ended with the change
+	LSN       int64 // Position at which the timeline ended
+	Reason    string
+	ChangedAt null.Time
+	NewLeader string // Only reported by Patroni 2.0 and newer
+}
+
+// Leader - Returns the current leader, if there is one
+func (c PatroniCluster) Leader() (PatroniMember, bool) {
+	for _, member := range c.Members {
+		if member.Role == "leader" || member.Role == "standby_leader" {
+			return member, true
+		}
+	}
+	return PatroniMember{}, false
+}
@@ -0,0 +1,30 @@
+package state
+
+import "time"
+
+// AmazonRdsPerformanceInsights - Database load as sampled by Amazon RDS Performance Insights
+//
+// Performance Insights samples active sessions every second, which gives a more
+// complete picture of short-lived activity than our pg_stat_activity snapshots.
+type AmazonRdsPerformanceInsights struct {
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Average number of active sessions, for each second in the period
+	LoadSamples []AmazonRdsLoadSample
+
+	// Average number of active sessions over the whole period, by wait event
+	// (sessions running on CPU have the wait event type "CPU")
+	WaitEvents []AmazonRdsWaitEventLoad
+}
+
+type AmazonRdsLoadSample struct {
+	Time    time.Time
+	LoadAvg float64
+}
+
+type AmazonRdsWaitEventLoad struct {
+	WaitEventType string
+	WaitEvent     string
+	LoadAvg       float64
+}
@@ -0,0 +1,46 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// PgAutoFailoverFormation - Nodes of the pg_auto_failover formation the server belongs to, as
+// tracked by the monitor
+type PgAutoFailoverFormation struct {
+	FormationID string
+	Nodes       []PgAutoFailoverNode
+
+	// Node ID of the server itself (not set if it couldn't be matched by host and port)
+	CurrentNodeID null.Int
+
+	// State transitions of the server's node reported within the last hour, oldest first
+	Events []PgAutoFailoverEvent
+}
+
+// PgAutoFailoverNode - Node registered with the monitor
+type PgAutoFailoverNode struct {
+	NodeID            int64
+	GroupID           int32
+	NodeName          string
+	NodeHost          string
+	NodePort          int32
+	ReportedState     string // e.g. "primary", "secondary", "wait_primary" or "catchingup"
+	GoalState         string // State the monitor assigned, differs from the reported state during transitions
+	ReportedRepState  null.String
+	ReportTime        null.Time
+	StateChangeTime   null.Time
+	Health            int32 // -1 = unknown, 0 = bad, 1 = good
+	CandidatePriority int32
+	ReplicationQuorum bool
+}
+
+// PgAutoFailoverEvent - State change of a node, as recorded in the monitor's event table
+type PgAutoFailoverEvent struct {
+	EventID       int64
+	EventTime     time.Time
+	ReportedState string
+	GoalState     string
+	Description   string
+}
@@ -0,0 +1,80 @@
+package state
+
+// PgBouncerInstance - Statistics of a PgBouncer instance in front of the server, from its admin console
+type PgBouncerInstance struct {
+	Address string // Host and port of the admin console
+
+	Pools   []PgBouncerPool
+	Clients []PgBouncerClientCount
+
+	// Items of SHOW LISTS, e.g. "used_clients" or "free_servers"
+	Lists map[string]int64
+}
+
+// PgBouncerDatabaseStats - Cumulative statistics of a database since PgBouncer started
+type PgBouncerDatabaseStats struct {
+	TotalXactCount   int64
+	TotalQueryCount  int64
+	TotalReceived    int64 // Bytes
+	TotalSent        int64 // Bytes
+	TotalXactTimeUs  int64
+	TotalQueryTimeUs int64
+	TotalWaitTimeUs  int64 // Time clients waited for a server connection
+}
+
+// PgBouncerStatsKey - Database of a PgBouncer instance, statistics are kept separately for each
+type PgBouncerStatsKey struct {
+	Address  string
+	Database string
+}
+
+type PgBouncerStatsMap map[PgBouncerStatsKey]PgBouncerDatabaseStats
+
+// DiffedPgBouncerStats - Activity of a database since the last run
+type DiffedPgBouncerStats struct {
+	XactCount   int64
+	QueryCount  int64
+	Received    int64 // Bytes
+	Sent        int64 // Bytes
+	XactTimeUs  int64
+	QueryTimeUs int64
+	WaitTimeUs  int64
+}
+
+type DiffedPgBouncerStatsMap map[PgBouncerStatsKey]DiffedPgBouncerStats
+
+// DiffSince - Calculate the diff between two PgBouncer stats runs
+func (curr PgBouncerDatabaseStats) DiffSince(prev PgBouncerDatabaseStats) DiffedPgBouncerStats {
+	return DiffedPgBouncerStats{
+		XactCount:   curr.TotalXactCount - prev.TotalXactCount,
+		QueryCount:  curr.TotalQueryCount - prev.TotalQueryCount,
+		Received:    curr.TotalReceived - prev.TotalReceived,
+		Sent:        curr.TotalSent - prev.TotalSent,
+		XactTimeUs:  curr.TotalXactTimeUs - prev.TotalXactTimeUs,
+		QueryTimeUs: curr.TotalQueryTimeUs - prev.TotalQueryTimeUs,
+		WaitTimeUs:  curr.TotalWaitTimeUs - prev.TotalWaitTimeUs,
+	}
+}
+
+// PgBouncerPool - Current client and server connections of a pool (database and user pair)
+type PgBouncerPool struct {
+	Database  string
+	User      string
+	PoolMode  string // "session", "transaction" or "statement"
+	ClActive  int64  // Clients that are linked to a server connection, or idle
+	ClWaiting int64  // Clients waiting for a server connection
+	SvActive  int64
+	SvIdle    int64
+	SvUsed    int64
+	SvTested  int64
+	SvLogin   int64
+	MaxWaitUs int64 // How long the oldest waiting client has been waiting
+}
+
+// PgBouncerClientCount - Number of client connections in a state, from SHOW CLIENTS
+type PgBouncerClientCount struct {
+	Database string
+	User     string
+	State    string // e.g. "active" or "waiting"
+	Count    int64
+}
@@ -0,0 +1,51 @@
+package state
+
+import "github.com/guregu/null"
+
+// PgpoolInstance - Status of the Pgpool-II instance in front of the server
+type PgpoolInstance struct {
+	Address string // Host and port of the Pgpool-II instance
+
+	Nodes []PgpoolNode
+
+	// Child processes that accept client connections, and how many of them are connected
+	ChildProcesses     int64
+	ConnectedProcesses []PgpoolProcessCount
+
+	// Only set if the in-memory query cache is enabled
+	QueryCache *PgpoolQueryCache
+}
+
+// PgpoolNode - Backend node, as reported by SHOW POOL_NODES
+type PgpoolNode struct {
+	NodeID           int32
+	Hostname         string
+	Port             int32
+	Status           string // "up", "down", "waiting" or "unused"
+	LbWeight         float64
+	Role             string // "primary" or "standby"
+	SelectCount      int64  // Number of SELECT queries load balanced to the node
+	LoadBalanceNode  bool
+	ReplicationDelay int64       // Bytes (or seconds, with delay_threshold_by_time)
+	ReplicationState null.String // Only reported by Pgpool-II 4.1 and newer
+	LastStatusChange null.String
+}
+
+// PgpoolProcessCount - Number of child processes connected to a database as a user
+type PgpoolProcessCount struct {
+	Database string
+	Username string
+	Count    int64
+}
+
+// PgpoolQueryCache - Statistics of the in-memory query cache, from SHOW POOL_CACHE
+type PgpoolQueryCache struct {
+	NumCacheHits         int64
+	NumSelects           int64
+	CacheHitRatio        float64
+	NumHashEntries       int64
+	UsedHashEntries      int64
+	NumCacheEntries      int64
+	UsedCacheEntriesSize int64
+	FreeCacheEntriesSize int64
+}
@@ -0,0 +1,31 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresAlloyDB - AlloyDB-specific information, only collected when connected to an AlloyDB instance
+type PostgresAlloyDB struct {
+	InstanceRole string // "primary" or "read_pool"
+
+	ColumnarEngineEnabled      bool
+	ColumnarRelations          []PostgresAlloyDBColumnarRelation
+	ColumnarRecommendedColumns []PostgresAlloyDBColumnarColumn
+}
+
+// PostgresAlloyDBColumnarRelation - Relation (or parts of a relation) currently held in the columnar engine
+type PostgresAlloyDBColumnarRelation struct {
+	DatabaseName      string
+	SchemaName        string
+	RelationName      string
+	Status            string
+	SizeBytes         int64
+	InvalidBlockCount null.Int
+	TotalBlockCount   null.Int
+}
+
+// PostgresAlloyDBColumnarColumn - Column recommended by the columnar engine recommendation feature
+type PostgresAlloyDBColumnarColumn struct {
+	DatabaseName string
+	SchemaName   string
+	RelationName string
+	ColumnName   string
+}
@@ -29,8 +29,14 @@ type PostgresBackend struct {
 
 	BackendType null.String // 10+ The process type of this backend
 
+	QueryID null.Int // 14+ Identifier of this backend's most recent query (if compute_query_id is enabled)
+
 	Query null.String // Text of this backend's most recent query
 
+	// Whether Query was truncated (at track_activity_query_size) and replaced with the full
+	// normalized text from pg_stat_statements (if activity_full_query_text is enabled)
+	QueryFullText bool
+
 	// Current overall state of this backend. Possible values are:
 	// - active: The backend is executing a query.
 	// - idle: The backend is waiting for a new client command.
@@ -0,0 +1,11 @@
+package state
+
+// PostgresBackendMemory - Memory use of a backend, as seen by the operating system, and whether
+// its memory contexts were written to the Postgres log (by pg_log_backend_memory_contexts)
+type PostgresBackendMemory struct {
+	Identity     uint64 // As in PostgresBackend.Identity
+	Pid          int32
+	PrivateBytes uint64 // Resident memory that isn't shared with other processes (e.g. shared buffers)
+
+	ContextsLogged bool
+}
@@ -0,0 +1,98 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresCitus - Citus-specific information about the cluster topology, only collected on
+// the coordinator of a Citus cluster (e.g. Azure Cosmos DB for PostgreSQL)
+type PostgresCitus struct {
+	// Database the Citus metadata was read from, which the distributed tables belong to
+	DatabaseName string
+
+	Nodes               []PostgresCitusNode
+	DistributedTables   []PostgresCitusTable
+	DistributedActivity []PostgresCitusBackend
+
+	// Requires citus.stat_statements_track = 'all', otherwise statistics are only kept in
+	// pg_stat_statements of each node
+	DistributedStatements []PostgresCitusStatement
+
+	ShardPlacements   []PostgresCitusShardPlacement
+	RebalanceProgress []PostgresCitusRebalanceMove
+}
+
+// PostgresCitusNode - Coordinator or worker node of the cluster, with the shards placed on it
+type PostgresCitusNode struct {
+	NodeID           int32
+	GroupID          int32 // 0 for the coordinator
+	NodeName         string
+	NodePort         int32
+	NodeRole         string // "primary" or "secondary"
+	IsActive         bool
+	ShouldHaveShards bool
+
+	ShardCount     int64
+	ShardSizeBytes int64 // Only available on Citus 10 and newer
+}
+
+// PostgresCitusTable - Distributed or reference table (only available on Citus 10 and newer)
+type PostgresCitusTable struct {
+	SchemaName         string
+	RelationName       string
+	TableType          string // "distributed", "reference" or "local"
+	DistributionColumn null.String
+	ColocationID       int32
+	ShardCount         int64
+	TableSizeBytes     int64
+}
+
+// PostgresCitusBackend - Backend running a distributed query, on any node of the cluster
+type PostgresCitusBackend struct {
+	NodeName string
+	NodePort int32
+
+	Pid       int32
+	GlobalPid null.Int // Only available on Citus 11.1 and newer
+
+	DatabaseName    null.String
+	RoleName        null.String
+	ApplicationName null.String
+	State           null.String
+	QueryStart      null.Time
+	WaitEventType   null.String
+	WaitEvent       null.String
+}
+
+// PostgresCitusStatement - Execution statistics of a query planned by Citus, matching the query
+// ID of its pg_stat_statements entry on the coordinator
+type PostgresCitusStatement struct {
+	QueryID      int64
+	UserOid      Oid
+	DatabaseOid  Oid
+	Executor     string      // e.g. "adaptive" or "insert-select"
+	PartitionKey null.String // Distribution column value, for queries routed to a single shard
+	Calls        int64
+}
+
+// PostgresCitusShardPlacement - Placement of a shard on a node, with the largest shards first
+type PostgresCitusShardPlacement struct {
+	ShardID      int64
+	SchemaName   string
+	RelationName string
+	NodeName     string
+	NodePort     int32
+	SizeBytes    int64 // Only available on Citus 10 and newer
+}
+
+// PostgresCitusRebalanceMove - Shard move of a running rebalance
+type PostgresCitusRebalanceMove struct {
+	SessionID    int32
+	SchemaName   string
+	RelationName string
+	ShardID      int64
+	SizeBytes    int64
+	SourceName   string
+	SourcePort   int32
+	TargetName   string
+	TargetPort   int32
+	Progress     int32 // 0 = waiting, 1 = moving, 2 = moved
+}
@@ -0,0 +1,27 @@
+package state
+
+import "time"
+
+// PostgresDatabaseStats - Cumulative statistics of a database from pg_stat_database, only
+// covering temporary files for now
+//
+// See also https://www.postgresql.org/docs/14/monitoring-stats.html#MONITORING-PG-STAT-DATABASE-VIEW
+type PostgresDatabaseStats struct {
+	TempFiles  int64     // Number of temporary files created by queries (regardless of log_temp_files)
+	TempBytes  int64     // Total amount of data written to temporary files by queries
+	StatsReset time.Time // Time at which these statistics were last reset
+}
+
+// PostgresDatabaseStatsMap - Database statistics by database OID
+type PostgresDatabaseStatsMap map[Oid]PostgresDatabaseStats
+
+type DiffedPostgresDatabaseStats PostgresDatabaseStats
+type DiffedPostgresDatabaseStatsMap map[Oid]DiffedPostgresDatabaseStats
+
+func (curr PostgresDatabaseStats) DiffSince(prev PostgresDatabaseStats) DiffedPostgresDatabaseStats {
+	return DiffedPostgresDatabaseStats{
+		TempFiles:  curr.TempFiles - prev.TempFiles,
+		TempBytes:  curr.TempBytes - prev.TempBytes,
+		StatsReset: curr.StatsReset,
+	}
+}
@@ -0,0 +1,26 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresBlockingLock - Edge of the lock dependency graph, with a backend that waits for a
+// heavyweight lock, and one of the backends that block it (from pg_blocking_pids)
+//
+// A backend that waits is blocked by the holders of conflicting locks, as well as by backends that
+// are queued ahead of it for a conflicting lock, which itself may be blocked by a third backend.
+//
+// See https://www.postgresql.org/docs/14/view-pg-locks.html
+type PostgresBlockingLock struct {
+	BlockedPid  int32
+	BlockingPid int32
+
+	LockType string // e.g. "relation", "transactionid" or "tuple" (as in pg_locks.locktype)
+	Mode     string // Lock mode the blocked backend requested, e.g. "AccessExclusiveLock"
+
+	DatabaseOid  Oid    // Zero for locks on objects that aren't part of a database (e.g. transaction IDs)
+	DatabaseName string // Empty for locks that aren't part of a database
+	RelationOid  Oid    // Zero for locks that aren't on a relation (or a tuple or page of one)
+	SchemaName   string // Only resolved for relations in the database we're connected to
+	RelationName string
+
+	WaitingSince null.Time // Postgres 14+ (from pg_locks.waitstart), otherwise start of the blocked query
+}
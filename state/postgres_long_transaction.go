@@ -0,0 +1,32 @@
+package state
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// PostgresLongTransaction - Transaction that exceeds the configured age threshold
+// (long_transaction_threshold), or session that has been idle in its transaction for longer than
+// idle_in_transaction_threshold
+//
+// Both hold back the xmin horizon, which keeps VACUUM from removing dead rows on all tables.
+type PostgresLongTransaction struct {
+	Pid             int32
+	DatabaseName    string
+	RoleName        string
+	ApplicationName string
+	ClientAddr      string
+	BackendType     string
+	State           string // e.g. "active" or "idle in transaction"
+	Query           string // Most recent query (which is no longer running when idle in transaction)
+
+	XactStart time.Time // Start of the transaction
+	XactAge   time.Duration
+	IdleTime  time.Duration // Time since the session became idle in transaction, zero if active
+
+	BackendXmin null.Int // xmin horizon held back by this backend (if any)
+	XminAge     int64    // Number of transactions started since BackendXmin (zero if not known)
+
+	IdleInTransaction bool // Whether the idle_in_transaction_threshold was exceeded (otherwise long_transaction_threshold)
+}
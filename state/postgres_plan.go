@@ -0,0 +1,34 @@
+package state
+
+// PostgresPlanKey - Information that uniquely identifies a query plan
+type PostgresPlanKey struct {
+	DatabaseOid Oid   // OID of database in which the statement was executed
+	UserOid     Oid   // OID of user who executed the statement
+	QueryID     int64 // Internal hash code, computed from the statement's parse tree (same as in pg_stat_statements)
+	PlanID      int64 // Internal hash code, computed from the plan tree
+}
+
+// PostgresPlanStats - Statistics from the pg_stat_plans or pg_store_plans extension for a
+// given plan of a statement
+type PostgresPlanStats struct {
+	Calls     int64   // Number of times executed with this plan
+	TotalTime float64 // Total time spent executing the statement with this plan, in milliseconds
+}
+
+type PostgresPlanTextMap map[PostgresPlanKey]string
+type PostgresPlanStatsMap map[PostgresPlanKey]PostgresPlanStats
+
+type DiffedPostgresPlanStats PostgresPlanStats
+type DiffedPostgresPlanStatsMap map[PostgresPlanKey]DiffedPostgresPlanStats
+
+// StatementKey - Returns the key of the statement this plan belongs to
+func (key PostgresPlanKey) StatementKey() PostgresStatementKey {
+	return PostgresStatementKey{DatabaseOid: key.DatabaseOid, UserOid: key.UserOid, QueryID: key.QueryID}
+}
+
+func (curr PostgresPlanStats) DiffSince(prev PostgresPlanStats) DiffedPostgresPlanStats {
+	return DiffedPostgresPlanStats{
+		Calls:     curr.Calls - prev.Calls,
+		TotalTime: curr.TotalTime - prev.TotalTime,
+	}
+}
@@ -0,0 +1,26 @@
+package state
+
+// PostgresProgress - Maintenance operation (other than VACUUM) thats currently running, based on
+// the pg_stat_progress_analyze, _cluster, _create_index, _basebackup and _copy views
+//
+// Which of the counters are set depends on the kind of operation, e.g. CREATE INDEX reports
+// blocks and tuples, whilst BASE_BACKUP and COPY report bytes.
+//
+// See https://www.postgresql.org/docs/14/progress-reporting.html
+type PostgresProgress struct {
+	Pid     int32
+	Command string // e.g. "ANALYZE", "CLUSTER", "VACUUM FULL", "CREATE INDEX CONCURRENTLY", "BASE_BACKUP" or "COPY FROM"
+
+	DatabaseName string // Empty for BASE_BACKUP
+	SchemaName   string
+	RelationName string
+
+	Phase string // Empty for COPY
+
+	BlocksTotal int64
+	BlocksDone  int64
+	TuplesTotal int64
+	TuplesDone  int64
+	BytesTotal  int64
+	BytesDone   int64
+}
@@ -14,6 +14,7 @@ type PostgresQuerySample struct {
 	Database   string
 	Query      string
 	Parameters []null.String
+	Tags       map[string]string // sqlcommenter/marginalia tags in the query text
 
 	LogLineUUID uuid.UUID
 
@@ -16,6 +16,7 @@ type PostgresRelation struct {
 	Columns                []PostgresColumn
 	Indices                []PostgresIndex
 	Constraints            []PostgresConstraint
+	ExtendedStatistics     []PostgresExtendedStatistic
 	ViewDefinition         string
 	Options                map[string]string
 	HasOids                bool
@@ -71,6 +72,25 @@ type PostgresConstraint struct {
 	ForeignMatchType  string  // Foreign key match type: f = full, p = partial, s = simple
 }
 
+// PostgresExtendedStatistic - Extended statistics object on a table (CREATE STATISTICS), from
+// pg_statistic_ext (Postgres 10+)
+//
+// See https://www.postgresql.org/docs/14/planner-stats.html#PLANNER-STATS-EXTENDED
+type PostgresExtendedStatistic struct {
+	RelationOid Oid      // The table this statistics object is on
+	SchemaName  string   // Schema of the statistics object (which may differ from the table's)
+	Name        string   // Statistics object name
+	Columns     []int32  // Columns covered by the statistics object (not including expressions)
+	Kinds       []string // d = n-distinct, f = functional dependencies, m = most common values (12+), e = expressions (14+)
+	Definition  string   // CREATE STATISTICS statement that recreates the object
+
+	// Whether ANALYZE has computed each kind of statistics yet (based on pg_stats_ext on Postgres
+	// 12+, which only shows statistics on tables the collector's user can read)
+	HasNDistinct    bool
+	HasDependencies bool
+	HasMCV          bool
+}
+
 // Fillfactor - Returns the FILLFACTOR storage parameter set on the table, or the default (100)
 func (r PostgresRelation) Fillfactor() int32 {
 	fstr, exists := r.Options["fillfactor"]
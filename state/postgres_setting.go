@@ -11,4 +11,13 @@ type PostgresSetting struct {
 	Source       null.String `json:"source"`
 	SourceFile   null.String `json:"sourcefile"`
 	SourceLine   null.String `json:"sourceline"`
+
+	// Only set for providers that expose server parameters through their API (currently Azure),
+	// for changes that were saved but only take effect once the server is restarted
+	PendingRestart bool        `json:"pending_restart"`
+	PendingValue   null.String `json:"pending_value"`
+
+	// Default value according to the provider's API (currently Azure), which can differ from
+	// the boot value in pg_settings
+	ProviderDefaultValue null.String `json:"provider_default_value"`
 }
@@ -0,0 +1,58 @@
+package state
+
+import "time"
+
+// PostgresStatIOKey - Identifies the I/O statistics of a backend type, target object and context
+type PostgresStatIOKey struct {
+	BackendType string // Type of backend (e.g. "client backend", "checkpointer", "background writer")
+	Object      string // Target object of the I/O operations ("relation" or "temp relation")
+	Context     string // Context of the I/O operations ("normal", "vacuum", "bulkread" or "bulkwrite")
+}
+
+// PostgresStatIO - Cumulative I/O statistics from pg_stat_io (Postgres 16+)
+//
+// Counts are in number of operations (of block size each), times are in milliseconds and only
+// tracked with track_io_timing enabled. Operations that don't apply to a combination of backend
+// type, object and context are reported as zero.
+//
+// See also https://www.postgresql.org/docs/16/monitoring-stats.html#MONITORING-PG-STAT-IO-VIEW
+type PostgresStatIO struct {
+	Reads         int64     // Number of read operations
+	ReadTime      float64   // Time spent in read operations
+	Writes        int64     // Number of write operations
+	WriteTime     float64   // Time spent in write operations
+	Writebacks    int64     // Number of blocks which the process requested the kernel write out to permanent storage
+	WritebackTime float64   // Time spent in writeback operations
+	Extends       int64     // Number of relation extend operations
+	ExtendTime    float64   // Time spent in extend operations
+	Hits          int64     // Number of times a desired block was found in a shared buffer
+	Evictions     int64     // Number of times a block has been written out from a shared or local buffer to make it available for another use
+	Reuses        int64     // Number of times an existing buffer in a size-limited ring buffer was reused
+	Fsyncs        int64     // Number of fsync calls (only tracked in the "normal" context)
+	FsyncTime     float64   // Time spent in fsync operations
+	StatsReset    time.Time // Time at which these statistics were last reset
+}
+
+type PostgresStatIOMap map[PostgresStatIOKey]PostgresStatIO
+
+type DiffedPostgresStatIO PostgresStatIO
+type DiffedPostgresStatIOMap map[PostgresStatIOKey]DiffedPostgresStatIO
+
+func (curr PostgresStatIO) DiffSince(prev PostgresStatIO) DiffedPostgresStatIO {
+	return DiffedPostgresStatIO{
+		Reads:         curr.Reads - prev.Reads,
+		ReadTime:      curr.ReadTime - prev.ReadTime,
+		Writes:        curr.Writes - prev.Writes,
+		WriteTime:     curr.WriteTime - prev.WriteTime,
+		Writebacks:    curr.Writebacks - prev.Writebacks,
+		WritebackTime: curr.WritebackTime - prev.WritebackTime,
+		Extends:       curr.Extends - prev.Extends,
+		ExtendTime:    curr.ExtendTime - prev.ExtendTime,
+		Hits:          curr.Hits - prev.Hits,
+		Evictions:     curr.Evictions - prev.Evictions,
+		Reuses:        curr.Reuses - prev.Reuses,
+		Fsyncs:        curr.Fsyncs - prev.Fsyncs,
+		FsyncTime:     curr.FsyncTime - prev.FsyncTime,
+		StatsReset:    curr.StatsReset,
+	}
+}
@@ -0,0 +1,37 @@
+package state
+
+import "time"
+
+// PostgresStatSLRU - Cumulative statistics of one of the SLRU (simple least-recently-used) caches,
+// from pg_stat_slru (Postgres 13+)
+//
+// See also https://www.postgresql.org/docs/13/monitoring-stats.html#MONITORING-PG-STAT-SLRU-VIEW
+type PostgresStatSLRU struct {
+	BlksZeroed  int64     // Number of blocks zeroed during initializations
+	BlksHit     int64     // Number of times disk blocks were found already in the SLRU
+	BlksRead    int64     // Number of disk blocks read for this SLRU
+	BlksWritten int64     // Number of disk blocks written for this SLRU
+	BlksExists  int64     // Number of blocks checked for existence for this SLRU
+	Flushes     int64     // Number of flushes of dirty data for this SLRU
+	Truncates   int64     // Number of truncates for this SLRU
+	StatsReset  time.Time // Time at which these statistics were last reset
+}
+
+// PostgresStatSLRUMap - SLRU statistics by cache name (e.g. "MultiXactMember", "Subtrans" or "Notify")
+type PostgresStatSLRUMap map[string]PostgresStatSLRU
+
+type DiffedPostgresStatSLRU PostgresStatSLRU
+type DiffedPostgresStatSLRUMap map[string]DiffedPostgresStatSLRU
+
+func (curr PostgresStatSLRU) DiffSince(prev PostgresStatSLRU) DiffedPostgresStatSLRU {
+	return DiffedPostgresStatSLRU{
+		BlksZeroed:  curr.BlksZeroed - prev.BlksZeroed,
+		BlksHit:     curr.BlksHit - prev.BlksHit,
+		BlksRead:    curr.BlksRead - prev.BlksRead,
+		BlksWritten: curr.BlksWritten - prev.BlksWritten,
+		BlksExists:  curr.BlksExists - prev.BlksExists,
+		Flushes:     curr.Flushes - prev.Flushes,
+		Truncates:   curr.Truncates - prev.Truncates,
+		StatsReset:  curr.StatsReset,
+	}
+}
@@ -0,0 +1,52 @@
+package state
+
+import "time"
+
+// PostgresStatWAL - Cumulative WAL activity statistics from pg_stat_wal (Postgres 14+)
+//
+// See also https://www.postgresql.org/docs/14/monitoring-stats.html#MONITORING-PG-STAT-WAL-VIEW
+type PostgresStatWAL struct {
+	WalRecords     int64     // Total number of WAL records generated
+	WalFpi         int64     // Total number of WAL full page images generated
+	WalBytes       int64     // Total amount of WAL generated in bytes
+	WalBuffersFull int64     // Number of times WAL data was written to disk because WAL buffers became full
+	WalWrite       int64     // Number of times WAL buffers were written out to disk (zero on Postgres 18+)
+	WalSync        int64     // Number of times WAL files were synced to disk (zero on Postgres 18+)
+	WalWriteTime   float64   // Total time spent writing WAL buffers to disk, in milliseconds (if track_wal_io_timing is enabled)
+	WalSyncTime    float64   // Total time spent syncing WAL files to disk, in milliseconds (if track_wal_io_timing is enabled)
+	StatsReset     time.Time // Time at which these statistics were last reset
+}
+
+// DiffedPostgresStatWAL - WAL activity since the last snapshot, including the WAL generation rates
+type DiffedPostgresStatWAL struct {
+	WalRecords     int64
+	WalFpi         int64
+	WalBytes       int64
+	WalBuffersFull int64
+	WalWrite       int64
+	WalSync        int64
+	WalWriteTime   float64
+	WalSyncTime    float64
+
+	WalRecordsPerSecond float64
+	WalFpiPerSecond     float64
+	WalBytesPerSecond   float64
+}
+
+// DiffSince - Calculate the diff between two pg_stat_wal runs
+func (curr PostgresStatWAL) DiffSince(prev PostgresStatWAL, collectedIntervalSecs uint32) DiffedPostgresStatWAL {
+	diffed := DiffedPostgresStatWAL{
+		WalRecords:     curr.WalRecords - prev.WalRecords,
+		WalFpi:         curr.WalFpi - prev.WalFpi,
+		WalBytes:       curr.WalBytes - prev.WalBytes,
+		WalBuffersFull: curr.WalBuffersFull - prev.WalBuffersFull,
+		WalWrite:       curr.WalWrite - prev.WalWrite,
+		WalSync:        curr.WalSync - prev.WalSync,
+		WalWriteTime:   curr.WalWriteTime - prev.WalWriteTime,
+		WalSyncTime:    curr.WalSyncTime - prev.WalSyncTime,
+	}
+	diffed.WalRecordsPerSecond = float64(diffed.WalRecords) / float64(collectedIntervalSecs)
+	diffed.WalFpiPerSecond = float64(diffed.WalFpi) / float64(collectedIntervalSecs)
+	diffed.WalBytesPerSecond = float64(diffed.WalBytes) / float64(collectedIntervalSecs)
+	return diffed
+}
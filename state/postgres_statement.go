@@ -13,6 +13,11 @@ type PostgresStatement struct {
 	QueryTextUnavailable  bool   // True if this represents a statement without query text
 	InsufficientPrivilege bool   // True if we're missing permissions to see the statement
 	Collector             bool   // True if this statement was produced by the pganalyze collector
+	Remainder             bool   // True if this represents the statements that exceeded the query_stats_limit
+
+	// sqlcommenter/marginalia tags in the query text (e.g. "application", "controller" or "route"),
+	// which pg_stat_statements retains from the first execution of the statement
+	Tags map[string]string
 }
 
 // PostgresStatementStats - Statistics from pg_stat_statements extension for a given
@@ -41,6 +46,18 @@ type PostgresStatementStats struct {
 	MaxTime    null.Float // Maximum time spent in the statement, in milliseconds
 	MeanTime   null.Float // Mean time spent in the statement, in milliseconds
 	StddevTime null.Float // Population standard deviation of time spent in the statement, in milliseconds
+
+	// Postgres 13+
+	Plans int64 // Number of times the statement was planned (if pg_stat_statements.track_planning is enabled, otherwise zero)
+
+	// Postgres 14+
+	NestedCalls int64 // Number of the calls that were executed within functions (if pg_stat_statements.track is "all"), instead of directly by the client
+
+	// pg_stat_kcache 2.1+ (if installed)
+	ExecUserTime   float64 // Total CPU time spent in user mode executing the statement, in milliseconds
+	ExecSystemTime float64 // Total CPU time spent in kernel mode executing the statement, in milliseconds
+	ExecReads      int64   // Total bytes read from the filesystem (not the OS page cache) executing the statement
+	ExecWrites     int64   // Total bytes written to the filesystem executing the statement
 }
 
 // PostgresStatementKey - Information that uniquely identifies a query
@@ -50,6 +67,13 @@ type PostgresStatementKey struct {
 	QueryID     int64 // Postgres 9.4+: Internal hash code, computed from the statement's parse tree
 }
 
+// PostgresStatementStatsInfo - Overall statistics of the pg_stat_statements extension itself
+// (Postgres 14+, from pg_stat_statements_info)
+type PostgresStatementStatsInfo struct {
+	Dealloc    int64     // Number of times the least-executed statements were evicted, because pg_stat_statements.max was exceeded
+	StatsReset time.Time // Time at which all statistics were last reset
+}
+
 type PostgresStatementStatsTimeKey struct {
 	CollectedAt           time.Time
 	CollectedIntervalSecs uint32
@@ -81,6 +105,12 @@ func (curr PostgresStatementStats) DiffSince(prev PostgresStatementStats) Diffed
 		TempBlksWritten:   curr.TempBlksWritten - prev.TempBlksWritten,
 		BlkReadTime:       curr.BlkReadTime - prev.BlkReadTime,
 		BlkWriteTime:      curr.BlkWriteTime - prev.BlkWriteTime,
+		Plans:             curr.Plans - prev.Plans,
+		NestedCalls:       curr.NestedCalls - prev.NestedCalls,
+		ExecUserTime:      curr.ExecUserTime - prev.ExecUserTime,
+		ExecSystemTime:    curr.ExecSystemTime - prev.ExecSystemTime,
+		ExecReads:         curr.ExecReads - prev.ExecReads,
+		ExecWrites:        curr.ExecWrites - prev.ExecWrites,
 	}
 }
 
@@ -102,5 +132,11 @@ func (stmt DiffedPostgresStatementStats) Add(other DiffedPostgresStatementStats)
 		TempBlksWritten:   stmt.TempBlksWritten + other.TempBlksWritten,
 		BlkReadTime:       stmt.BlkReadTime + other.BlkReadTime,
 		BlkWriteTime:      stmt.BlkWriteTime + other.BlkWriteTime,
+		Plans:             stmt.Plans + other.Plans,
+		NestedCalls:       stmt.NestedCalls + other.NestedCalls,
+		ExecUserTime:      stmt.ExecUserTime + other.ExecUserTime,
+		ExecSystemTime:    stmt.ExecSystemTime + other.ExecSystemTime,
+		ExecReads:         stmt.ExecReads + other.ExecReads,
+		ExecWrites:        stmt.ExecWrites + other.ExecWrites,
 	}
 }
@@ -0,0 +1,48 @@
+package state
+
+import "github.com/guregu/null"
+
+// PostgresTimescaleHypertable - TimescaleDB hypertable, whose chunks are rolled up into the
+// hypertable's relation and index statistics instead of being collected as separate tables
+type PostgresTimescaleHypertable struct {
+	DatabaseOid   Oid
+	RelationOid   Oid
+	SchemaName    string
+	RelationName  string
+	NumDimensions int32
+	NumChunks     int64
+
+	// Only set if compression is enabled for the hypertable
+	CompressionEnabled         bool
+	CompressedChunks           int64
+	BeforeCompressionSizeBytes int64
+	AfterCompressionSizeBytes  int64
+}
+
+// CompressionRatio - Returns the uncompressed size of the compressed chunks divided by their
+// compressed size, or 0 if no chunks are compressed
+func (h PostgresTimescaleHypertable) CompressionRatio() float64 {
+	if h.AfterCompressionSizeBytes == 0 {
+		return 0
+	}
+	return float64(h.BeforeCompressionSizeBytes) / float64(h.AfterCompressionSizeBytes)
+}
+
+// PostgresTimescaleContinuousAggregate - Continuous aggregate, with the statistics of the
+// background job that refreshes it (if there is a refresh policy)
+type PostgresTimescaleContinuousAggregate struct {
+	DatabaseOid            Oid
+	SchemaName             string
+	ViewName               string
+	HypertableSchemaName   string
+	HypertableName         string
+	MaterializedOnly       bool
+	JobID                  null.Int
+	LastRunStartedAt       null.Time
+	LastSuccessfulFinish   null.Time
+	LastRunStatus          null.String // "Success" or "Failed"
+	LastRunDurationSeconds null.Float
+	NextStart              null.Time
+	TotalRuns              int64
+	TotalFailures          int64
+}
@@ -17,11 +17,23 @@ type PostgresVacuumProgress struct {
 	Autovacuum   bool
 	Toast        bool
 
+	// Details of the (auto)vacuum worker running the vacuum, from pg_stat_activity
+	AntiWraparound bool // Whether this is an autovacuum to prevent transaction ID wraparound
+	Pid            int32
+	WaitEventType  string // 9.6+ Empty if not waiting
+	WaitEvent      string
+
 	Phase            string
 	HeapBlksTotal    int64
 	HeapBlksScanned  int64
 	HeapBlksVacuumed int64
 	IndexVacuumCount int64
-	MaxDeadTuples    int64
-	NumDeadTuples    int64
+	MaxDeadTuples    int64 // Before Postgres 17
+	NumDeadTuples    int64 // On Postgres 17+ the number of dead item IDs collected
+
+	// Postgres 17+
+	MaxDeadTupleBytes int64
+	DeadTupleBytes    int64
+	IndexesTotal      int64
+	IndexesProcessed  int64
 }
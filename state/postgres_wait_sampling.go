@@ -0,0 +1,28 @@
+package state
+
+// PostgresWaitSamplingKey - Identifies a wait event profile entry of pg_wait_sampling, which is
+// kept for each process separately
+type PostgresWaitSamplingKey struct {
+	Pid       int32
+	EventType string // Empty if the process was running (on CPU), instead of waiting
+	Event     string
+	QueryID   int64 // Zero unless pg_wait_sampling.profile_queries is enabled
+}
+
+// PostgresWaitSamplingProfile - Cumulative number of times each wait event was sampled by pg_wait_sampling
+type PostgresWaitSamplingProfile map[PostgresWaitSamplingKey]int64
+
+// PostgresWaitEventKey - Identifies a wait event of a query, across all processes
+type PostgresWaitEventKey struct {
+	QueryID   int64
+	EventType string
+	Event     string
+}
+
+// DiffedPostgresWaitEvent - Wait event samples of a query since the last snapshot
+type DiffedPostgresWaitEvent struct {
+	Samples int64
+	TimeMs  int64 // Approximate time spent, based on the sample count and pg_wait_sampling.sample_period
+}
+
+type DiffedPostgresWaitEventsMap map[PostgresWaitEventKey]DiffedPostgresWaitEvent
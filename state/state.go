@@ -13,6 +13,11 @@ type SchemaStats struct {
 	ColumnStats   PostgresColumnStatsMap
 	IndexStats    PostgresIndexStatsMap
 	FunctionStats PostgresFunctionStatsMap
+
+	// TimescaleDB chunks (and their indices), mapped to the hypertable (or hypertable index)
+	// their statistics get added to after diffing
+	TimescaleChunks       map[Oid]Oid
+	TimescaleChunkIndices map[Oid]Oid
 }
 
 // PersistedState - State thats kept across collector runs to be used for diffs
@@ -22,6 +27,32 @@ type PersistedState struct {
 	StatementStats PostgresStatementStatsMap
 	SchemaStats    map[Oid]*SchemaStats
 
+	// Postgres 14+ only, used to detect resets of all statement stats (e.g. by someone
+	// calling pg_stat_statements_reset(), or a crash restart)
+	StatementStatsInfo PostgresStatementStatsInfo
+
+	// Only collected when pg_stat_plans or pg_store_plans is installed
+	PlanStats PostgresPlanStatsMap
+
+	// Temporary file counters of each database, from pg_stat_database
+	DatabaseStats PostgresDatabaseStatsMap
+
+	// Postgres 13+ only
+	StatSLRU PostgresStatSLRUMap
+
+	// Postgres 14+ only
+	StatWAL *PostgresStatWAL
+
+	// Postgres 16+ only
+	StatIO PostgresStatIOMap
+
+	// Only collected when pg_wait_sampling is installed
+	WaitSamplingProfile        PostgresWaitSamplingProfile
+	WaitSamplingSamplePeriodMs int64
+
+	// SHOW STATS of the PgBouncer instances in front of the server (if pgbouncer_url is set)
+	PgBouncerStats PgBouncerStatsMap
+
 	Relations []PostgresRelation
 	Functions []PostgresFunction
 
@@ -36,6 +67,10 @@ type PersistedState struct {
 	// Keep track of when we last collected statement stats, to calculate time distance
 	LastStatementStatsAt time.Time
 
+	// Only collected for serverless endpoints, which restart (and reset their statistics)
+	// whenever they resume after being suspended
+	PostmasterStartTime time.Time
+
 	// All statement stats that have not been identified (will be cleared by the next full snapshot)
 	UnidentifiedStatementStats HistoricStatementStatsMap
 }
@@ -52,17 +87,52 @@ type TransientState struct {
 	Statements             PostgresStatementMap
 	StatementTexts         PostgresStatementTextMap
 	HistoricStatementStats HistoricStatementStatsMap
+	PlanTexts              PostgresPlanTextMap
+
+	// Maximum number of statements sent individually (0 = no limit), see query_stats_limit
+	StatementLimit int
+
+	// Whether statement stats are reported per role ("full"/""), only for the roles with the most
+	// load ("rollup"), or summed up across roles ("collapse"), see query_stats_user_dimension
+	StatementUserDimension string
 
 	// This is a new zero value that was recorded after a pg_stat_statements_reset(),
 	// in order to enable the next snapshot to be able to diff against something
-	ResetStatementStats PostgresStatementStatsMap
+	ResetStatementStats     PostgresStatementStatsMap
+	ResetStatementStatsInfo PostgresStatementStatsInfo
 
 	Replication   PostgresReplication
 	Settings      []PostgresSetting
 	BackendCounts []PostgresBackendCount
 
+	// Activity (including temporary files) derived from the log lines analyzed since the last
+	// full snapshot, only set if any log lines were analyzed
+	LogMetrics LogMetrics
+
 	Version PostgresVersion
 
+	// Only set for AlloyDB instances
+	AlloyDB *PostgresAlloyDB
+
+	// Only set for the coordinator of Citus clusters
+	Citus *PostgresCitus
+
+	// Only set for servers that have patroni_api_url configured
+	Patroni *PatroniCluster
+
+	// One entry for each of the server's pgbouncer_url instances that could be reached
+	PgBouncer []PgBouncerInstance
+
+	// Only set for servers that have pgpool_url configured
+	Pgpool *PgpoolInstance
+
+	// Only set for servers that have pg_auto_failover_monitor_url configured
+	PgAutoFailover *PgAutoFailoverFormation
+
+	// Only set for databases that have the TimescaleDB extension installed
+	TimescaleHypertables          []PostgresTimescaleHypertable
+	TimescaleContinuousAggregates []PostgresTimescaleContinuousAggregate
+
 	SentryClient *raven.Client
 
 	CollectorConfig   CollectorConfig
@@ -142,6 +212,13 @@ type DiffedSchemaStats struct {
 // DiffState - Result of diff-ing two persistent state structs
 type DiffState struct {
 	StatementStats DiffedPostgresStatementStatsMap
+	PlanStats      DiffedPostgresPlanStatsMap
+	DatabaseStats  DiffedPostgresDatabaseStatsMap
+	PgBouncerStats DiffedPgBouncerStatsMap
+	StatSLRU       DiffedPostgresStatSLRUMap
+	StatWAL        *DiffedPostgresStatWAL
+	StatIO         DiffedPostgresStatIOMap
+	WaitEvents     DiffedPostgresWaitEventsMap
 	SchemaStats    map[Oid]*DiffedSchemaStats
 
 	SystemCPUStats     DiffedSystemCPUStatsMap
@@ -158,6 +235,8 @@ type StateOnDisk struct {
 	FormatVersion uint
 
 	PrevStateByServer map[config.ServerIdentifier]PersistedState
+
+	LogStateByServer map[config.ServerIdentifier]PersistedLogState
 }
 
 type CollectionOpts struct {
@@ -233,6 +312,16 @@ type CollectionStatus struct {
 	CollectionDisabledReason  string
 	LogSnapshotDisabled       bool
 	LogSnapshotDisabledReason string
+
+	// The log_line_prefix setting, as last read from the server, which selects the log line
+	// parser (when it's not supported, the prefix is detected from each line instead)
+	LogLinePrefix string
+
+	// Fingerprints of the pg_stat_statements entries by query ID, and their normalized query
+	// texts, as of the last full snapshot - used to associate log lines that include the query
+	// ID (from %Q in log_line_prefix, or csvlog/jsonlog) with query statistics
+	QueryFingerprints map[int64]uint64
+	QueryTexts        PostgresStatementTextMap
 }
 
 type Server struct {
@@ -247,9 +336,23 @@ type Server struct {
 	LogPrevState  PersistedLogState
 	LogStateMutex *sync.Mutex
 
+	LogRateLimitState LogRateLimitState
+	LogRateLimitMutex *sync.Mutex
+
+	LogMetrics      LogMetrics
+	LogMetricsMutex *sync.Mutex
+
 	ActivityPrevState  PersistedActivityState
 	ActivityStateMutex *sync.Mutex
 
+	// Aggregate of the activity samples since the last activity snapshot (if activity_sample_interval is set)
+	ActivitySamples      ActivitySamples
+	ActivitySamplesMutex *sync.Mutex
+
 	CollectionStatus      CollectionStatus
 	CollectionStatusMutex *sync.Mutex
+
+	// Since when connections to a serverless endpoint have been failing (e.g. because it's
+	// suspended), zero if the last connection was successful. Protected by StateMutex.
+	ServerlessUnavailableSince time.Time
 }
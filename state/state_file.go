@@ -9,10 +9,25 @@ import (
 )
 
 func WriteStateFile(servers []*Server, globalCollectionOpts CollectionOpts, logger *util.Logger) {
-	stateOnDisk := StateOnDisk{PrevStateByServer: make(map[config.ServerIdentifier]PersistedState), FormatVersion: StateOnDiskFormatVersion}
+	stateOnDisk := StateOnDisk{
+		PrevStateByServer: make(map[config.ServerIdentifier]PersistedState),
+		LogStateByServer:  make(map[config.ServerIdentifier]PersistedLogState),
+		FormatVersion:     StateOnDiskFormatVersion,
+	}
 
 	for _, server := range servers {
 		stateOnDisk.PrevStateByServer[server.Config.Identifier] = server.PrevState
+
+		// Only markers that are safe to resume from after a restart are kept
+		server.LogStateMutex.Lock()
+		stateOnDisk.LogStateByServer[server.Config.Identifier] = PersistedLogState{
+			AwsMarkers:          server.LogPrevState.AwsMarkers,
+			AwsMarkersUpdatedAt: server.LogPrevState.AwsMarkersUpdatedAt,
+			GcsMarkers:          server.LogPrevState.GcsMarkers,
+			KinesisCheckpoints:  server.LogPrevState.KinesisCheckpoints,
+			S3LogMarkers:        server.LogPrevState.S3LogMarkers,
+		}
+		server.LogStateMutex.Unlock()
 	}
 
 	file, err := os.Create(globalCollectionOpts.StateFilename)
@@ -55,5 +70,15 @@ func ReadStateFile(servers []*Server, globalCollectionOpts CollectionOpts, logge
 			prefixedLogger.PrintVerbose("Successfully recovered state from on-disk file")
 			servers[idx].PrevState = prevState
 		}
+		logState, exist := stateOnDisk.LogStateByServer[server.Config.Identifier]
+		if exist {
+			server.LogStateMutex.Lock()
+			servers[idx].LogPrevState.AwsMarkers = logState.AwsMarkers
+			servers[idx].LogPrevState.AwsMarkersUpdatedAt = logState.AwsMarkersUpdatedAt
+			servers[idx].LogPrevState.GcsMarkers = logState.GcsMarkers
+			servers[idx].LogPrevState.KinesisCheckpoints = logState.KinesisCheckpoints
+			servers[idx].LogPrevState.S3LogMarkers = logState.S3LogMarkers
+			server.LogStateMutex.Unlock()
+		}
 	}
 }
@@ -18,6 +18,25 @@ type SystemState struct {
 	DataDirectoryPartition string // Partition that the data directory lives on (identified by the partition's mountpoint)
 	XlogPartition          string // Partition that the WAL directory lives on
 	XlogUsedBytes          uint64
+
+	// Only available for some platforms (currently Amazon RDS with Enhanced Monitoring)
+	Processes []SystemProcess
+	Tasks     SystemTasks
+
+	// Only set for Aurora Serverless v2 instances
+	ServerlessCapacity *AuroraServerlessCapacity
+
+	// Replication lag of Amazon RDS read replicas and Aurora readers (as reported by
+	// CloudWatch when Enhanced Monitoring is disabled), of Azure Database read
+	// replicas, and of Google Cloud SQL replicas and AlloyDB read pools
+	ReplicaLagSeconds float64
+
+	// Only set for platforms that report memory usage as a percentage without the
+	// absolute values (currently Azure Database)
+	MemoryUsedPercent float64
+
+	// Only set when an RDS Proxy is configured (aws_db_proxy_name)
+	ProxyStats *RdsProxyStats
 }
 
 // SystemType - Enum that describes which kind of system we're monitoring
@@ -41,6 +60,9 @@ type SystemInfo struct {
 	SelfHosted *SystemInfoSelfHosted
 	AmazonRds  *SystemInfoAmazonRds
 
+	// Only set when the Crunchy Bridge API is used
+	CrunchyBridge *SystemInfoCrunchyBridge
+
 	BootTime time.Time
 }
 
@@ -89,6 +111,33 @@ type SystemInfoAmazonRds struct {
 	ParameterPgssEnabled        bool
 	ParameterAutoExplainEnabled bool
 	IsAuroraPostgres            bool
+	IsAuroraServerless          bool
+}
+
+// SystemInfoCrunchyBridge - Cluster information for Crunchy Bridge systems
+type SystemInfoCrunchyBridge struct {
+	ClusterName  string
+	PlanID       string
+	ProviderID   string
+	RegionID     string
+	MajorVersion int
+	IsHA         bool
+	State        string
+	CreatedAt    time.Time
+}
+
+// AuroraServerlessCapacity - Current capacity of an Aurora Serverless v2 instance
+type AuroraServerlessCapacity struct {
+	CapacityUnits      float64 // Aurora capacity units (ACUs) currently allocated to the instance
+	UtilizationPercent float64 // Allocated capacity relative to the maximum capacity of the cluster
+}
+
+// RdsProxyStats - Connection pool statistics of the RDS Proxy in front of an Amazon RDS instance
+type RdsProxyStats struct {
+	ProxyName              string
+	ClientConnections      int64   // Connections from applications to the proxy
+	DatabaseConnections    int64   // Connections from the proxy to the database
+	QueryRequestsPerSecond float64 // Queries received by the proxy
 }
 
 // Scheduler - Information about the OS scheduler
@@ -98,6 +147,27 @@ type Scheduler struct {
 	Loadavg15min float64
 }
 
+// SystemProcess - Resource usage of a single OS process
+type SystemProcess struct {
+	Pid           int32
+	ParentPid     int32
+	Name          string
+	CPUPercent    float64
+	MemoryPercent float64
+	RssBytes      uint64
+	VssBytes      uint64
+}
+
+// SystemTasks - Number of OS tasks (processes and threads) by state
+type SystemTasks struct {
+	Total    int32
+	Running  int32
+	Sleeping int32
+	Blocked  int32
+	Stopped  int32
+	Zombie   int32
+}
+
 // Memory - Metrics related to system memory
 type Memory struct {
 	TotalBytes      uint64
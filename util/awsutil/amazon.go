@@ -1,12 +1,17 @@
 package awsutil
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pganalyze/collector/config"
 )
 
@@ -14,7 +19,18 @@ import (
 func GetAwsSession(cfg config.ServerConfig) (*session.Session, error) {
 	var providers []credentials.Provider
 
+	endpointURLs, err := cfg.GetAwsEndpointURLs()
+	if err != nil {
+		return nil, err
+	}
+
 	customResolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if url, ok := endpointURLs[service]; ok {
+			return endpoints.ResolvedEndpoint{
+				URL:           url,
+				SigningRegion: cfg.AwsEndpointSigningRegion,
+			}, nil
+		}
 		if service == endpoints.RdsServiceID && cfg.AwsEndpointRdsURL != "" {
 			return endpoints.ResolvedEndpoint{
 				URL:           cfg.AwsEndpointRdsURL,
@@ -40,14 +56,23 @@ func GetAwsSession(cfg config.ServerConfig) (*session.Session, error) {
 			}, nil
 		}
 
+		// The region determines the partition (e.g. aws-us-gov or aws-cn), and services
+		// the SDK doesn't know about in a partition get the partition's endpoint pattern
+		optFns = append(optFns, func(o *endpoints.Options) {
+			o.ResolveUnknownService = true
+		})
 		return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
 	}
 
 	if cfg.AwsAccessKeyID != "" {
+		accessKeyID, secretAccessKey, err := resolveAwsKeys(cfg)
+		if err != nil {
+			return nil, err
+		}
 		providers = append(providers, &credentials.StaticProvider{
 			Value: credentials.Value{
-				AccessKeyID:     cfg.AwsAccessKeyID,
-				SecretAccessKey: cfg.AwsSecretAccessKey,
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
 				SessionToken:    "",
 			},
 		})
@@ -65,33 +90,120 @@ func GetAwsSession(cfg config.ServerConfig) (*session.Session, error) {
 
 	creds := credentials.NewChainCredentials(providers)
 
-	if cfg.AwsAssumeRole != "" || (cfg.AwsWebIdentityTokenFile != "" && cfg.AwsRoleArn != "") {
-		sess, err := session.NewSession(&aws.Config{
-			Credentials:                   creds,
-			CredentialsChainVerboseErrors: aws.Bool(true),
-			Region:                        aws.String(cfg.AwsRegion),
-			HTTPClient:                    cfg.HTTPClient,
-			EndpointResolver:              endpoints.ResolverFunc(customResolver),
-		})
-		if err != nil {
-			return nil, err
+	roles := cfg.GetAwsAssumeRoles()
+	if len(roles) > 0 || (cfg.AwsWebIdentityTokenFile != "" && cfg.AwsRoleArn != "") {
+		// Assumed role credentials are cached for each step of the chain, so that servers
+		// sharing the same roles don't assume them again, and each step refreshes on its own
+		cacheKey := strings.Join([]string{cfg.AwsRegion, cfg.AwsEndpointURLs, cfg.AwsAccessKeyID, cfg.AwsWebIdentityTokenFile, cfg.AwsRoleArn, cfg.AwsAssumeRoleSessionName}, "|")
+
+		if cfg.AwsWebIdentityTokenFile != "" && cfg.AwsRoleArn != "" {
+			sess, err := newAwsSession(cfg, creds, customResolver)
+			if err != nil {
+				return nil, err
+			}
+			creds = cachedAssumeRoleCredentials(cacheKey, func() *credentials.Credentials {
+				return stscreds.NewWebIdentityCredentials(sess, cfg.AwsRoleArn, cfg.AwsAssumeRoleSessionName, cfg.AwsWebIdentityTokenFile)
+			})
 		}
-		if cfg.AwsAssumeRole != "" {
-			creds = stscreds.NewCredentials(sess, cfg.AwsAssumeRole)
-		} else if cfg.AwsWebIdentityTokenFile != "" && cfg.AwsRoleArn != "" {
-			creds = stscreds.NewWebIdentityCredentials(sess, cfg.AwsRoleArn, "", cfg.AwsWebIdentityTokenFile)
+
+		if len(roles) > 0 {
+			tags, err := parseAssumeRoleTags(cfg.AwsAssumeRoleTags)
+			if err != nil {
+				return nil, err
+			}
+			for idx, role := range roles {
+				sess, err := newAwsSession(cfg, creds, customResolver)
+				if err != nil {
+					return nil, err
+				}
+				last := idx == len(roles)-1
+				cacheKey += "|" + role
+				if last {
+					cacheKey += "|" + cfg.AwsAssumeRoleExternalID + "|" + cfg.AwsAssumeRoleTags
+				}
+				creds = cachedAssumeRoleCredentials(cacheKey, func() *credentials.Credentials {
+					return stscreds.NewCredentials(sess, role, func(p *stscreds.AssumeRoleProvider) {
+						if cfg.AwsAssumeRoleSessionName != "" {
+							p.RoleSessionName = cfg.AwsAssumeRoleSessionName
+						}
+						if last {
+							if cfg.AwsAssumeRoleExternalID != "" {
+								p.ExternalID = aws.String(cfg.AwsAssumeRoleExternalID)
+							}
+							p.Tags = tags
+						}
+					})
+				})
+			}
 		}
 	}
 
+	return newAwsSession(cfg, creds, customResolver)
+}
+
+func newAwsSession(cfg config.ServerConfig, creds *credentials.Credentials, resolver endpoints.ResolverFunc) (*session.Session, error) {
 	return session.NewSession(&aws.Config{
 		Credentials:                   creds,
 		CredentialsChainVerboseErrors: aws.Bool(true),
 		Region:                        aws.String(cfg.AwsRegion),
 		HTTPClient:                    cfg.HTTPClient,
-		EndpointResolver:              endpoints.ResolverFunc(customResolver),
+		EndpointResolver:              resolver,
 	})
 }
 
+var assumeRoleCredentialsMutex sync.Mutex
+var assumeRoleCredentials = make(map[string]*credentials.Credentials)
+
+// cachedAssumeRoleCredentials - Returns the credentials for the given step of a role chain,
+// creating them on first use (they get refreshed automatically before they expire)
+func cachedAssumeRoleCredentials(key string, create func() *credentials.Credentials) *credentials.Credentials {
+	assumeRoleCredentialsMutex.Lock()
+	defer assumeRoleCredentialsMutex.Unlock()
+
+	creds, ok := assumeRoleCredentials[key]
+	if !ok {
+		creds = create()
+		assumeRoleCredentials[key] = creds
+	}
+	return creds
+}
+
+// resolveAwsKeys - Gets the configured AWS keys, which may be stored in Secrets Manager or SSM
+func resolveAwsKeys(cfg config.ServerConfig) (accessKeyID string, secretAccessKey string, err error) {
+	accessKeyID = cfg.AwsAccessKeyID
+	secretAccessKey = cfg.AwsSecretAccessKey
+	if IsSecretReference(accessKeyID) {
+		accessKeyID, err = ResolveSecretReference(cfg, accessKeyID, "")
+		if err != nil {
+			return
+		}
+	}
+	if IsSecretReference(secretAccessKey) {
+		secretAccessKey, err = ResolveSecretReference(cfg, secretAccessKey, "")
+	}
+	return
+}
+
+// parseAssumeRoleTags - Parses session tags in the "key=value,key2=value2" format
+func parseAssumeRoleTags(value string) ([]*sts.Tag, error) {
+	var tags []*sts.Tag
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		keyValue := strings.SplitN(pair, "=", 2)
+		if len(keyValue) != 2 || strings.TrimSpace(keyValue[0]) == "" {
+			return nil, fmt.Errorf("Invalid aws_assume_role_tags entry \"%s\", expected key=value", pair)
+		}
+		tags = append(tags, &sts.Tag{
+			Key:   aws.String(strings.TrimSpace(keyValue[0])),
+			Value: aws.String(strings.TrimSpace(keyValue[1])),
+		})
+	}
+	return tags, nil
+}
+
 //sess.Handlers.Send.PushFront(func(r *request.Request) {
 // Log every request made and its payload
 //  fmt.Printf("Request: %s/%s, Payload: %s\n", r.ClientInfo.ServiceName, r.Operation, r.Params)
@@ -0,0 +1,160 @@
+package awsutil
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+// The AWS SDK version we use doesn't include the Kinesis service client, so
+// this implements the few read operations we need on top of the SDK's JSON RPC
+// protocol support, the same way the generated service clients do.
+
+// KinesisClient - Minimal client for reading records from a Kinesis Data Stream
+type KinesisClient struct {
+	*client.Client
+}
+
+const (
+	kinesisServiceName = "kinesis"
+	kinesisServiceID   = "Kinesis"
+)
+
+// Error codes returned by Kinesis
+const (
+	KinesisErrCodeExpiredIteratorException               = "ExpiredIteratorException"
+	KinesisErrCodeProvisionedThroughputExceededException = "ProvisionedThroughputExceededException"
+	KinesisErrCodeResourceNotFoundException              = "ResourceNotFoundException"
+)
+
+// Shard iterator types
+const (
+	KinesisShardIteratorTypeAfterSequenceNumber = "AFTER_SEQUENCE_NUMBER"
+	KinesisShardIteratorTypeAtTimestamp         = "AT_TIMESTAMP"
+	KinesisShardIteratorTypeTrimHorizon         = "TRIM_HORIZON"
+)
+
+// NewKinesisClient - Creates a Kinesis client for the specified session
+func NewKinesisClient(p client.ConfigProvider, cfgs ...*aws.Config) *KinesisClient {
+	c := p.ClientConfig(kinesisServiceName, cfgs...)
+
+	svc := &KinesisClient{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   kinesisServiceName,
+				ServiceID:     kinesisServiceID,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				PartitionID:   c.PartitionID,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2013-12-02",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "Kinesis_20131202",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(
+		protocol.NewUnmarshalErrorHandler(jsonrpc.NewUnmarshalTypedError(nil)).NamedHandler(),
+	)
+
+	return svc
+}
+
+type KinesisListShardsInput struct {
+	_ struct{} `type:"structure"`
+
+	NextToken  *string `type:"string"`
+	StreamName *string `type:"string"`
+}
+
+type KinesisShard struct {
+	_ struct{} `type:"structure"`
+
+	ShardId               *string `type:"string"`
+	ParentShardId         *string `type:"string"`
+	AdjacentParentShardId *string `type:"string"`
+}
+
+type KinesisListShardsOutput struct {
+	_ struct{} `type:"structure"`
+
+	NextToken *string         `type:"string"`
+	Shards    []*KinesisShard `type:"list"`
+}
+
+type KinesisGetShardIteratorInput struct {
+	_ struct{} `type:"structure"`
+
+	ShardId                *string    `type:"string"`
+	ShardIteratorType      *string    `type:"string"`
+	StartingSequenceNumber *string    `type:"string"`
+	StreamName             *string    `type:"string"`
+	Timestamp              *time.Time `type:"timestamp"`
+}
+
+type KinesisGetShardIteratorOutput struct {
+	_ struct{} `type:"structure"`
+
+	ShardIterator *string `type:"string"`
+}
+
+type KinesisGetRecordsInput struct {
+	_ struct{} `type:"structure"`
+
+	Limit         *int64  `type:"integer"`
+	ShardIterator *string `type:"string"`
+}
+
+type KinesisRecord struct {
+	_ struct{} `type:"structure"`
+
+	ApproximateArrivalTimestamp *time.Time `type:"timestamp"`
+	Data                        []byte     `type:"blob"`
+	PartitionKey                *string    `type:"string"`
+	SequenceNumber              *string    `type:"string"`
+}
+
+type KinesisGetRecordsOutput struct {
+	_ struct{} `type:"structure"`
+
+	MillisBehindLatest *int64           `type:"long"`
+	NextShardIterator  *string          `type:"string"`
+	Records            []*KinesisRecord `type:"list"`
+}
+
+// ListShardsWithContext - Lists one page of shards of a stream (only one of StreamName and NextToken may be set)
+func (c *KinesisClient) ListShardsWithContext(ctx aws.Context, input *KinesisListShardsInput) (*KinesisListShardsOutput, error) {
+	output := &KinesisListShardsOutput{}
+	req := c.NewRequest(&request.Operation{Name: "ListShards", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
+
+// GetShardIteratorWithContext - Gets an iterator for reading the specified shard from the given position
+func (c *KinesisClient) GetShardIteratorWithContext(ctx aws.Context, input *KinesisGetShardIteratorInput) (*KinesisGetShardIteratorOutput, error) {
+	output := &KinesisGetShardIteratorOutput{}
+	req := c.NewRequest(&request.Operation{Name: "GetShardIterator", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
+
+// GetRecordsWithContext - Reads the next batch of records from a shard
+func (c *KinesisClient) GetRecordsWithContext(ctx aws.Context, input *KinesisGetRecordsInput) (*KinesisGetRecordsOutput, error) {
+	output := &KinesisGetRecordsOutput{}
+	req := c.NewRequest(&request.Operation{Name: "GetRecords", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
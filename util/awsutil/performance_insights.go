@@ -0,0 +1,158 @@
+package awsutil
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+// Like for Kinesis, the AWS SDK version we use doesn't include a Performance
+// Insights client, so this implements the two read operations we need.
+
+// PerformanceInsightsClient - Minimal client for the Performance Insights API
+type PerformanceInsightsClient struct {
+	*client.Client
+}
+
+const (
+	performanceInsightsServiceName = "pi"
+	performanceInsightsServiceID   = "PI"
+)
+
+// NewPerformanceInsightsClient - Creates a Performance Insights client for the specified session
+func NewPerformanceInsightsClient(p client.ConfigProvider, cfgs ...*aws.Config) *PerformanceInsightsClient {
+	c := p.ClientConfig(performanceInsightsServiceName, cfgs...)
+
+	svc := &PerformanceInsightsClient{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   performanceInsightsServiceName,
+				ServiceID:     performanceInsightsServiceID,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				PartitionID:   c.PartitionID,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2018-02-27",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "PerformanceInsightsv20180227",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(
+		protocol.NewUnmarshalErrorHandler(jsonrpc.NewUnmarshalTypedError(nil)).NamedHandler(),
+	)
+
+	return svc
+}
+
+type PerformanceInsightsDimensionGroup struct {
+	_ struct{} `type:"structure"`
+
+	Group      *string   `type:"string"`
+	Dimensions []*string `type:"list"`
+	Limit      *int64    `type:"integer"`
+}
+
+type PerformanceInsightsMetricQuery struct {
+	_ struct{} `type:"structure"`
+
+	Metric  *string                            `type:"string"`
+	GroupBy *PerformanceInsightsDimensionGroup `type:"structure"`
+}
+
+type PerformanceInsightsGetResourceMetricsInput struct {
+	_ struct{} `type:"structure"`
+
+	ServiceType     *string                           `type:"string"`
+	Identifier      *string                           `type:"string"`
+	MetricQueries   []*PerformanceInsightsMetricQuery `type:"list"`
+	StartTime       *time.Time                        `type:"timestamp"`
+	EndTime         *time.Time                        `type:"timestamp"`
+	PeriodInSeconds *int64                            `type:"integer"`
+}
+
+type PerformanceInsightsDataPoint struct {
+	_ struct{} `type:"structure"`
+
+	Timestamp *time.Time `type:"timestamp"`
+	Value     *float64   `type:"double"`
+}
+
+type PerformanceInsightsResponseResourceMetricKey struct {
+	_ struct{} `type:"structure"`
+
+	Metric     *string            `type:"string"`
+	Dimensions map[string]*string `type:"map"`
+}
+
+type PerformanceInsightsMetricKeyDataPoints struct {
+	_ struct{} `type:"structure"`
+
+	Key        *PerformanceInsightsResponseResourceMetricKey `type:"structure"`
+	DataPoints []*PerformanceInsightsDataPoint               `type:"list"`
+}
+
+type PerformanceInsightsGetResourceMetricsOutput struct {
+	_ struct{} `type:"structure"`
+
+	AlignedStartTime *time.Time                                `type:"timestamp"`
+	AlignedEndTime   *time.Time                                `type:"timestamp"`
+	MetricList       []*PerformanceInsightsMetricKeyDataPoints `type:"list"`
+}
+
+type PerformanceInsightsDescribeDimensionKeysInput struct {
+	_ struct{} `type:"structure"`
+
+	ServiceType     *string                            `type:"string"`
+	Identifier      *string                            `type:"string"`
+	Metric          *string                            `type:"string"`
+	GroupBy         *PerformanceInsightsDimensionGroup `type:"structure"`
+	StartTime       *time.Time                         `type:"timestamp"`
+	EndTime         *time.Time                         `type:"timestamp"`
+	PeriodInSeconds *int64                             `type:"integer"`
+	MaxResults      *int64                             `type:"integer"`
+}
+
+type PerformanceInsightsDimensionKeyDescription struct {
+	_ struct{} `type:"structure"`
+
+	Dimensions map[string]*string `type:"map"`
+	Total      *float64           `type:"double"`
+}
+
+type PerformanceInsightsDescribeDimensionKeysOutput struct {
+	_ struct{} `type:"structure"`
+
+	AlignedStartTime *time.Time                                    `type:"timestamp"`
+	AlignedEndTime   *time.Time                                    `type:"timestamp"`
+	Keys             []*PerformanceInsightsDimensionKeyDescription `type:"list"`
+}
+
+// GetResourceMetricsWithContext - Retrieves time series of Performance Insights metrics
+func (c *PerformanceInsightsClient) GetResourceMetricsWithContext(ctx aws.Context, input *PerformanceInsightsGetResourceMetricsInput) (*PerformanceInsightsGetResourceMetricsOutput, error) {
+	output := &PerformanceInsightsGetResourceMetricsOutput{}
+	req := c.NewRequest(&request.Operation{Name: "GetResourceMetrics", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
+
+// DescribeDimensionKeysWithContext - Retrieves the top dimension keys (e.g. wait events) for a metric
+func (c *PerformanceInsightsClient) DescribeDimensionKeysWithContext(ctx aws.Context, input *PerformanceInsightsDescribeDimensionKeysInput) (*PerformanceInsightsDescribeDimensionKeysOutput, error) {
+	output := &PerformanceInsightsDescribeDimensionKeysOutput{}
+	req := c.NewRequest(&request.Operation{Name: "DescribeDimensionKeys", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
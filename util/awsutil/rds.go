@@ -2,6 +2,7 @@ package awsutil
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -77,6 +78,53 @@ func FindRdsInstance(config config.ServerConfig, sess *session.Session) (instanc
 	return
 }
 
+// FindAuroraClusterReaders - Finds the reader instances of the Aurora cluster specified by aws_db_cluster_id
+func FindAuroraClusterReaders(config config.ServerConfig, sess *session.Session) (readers []*rds.DBInstance, err error) {
+	svc := rds.New(sess)
+
+	clusterResp, err := svc.DescribeDBClusters(&rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(config.AwsDbClusterID),
+	})
+	if err != nil {
+		return
+	}
+	if len(clusterResp.DBClusters) == 0 {
+		err = fmt.Errorf("Could not find Aurora cluster %s", config.AwsDbClusterID)
+		return
+	}
+
+	isReader := make(map[string]bool)
+	for _, member := range clusterResp.DBClusters[0].DBClusterMembers {
+		if member.DBInstanceIdentifier != nil && !util.BoolPtrToBool(member.IsClusterWriter) {
+			isReader[*member.DBInstanceIdentifier] = true
+		}
+	}
+	if len(isReader) == 0 {
+		return
+	}
+
+	params := &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{{
+			Name:   aws.String("db-cluster-id"),
+			Values: []*string{aws.String(config.AwsDbClusterID)},
+		}},
+	}
+	err = svc.DescribeDBInstancesPages(params, func(resp *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, instance := range resp.DBInstances {
+			// Instances that are still being created don't have an endpoint yet
+			if instance.DBInstanceIdentifier == nil || !isReader[*instance.DBInstanceIdentifier] || instance.Endpoint == nil || instance.Endpoint.Address == nil || instance.Endpoint.Port == nil {
+				continue
+			}
+			readers = append(readers, instance)
+		}
+		return true
+	})
+	if err != nil {
+		readers = nil
+	}
+	return
+}
+
 func GetRdsParameter(group *rds.DBParameterGroupStatus, name string, svc *rds.RDS) (parameter *rds.Parameter, err error) {
 	var resp *rds.DescribeDBParametersOutput
 
@@ -110,11 +158,20 @@ func GetRdsParameter(group *rds.DBParameterGroupStatus, name string, svc *rds.RD
 type RdsCloudWatchReader struct {
 	svc      *cloudwatch.CloudWatch
 	instance string
+	period   time.Duration
+	metrics  map[string]bool // Metrics that may be fetched (nil if not restricted)
 	logger   *util.Logger
 }
 
-func NewRdsCloudWatchReader(sess *session.Session, logger *util.Logger, instance string) RdsCloudWatchReader {
-	return RdsCloudWatchReader{svc: cloudwatch.New(sess), instance: instance, logger: logger}
+func NewRdsCloudWatchReader(sess *session.Session, config config.ServerConfig, logger *util.Logger, instance string) RdsCloudWatchReader {
+	reader := RdsCloudWatchReader{svc: cloudwatch.New(sess), instance: instance, period: config.GetAwsCloudWatchPeriod(), logger: logger}
+	if metrics := config.GetAwsCloudWatchMetrics(); len(metrics) > 0 {
+		reader.metrics = make(map[string]bool)
+		for _, metric := range metrics {
+			reader.metrics[metric] = true
+		}
+	}
+	return reader
 }
 
 // GetRdsIntMetric - Gets an integer value from Cloudwatch
@@ -123,23 +180,49 @@ func (reader RdsCloudWatchReader) GetRdsIntMetric(metricName string, unit string
 }
 
 // GetRdsFloatMetric - Gets a float value from Cloudwatch
+//
+// This returns the average of the most recent period, or 0 if the metric is not available.
 func (reader RdsCloudWatchReader) GetRdsFloatMetric(metricName string, unit string) float64 {
+	return reader.getMetric(metricName, unit, "Average", []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("DBInstanceIdentifier"),
+			Value: aws.String(reader.instance),
+		},
+	})
+}
+
+// GetProxyFloatMetric - Gets a value of an RDS Proxy from Cloudwatch, summed or averaged
+// over the most recent period depending on the statistic
+func (reader RdsCloudWatchReader) GetProxyFloatMetric(proxyName string, metricName string, unit string, statistic string) float64 {
+	return reader.getMetric(metricName, unit, statistic, []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("ProxyName"),
+			Value: aws.String(proxyName),
+		},
+	})
+}
+
+// Period - Gets the period that metric values are aggregated over
+func (reader RdsCloudWatchReader) Period() time.Duration {
+	return reader.period
+}
+
+func (reader RdsCloudWatchReader) getMetric(metricName string, unit string, statistic string, dimensions []*cloudwatch.Dimension) float64 {
+	if reader.metrics != nil && !reader.metrics[metricName] {
+		return 0.0
+	}
+
 	params := &cloudwatch.GetMetricStatisticsInput{
 		EndTime:    aws.Time(time.Now()),
 		MetricName: aws.String(metricName),
 		Namespace:  aws.String("AWS/RDS"),
-		Period:     aws.Int64(60),
-		StartTime:  aws.Time(time.Now().Add(-10 * time.Minute)),
+		Period:     aws.Int64(int64(reader.period / time.Second)),
+		StartTime:  aws.Time(time.Now().Add(-10 * reader.period)),
 		Unit:       aws.String(unit),
 		Statistics: []*string{
-			aws.String("Average"),
-		},
-		Dimensions: []*cloudwatch.Dimension{
-			{
-				Name:  aws.String("DBInstanceIdentifier"),
-				Value: aws.String(reader.instance),
-			},
+			aws.String(statistic),
 		},
+		Dimensions: dimensions,
 	}
 	resp, err := reader.svc.GetMetricStatistics(params)
 
@@ -148,14 +231,31 @@ func (reader RdsCloudWatchReader) GetRdsFloatMetric(metricName string, unit stri
 		return 0.0
 	}
 
-	if len(resp.Datapoints) == 0 {
-		return 0.0
+	// Datapoints are returned in no particular order
+	var latest *cloudwatch.Datapoint
+	for _, datapoint := range resp.Datapoints {
+		if datapoint.Timestamp == nil || datapointValue(datapoint, statistic) == nil {
+			continue
+		}
+		if latest == nil || datapoint.Timestamp.After(*latest.Timestamp) {
+			latest = datapoint
+		}
 	}
 
-	val := resp.Datapoints[0].Average
-	if val != nil {
-		return *resp.Datapoints[0].Average
+	if latest == nil {
+		return 0.0
 	}
 
-	return 0.0
+	return *datapointValue(latest, statistic)
+}
+
+func datapointValue(datapoint *cloudwatch.Datapoint, statistic string) *float64 {
+	switch statistic {
+	case "Sum":
+		return datapoint.Sum
+	case "Maximum":
+		return datapoint.Maximum
+	default:
+		return datapoint.Average
+	}
 }
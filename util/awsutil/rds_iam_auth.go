@@ -0,0 +1,74 @@
+package awsutil
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/pganalyze/collector/config"
+)
+
+// Auth tokens are valid for 15 minutes, so we generate a new one well before they expire
+const rdsAuthTokenMaxAge = 10 * time.Minute
+
+type rdsAuthToken struct {
+	token     string
+	createdAt time.Time
+}
+
+var rdsAuthTokensMutex sync.Mutex
+var rdsAuthTokens = make(map[string]rdsAuthToken)
+
+// GetRdsAuthToken - Returns an IAM database authentication token to be used as the password for the specified server
+//
+// This works the same way as rdsutils.BuildAuthToken in newer AWS SDK versions, by
+// presigning a "connect" request for the database user.
+func GetRdsAuthToken(cfg config.ServerConfig) (string, error) {
+	// The token is only valid for the exact endpoint and user we connect with
+	dbHost, dbPort, dbUser := cfg.GetDbEndpoint()
+	endpoint := fmt.Sprintf("%s:%d", dbHost, dbPort)
+	key := endpoint + "/" + dbUser
+
+	rdsAuthTokensMutex.Lock()
+	defer rdsAuthTokensMutex.Unlock()
+
+	if t, ok := rdsAuthTokens[key]; ok && time.Since(t.createdAt) < rdsAuthTokenMaxAge {
+		return t.token, nil
+	}
+
+	sess, err := GetAwsSession(cfg)
+	if err != nil {
+		return "", fmt.Errorf("Error getting session: %s", err)
+	}
+	region := cfg.AwsRegion
+	if sess.Config.Region != nil && *sess.Config.Region != "" {
+		region = *sess.Config.Region
+	}
+	if region == "" {
+		return "", fmt.Errorf("aws_region must be set to use IAM database authentication")
+	}
+
+	req, err := http.NewRequest("GET", "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	values := url.Values{}
+	values.Set("Action", "connect")
+	values.Set("DBUser", dbUser)
+	req.URL.RawQuery = values.Encode()
+
+	createdAt := time.Now()
+	_, err = v4.NewSigner(sess.Config.Credentials).Presign(req, nil, "rds-db", region, 15*time.Minute, createdAt)
+	if err != nil {
+		return "", fmt.Errorf("Could not sign IAM database authentication token: %s", err)
+	}
+
+	token := strings.TrimPrefix(req.URL.String(), "https://")
+	rdsAuthTokens[key] = rdsAuthToken{token: token, createdAt: createdAt}
+
+	return token, nil
+}
@@ -0,0 +1,169 @@
+package awsutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pganalyze/collector/config"
+)
+
+// Secret references have one of these formats, with an optional "#key" suffix to pick
+// a field from secrets that contain a JSON object:
+//
+//	arn:aws:secretsmanager:us-east-1:123456789012:secret:name
+//	arn:aws:ssm:us-east-1:123456789012:parameter/path/to/param
+//	ssm:/path/to/param (in the server's AWS region)
+const ssmReferencePrefix = "ssm:"
+
+const secretLookupTimeout = 10 * time.Second
+
+// Resolved values are re-used until the collector sees an authentication failure,
+// but re-checked periodically for values that we can't detect failures for (AWS keys)
+const secretCacheMaxAge = 1 * time.Hour
+
+type resolvedSecret struct {
+	value      string
+	resolvedAt time.Time
+}
+
+var secretCacheMutex sync.Mutex
+var secretCache = make(map[string]resolvedSecret)
+
+// IsSecretReference - Determines whether a config value refers to a Secrets Manager secret or SSM parameter
+func IsSecretReference(value string) bool {
+	if strings.HasPrefix(value, ssmReferencePrefix) {
+		return true
+	}
+	if !arn.IsARN(value) {
+		return false
+	}
+	parsed, err := arn.Parse(value)
+	return err == nil && (parsed.Service == "secretsmanager" || parsed.Service == "ssm")
+}
+
+// ResolveSecretReference - Returns the value the reference points to, fetching it if it isn't cached
+//
+// When the secret is a JSON object, the field from the reference's "#key" suffix is
+// returned, or defaultKey if the reference doesn't specify one.
+func ResolveSecretReference(cfg config.ServerConfig, reference string, defaultKey string) (string, error) {
+	secretCacheMutex.Lock()
+	cached, ok := secretCache[reference]
+	secretCacheMutex.Unlock()
+	if ok && time.Since(cached.resolvedAt) < secretCacheMaxAge {
+		return cached.value, nil
+	}
+
+	location := reference
+	key := defaultKey
+	if idx := strings.LastIndex(reference, "#"); idx != -1 {
+		location = reference[:idx]
+		key = reference[idx+1:]
+	}
+
+	// Secret references for the AWS keys themselves can only be resolved with the
+	// credentials of the environment (e.g. an instance profile)
+	lookupCfg := cfg
+	if IsSecretReference(cfg.AwsAccessKeyID) || IsSecretReference(cfg.AwsSecretAccessKey) {
+		lookupCfg.AwsAccessKeyID = ""
+		lookupCfg.AwsSecretAccessKey = ""
+	}
+	isSSM := strings.HasPrefix(location, ssmReferencePrefix)
+	if arn.IsARN(location) {
+		parsed, err := arn.Parse(location)
+		if err == nil {
+			isSSM = parsed.Service == "ssm"
+			if parsed.Region != "" {
+				lookupCfg.AwsRegion = parsed.Region
+			}
+		}
+	}
+
+	sess, err := GetAwsSession(lookupCfg)
+	if err != nil {
+		return "", fmt.Errorf("Error getting session: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretLookupTimeout)
+	defer cancel()
+
+	var value string
+	if isSSM {
+		name := strings.TrimPrefix(location, ssmReferencePrefix)
+		resp, err := NewSSMClient(sess).GetParameterWithContext(ctx, &SSMGetParameterInput{
+			Name:           aws.String(name),
+			WithDecryption: aws.Bool(true),
+		})
+		if err != nil {
+			return "", fmt.Errorf("Error getting SSM parameter %s: %s", name, err)
+		}
+		if resp.Parameter == nil || resp.Parameter.Value == nil {
+			return "", fmt.Errorf("SSM parameter %s has no value", name)
+		}
+		value = *resp.Parameter.Value
+	} else {
+		resp, err := NewSecretsManagerClient(sess).GetSecretValueWithContext(ctx, &SecretsManagerGetSecretValueInput{
+			SecretId: aws.String(location),
+		})
+		if err != nil {
+			return "", fmt.Errorf("Error getting secret %s: %s", location, err)
+		}
+		if resp.SecretString == nil {
+			return "", fmt.Errorf("Secret %s has no string value (binary secrets are not supported)", location)
+		}
+		value = *resp.SecretString
+	}
+
+	// Secrets managed by RDS are stored as JSON objects with the username and password
+	if strings.HasPrefix(strings.TrimSpace(value), "{") {
+		var fields map[string]interface{}
+		if json.Unmarshal([]byte(value), &fields) == nil {
+			field, ok := fields[key].(string)
+			if key == "" || !ok {
+				return "", fmt.Errorf("Secret %s is a JSON object without a \"%s\" field, specify the field to use with a #key suffix", location, key)
+			}
+			value = field
+		}
+	}
+
+	secretCacheMutex.Lock()
+	secretCache[reference] = resolvedSecret{value: value, resolvedAt: time.Now()}
+	secretCacheMutex.Unlock()
+
+	return value, nil
+}
+
+// InvalidateSecretReference - Forgets the cached value, so it gets fetched again (e.g. after it was rotated)
+func InvalidateSecretReference(reference string) {
+	secretCacheMutex.Lock()
+	delete(secretCache, reference)
+	secretCacheMutex.Unlock()
+}
+
+// ResolveSecretReferences - Resolves all secret references in the server's configuration, so
+// errors are reported on startup and the values are cached for later use
+func ResolveSecretReferences(cfg config.ServerConfig) error {
+	references := []struct {
+		value      string
+		defaultKey string
+	}{
+		{cfg.DbPassword, "password"},
+		{cfg.AwsAccessKeyID, ""},
+		{cfg.AwsSecretAccessKey, ""},
+	}
+	for _, reference := range references {
+		if !IsSecretReference(reference.value) {
+			continue
+		}
+		_, err := ResolveSecretReference(cfg, reference.value, reference.defaultKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package awsutil
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+// SecretsManagerClient - Minimal client for reading secrets from AWS Secrets Manager
+type SecretsManagerClient struct {
+	*client.Client
+}
+
+const (
+	secretsManagerServiceName = "secretsmanager"
+	secretsManagerServiceID   = "Secrets Manager"
+)
+
+// NewSecretsManagerClient - Creates a Secrets Manager client for the specified session
+func NewSecretsManagerClient(p client.ConfigProvider, cfgs ...*aws.Config) *SecretsManagerClient {
+	c := p.ClientConfig(secretsManagerServiceName, cfgs...)
+
+	svc := &SecretsManagerClient{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   secretsManagerServiceName,
+				ServiceID:     secretsManagerServiceID,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				PartitionID:   c.PartitionID,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2017-10-17",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "secretsmanager",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(
+		protocol.NewUnmarshalErrorHandler(jsonrpc.NewUnmarshalTypedError(nil)).NamedHandler(),
+	)
+
+	return svc
+}
+
+type SecretsManagerGetSecretValueInput struct {
+	_ struct{} `type:"structure"`
+
+	SecretId *string `type:"string"`
+}
+
+type SecretsManagerGetSecretValueOutput struct {
+	_ struct{} `type:"structure"`
+
+	ARN          *string `type:"string"`
+	Name         *string `type:"string"`
+	SecretString *string `type:"string"`
+	VersionId    *string `type:"string"`
+}
+
+// GetSecretValueWithContext - Retrieves the current version of a secret
+func (c *SecretsManagerClient) GetSecretValueWithContext(ctx aws.Context, input *SecretsManagerGetSecretValueInput) (*SecretsManagerGetSecretValueOutput, error) {
+	output := &SecretsManagerGetSecretValueOutput{}
+	req := c.NewRequest(&request.Operation{Name: "GetSecretValue", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
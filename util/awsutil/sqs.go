@@ -0,0 +1,105 @@
+package awsutil
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/query"
+)
+
+// SQS isn't included in the AWS SDK version we use either. Unlike Kinesis it
+// uses the query protocol (like RDS), and we only need to receive and delete
+// messages with S3 event notifications.
+
+// SQSClient - Minimal client for consuming messages from an SQS queue
+type SQSClient struct {
+	*client.Client
+}
+
+const (
+	sqsServiceName = "sqs"
+	sqsServiceID   = "SQS"
+)
+
+// NewSQSClient - Creates an SQS client for the specified session
+func NewSQSClient(p client.ConfigProvider, cfgs ...*aws.Config) *SQSClient {
+	c := p.ClientConfig(sqsServiceName, cfgs...)
+
+	svc := &SQSClient{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   sqsServiceName,
+				ServiceID:     sqsServiceID,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				PartitionID:   c.PartitionID,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2012-11-05",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(query.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
+
+	return svc
+}
+
+type SQSReceiveMessageInput struct {
+	_ struct{} `type:"structure"`
+
+	QueueUrl            *string `type:"string"`
+	MaxNumberOfMessages *int64  `type:"integer"`
+	VisibilityTimeout   *int64  `type:"integer"`
+	WaitTimeSeconds     *int64  `type:"integer"`
+}
+
+type SQSMessage struct {
+	_ struct{} `type:"structure"`
+
+	MessageId     *string `type:"string"`
+	ReceiptHandle *string `type:"string"`
+	Body          *string `type:"string"`
+}
+
+type SQSReceiveMessageOutput struct {
+	_ struct{} `type:"structure"`
+
+	Messages []*SQSMessage `locationNameList:"Message" type:"list" flattened:"true"`
+}
+
+type SQSDeleteMessageInput struct {
+	_ struct{} `type:"structure"`
+
+	QueueUrl      *string `type:"string"`
+	ReceiptHandle *string `type:"string"`
+}
+
+type SQSDeleteMessageOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// ReceiveMessageWithContext - Receives messages from the queue, waiting up to WaitTimeSeconds for them to arrive
+func (c *SQSClient) ReceiveMessageWithContext(ctx aws.Context, input *SQSReceiveMessageInput) (*SQSReceiveMessageOutput, error) {
+	output := &SQSReceiveMessageOutput{}
+	req := c.NewRequest(&request.Operation{Name: "ReceiveMessage", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
+
+// DeleteMessageWithContext - Deletes a message that was processed, so it isn't delivered again
+func (c *SQSClient) DeleteMessageWithContext(ctx aws.Context, input *SQSDeleteMessageInput) (*SQSDeleteMessageOutput, error) {
+	output := &SQSDeleteMessageOutput{}
+	req := c.NewRequest(&request.Operation{Name: "DeleteMessage", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
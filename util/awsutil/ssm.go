@@ -0,0 +1,85 @@
+package awsutil
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+// SSMClient - Minimal client for reading parameters from the AWS Systems Manager Parameter Store
+type SSMClient struct {
+	*client.Client
+}
+
+const (
+	ssmServiceName = "ssm"
+	ssmServiceID   = "SSM"
+)
+
+// NewSSMClient - Creates an SSM client for the specified session
+func NewSSMClient(p client.ConfigProvider, cfgs ...*aws.Config) *SSMClient {
+	c := p.ClientConfig(ssmServiceName, cfgs...)
+
+	svc := &SSMClient{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   ssmServiceName,
+				ServiceID:     ssmServiceID,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				PartitionID:   c.PartitionID,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2014-11-06",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "AmazonSSM",
+			},
+			c.Handlers,
+		),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(
+		protocol.NewUnmarshalErrorHandler(jsonrpc.NewUnmarshalTypedError(nil)).NamedHandler(),
+	)
+
+	return svc
+}
+
+type SSMGetParameterInput struct {
+	_ struct{} `type:"structure"`
+
+	Name           *string `type:"string"`
+	WithDecryption *bool   `type:"boolean"`
+}
+
+type SSMParameter struct {
+	_ struct{} `type:"structure"`
+
+	ARN     *string `type:"string"`
+	Name    *string `type:"string"`
+	Type    *string `type:"string"`
+	Value   *string `type:"string"`
+	Version *int64  `type:"long"`
+}
+
+type SSMGetParameterOutput struct {
+	_ struct{} `type:"structure"`
+
+	Parameter *SSMParameter `type:"structure"`
+}
+
+// GetParameterWithContext - Retrieves a parameter (SecureString parameters are decrypted if requested)
+func (c *SSMClient) GetParameterWithContext(ctx aws.Context, input *SSMGetParameterInput) (*SSMGetParameterOutput, error) {
+	output := &SSMGetParameterOutput{}
+	req := c.NewRequest(&request.Operation{Name: "GetParameter", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
@@ -0,0 +1,294 @@
+package azureutil
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+	"github.com/pganalyze/collector/config"
+	uuid "github.com/satori/go.uuid"
+)
+
+const storageResource = "https://storage.azure.com/"
+
+const storageAPIVersion = "2020-04-08"
+
+const storageRequestTimeout = 30 * time.Second
+
+// Partitions whose owner stopped renewing its lease become available to other collectors after this
+const PartitionLeaseDuration = 60 * time.Second
+
+// BlobCheckpointStore - Checkpoints Event Hub partition offsets to an Azure Blob Storage container,
+// and coordinates which collector receives from which partition using blob leases
+//
+// Blobs follow the layout of the standard Event Hubs checkpoint store (offsets are stored as blob
+// metadata in "<namespace>/<hub>/<consumer group>/checkpoint/<partition ID>"), but ownership is
+// tracked through leases on the "ownership/<partition ID>" blobs, so the container shouldn't be
+// shared with other Event Hub consumers in the same consumer group.
+//
+// Checkpoints are only kept in memory on each received event, and uploaded when calling Flush.
+type BlobCheckpointStore struct {
+	cfg       config.ServerConfig
+	container string
+
+	mutex       sync.Mutex
+	checkpoints map[string]persist.Checkpoint
+	dirty       map[string]bool
+	leases      map[string]string
+}
+
+// NewBlobCheckpointStore - Sets up a checkpoint store using the storage account and container of the server config
+func NewBlobCheckpointStore(cfg config.ServerConfig) *BlobCheckpointStore {
+	return &BlobCheckpointStore{
+		cfg:         cfg,
+		container:   fmt.Sprintf("https://%s.blob.core.windows.net/%s", cfg.AzureEventhubCheckpointStorageAccount, cfg.AzureEventhubCheckpointContainer),
+		checkpoints: make(map[string]persist.Checkpoint),
+		dirty:       make(map[string]bool),
+		leases:      make(map[string]string),
+	}
+}
+
+func blobPrefix(namespace, name, consumerGroup string) string {
+	return strings.ToLower(fmt.Sprintf("%s.servicebus.windows.net/%s/%s", namespace, name, consumerGroup))
+}
+
+func checkpointBlob(namespace, name, consumerGroup, partitionID string) string {
+	return blobPrefix(namespace, name, consumerGroup) + "/checkpoint/" + partitionID
+}
+
+func ownershipBlob(namespace, name, consumerGroup, partitionID string) string {
+	return blobPrefix(namespace, name, consumerGroup) + "/ownership/" + partitionID
+}
+
+// Read - Returns the last checkpoint of the partition, or the start of the stream if there is none
+func (s *BlobCheckpointStore) Read(namespace, name, consumerGroup, partitionID string) (persist.Checkpoint, error) {
+	blob := checkpointBlob(namespace, name, consumerGroup, partitionID)
+
+	s.mutex.Lock()
+	checkpoint, ok := s.checkpoints[blob]
+	s.mutex.Unlock()
+	if ok {
+		return checkpoint, nil
+	}
+
+	resp, _, err := s.request("HEAD", blob, nil, nil)
+	if err != nil {
+		return persist.Checkpoint{}, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return persist.NewCheckpointFromStartOfStream(), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return persist.Checkpoint{}, fmt.Errorf("Unexpected status code %d reading Event Hub checkpoint %s", resp.StatusCode, blob)
+	}
+
+	checkpoint = persist.NewCheckpointFromStartOfStream()
+	if offset := resp.Header.Get("x-ms-meta-offset"); offset != "" {
+		checkpoint.Offset = offset
+	}
+	checkpoint.SequenceNumber, _ = strconv.ParseInt(resp.Header.Get("x-ms-meta-sequencenumber"), 10, 64)
+	return checkpoint, nil
+}
+
+// Write - Remembers the checkpoint of an owned partition, to be uploaded on the next Flush
+func (s *BlobCheckpointStore) Write(namespace, name, consumerGroup, partitionID string, checkpoint persist.Checkpoint) error {
+	blob := checkpointBlob(namespace, name, consumerGroup, partitionID)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	// Receivers of partitions that were lost may still be processing their last events
+	if _, ok := s.leases[ownershipBlob(namespace, name, consumerGroup, partitionID)]; !ok {
+		return nil
+	}
+	if existing, ok := s.checkpoints[blob]; ok && existing == checkpoint {
+		return nil
+	}
+	s.checkpoints[blob] = checkpoint
+	s.dirty[blob] = true
+	return nil
+}
+
+// Flush - Uploads the checkpoints that changed since the last call
+func (s *BlobCheckpointStore) Flush() error {
+	s.mutex.Lock()
+	pending := make(map[string]persist.Checkpoint)
+	for blob := range s.dirty {
+		pending[blob] = s.checkpoints[blob]
+	}
+	s.dirty = make(map[string]bool)
+	s.mutex.Unlock()
+
+	var firstErr error
+	for blob, checkpoint := range pending {
+		headers := map[string]string{
+			"x-ms-blob-type":           "BlockBlob",
+			"x-ms-meta-offset":         checkpoint.Offset,
+			"x-ms-meta-sequencenumber": strconv.FormatInt(checkpoint.SequenceNumber, 10),
+		}
+		resp, body, err := s.request("PUT", blob, nil, headers)
+		if err == nil && resp.StatusCode != http.StatusCreated {
+			err = fmt.Errorf("Unexpected status code %d writing Event Hub checkpoint %s: %s", resp.StatusCode, blob, body)
+		}
+		if err != nil {
+			s.mutex.Lock()
+			s.dirty[blob] = true
+			s.mutex.Unlock()
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// ClaimPartition - Tries to become the owner of the partition, returns false if another collector owns it
+//
+// Claimed partitions need to be renewed regularly (well within PartitionLeaseDuration) using RenewPartition.
+func (s *BlobCheckpointStore) ClaimPartition(namespace, name, consumerGroup, partitionID string) (bool, error) {
+	blob := ownershipBlob(namespace, name, consumerGroup, partitionID)
+
+	// Create the (empty) ownership blob if this is the first time the partition is received from
+	resp, body, err := s.request("PUT", blob, nil, map[string]string{"x-ms-blob-type": "BlockBlob", "If-None-Match": "*"})
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusPreconditionFailed {
+		return false, fmt.Errorf("Unexpected status code %d creating Event Hub ownership blob %s: %s", resp.StatusCode, blob, body)
+	}
+
+	leaseID := uuid.NewV4().String()
+	resp, body, err = s.request("PUT", blob, url.Values{"comp": {"lease"}}, map[string]string{
+		"x-ms-lease-action":      "acquire",
+		"x-ms-lease-duration":    strconv.Itoa(int(PartitionLeaseDuration.Seconds())),
+		"x-ms-proposed-lease-id": leaseID,
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return false, fmt.Errorf("Unexpected status code %d acquiring lease on %s: %s", resp.StatusCode, blob, body)
+	}
+
+	s.mutex.Lock()
+	s.leases[blob] = leaseID
+	// Another collector may have received from the partition in the meantime, so start from its checkpoint
+	delete(s.checkpoints, checkpointBlob(namespace, name, consumerGroup, partitionID))
+	s.mutex.Unlock()
+
+	return true, nil
+}
+
+// RenewPartition - Extends the ownership of a claimed partition, returns false if it was lost
+func (s *BlobCheckpointStore) RenewPartition(namespace, name, consumerGroup, partitionID string) (bool, error) {
+	blob := ownershipBlob(namespace, name, consumerGroup, partitionID)
+
+	s.mutex.Lock()
+	leaseID, ok := s.leases[blob]
+	s.mutex.Unlock()
+	if !ok {
+		return false, nil
+	}
+
+	resp, body, err := s.request("PUT", blob, url.Values{"comp": {"lease"}}, map[string]string{
+		"x-ms-lease-action": "renew",
+		"x-ms-lease-id":     leaseID,
+	})
+	if err != nil {
+		return true, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return true, nil
+	}
+	if resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusPreconditionFailed {
+		return true, fmt.Errorf("Unexpected status code %d renewing lease on %s: %s", resp.StatusCode, blob, body)
+	}
+
+	s.forgetPartition(namespace, name, consumerGroup, partitionID)
+	return false, nil
+}
+
+// ReleasePartition - Gives up the ownership of the partition, so another collector can take over right away
+func (s *BlobCheckpointStore) ReleasePartition(namespace, name, consumerGroup, partitionID string) error {
+	blob := ownershipBlob(namespace, name, consumerGroup, partitionID)
+
+	s.mutex.Lock()
+	leaseID, ok := s.leases[blob]
+	s.mutex.Unlock()
+	if !ok {
+		return nil
+	}
+	s.forgetPartition(namespace, name, consumerGroup, partitionID)
+
+	resp, body, err := s.request("PUT", blob, url.Values{"comp": {"lease"}}, map[string]string{
+		"x-ms-lease-action": "release",
+		"x-ms-lease-id":     leaseID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status code %d releasing lease on %s: %s", resp.StatusCode, blob, body)
+	}
+	return nil
+}
+
+// Drops local state of a partition that is no longer owned, so a stale checkpoint can't
+// overwrite the one of the new owner
+func (s *BlobCheckpointStore) forgetPartition(namespace, name, consumerGroup, partitionID string) {
+	checkpoint := checkpointBlob(namespace, name, consumerGroup, partitionID)
+
+	s.mutex.Lock()
+	delete(s.leases, ownershipBlob(namespace, name, consumerGroup, partitionID))
+	delete(s.checkpoints, checkpoint)
+	delete(s.dirty, checkpoint)
+	s.mutex.Unlock()
+}
+
+func (s *BlobCheckpointStore) request(method string, blob string, params url.Values, headers map[string]string) (*http.Response, []byte, error) {
+	token, err := GetAccessToken(s.cfg, storageResource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := s.container + "/" + blob
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequest(method, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("x-ms-version", storageAPIVersion)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	client := s.cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), storageRequestTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error accessing Blob Storage: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
@@ -0,0 +1,223 @@
+package azureutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+// Key Vault references are secret URIs, optionally including the version, e.g.
+//
+//	https://myvault.vault.azure.net/secrets/pganalyze-api-key
+//	https://myvault.vault.azure.net/secrets/pganalyze-api-key/0123456789abcdef0123456789abcdef
+var keyVaultDomains = []string{".vault.azure.net", ".vault.usgovcloudapi.net", ".vault.azure.cn"}
+
+const keyVaultAPIVersion = "7.4"
+
+const keyVaultLookupTimeout = 10 * time.Second
+
+// Resolved values are re-used for a while, so that rotated secrets get picked up eventually
+const keyVaultCacheMaxAge = 1 * time.Hour
+
+// How often references that were resolved on startup are checked for changes
+const keyVaultRefreshInterval = 1 * time.Hour
+
+type resolvedKeyVaultSecret struct {
+	value      string
+	resolvedAt time.Time
+}
+
+var keyVaultCacheMutex sync.Mutex
+var keyVaultCache = make(map[string]resolvedKeyVaultSecret)
+
+// IsKeyVaultReference - Determines whether a config value refers to an Azure Key Vault secret
+func IsKeyVaultReference(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || !strings.HasPrefix(u.Path, "/secrets/") {
+		return false
+	}
+	for _, domain := range keyVaultDomains {
+		if strings.HasSuffix(u.Hostname(), domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveKeyVaultReference - Returns the value of the referenced secret, fetching it if it isn't cached
+func ResolveKeyVaultReference(cfg config.ServerConfig, reference string) (string, error) {
+	keyVaultCacheMutex.Lock()
+	cached, ok := keyVaultCache[reference]
+	keyVaultCacheMutex.Unlock()
+	if ok && time.Since(cached.resolvedAt) < keyVaultCacheMaxAge {
+		return cached.value, nil
+	}
+
+	value, err := fetchKeyVaultSecret(cfg, reference)
+	if err != nil {
+		return "", err
+	}
+
+	keyVaultCacheMutex.Lock()
+	keyVaultCache[reference] = resolvedKeyVaultSecret{value: value, resolvedAt: time.Now()}
+	keyVaultCacheMutex.Unlock()
+
+	return value, nil
+}
+
+// InvalidateKeyVaultReference - Forgets the cached value, so it gets fetched again (e.g. after it was rotated)
+func InvalidateKeyVaultReference(reference string) {
+	keyVaultCacheMutex.Lock()
+	delete(keyVaultCache, reference)
+	keyVaultCacheMutex.Unlock()
+}
+
+func fetchKeyVaultSecret(cfg config.ServerConfig, reference string) (string, error) {
+	token, err := GetAccessToken(cfg, azure.PublicCloud.ResourceIdentifiers.KeyVault)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("GET", reference+"?api-version="+keyVaultAPIVersion, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), keyVaultLookupTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("Error getting Key Vault secret %s: %s", reference, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error getting Key Vault secret %s: unexpected status code %d: %s", reference, resp.StatusCode, body)
+	}
+
+	var secret struct {
+		Value *string `json:"value"`
+	}
+	err = json.Unmarshal(body, &secret)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Key Vault secret %s: %s", reference, err)
+	}
+	if secret.Value == nil {
+		return "", fmt.Errorf("Key Vault secret %s has no value", reference)
+	}
+
+	return *secret.Value, nil
+}
+
+// ResolveKeyVaultReferences - Replaces Key Vault references in the API key and TLS settings with
+// their values, and returns the references that were resolved (to be passed to WatchKeyVaultReferences)
+//
+// References in db_password are resolved whenever a new connection is made instead.
+func ResolveKeyVaultReferences(cfg *config.ServerConfig) ([]string, error) {
+	var references []string
+
+	if IsKeyVaultReference(cfg.APIKey) {
+		value, err := ResolveKeyVaultReference(*cfg, cfg.APIKey)
+		if err != nil {
+			return references, err
+		}
+		references = append(references, cfg.APIKey)
+		cfg.APIKey = value
+	}
+
+	files := []struct {
+		contents *string
+		path     *string
+	}{
+		{&cfg.DbSslRootCertContents, &cfg.DbSslRootCert},
+		{&cfg.DbSslCertContents, &cfg.DbSslCert},
+		{&cfg.DbSslKeyContents, &cfg.DbSslKey},
+	}
+	for _, file := range files {
+		if !IsKeyVaultReference(*file.contents) {
+			continue
+		}
+		value, err := ResolveKeyVaultReference(*cfg, *file.contents)
+		if err != nil {
+			return references, err
+		}
+		path, err := config.WriteValueToTempfile(value)
+		if err != nil {
+			return references, err
+		}
+		references = append(references, *file.contents)
+		*file.contents = value
+		*file.path = path
+	}
+
+	return references, nil
+}
+
+// WatchKeyVaultReferences - Periodically fetches the secrets that were resolved on startup, and notifies
+// the passed channel when any of them changed (which requires a configuration reload)
+func WatchKeyVaultReferences(ctx context.Context, wg *sync.WaitGroup, cfg config.ServerConfig, references []string, logger *util.Logger, changed chan<- struct{}) {
+	if len(references) == 0 {
+		return
+	}
+
+	initial := make(map[string]string)
+	for _, reference := range references {
+		value, err := ResolveKeyVaultReference(cfg, reference)
+		if err == nil {
+			initial[reference] = value
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(keyVaultRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, reference := range references {
+					InvalidateKeyVaultReference(reference)
+					value, err := ResolveKeyVaultReference(cfg, reference)
+					if err != nil {
+						logger.PrintVerbose("Could not check Key Vault secret for changes: %s", err)
+						continue
+					}
+					if value == initial[reference] {
+						continue
+					}
+
+					logger.PrintInfo("Key Vault secret %s changed", reference)
+					select {
+					case changed <- struct{}{}:
+					default:
+					}
+					return
+				}
+			}
+		}
+	}()
+}
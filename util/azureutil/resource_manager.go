@@ -0,0 +1,68 @@
+package azureutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pganalyze/collector/config"
+)
+
+const resourceManagerTimeout = 30 * time.Second
+
+// GetResourceManagerJSON - Calls the Azure Resource Manager API for the resource path (e.g. a resource
+// ID followed by "/providers/Microsoft.Insights/metrics") and decodes the JSON response into out
+//
+// The path can also be the full URL of a follow-up request (e.g. the "nextLink" of a paged list).
+func GetResourceManagerJSON(cfg config.ServerConfig, path string, params url.Values, out interface{}) error {
+	token, err := GetAccessToken(cfg, azure.PublicCloud.ResourceManagerEndpoint)
+	if err != nil {
+		return err
+	}
+
+	endpoint := path
+	if !strings.HasPrefix(path, "https://") {
+		endpoint = strings.TrimRight(azure.PublicCloud.ResourceManagerEndpoint, "/") + "/" + strings.TrimLeft(path, "/")
+	}
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), resourceManagerTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status code %d from Azure Resource Manager API: %s", resp.StatusCode, body)
+	}
+
+	err = json.Unmarshal(body, out)
+	if err != nil {
+		return fmt.Errorf("Error parsing Azure Resource Manager API response: %s", err)
+	}
+
+	return nil
+}
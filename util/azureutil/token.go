@@ -0,0 +1,96 @@
+package azureutil
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pganalyze/collector/config"
+	"golang.org/x/crypto/pkcs12"
+)
+
+const tokenRefreshTimeout = 10 * time.Second
+
+var tokensMutex sync.Mutex
+var tokens = make(map[string]*adal.ServicePrincipalToken)
+
+// GetAccessToken - Returns an Azure AD access token for the specified resource (e.g. the
+// Azure Resource Manager API), refreshing it before it expires
+//
+// Tokens are acquired for the service principal configured through azure_ad_client_secret or
+// azure_ad_certificate_path, or otherwise for the managed identity of the machine the collector
+// runs on (with azure_ad_client_id selecting a user-assigned identity).
+func GetAccessToken(cfg config.ServerConfig, resource string) (string, error) {
+	key := strings.Join([]string{resource, cfg.AzureADTenantID, cfg.AzureADClientID, cfg.AzureADClientSecret, cfg.AzureADCertificatePath}, "|")
+
+	tokensMutex.Lock()
+	defer tokensMutex.Unlock()
+
+	spt, ok := tokens[key]
+	if !ok {
+		var err error
+		spt, err = newServicePrincipalToken(cfg, resource)
+		if err != nil {
+			return "", err
+		}
+		tokens[key] = spt
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tokenRefreshTimeout)
+	defer cancel()
+	err := spt.EnsureFreshWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Could not refresh Azure AD token: %s", err)
+	}
+
+	return spt.OAuthToken(), nil
+}
+
+// GetDbAuthToken - Returns an Azure AD token to be used as the password for Azure Database for PostgreSQL
+func GetDbAuthToken(cfg config.ServerConfig) (string, error) {
+	return GetAccessToken(cfg, azure.PublicCloud.ResourceIdentifiers.OSSRDBMS)
+}
+
+func newServicePrincipalToken(cfg config.ServerConfig, resource string) (*adal.ServicePrincipalToken, error) {
+	if cfg.AzureADClientSecret == "" && cfg.AzureADCertificatePath == "" {
+		options := &adal.ManagedIdentityOptions{ClientID: cfg.AzureADClientID}
+		spt, err := adal.NewServicePrincipalTokenFromManagedIdentity(resource, options)
+		if err != nil {
+			return nil, fmt.Errorf("Could not set up managed identity authentication: %s", err)
+		}
+		return spt, nil
+	}
+
+	if cfg.AzureADTenantID == "" || cfg.AzureADClientID == "" {
+		return nil, fmt.Errorf("azure_ad_tenant_id and azure_ad_client_id must be set to authenticate as a service principal")
+	}
+	oauthConfig, err := adal.NewOAuthConfig(azure.PublicCloud.ActiveDirectoryEndpoint, cfg.AzureADTenantID)
+	if err != nil {
+		return nil, fmt.Errorf("Could not set up Azure AD authentication: %s", err)
+	}
+
+	if cfg.AzureADClientSecret != "" {
+		return adal.NewServicePrincipalToken(*oauthConfig, cfg.AzureADClientID, cfg.AzureADClientSecret, resource)
+	}
+
+	data, err := ioutil.ReadFile(cfg.AzureADCertificatePath)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read Azure AD certificate: %s", err)
+	}
+	privateKey, certificate, err := pkcs12.Decode(data, cfg.AzureADCertificatePassword)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode Azure AD certificate: %s", err)
+	}
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("Azure AD certificate must use an RSA private key")
+	}
+
+	return adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, cfg.AzureADClientID, certificate, rsaPrivateKey, resource)
+}
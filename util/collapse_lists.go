@@ -0,0 +1,68 @@
+package util
+
+import (
+	pg_query "github.com/pganalyze/pg_query_go/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CollapseQueryLists - Collapses variadic IN-lists (e.g. "IN ($1, $2, $3)") and multi-row VALUES
+// lists into a single element/row, so that queries only differing in the length of these lists
+// (commonly generated by ORMs) share the same canonical form
+//
+// The query is re-generated from its parse tree, which loses comments and the original formatting.
+// Queries that can't be parsed (e.g. because they are truncated) are returned unchanged.
+func CollapseQueryLists(query string) string {
+	tree, err := pg_query.Parse(query)
+	if err != nil {
+		return query
+	}
+	collapsed := false
+	for _, rawStmt := range tree.Stmts {
+		if collapseNodeLists(rawStmt.ProtoReflect()) {
+			collapsed = true
+		}
+	}
+	if !collapsed {
+		return query
+	}
+	collapsedQuery, err := pg_query.Deparse(tree)
+	if err != nil {
+		return query
+	}
+	return collapsedQuery
+}
+
+func collapseNodeLists(msg protoreflect.Message) (collapsed bool) {
+	switch node := msg.Interface().(type) {
+	case *pg_query.A_Expr:
+		if node.Kind == pg_query.A_Expr_Kind_AEXPR_IN {
+			if list := node.GetRexpr().GetList(); list != nil && len(list.Items) > 1 {
+				list.Items = list.Items[:1]
+				collapsed = true
+			}
+		}
+	case *pg_query.SelectStmt:
+		if len(node.ValuesLists) > 1 {
+			node.ValuesLists = node.ValuesLists[:1]
+			collapsed = true
+		}
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		if fd.IsList() {
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				if collapseNodeLists(list.Get(i).Message()) {
+					collapsed = true
+				}
+			}
+		} else if collapseNodeLists(value.Message()) {
+			collapsed = true
+		}
+		return true
+	})
+	return
+}
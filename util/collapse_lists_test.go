@@ -0,0 +1,50 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/pganalyze/collector/util"
+)
+
+var collapseQueryListsTests = []struct {
+	input    string
+	expected string
+}{
+	{
+		"SELECT * FROM x WHERE id IN ($1, $2, $3)",
+		"SELECT * FROM x WHERE id IN ($1)",
+	},
+	{
+		"SELECT * FROM x WHERE id NOT IN ($1, $2) AND y = $3",
+		"SELECT * FROM x WHERE id NOT IN ($1) AND y = $3",
+	},
+	{
+		"SELECT * FROM x WHERE id IN (SELECT id FROM y WHERE z IN ($1, $2))",
+		"SELECT * FROM x WHERE id IN (SELECT id FROM y WHERE z IN ($1))",
+	},
+	{
+		"INSERT INTO x (a, b) VALUES ($1, $2), ($3, $4), ($5, $6)",
+		"INSERT INTO x (a, b) VALUES ($1, $2)",
+	},
+	{
+		"SELECT * FROM x WHERE id IN ($1)",
+		"SELECT * FROM x WHERE id IN ($1)",
+	},
+	{
+		"SELECT * FROM x WHERE id = $1 /* unchanged */",
+		"SELECT * FROM x WHERE id = $1 /* unchanged */",
+	},
+	{
+		"SELECT * FROM x WHERE id IN ($1, $2, $",
+		"SELECT * FROM x WHERE id IN ($1, $2, $",
+	},
+}
+
+func TestCollapseQueryLists(t *testing.T) {
+	for _, test := range collapseQueryListsTests {
+		actual := util.CollapseQueryLists(test.input)
+		if actual != test.expected {
+			t.Errorf("\nQuery: %s\n Actual: %s\n Expected: %s\n", test.input, actual, test.expected)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package gcputil
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+const sqlAdminAPIURL = "https://sqladmin.googleapis.com/sql/v1beta4"
+
+// Port of the server-side proxy on Cloud SQL instances, which only accepts
+// connections authenticated with an ephemeral client certificate
+const cloudSQLServerProxyPort = 3307
+
+// Refresh the ephemeral certificate this long before it expires
+const cloudSQLRefreshBuffer = 5 * time.Minute
+
+// CloudSQLDialer - Connects to Cloud SQL instances the same way the Cloud SQL Auth Proxy
+// does, so that no proxy sidecar needs to be run alongside the collector
+//
+// When IAM database authentication is enabled, an OAuth2 token is embedded in the
+// ephemeral certificate, and the server accepts the connection without a password.
+type CloudSQLDialer struct {
+	projectID  string
+	instanceID string
+	ipType     string
+	iamAuth    bool
+
+	client      *http.Client
+	tokenSource oauth2.TokenSource
+	key         *rsa.PrivateKey
+
+	mutex     sync.Mutex
+	tlsConfig *tls.Config
+	address   string
+	expiresAt time.Time
+}
+
+type cloudSQLConnectSettings struct {
+	ServerCaCert struct {
+		Cert string `json:"cert"`
+	} `json:"serverCaCert"`
+	IPAddresses []struct {
+		Type      string `json:"type"`
+		IPAddress string `json:"ipAddress"`
+	} `json:"ipAddresses"`
+}
+
+type cloudSQLEphemeralCertResponse struct {
+	EphemeralCert struct {
+		Cert           string `json:"cert"`
+		ExpirationTime string `json:"expirationTime"`
+	} `json:"ephemeralCert"`
+}
+
+var cloudSQLDialersMutex sync.Mutex
+var cloudSQLDialers = make(map[string]*CloudSQLDialer)
+
+// GetCloudSQLDialer - Returns the (shared) dialer for the Cloud SQL instance of the specified server configuration
+func GetCloudSQLDialer(cfg config.ServerConfig, logger *util.Logger) (*CloudSQLDialer, error) {
+	if cfg.GcpProjectID == "" || cfg.GcpCloudSQLInstanceID == "" {
+		return nil, fmt.Errorf("The Cloud SQL connector requires gcp_project_id and gcp_cloudsql_instance_id to be set")
+	}
+
+	ipType := strings.ToUpper(cfg.GcpCloudSQLIPType)
+	switch ipType {
+	case "", "PUBLIC":
+		ipType = "PRIMARY"
+	case "PRIVATE":
+	default:
+		return nil, fmt.Errorf("Unsupported gcp_cloudsql_ip_type \"%s\", must be \"public\" or \"private\"", cfg.GcpCloudSQLIPType)
+	}
+
+	key := fmt.Sprintf("%s:%s:%s:%t", cfg.GcpProjectID, cfg.GcpCloudSQLInstanceID, ipType, cfg.GcpCloudSQLIAMAuth)
+
+	cloudSQLDialersMutex.Lock()
+	defer cloudSQLDialersMutex.Unlock()
+
+	if d, ok := cloudSQLDialers[key]; ok {
+		return d, nil
+	}
+
+	ctx := context.Background()
+	if cfg.HTTPClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, cfg.HTTPClient)
+	}
+	ts, err := getTokenSource(ctx, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("Could not generate key for Cloud SQL connector: %s", err)
+	}
+
+	d := &CloudSQLDialer{
+		projectID:   cfg.GcpProjectID,
+		instanceID:  cfg.GcpCloudSQLInstanceID,
+		ipType:      ipType,
+		iamAuth:     cfg.GcpCloudSQLIAMAuth,
+		client:      oauth2.NewClient(ctx, ts),
+		tokenSource: ts,
+		key:         rsaKey,
+	}
+	cloudSQLDialers[key] = d
+
+	return d, nil
+}
+
+// Dial - Opens a new connection to the instance, ignoring the passed address
+func (d *CloudSQLDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// DialTimeout - Opens a new connection to the instance, ignoring the passed address
+func (d *CloudSQLDialer) DialTimeout(network, address string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return d.DialContext(ctx, network, address)
+}
+
+// DialContext - Opens a new connection to the instance, ignoring the passed address
+func (d *CloudSQLDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	tlsConfig, instanceAddress, err := d.getConnectInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", instanceAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	if deadline, ok := ctx.Deadline(); ok {
+		tlsConn.SetDeadline(deadline)
+	}
+	err = tlsConn.Handshake()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("Could not establish TLS connection to Cloud SQL instance: %s", err)
+	}
+	tlsConn.SetDeadline(time.Time{})
+
+	return tlsConn, nil
+}
+
+func (d *CloudSQLDialer) getConnectInfo(ctx context.Context) (*tls.Config, string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.tlsConfig != nil && time.Now().Add(cloudSQLRefreshBuffer).Before(d.expiresAt) {
+		return d.tlsConfig, d.address, nil
+	}
+
+	err := d.refresh(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return d.tlsConfig, d.address, nil
+}
+
+func (d *CloudSQLDialer) instanceURL() string {
+	return fmt.Sprintf("%s/projects/%s/instances/%s", sqlAdminAPIURL, d.projectID, d.instanceID)
+}
+
+func (d *CloudSQLDialer) refresh(ctx context.Context) error {
+	req, err := http.NewRequest("GET", d.instanceURL()+"/connectSettings", nil)
+	if err != nil {
+		return err
+	}
+	data, err := doRequest(d.client, req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("Could not get Cloud SQL connection settings: %s", err)
+	}
+	var settings cloudSQLConnectSettings
+	err = json.Unmarshal(data, &settings)
+	if err != nil {
+		return fmt.Errorf("Could not parse Cloud SQL connection settings: %s", err)
+	}
+
+	var ipAddress string
+	for _, ip := range settings.IPAddresses {
+		if ip.Type == d.ipType {
+			ipAddress = ip.IPAddress
+			break
+		}
+	}
+	if ipAddress == "" {
+		return fmt.Errorf("Cloud SQL instance %s:%s does not have an IP address of type %s", d.projectID, d.instanceID, d.ipType)
+	}
+
+	caBlock, _ := pem.Decode([]byte(settings.ServerCaCert.Cert))
+	if caBlock == nil {
+		return fmt.Errorf("Could not decode Cloud SQL server CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		return fmt.Errorf("Could not parse Cloud SQL server CA certificate: %s", err)
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&d.key.PublicKey)
+	if err != nil {
+		return err
+	}
+	certRequest := map[string]string{
+		"public_key": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: publicKey})),
+	}
+	var tokenExpiry time.Time
+	if d.iamAuth {
+		token, err := d.tokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("Could not get token for Cloud SQL IAM database authentication: %s", err)
+		}
+		certRequest["access_token"] = token.AccessToken
+		tokenExpiry = token.Expiry
+	}
+	body, err := json.Marshal(certRequest)
+	if err != nil {
+		return err
+	}
+	req, err = http.NewRequest("POST", d.instanceURL()+":generateEphemeralCert", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	data, err = doRequest(d.client, req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("Could not generate Cloud SQL client certificate: %s", err)
+	}
+	var certResponse cloudSQLEphemeralCertResponse
+	err = json.Unmarshal(data, &certResponse)
+	if err != nil {
+		return fmt.Errorf("Could not parse Cloud SQL client certificate: %s", err)
+	}
+	certBlock, _ := pem.Decode([]byte(certResponse.EphemeralCert.Cert))
+	if certBlock == nil {
+		return fmt.Errorf("Could not decode Cloud SQL client certificate")
+	}
+	clientCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return fmt.Errorf("Could not parse Cloud SQL client certificate: %s", err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	serverName := d.projectID + ":" + d.instanceID
+
+	d.tlsConfig = &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{clientCert.Raw},
+			PrivateKey:  d.key,
+			Leaf:        clientCert,
+		}},
+		MinVersion: tls.VersionTLS13,
+		// The server certificate is issued for the instance name, not a hostname, so
+		// we verify it ourselves below instead of relying on the hostname check
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return fmt.Errorf("no server certificate presented")
+			}
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+			_, err = cert.Verify(x509.VerifyOptions{Roots: roots})
+			if err != nil {
+				return err
+			}
+			if cert.Subject.CommonName != serverName {
+				return fmt.Errorf("server certificate was issued for \"%s\", expected \"%s\"", cert.Subject.CommonName, serverName)
+			}
+			return nil
+		},
+	}
+	d.address = fmt.Sprintf("%s:%d", ipAddress, cloudSQLServerProxyPort)
+	d.expiresAt = clientCert.NotAfter
+	if !tokenExpiry.IsZero() && tokenExpiry.Before(d.expiresAt) {
+		d.expiresAt = tokenExpiry
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package gcputil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"golang.org/x/oauth2"
+)
+
+// Credential configuration files for workload identity federation, see
+// https://cloud.google.com/iam/docs/workload-identity-federation
+const externalAccountType = "external_account"
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+var googleapisHostRegexp = regexp.MustCompile(`^([a-z0-9-]+\.)*googleapis\.com$`)
+
+type externalAccountFormat struct {
+	Type                  string `json:"type"`
+	SubjectTokenFieldName string `json:"subject_token_field_name"`
+}
+
+type externalAccountCredentialSource struct {
+	File    string                `json:"file"`
+	URL     string                `json:"url"`
+	Headers map[string]string     `json:"headers"`
+	Format  externalAccountFormat `json:"format"`
+
+	// Only set for AWS-based credential sources
+	EnvironmentID               string `json:"environment_id"`
+	RegionURL                   string `json:"region_url"`
+	RegionalCredVerificationURL string `json:"regional_cred_verification_url"`
+}
+
+type externalAccountConfig struct {
+	Type                           string                          `json:"type"`
+	Audience                       string                          `json:"audience"`
+	SubjectTokenType               string                          `json:"subject_token_type"`
+	TokenURL                       string                          `json:"token_url"`
+	ServiceAccountImpersonationURL string                          `json:"service_account_impersonation_url"`
+	CredentialSource               externalAccountCredentialSource `json:"credential_source"`
+}
+
+type externalAccountTokenSource struct {
+	config     externalAccountConfig
+	httpClient *http.Client
+}
+
+func validateGoogleapisURL(name string, value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("Invalid %s \"%s\": %s", name, value, err)
+	}
+	if u.Scheme != "https" || !googleapisHostRegexp.MatchString(strings.ToLower(u.Hostname())) {
+		return fmt.Errorf("Invalid %s \"%s\": must be a https://*.googleapis.com URL", name, value)
+	}
+	return nil
+}
+
+func (c externalAccountConfig) validate() error {
+	if c.Audience == "" {
+		return fmt.Errorf("Missing \"audience\"")
+	}
+	if c.SubjectTokenType == "" {
+		return fmt.Errorf("Missing \"subject_token_type\"")
+	}
+	if c.TokenURL == "" {
+		return fmt.Errorf("Missing \"token_url\"")
+	}
+	if err := validateGoogleapisURL("token_url", c.TokenURL); err != nil {
+		return err
+	}
+	if c.ServiceAccountImpersonationURL != "" {
+		if err := validateGoogleapisURL("service_account_impersonation_url", c.ServiceAccountImpersonationURL); err != nil {
+			return err
+		}
+	}
+
+	source := c.CredentialSource
+	sources := 0
+	for _, s := range []string{source.File, source.URL, source.EnvironmentID} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("Exactly one of \"file\", \"url\" or \"environment_id\" must be set in \"credential_source\"")
+	}
+	if source.EnvironmentID != "" {
+		if source.EnvironmentID != "aws1" {
+			return fmt.Errorf("Unsupported \"environment_id\" \"%s\", only \"aws1\" is supported", source.EnvironmentID)
+		}
+		if source.RegionalCredVerificationURL == "" {
+			return fmt.Errorf("Missing \"regional_cred_verification_url\" in \"credential_source\"")
+		}
+	}
+	switch source.Format.Type {
+	case "", "text":
+	case "json":
+		if source.Format.SubjectTokenFieldName == "" {
+			return fmt.Errorf("Missing \"subject_token_field_name\" for JSON credential source format")
+		}
+	default:
+		return fmt.Errorf("Unsupported credential source format \"%s\"", source.Format.Type)
+	}
+
+	return nil
+}
+
+// readExternalAccountConfig - Parses the given credentials file, returning nil if its not a workload identity federation config
+func readExternalAccountConfig(filename string) (*externalAccountConfig, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read GCP credentials file: %s", err)
+	}
+
+	var c externalAccountConfig
+	err = json.Unmarshal(data, &c)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse GCP credentials file: %s", err)
+	}
+	if c.Type != externalAccountType {
+		return nil, nil
+	}
+
+	err = c.validate()
+	if err != nil {
+		return nil, fmt.Errorf("Invalid workload identity federation config in %s: %s", filename, err)
+	}
+
+	return &c, nil
+}
+
+func newExternalAccountTokenSource(c externalAccountConfig, httpClient *http.Client) oauth2.TokenSource {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return oauth2.ReuseTokenSource(nil, &externalAccountTokenSource{config: c, httpClient: httpClient})
+}
+
+func (ts *externalAccountTokenSource) Token() (*oauth2.Token, error) {
+	subjectToken, err := ts.subjectToken()
+	if err != nil {
+		return nil, fmt.Errorf("Could not retrieve subject token for workload identity federation: %s", err)
+	}
+
+	token, err := ts.exchangeToken(subjectToken)
+	if err != nil {
+		return nil, fmt.Errorf("Could not exchange subject token for workload identity federation: %s", err)
+	}
+
+	if ts.config.ServiceAccountImpersonationURL != "" {
+		token, err = generateAccessToken(ts.httpClient, ts.config.ServiceAccountImpersonationURL, token)
+		if err != nil {
+			return nil, fmt.Errorf("Could not impersonate service account for workload identity federation: %s", err)
+		}
+	}
+
+	return token, nil
+}
+
+func (ts *externalAccountTokenSource) subjectToken() (string, error) {
+	source := ts.config.CredentialSource
+
+	var data []byte
+	var err error
+	if source.EnvironmentID != "" {
+		return ts.awsSubjectToken()
+	} else if source.File != "" {
+		data, err = ioutil.ReadFile(source.File)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		req, err := http.NewRequest("GET", source.URL, nil)
+		if err != nil {
+			return "", err
+		}
+		for key, value := range source.Headers {
+			req.Header.Set(key, value)
+		}
+		data, err = doRequest(ts.httpClient, req)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if source.Format.Type == "json" {
+		var fields map[string]interface{}
+		err = json.Unmarshal(data, &fields)
+		if err != nil {
+			return "", fmt.Errorf("could not parse JSON subject token: %s", err)
+		}
+		token, ok := fields[source.Format.SubjectTokenFieldName].(string)
+		if !ok || token == "" {
+			return "", fmt.Errorf("subject token field \"%s\" not found", source.Format.SubjectTokenFieldName)
+		}
+		return token, nil
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// awsSubjectToken - Returns a signed GetCallerIdentity request, which Google's STS verifies against AWS
+func (ts *externalAccountTokenSource) awsSubjectToken() (string, error) {
+	sess, err := session.NewSession(&aws.Config{HTTPClient: ts.httpClient})
+	if err != nil {
+		return "", err
+	}
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		region, err = ec2metadata.New(sess).Region()
+		if err != nil {
+			return "", fmt.Errorf("could not determine AWS region: %s", err)
+		}
+	}
+
+	verificationURL := strings.Replace(ts.config.CredentialSource.RegionalCredVerificationURL, "{region}", region, 1)
+	return signAwsSubjectToken(verificationURL, ts.config.Audience, region, sess.Config.Credentials, time.Now())
+}
+
+// signAwsSubjectToken - Signs the GetCallerIdentity request, and serializes it in the subject token format Google's STS expects
+func signAwsSubjectToken(verificationURL string, audience string, region string, creds *credentials.Credentials, signTime time.Time) (string, error) {
+	req, err := http.NewRequest("POST", verificationURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("x-goog-cloud-target-resource", audience)
+
+	_, err = v4.NewSigner(creds).Sign(req, nil, "sts", region, signTime)
+	if err != nil {
+		return "", fmt.Errorf("could not sign AWS request: %s", err)
+	}
+
+	type header struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	headers := []header{{Key: "host", Value: req.URL.Host}}
+	for key := range req.Header {
+		headers = append(headers, header{Key: key, Value: req.Header.Get(key)})
+	}
+	sort.Slice(headers, func(i, j int) bool {
+		return strings.ToLower(headers[i].Key) < strings.ToLower(headers[j].Key)
+	})
+
+	token, err := json.Marshal(struct {
+		URL     string   `json:"url"`
+		Method  string   `json:"method"`
+		Headers []header `json:"headers"`
+	}{verificationURL, req.Method, headers})
+	if err != nil {
+		return "", err
+	}
+
+	return url.QueryEscape(string(token)), nil
+}
+
+func (ts *externalAccountTokenSource) exchangeToken(subjectToken string) (*oauth2.Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+	form.Set("audience", ts.config.Audience)
+	form.Set("scope", cloudPlatformScope)
+	form.Set("requested_token_type", "urn:ietf:params:oauth:token-type:access_token")
+	form.Set("subject_token", subjectToken)
+	form.Set("subject_token_type", ts.config.SubjectTokenType)
+
+	req, err := http.NewRequest("POST", ts.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	data, err := doRequest(ts.httpClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{
+		AccessToken: resp.AccessToken,
+		TokenType:   resp.TokenType,
+		Expiry:      time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second),
+	}, nil
+}
+
+func doRequest(httpClient *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code %d from %s: %s", resp.StatusCode, req.URL.Host, strings.TrimSpace(string(data)))
+	}
+
+	return data, nil
+}
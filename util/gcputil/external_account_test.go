@@ -0,0 +1,209 @@
+package gcputil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+const testAudience = "//iam.googleapis.com/projects/123456/locations/global/workloadIdentityPools/pool/providers/aws"
+
+func TestSignAwsSubjectToken(t *testing.T) {
+	creds := credentials.NewStaticCredentials("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "")
+	signTime := time.Date(2011, 9, 9, 23, 36, 0, 0, time.UTC)
+	verificationURL := "https://sts.us-east-2.amazonaws.com?Action=GetCallerIdentity&Version=2011-06-15"
+
+	token, err := signAwsSubjectToken(verificationURL, testAudience, "us-east-2", creds, signTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	unescaped, err := url.QueryUnescape(token)
+	if err != nil {
+		t.Fatalf("subject token is not query escaped: %s", err)
+	}
+	var actual struct {
+		URL     string `json:"url"`
+		Method  string `json:"method"`
+		Headers []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"headers"`
+	}
+	if err = json.Unmarshal([]byte(unescaped), &actual); err != nil {
+		t.Fatalf("subject token is not valid JSON: %s", err)
+	}
+
+	if actual.URL != verificationURL || actual.Method != "POST" {
+		t.Errorf("unexpected request: %s %s", actual.Method, actual.URL)
+	}
+
+	// Signature computed independently following the AWS Signature Version 4 specification
+	expected := [][2]string{
+		{"Authorization", "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20110909/us-east-2/sts/aws4_request, SignedHeaders=host;x-amz-date;x-goog-cloud-target-resource, Signature=2ea38149aa79a7194e90dd6b82457ae8db04d48fa21153c33ee3f78f8e862e4b"},
+		{"host", "sts.us-east-2.amazonaws.com"},
+		{"X-Amz-Date", "20110909T233600Z"},
+		{"X-Goog-Cloud-Target-Resource", testAudience},
+	}
+	if len(actual.Headers) != len(expected) {
+		t.Fatalf("expected %d headers, got %d: %+v", len(expected), len(actual.Headers), actual.Headers)
+	}
+	for i, h := range actual.Headers {
+		if h.Key != expected[i][0] || h.Value != expected[i][1] {
+			t.Errorf("header %d: expected %s: %s, got %s: %s", i, expected[i][0], expected[i][1], h.Key, h.Value)
+		}
+	}
+}
+
+func TestExternalAccountTokenExchange(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("could not parse STS request: %s", err)
+		}
+		expected := map[string]string{
+			"grant_type":           "urn:ietf:params:oauth:grant-type:token-exchange",
+			"audience":             testAudience,
+			"scope":                cloudPlatformScope,
+			"requested_token_type": "urn:ietf:params:oauth:token-type:access_token",
+			"subject_token":        "oidc-subject-token",
+			"subject_token_type":   "urn:ietf:params:oauth:token-type:jwt",
+		}
+		for key, value := range expected {
+			if r.PostForm.Get(key) != value {
+				t.Errorf("STS request: expected %s=%q, got %q", key, value, r.PostForm.Get(key))
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"federated-token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/v1/projects/-/serviceAccounts/sa@example.iam.gserviceaccount.com:generateAccessToken", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer federated-token" {
+			t.Errorf("impersonation request: expected federated token, got %q", auth)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"accessToken":"service-account-token","expireTime":"2030-01-01T00:00:00Z"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "gcputil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token.json")
+	if err = ioutil.WriteFile(tokenFile, []byte(`{"id_token":"oidc-subject-token"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := externalAccountConfig{
+		Type:                           externalAccountType,
+		Audience:                       testAudience,
+		SubjectTokenType:               "urn:ietf:params:oauth:token-type:jwt",
+		TokenURL:                       server.URL + "/v1/token",
+		ServiceAccountImpersonationURL: server.URL + "/v1/projects/-/serviceAccounts/sa@example.iam.gserviceaccount.com:generateAccessToken",
+		CredentialSource: externalAccountCredentialSource{
+			File:   tokenFile,
+			Format: externalAccountFormat{Type: "json", SubjectTokenFieldName: "id_token"},
+		},
+	}
+
+	token, err := newExternalAccountTokenSource(c, server.Client()).Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "service-account-token" {
+		t.Errorf("expected impersonated access token, got %q", token.AccessToken)
+	}
+
+	c.ServiceAccountImpersonationURL = ""
+	token, err = newExternalAccountTokenSource(c, server.Client()).Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "federated-token" || token.Expiry.Before(time.Now().Add(59*time.Minute)) {
+		t.Errorf("expected federated access token valid for one hour, got %q expiring %s", token.AccessToken, token.Expiry)
+	}
+}
+
+func TestExternalAccountTokenExchangeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant"}`))
+	}))
+	defer server.Close()
+
+	ts := &externalAccountTokenSource{
+		config:     externalAccountConfig{Audience: testAudience, TokenURL: server.URL},
+		httpClient: server.Client(),
+	}
+	_, err := ts.exchangeToken("subject")
+	if err == nil {
+		t.Fatal("expected error for rejected token exchange")
+	}
+}
+
+var externalAccountConfigTests = []struct {
+	config    externalAccountConfig
+	expectErr bool
+}{
+	{
+		externalAccountConfig{
+			Audience:         testAudience,
+			SubjectTokenType: "urn:ietf:params:aws:token-type:aws4_request",
+			TokenURL:         "https://sts.googleapis.com/v1/token",
+			CredentialSource: externalAccountCredentialSource{
+				EnvironmentID:               "aws1",
+				RegionalCredVerificationURL: "https://sts.{region}.amazonaws.com?Action=GetCallerIdentity&Version=2011-06-15",
+			},
+		},
+		false,
+	},
+	{
+		externalAccountConfig{
+			Audience:         testAudience,
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+			TokenURL:         "https://sts.example.com/v1/token",
+			CredentialSource: externalAccountCredentialSource{File: "/var/run/token"},
+		},
+		true,
+	},
+	{
+		externalAccountConfig{
+			Audience:         testAudience,
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+			TokenURL:         "https://sts.googleapis.com/v1/token",
+			CredentialSource: externalAccountCredentialSource{File: "/var/run/token", URL: "http://169.254.169.254/token"},
+		},
+		true,
+	},
+	{
+		externalAccountConfig{
+			Audience:         testAudience,
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+			TokenURL:         "https://sts.googleapis.com/v1/token",
+			CredentialSource: externalAccountCredentialSource{File: "/var/run/token", Format: externalAccountFormat{Type: "json"}},
+		},
+		true,
+	},
+}
+
+func TestExternalAccountConfigValidate(t *testing.T) {
+	for i, test := range externalAccountConfigTests {
+		err := test.config.validate()
+		if test.expectErr && err == nil {
+			t.Errorf("test %d: expected error, got none", i)
+		} else if !test.expectErr && err != nil {
+			t.Errorf("test %d: unexpected error: %s", i, err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package gcputil
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/pganalyze/collector/config"
+	"github.com/pganalyze/collector/util"
+)
+
+func getCredentialsFile(cfg config.ServerConfig) string {
+	if cfg.GcpCredentialsFile != "" {
+		return cfg.GcpCredentialsFile
+	}
+	return os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+}
+
+// GetClientOptions - Returns the options for creating Google Cloud API clients for the specified server configuration
+func GetClientOptions(cfg config.ServerConfig, logger *util.Logger) ([]option.ClientOption, error) {
+	var opts []option.ClientOption
+
+	credentialsFile := getCredentialsFile(cfg)
+	if credentialsFile != "" {
+		externalAccount, err := readExternalAccountConfig(credentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		if externalAccount != nil {
+			logger.PrintVerbose("Using GCP workload identity federation config located at: %s", credentialsFile)
+			opts = append(opts, option.WithTokenSource(newExternalAccountTokenSource(*externalAccount, cfg.HTTPClient)))
+		} else {
+			logger.PrintVerbose("Using GCP credentials file located at: %s", credentialsFile)
+			opts = append(opts, option.WithCredentialsFile(credentialsFile))
+		}
+	} else {
+		logger.PrintVerbose("No GCP credentials file provided; assuming GKE workload identity or VM-associated service account")
+	}
+
+	if cfg.GcpImpersonateServiceAccount != "" {
+		logger.PrintVerbose("Impersonating GCP service account: %s", cfg.GcpImpersonateServiceAccount)
+		opts = append(opts, option.ImpersonateCredentials(cfg.GcpImpersonateServiceAccount))
+	}
+
+	return opts, nil
+}
+
+// GetHTTPClient - Returns an HTTP client for Google Cloud REST APIs, authenticated the same way as GetClientOptions
+func GetHTTPClient(ctx context.Context, cfg config.ServerConfig, logger *util.Logger) (*http.Client, error) {
+	if cfg.HTTPClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, cfg.HTTPClient)
+	}
+
+	ts, err := getTokenSource(ctx, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth2.NewClient(ctx, ts), nil
+}
+
+func getTokenSource(ctx context.Context, cfg config.ServerConfig, logger *util.Logger) (oauth2.TokenSource, error) {
+	var ts oauth2.TokenSource
+
+	credentialsFile := getCredentialsFile(cfg)
+	if credentialsFile != "" {
+		externalAccount, err := readExternalAccountConfig(credentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		if externalAccount != nil {
+			logger.PrintVerbose("Using GCP workload identity federation config located at: %s", credentialsFile)
+			ts = newExternalAccountTokenSource(*externalAccount, cfg.HTTPClient)
+		} else {
+			logger.PrintVerbose("Using GCP credentials file located at: %s", credentialsFile)
+			data, err := ioutil.ReadFile(credentialsFile)
+			if err != nil {
+				return nil, fmt.Errorf("Could not read GCP credentials file: %s", err)
+			}
+			creds, err := google.CredentialsFromJSON(ctx, data, cloudPlatformScope)
+			if err != nil {
+				return nil, fmt.Errorf("Could not parse GCP credentials file: %s", err)
+			}
+			ts = creds.TokenSource
+		}
+	} else {
+		logger.PrintVerbose("No GCP credentials file provided; assuming GKE workload identity or VM-associated service account")
+		var err error
+		ts, err = google.DefaultTokenSource(ctx, cloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("Could not find default GCP credentials: %s", err)
+		}
+	}
+
+	if cfg.GcpImpersonateServiceAccount != "" {
+		logger.PrintVerbose("Impersonating GCP service account: %s", cfg.GcpImpersonateServiceAccount)
+		ts = newImpersonatedTokenSource(ts, cfg.GcpImpersonateServiceAccount, cfg.HTTPClient)
+	}
+
+	return ts, nil
+}
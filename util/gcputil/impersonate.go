@@ -0,0 +1,79 @@
+package gcputil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const generateAccessTokenURL = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+
+type impersonatedTokenSource struct {
+	base       oauth2.TokenSource
+	target     string
+	httpClient *http.Client
+}
+
+func newImpersonatedTokenSource(base oauth2.TokenSource, target string, httpClient *http.Client) oauth2.TokenSource {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return oauth2.ReuseTokenSource(nil, &impersonatedTokenSource{base: base, target: target, httpClient: httpClient})
+}
+
+func (ts *impersonatedTokenSource) Token() (*oauth2.Token, error) {
+	token, err := ts.base.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err = generateAccessToken(ts.httpClient, fmt.Sprintf(generateAccessTokenURL, ts.target), token)
+	if err != nil {
+		return nil, fmt.Errorf("Could not impersonate service account %s: %s", ts.target, err)
+	}
+
+	return token, nil
+}
+
+// generateAccessToken - Calls the IAM credentials API to get an access token for a service account, authenticated by the given token
+func generateAccessToken(httpClient *http.Client, url string, token *oauth2.Token) (*oauth2.Token, error) {
+	body, err := json.Marshal(map[string]interface{}{"scope": []string{cloudPlatformScope}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(body)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	token.SetAuthHeader(req)
+
+	data, err := doRequest(httpClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		AccessToken string `json:"accessToken"`
+		ExpireTime  string `json:"expireTime"`
+	}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := time.Parse(time.RFC3339, resp.ExpireTime)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse expiry time: %s", err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: resp.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      expiry,
+	}, nil
+}
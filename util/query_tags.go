@@ -0,0 +1,70 @@
+package util
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	pg_query "github.com/pganalyze/pg_query_go/v2"
+)
+
+const sqlcommenterPair = `([^=,'\s]+)='((?:[^'\\]|\\.)*)'`
+
+var sqlcommenterPairRegexp = regexp.MustCompile(sqlcommenterPair)
+var sqlcommenterRegexp = regexp.MustCompile(`^\s*` + sqlcommenterPair + `(?:\s*,\s*` + sqlcommenterPair + `)*\s*$`)
+var marginaliaRegexp = regexp.MustCompile(`^\s*[\w.-]+:[^,]+(?:,[\w.-]+:[^,]+)*\s*$`)
+
+// ParseQueryTags - Extracts the key/value tags of sqlcommenter ("/*key='value',...*/") and
+// marginalia ("/*key:value,...*/") comments in the query text, for attributing queries to the
+// application endpoints that ran them (e.g. by "application", "controller", "route" or "traceparent")
+//
+// Comments that are neither are ignored. Returns nil if the query has no tags.
+func ParseQueryTags(query string) map[string]string {
+	if !strings.Contains(query, "/*") {
+		return nil
+	}
+	result, err := pg_query.Scan(query)
+	if err != nil {
+		return nil
+	}
+
+	var tags map[string]string
+	for _, token := range result.Tokens {
+		if token.Token != pg_query.Token_C_COMMENT {
+			continue
+		}
+		comment := query[token.Start:token.End]
+		comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/")
+		for key, value := range parseCommentTags(comment) {
+			if tags == nil {
+				tags = make(map[string]string)
+			}
+			tags[key] = value
+		}
+	}
+	return tags
+}
+
+func parseCommentTags(comment string) map[string]string {
+	tags := make(map[string]string)
+	if sqlcommenterRegexp.MatchString(comment) {
+		// Keys and values are URL encoded, and single quotes in values are escaped with a backslash
+		for _, pair := range sqlcommenterPairRegexp.FindAllStringSubmatch(comment, -1) {
+			key, err := url.PathUnescape(pair[1])
+			if err != nil {
+				continue
+			}
+			value, err := url.PathUnescape(strings.ReplaceAll(pair[2], `\'`, `'`))
+			if err != nil {
+				continue
+			}
+			tags[key] = value
+		}
+	} else if marginaliaRegexp.MatchString(comment) {
+		for _, pair := range strings.Split(strings.TrimSpace(comment), ",") {
+			keyValue := strings.SplitN(pair, ":", 2)
+			tags[keyValue[0]] = keyValue[1]
+		}
+	}
+	return tags
+}
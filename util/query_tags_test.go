@@ -0,0 +1,56 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pganalyze/collector/util"
+)
+
+var queryTagsTests = []struct {
+	input    string
+	expected map[string]string
+}{
+	{
+		"SELECT * FROM users /*action='%2Fusers%2F%3Aid',controller='users',framework='rails%3A7.0',traceparent='00-5bd66ef5095369c7b0d1f8f4bd33716a-c532cb4098ac3dd2-01'*/",
+		map[string]string{
+			"action":      "/users/:id",
+			"controller":  "users",
+			"framework":   "rails:7.0",
+			"traceparent": "00-5bd66ef5095369c7b0d1f8f4bd33716a-c532cb4098ac3dd2-01",
+		},
+	},
+	{
+		"SELECT * FROM users WHERE id = $1 /*application:Shop,controller:users,action:show,line:/app/models/user.rb:12*/",
+		map[string]string{
+			"application": "Shop",
+			"controller":  "users",
+			"action":      "show",
+			"line":        "/app/models/user.rb:12",
+		},
+	},
+	{
+		"/* route='checkout', note='it\\'s' */ SELECT 1",
+		map[string]string{
+			"route": "checkout",
+			"note":  "it's",
+		},
+	},
+	{
+		"SELECT '/*controller=''users''*/' /* just a comment */",
+		nil,
+	},
+	{
+		"SELECT 1",
+		nil,
+	},
+}
+
+func TestParseQueryTags(t *testing.T) {
+	for _, test := range queryTagsTests {
+		actual := util.ParseQueryTags(test.input)
+		if diff := pretty.Compare(test.expected, actual); diff != "" {
+			t.Errorf("\nQuery: %s\n diff: (-want +got)\n%s", test.input, diff)
+		}
+	}
+}
@@ -23,6 +23,10 @@ const QueryTextInsufficientPrivs string = "<insufficient privilege>"
 // QueryTextCollector - Query generated by the pganalyze collector itself.
 const QueryTextCollector string = "<pganalyze-collector>"
 
+// QueryTextRemainder - Statistics of all queries in a database that were not sent individually,
+// because of the query_stats_limit setting.
+const QueryTextRemainder string = "<remaining queries>"
+
 func fixTruncatedQuery(query string) string {
 	if strings.Count(query, "'")%2 == 1 { // Odd number of '
 		query += "'"